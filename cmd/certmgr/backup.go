@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/logeable/certmgr/internal/service"
+)
+
+// The archive passphrase is read from CERTMGR_BACKUP_PASSPHRASE. It may only
+// be empty when no certificate has a key in the database.
+func backup(args []string) error {
+	fs := flag.NewFlagSet("backup", flag.ExitOnError)
+	keyFile := addKeyFileFlag(fs)
+	out := fs.String("o", "", "output archive file")
+	_ = fs.Parse(args)
+	if *out == "" {
+		return fmt.Errorf("-o is required")
+	}
+
+	ctx := commandContext()
+	svcCtx, closeFn, err := openServiceContext(ctx, *keyFile)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	data, err := service.NewBackupService(svcCtx).Backup(ctx, os.Getenv("CERTMGR_BACKUP_PASSPHRASE"))
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(*out, data, 0600)
+	if err != nil {
+		return fmt.Errorf("write archive failed: %w", err)
+	}
+	fmt.Printf("backup written to %s\n", *out)
+	return nil
+}
+
+func restore(args []string) error {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	keyFile := addKeyFileFlag(fs)
+	in := fs.String("i", "", "archive file to restore")
+	mode := fs.String("mode", service.RestoreModeMerge, "restore mode, replace or merge")
+	_ = fs.Parse(args)
+	if *in == "" {
+		return fmt.Errorf("-i is required")
+	}
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		return fmt.Errorf("read archive failed: %w", err)
+	}
+	ctx := commandContext()
+	svcCtx, closeFn, err := openServiceContext(ctx, *keyFile)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	result, err := service.NewBackupService(svcCtx).Restore(ctx, data, *mode, os.Getenv("CERTMGR_BACKUP_PASSPHRASE"))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("restored %d namespaces and %d certificates, skipped %d existing certificates\n",
+		result.Namespaces, result.Certificates, result.Skipped)
+	return nil
+}
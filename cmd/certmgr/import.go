@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/logeable/certmgr/internal/service"
+)
+
+// importDir inventories certificates and keys found under a directory. PKCS#12
+// passwords are read from CERTMGR_P12_PASSWORDS, separated by newlines.
+func importDir(args []string) error {
+	fs := flag.NewFlagSet("import-dir", flag.ExitOnError)
+	keyFile := addKeyFileFlag(fs)
+	dir := fs.String("dir", "", "directory to scan")
+	namespaceID := fs.Int("namespace", 0, "id of the namespace to import into")
+	asJSON := fs.Bool("json", false, "print the report as JSON")
+	_ = fs.Parse(args)
+	if *dir == "" || *namespaceID == 0 {
+		return fmt.Errorf("-dir and -namespace are required")
+	}
+	var passwords []string
+	if env := os.Getenv("CERTMGR_P12_PASSWORDS"); env != "" {
+		passwords = strings.Split(env, "\n")
+	}
+
+	ctx := commandContext()
+	svcCtx, closeFn, err := openServiceContext(ctx, *keyFile)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	result, err := service.NewNamespaceService(svcCtx).ImportDirectory(ctx, *namespaceID, service.ScanImportReq{
+		Dir:          *dir,
+		P12Passwords: passwords,
+	})
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	fileCounts := make(map[string]int)
+	certCounts := make(map[string]int)
+	for _, file := range result.Files {
+		fileCounts[file.Status]++
+		if file.Status == service.ScanFileSkipped {
+			continue
+		}
+		line := fmt.Sprintf("%-10s %s", file.Status, file.Path)
+		if file.Reason != "" {
+			line += ": " + file.Reason
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+	for _, cert := range result.Certificates {
+		certCounts[cert.Status]++
+		line := fmt.Sprintf("%-10s %s", cert.Status, cert.Subject)
+		if cert.CertificateID != 0 {
+			line += fmt.Sprintf(" (id %d)", cert.CertificateID)
+		}
+		if cert.KeyPath != "" {
+			line += " with key"
+		}
+		if cert.Reason != "" {
+			line += ": " + cert.Reason
+		}
+		fmt.Println(line)
+	}
+	for _, path := range result.UnmatchedKeys {
+		fmt.Printf("%-10s %s: private key matches no certificate\n", "unmatched", path)
+	}
+	fmt.Printf("\nscanned %d files: %d matched, %d skipped, %d unreadable\n",
+		len(result.Files), fileCounts[service.ScanFileMatched], fileCounts[service.ScanFileSkipped], fileCounts[service.ScanFileUnreadable])
+	fmt.Printf("imported %d certificates, added %d keys, skipped %d existing and %d unlinked certificates\n",
+		certCounts[service.ScanCertImported], certCounts[service.ScanCertKeyAdded], certCounts[service.ScanCertDuplicate], certCounts[service.ScanCertSkipped])
+	return nil
+}
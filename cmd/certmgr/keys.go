@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/logeable/certmgr/internal/service"
+)
+
+func genKeyFile(args []string) error {
+	fs := flag.NewFlagSet("gen-key-file", flag.ExitOnError)
+	out := fs.String("o", "", "output path of the key file")
+	_ = fs.Parse(args)
+	if *out == "" {
+		return fmt.Errorf("-o is required")
+	}
+	return service.GenerateKeyFile(*out)
+}
+
+func encryptKeys(args []string) error {
+	fs := flag.NewFlagSet("encrypt-keys", flag.ExitOnError)
+	keyFile := addKeyFileFlag(fs)
+	_ = fs.Parse(args)
+	if *keyFile == "" && os.Getenv("CERTMGR_PASSPHRASE") == "" {
+		return fmt.Errorf("a passphrase or key file is required")
+	}
+
+	ctx := commandContext()
+	svcCtx, closeFn, err := openServiceContext(ctx, *keyFile)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	count, err := svcCtx.EncryptExistingKeys(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("encrypted %d private keys\n", count)
+	return nil
+}
+
+func rotateMasterKey(args []string) error {
+	fs := flag.NewFlagSet("rotate-master-key", flag.ExitOnError)
+	keyFile := addKeyFileFlag(fs)
+	newKeyFile := fs.String("new-key-file", "", "new master key file, the new passphrase can be set by CERTMGR_NEW_PASSPHRASE instead")
+	_ = fs.Parse(args)
+	next := service.MasterKeySource{
+		Passphrase: os.Getenv("CERTMGR_NEW_PASSPHRASE"),
+		KeyFile:    *newKeyFile,
+	}
+	if next.IsZero() {
+		return fmt.Errorf("a new passphrase or key file is required")
+	}
+
+	ctx := commandContext()
+	svcCtx, closeFn, err := openServiceContext(ctx, *keyFile)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	count, err := svcCtx.RotateMasterKey(ctx, next)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("re-wrapped %d private keys\n", count)
+	return nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/logeable/certmgr/internal/infra"
+	"github.com/logeable/certmgr/internal/service"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands = []command{
+	{name: "gen-key-file", usage: "generate a random master key file", run: genKeyFile},
+	{name: "encrypt-keys", usage: "encrypt private keys that are still stored in plain text", run: encryptKeys},
+	{name: "rotate-master-key", usage: "re-wrap every data key under a new master key", run: rotateMasterKey},
+	{name: "signer", usage: "run an external signing process for the socket key backend", run: runSigner},
+	{name: "sign-bundle", usage: "sign a signing request bundle with an offline CA key", run: signBundle},
+	{name: "backup", usage: "write an archive of all namespaces and certificates", run: backup},
+	{name: "restore", usage: "load an archive written by backup", run: restore},
+	{name: "import-dir", usage: "import certificates and keys found under a directory", run: importDir},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(2)
+	}
+	for _, cmd := range commands {
+		if cmd.name == os.Args[1] {
+			if err := cmd.run(os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", cmd.name, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+	printUsage()
+	os.Exit(2)
+}
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "usage: certmgr <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-20s %s\n", cmd.name, cmd.usage)
+	}
+}
+
+// addKeyFileFlag registers the -key-file flag shared by every command that
+// needs to unlock the keyring. The passphrase comes from CERTMGR_PASSPHRASE.
+func addKeyFileFlag(fs *flag.FlagSet) *string {
+	return fs.String("key-file", "", "master key file, the passphrase can be set by CERTMGR_PASSPHRASE instead")
+}
+
+// commandContext carries the local user as the actor of everything a command
+// records in the audit log.
+func commandContext() context.Context {
+	return service.WithAuditActor(context.Background(), service.AuditSourceCLI, service.LocalUser())
+}
+
+func openServiceContext(ctx context.Context, keyFile string) (*service.ServiceContext, func(), error) {
+	client, err := infra.InitDB()
+	if err != nil {
+		return nil, nil, fmt.Errorf("init db failed: %w", err)
+	}
+	closeFn := func() {
+		_ = client.Close()
+	}
+	svcCtx := service.NewServiceContext(client)
+	err = svcCtx.Unlock(ctx, service.MasterKeySource{
+		Passphrase: os.Getenv("CERTMGR_PASSPHRASE"),
+		KeyFile:    keyFile,
+	})
+	if err != nil {
+		closeFn()
+		return nil, nil, fmt.Errorf("unlock keyring failed: %w", err)
+	}
+	if _, err := svcCtx.BackfillCertificateAttributes(ctx); err != nil {
+		closeFn()
+		return nil, nil, fmt.Errorf("backfill certificate attributes failed: %w", err)
+	}
+	return svcCtx, closeFn, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/logeable/certmgr/internal/service"
+)
+
+// signBundle signs a bundle downloaded from a signing request with an offline
+// CA key. It only touches the given files, so it can run on an air-gapped host.
+func signBundle(args []string) error {
+	fs := flag.NewFlagSet("sign-bundle", flag.ExitOnError)
+	bundlePath := fs.String("bundle", "", "signing bundle downloaded from certmgr")
+	keyPath := fs.String("key", "", "offline CA key, the passphrase is read from CERTMGR_OFFLINE_PASSPHRASE")
+	out := fs.String("o", "", "output certificate file, stdout if empty")
+	_ = fs.Parse(args)
+	if *bundlePath == "" || *keyPath == "" {
+		return fmt.Errorf("-bundle and -key are required")
+	}
+
+	bundleData, err := os.ReadFile(*bundlePath)
+	if err != nil {
+		return fmt.Errorf("read bundle failed: %w", err)
+	}
+	var bundle service.SigningBundle
+	if err := json.Unmarshal(bundleData, &bundle); err != nil {
+		return fmt.Errorf("parse bundle failed: %w", err)
+	}
+	keyData, err := os.ReadFile(*keyPath)
+	if err != nil {
+		return fmt.Errorf("read key failed: %w", err)
+	}
+	signer, err := service.OpenOfflineKey(keyData, os.Getenv("CERTMGR_OFFLINE_PASSPHRASE"))
+	if err != nil {
+		return err
+	}
+	certPem, err := service.SignBundle(&bundle, signer)
+	if err != nil {
+		return fmt.Errorf("sign bundle %d failed: %w", bundle.RequestID, err)
+	}
+
+	if *out == "" {
+		fmt.Print(certPem)
+		return nil
+	}
+	err = os.WriteFile(*out, []byte(certPem), 0644)
+	if err != nil {
+		return fmt.Errorf("write certificate failed: %w", err)
+	}
+	return nil
+}
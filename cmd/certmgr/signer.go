@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/logeable/certmgr/internal/service"
+)
+
+// runSigner serves the socket key backend: the server only ever sees key ids,
+// public keys and signatures, the keys stay in -dir.
+func runSigner(args []string) error {
+	fs := flag.NewFlagSet("signer", flag.ExitOnError)
+	socket := fs.String("socket", "", "unix socket to listen on")
+	dir := fs.String("dir", "", "directory holding the signing keys")
+	_ = fs.Parse(args)
+	if *socket == "" || *dir == "" {
+		return fmt.Errorf("-socket and -dir are required")
+	}
+	err := os.MkdirAll(*dir, 0700)
+	if err != nil {
+		return fmt.Errorf("create key dir failed: %w", err)
+	}
+	_ = os.Remove(*socket)
+	ln, err := net.Listen("unix", *socket)
+	if err != nil {
+		return fmt.Errorf("listen failed: %w", err)
+	}
+	err = os.Chmod(*socket, 0600)
+	if err != nil {
+		return fmt.Errorf("chmod socket failed: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	fmt.Printf("signer listening on %s\n", *socket)
+	return service.ServeSocketSigner(ctx, ln, *dir)
+}
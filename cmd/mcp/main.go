@@ -1,15 +1,29 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"os"
 
 	"github.com/logeable/certmgr/internal/infra"
 	"github.com/logeable/certmgr/internal/mcptools"
+	"github.com/logeable/certmgr/internal/service"
 	"github.com/mark3labs/mcp-go/server"
 	_ "github.com/mattn/go-sqlite3"
 )
 
+var (
+	keyFile      = flag.String("key-file", "", "master key file used to encrypt private keys, the passphrase can be set by CERTMGR_PASSPHRASE instead")
+	signerSocket = flag.String("signer-socket", "", "unix socket of an external signing process, enables the socket key backend")
+	pkcs11Module = flag.String("pkcs11-module", "", "path of a PKCS#11 module, enables the pkcs11 key backend, the PIN is read from CERTMGR_PKCS11_PIN")
+	pkcs11Slot   = flag.Int("pkcs11-slot", 0, "PKCS#11 slot number")
+	strictLint   = flag.Bool("strict-lint", false, "refuse to issue certificates with lint errors instead of only logging them")
+)
+
 func main() {
+	flag.Parse()
+
 	dbClient, err := infra.InitDB()
 	if err != nil {
 		panic(err)
@@ -21,9 +35,40 @@ func main() {
 		}
 	}()
 
+	svcCtx := service.NewServiceContext(dbClient)
+	svcCtx.SetStrictLint(*strictLint)
+	if *signerSocket != "" {
+		svcCtx.RegisterKeyStore(service.SocketKeyBackend, service.NewSocketKeyStore(*signerSocket))
+	}
+	if *pkcs11Module != "" {
+		pkcs11KeyStore, err := service.NewPKCS11KeyStore(service.PKCS11Config{
+			ModulePath: *pkcs11Module,
+			SlotNumber: *pkcs11Slot,
+			Pin:        os.Getenv("CERTMGR_PKCS11_PIN"),
+		})
+		if err != nil {
+			panic(fmt.Errorf("failed to init pkcs11 key store: %v", err))
+		}
+		defer pkcs11KeyStore.Close()
+		svcCtx.RegisterKeyStore(service.PKCS11KeyBackend, pkcs11KeyStore)
+	}
+	err = svcCtx.Unlock(context.Background(), service.MasterKeySource{
+		Passphrase: os.Getenv("CERTMGR_PASSPHRASE"),
+		KeyFile:    *keyFile,
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to unlock keyring: %v", err))
+	}
+	if _, err := svcCtx.EncryptExistingKeys(context.Background()); err != nil {
+		panic(fmt.Errorf("failed to encrypt existing keys: %v", err))
+	}
+	if _, err := svcCtx.BackfillCertificateAttributes(context.Background()); err != nil {
+		panic(fmt.Errorf("failed to backfill certificate attributes: %v", err))
+	}
+
 	s := server.NewMCPServer("certmgr", "v0.1.0", server.WithToolCapabilities(false))
 
-	tools, err := mcptools.InitTools(dbClient)
+	tools, err := mcptools.InitTools(svcCtx)
 	if err != nil {
 		panic(err)
 	}
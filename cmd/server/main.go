@@ -1,17 +1,40 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/logeable/certmgr/internal/api"
 	"github.com/logeable/certmgr/internal/infra"
+	"github.com/logeable/certmgr/internal/scheduler"
+	"github.com/logeable/certmgr/internal/service"
 	_ "github.com/mattn/go-sqlite3"
 	"go.uber.org/zap"
 )
 
-var port = flag.Int("port", 0, "port to listen on")
+var (
+	port         = flag.Int("port", 0, "port to listen on")
+	keyFile      = flag.String("key-file", "", "master key file used to encrypt private keys, the passphrase can be set by CERTMGR_PASSPHRASE instead")
+	signerSocket = flag.String("signer-socket", "", "unix socket of an external signing process, enables the socket key backend")
+	pkcs11Module = flag.String("pkcs11-module", "", "path of a PKCS#11 module, enables the pkcs11 key backend, the PIN is read from CERTMGR_PKCS11_PIN")
+	pkcs11Slot   = flag.Int("pkcs11-slot", 0, "PKCS#11 slot number")
+	strictLint   = flag.Bool("strict-lint", false, "refuse to issue certificates with lint errors instead of only logging them")
+
+	expiryInterval   = flag.Duration("expiry-scan-interval", time.Hour, "how often to scan for expiring certificates, 0 disables the scan")
+	expiryThresholds = flag.String("expiry-thresholds", "30,7,1", "comma separated days before expiry at which to notify")
+	notifyWebhook    = flag.String("notify-webhook", "", "URL that receives expiry notices as JSON")
+	notifySMTPAddr   = flag.String("notify-smtp-addr", "", "SMTP server (host:port) used to mail expiry notices, credentials are read from CERTMGR_SMTP_USERNAME and CERTMGR_SMTP_PASSWORD")
+	notifySMTPFrom   = flag.String("notify-smtp-from", "certmgr@localhost", "sender address of expiry mails")
+	notifySMTPTo     = flag.String("notify-smtp-to", "", "comma separated recipients of expiry mails")
+
+	renewInterval = flag.Duration("renew-interval", 10*time.Minute, "how often to run due renewal policies, 0 disables automatic renewal")
+)
 
 func main() {
 	flag.Parse()
@@ -34,11 +57,110 @@ func main() {
 	}
 	defer client.Close()
 
+	svcCtx := service.NewServiceContext(client)
+	svcCtx.SetStrictLint(*strictLint)
+	if *signerSocket != "" {
+		svcCtx.RegisterKeyStore(service.SocketKeyBackend, service.NewSocketKeyStore(*signerSocket))
+	}
+	if *pkcs11Module != "" {
+		pkcs11KeyStore, err := service.NewPKCS11KeyStore(service.PKCS11Config{
+			ModulePath: *pkcs11Module,
+			SlotNumber: *pkcs11Slot,
+			Pin:        os.Getenv("CERTMGR_PKCS11_PIN"),
+		})
+		if err != nil {
+			zap.L().Fatal("failed to init pkcs11 key store", zap.Error(err))
+		}
+		defer pkcs11KeyStore.Close()
+		svcCtx.RegisterKeyStore(service.PKCS11KeyBackend, pkcs11KeyStore)
+	}
+	err = svcCtx.Unlock(context.Background(), service.MasterKeySource{
+		Passphrase: os.Getenv("CERTMGR_PASSPHRASE"),
+		KeyFile:    *keyFile,
+	})
+	if err != nil {
+		zap.L().Fatal("failed to unlock keyring", zap.Error(err))
+	}
+	encrypted, err := svcCtx.EncryptExistingKeys(context.Background())
+	if err != nil {
+		zap.L().Fatal("failed to encrypt existing keys", zap.Error(err))
+	}
+	if encrypted > 0 {
+		zap.L().Info("encrypted existing private keys", zap.Int("count", encrypted))
+	}
+	backfilled, err := svcCtx.BackfillCertificateAttributes(context.Background())
+	if err != nil {
+		zap.L().Fatal("failed to backfill certificate attributes", zap.Error(err))
+	}
+	if backfilled > 0 {
+		zap.L().Info("backfilled certificate attributes", zap.Int("count", backfilled))
+	}
+
+	thresholds, err := parseThresholds(*expiryThresholds)
+	if err != nil {
+		zap.L().Fatal("invalid expiry thresholds", zap.Error(err))
+	}
+	expirySvc := service.NewExpiryService(svcCtx, thresholds, buildNotifiers())
+	renewalSvc := service.NewRenewalService(svcCtx)
+	scheduler.Start(context.Background(), scheduler.Job{
+		Name:     "expiry-scan",
+		Interval: *expiryInterval,
+		Run: func(ctx context.Context) error {
+			sent, err := expirySvc.ScanExpiring(ctx)
+			if sent > 0 {
+				zap.L().Info("sent expiry notices", zap.Int("count", sent))
+			}
+			return err
+		},
+	}, scheduler.Job{
+		Name:     "auto-renew",
+		Interval: *renewInterval,
+		Run: func(ctx context.Context) error {
+			renewed, err := renewalSvc.RunDueRenewals(ctx)
+			if renewed > 0 {
+				zap.L().Info("renewed certificates", zap.Int("count", renewed))
+			}
+			return err
+		},
+	})
+
 	e := echo.New()
-	api.RegisterRoutes(e, client)
+	api.RegisterRoutes(e, svcCtx)
 
 	err = e.Start("127.0.0.1:" + strconv.Itoa(*port))
 	if err != nil {
 		zap.L().Fatal("server start failed", zap.Error(err))
 	}
 }
+
+func buildNotifiers() []service.Notifier {
+	notifiers := []service.Notifier{service.LogNotifier{}}
+	if *notifyWebhook != "" {
+		notifiers = append(notifiers, &service.WebhookNotifier{URL: *notifyWebhook})
+	}
+	if *notifySMTPAddr != "" {
+		if *notifySMTPTo == "" {
+			zap.L().Fatal("-notify-smtp-to is required with -notify-smtp-addr")
+		}
+		notifiers = append(notifiers, &service.SMTPNotifier{
+			Addr:     *notifySMTPAddr,
+			From:     *notifySMTPFrom,
+			To:       strings.Split(*notifySMTPTo, ","),
+			Username: os.Getenv("CERTMGR_SMTP_USERNAME"),
+			Password: os.Getenv("CERTMGR_SMTP_PASSWORD"),
+		})
+	}
+	return notifiers
+}
+
+func parseThresholds(s string) ([]int, error) {
+	var thresholds []int
+	for _, part := range strings.Split(s, ",") {
+		days, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("parse threshold %q failed: %w", part, err)
+		}
+		thresholds = append(thresholds, days)
+	}
+	return thresholds, nil
+}
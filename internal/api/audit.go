@@ -0,0 +1,122 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/logeable/certmgr/internal/service"
+	"go.uber.org/zap"
+)
+
+// AuditActorHeader names the caller in the audit log. The API has no
+// authentication, so requests without it are recorded under the client
+// address.
+const AuditActorHeader = "X-Certmgr-Actor"
+
+func RegisterAuditRoutes(g *echo.Group, ctx *service.ServiceContext) {
+	g.GET("", ListAuditEventsHandler(ctx))
+	g.GET("/verify", VerifyAuditChainHandler(ctx))
+}
+
+// auditActor tags the request context with the caller for the audit log.
+func auditActor(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		actor := c.Request().Header.Get(AuditActorHeader)
+		if actor == "" {
+			actor = c.RealIP()
+		}
+		ctx := service.WithAuditActor(c.Request().Context(), service.AuditSourceHTTP, actor)
+		c.SetRequest(c.Request().WithContext(ctx))
+		return next(c)
+	}
+}
+
+func ListAuditEventsHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "ListAuditEventsHandler"))
+		filter, err := parseAuditFilter(c)
+		if err != nil {
+			logger.Error("parse filter failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		opts, err := parseListOptions(c)
+		if err != nil {
+			logger.Error("parse list options failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		if err := opts.CheckFields(service.AuditEvent{}); err != nil {
+			logger.Error("check field mask failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		svc := service.NewAuditService(ctx)
+		events, total, err := svc.ListAuditEvents(c.Request().Context(), filter, opts)
+		if err != nil {
+			logger.Error("list failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		page, err := service.NewListPage(events, total, opts)
+		if err != nil {
+			logger.Error("apply field mask failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusOK, page)
+	}
+}
+
+func VerifyAuditChainHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "VerifyAuditChainHandler"))
+		svc := service.NewAuditService(ctx)
+		result, err := svc.VerifyAuditChain(c.Request().Context())
+		if err != nil {
+			logger.Error("verify audit chain failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+func parseAuditFilter(c echo.Context) (service.AuditFilter, error) {
+	filter := service.AuditFilter{
+		Actor:      c.QueryParam("actor"),
+		Source:     c.QueryParam("source"),
+		Operation:  c.QueryParam("operation"),
+		TargetType: c.QueryParam("targetType"),
+		Outcome:    c.QueryParam("outcome"),
+	}
+	var err error
+	if v := c.QueryParam("targetId"); v != "" {
+		if filter.TargetID, err = strconv.Atoi(v); err != nil {
+			return filter, fmt.Errorf("invalid targetId: %w", err)
+		}
+	}
+	if v := c.QueryParam("namespaceId"); v != "" {
+		if filter.NamespaceID, err = strconv.Atoi(v); err != nil {
+			return filter, fmt.Errorf("invalid namespaceId: %w", err)
+		}
+	}
+	if filter.Since, err = parseTimeParam(c, "since"); err != nil {
+		return filter, err
+	}
+	if filter.Until, err = parseTimeParam(c, "until"); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+func parseTimeParam(c echo.Context, name string) (*time.Time, error) {
+	v := c.QueryParam(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return &t, nil
+}
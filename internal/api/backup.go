@@ -0,0 +1,80 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/logeable/certmgr/internal/service"
+	"go.uber.org/zap"
+)
+
+// The archive passphrase travels in a header so that it does not end up in
+// access logs.
+const (
+	backupPassphraseHeader = "X-Backup-Passphrase"
+	maxRestoreSize         = 64 << 20
+)
+
+func RegisterBackupRoutes(g *echo.Group, ctx *service.ServiceContext) {
+	g.GET("/backup", BackupHandler(ctx))
+	g.POST("/restore", RestoreHandler(ctx))
+}
+
+func BackupHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "BackupHandler"))
+		passphrase := c.Request().Header.Get(backupPassphraseHeader)
+
+		svc := service.NewBackupService(ctx)
+		data, err := svc.Backup(c.Request().Context(), passphrase)
+		if errors.Is(err, service.ErrBackupPassphraseRequired) {
+			logger.Error("backup refused", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		if err != nil {
+			logger.Error("backup failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		ext := "json.gz"
+		if passphrase != "" {
+			ext = "pem"
+		}
+		filename := fmt.Sprintf("certmgr-backup-%s.%s", time.Now().Format("20060102-150405"), ext)
+		c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+		return c.Blob(http.StatusOK, "application/octet-stream", data)
+	}
+}
+
+func RestoreHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "RestoreHandler"))
+		mode := c.QueryParam("mode")
+		if mode == "" {
+			mode = service.RestoreModeMerge
+		}
+
+		logger = logger.With(zap.String("mode", mode))
+		data, err := io.ReadAll(io.LimitReader(c.Request().Body, maxRestoreSize+1))
+		if err != nil {
+			logger.Error("read body failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		if len(data) > maxRestoreSize {
+			return c.JSON(http.StatusRequestEntityTooLarge, ErrorResponse{Error: "archive too large"})
+		}
+
+		svc := service.NewBackupService(ctx)
+		result, err := svc.Restore(c.Request().Context(), data, mode, c.Request().Header.Get(backupPassphraseHeader))
+		if err != nil {
+			logger.Error("restore failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
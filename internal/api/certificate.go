@@ -2,9 +2,11 @@ package api
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/logeable/certmgr/internal/service"
@@ -13,11 +15,22 @@ import (
 
 func RegisterCertificateRoutes(g *echo.Group, ctx *service.ServiceContext) {
 	g.GET("/", ListCertificatesHandler(ctx))
+	g.GET("/search", SearchCertificatesHandler(ctx))
 	g.GET("/:id", GetCertificateHandler(ctx))
+	g.GET("/:id/dns-records", GetCertificateDNSRecordsHandler(ctx))
+	g.GET("/:id/lint", LintCertificateHandler(ctx))
 	g.DELETE("/:id", DeleteCertificateHandler(ctx))
 	g.POST("/", CreateCertificateHandler(ctx))
+	g.POST("/chain-doctor", ChainDoctorHandler(ctx))
+	g.POST("/probe", ProbeEndpointHandler(ctx))
 	g.POST("/:id/renew/", RenewCertificateHandler(ctx))
 	g.POST("/:id/export/", ExportCertificateHandler(ctx))
+	g.POST("/:id/offline-key", ExportOfflineKeyHandler(ctx))
+	g.POST("/:id/offline/", TakeCertificateOfflineHandler(ctx))
+	g.POST("/:id/private-key", RevealPrivateKeyHandler(ctx))
+	g.POST("/:id/rekey/", RekeyCertificateHandler(ctx))
+	g.PUT("/:id/renewal-policy", SetRenewalPolicyHandler(ctx))
+	g.DELETE("/:id/renewal-policy", DeleteRenewalPolicyHandler(ctx))
 }
 
 func ListCertificatesHandler(ctx *service.ServiceContext) echo.HandlerFunc {
@@ -40,9 +53,19 @@ func ListCertificatesHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid namespace_id"})
 		}
 
+		opts, err := parseListOptions(c)
+		if err != nil {
+			logger.Error("parse list options failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		if err := opts.CheckFields(CertificateResponse{}); err != nil {
+			logger.Error("check field mask failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
 		logger = logger.With(zap.Int("namespaceId", nsID))
 		svc := service.NewCertificateService(ctx)
-		certs, err := svc.ListCertificates(c.Request().Context(), nsID)
+		certs, total, err := svc.ListCertificates(c.Request().Context(), nsID, opts)
 		if err != nil {
 			logger.Error("list failed", zap.Error(err))
 			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
@@ -62,7 +85,13 @@ func ListCertificatesHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 			}
 		}
 
-		return c.JSON(http.StatusOK, resp)
+		page, err := service.NewListPage(resp, total, opts)
+		if err != nil {
+			logger.Error("apply field mask failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusOK, page)
 	}
 }
 
@@ -119,6 +148,7 @@ func CreateCertificateHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 		KeyType          string           `json:"keyType"`
 		KeyLen           int              `json:"keyLen"`
 		ECCCurve         string           `json:"eccCurve"`
+		KeyBackend       string           `json:"keyBackend"`
 		ValidDays        int              `json:"validDays"`
 		Desc             string           `json:"desc"`
 		Subject          Subject          `json:"subject"`
@@ -146,6 +176,7 @@ func CreateCertificateHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 				KeyType:     req.KeyType,
 				KeyLen:      req.KeyLen,
 				ECCCurve:    req.ECCCurve,
+				KeyBackend:  req.KeyBackend,
 				ValidDays:   req.ValidDays,
 				Desc:        req.Desc,
 				Subject: service.Subject{
@@ -180,6 +211,9 @@ func CreateCertificateHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 			logger.Error("create failed", zap.Error(err))
 			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
 		}
+		if createdCert.SigningRequestID != 0 {
+			return c.JSON(http.StatusAccepted, createdCert)
+		}
 
 		return c.JSON(http.StatusCreated, createdCert)
 	}
@@ -206,11 +240,14 @@ func RenewCertificateHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 		}
 
 		svc := service.NewCertificateService(ctx)
-		err = svc.RenewCertificate(c.Request().Context(), id, req.ValidDays)
+		signingRequest, err := svc.RenewCertificate(c.Request().Context(), id, req.ValidDays)
 		if err != nil {
 			logger.Error("renew failed", zap.Error(err))
 			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
 		}
+		if signingRequest != nil {
+			return c.JSON(http.StatusAccepted, signingRequest)
+		}
 
 		return c.JSON(http.StatusOK, nil)
 	}
@@ -237,7 +274,76 @@ func GetCertificateHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 	}
 }
 
+func LintCertificateHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "LintCertificateHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		svc := service.NewCertificateService(ctx)
+		result, err := svc.LintCertificate(c.Request().Context(), id)
+		if err != nil {
+			logger.Error("lint failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+// GetCertificateDNSRecordsHandler answers with zone file lines when
+// format=zone is given and with JSON otherwise.
+func GetCertificateDNSRecordsHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "GetCertificateDNSRecordsHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		req := service.DNSRecordsReq{
+			Protocol: c.QueryParam("protocol"),
+			CADomain: c.QueryParam("caDomain"),
+		}
+		if v := c.QueryParam("domain"); v != "" {
+			req.Domains = strings.Split(v, ",")
+		}
+		if v := c.QueryParam("port"); v != "" {
+			req.Port, err = strconv.Atoi(v)
+			if err != nil {
+				logger.Error("convert param failed", zap.String("port", v), zap.Error(err))
+				return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid port"})
+			}
+		}
+
+		svc := service.NewCertificateService(ctx)
+		records, err := svc.DNSRecords(c.Request().Context(), id, req)
+		if err != nil {
+			logger.Error("generate dns records failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		if c.QueryParam("format") == "zone" {
+			return c.String(http.StatusOK, records.Zone())
+		}
+		return c.JSON(http.StatusOK, records)
+	}
+}
+
 func ExportCertificateHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	type Req struct {
+		Format    string            `json:"format"`
+		Name      string            `json:"name"`
+		Namespace string            `json:"namespace"`
+		Labels    map[string]string `json:"labels"`
+		Password  string            `json:"password"`
+	}
+
 	return func(c echo.Context) error {
 		logger := zap.L().With(zap.String("handler", "ExportCertificateHandler"))
 		id, err := strconv.Atoi(c.Param("id"))
@@ -247,14 +353,221 @@ func ExportCertificateHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 		}
 
 		logger = logger.With(zap.Int("id", id))
+		var req Req
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
 		svc := service.NewCertificateService(ctx)
-		tar, err := svc.ExportCertificate(c.Request().Context(), id)
+		file, err := svc.ExportCertificate(c.Request().Context(), id, service.ExportCertReq{
+			Format:    req.Format,
+			Name:      req.Name,
+			Namespace: req.Namespace,
+			Labels:    req.Labels,
+			Password:  req.Password,
+		})
+		if errors.Is(err, service.ErrKeyRevealDisabled) {
+			logger.Error("export refused", zap.Error(err))
+			return c.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+		}
 		if err != nil {
 			logger.Error("export failed", zap.Error(err))
 			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
 		}
 
-		c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=certificate-%d.tar", id))
-		return c.Stream(http.StatusOK, "application/x-tar", bytes.NewReader(tar))
+		c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", file.Filename))
+		return c.Stream(http.StatusOK, file.ContentType, bytes.NewReader(file.Data))
+	}
+}
+
+func ExportOfflineKeyHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	type Req struct {
+		Passphrase string `json:"passphrase"`
+	}
+
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "ExportOfflineKeyHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		var req Req
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		svc := service.NewCertificateService(ctx)
+		keyPem, err := svc.ExportOfflineKey(c.Request().Context(), id, req.Passphrase)
+		if err != nil {
+			logger.Error("export offline key failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		c.Response().Header().Set("Cache-Control", "no-store")
+		c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=certificate-%d-offline.pem", id))
+		return c.Blob(http.StatusOK, "application/x-pem-file", []byte(keyPem))
+	}
+}
+
+// TakeCertificateOfflineHandler wipes the key once the caller sends back the
+// file from ExportOfflineKeyHandler together with its passphrase.
+func TakeCertificateOfflineHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	type Req struct {
+		OfflineKey string `json:"offlineKey"`
+		Passphrase string `json:"passphrase"`
+	}
+
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "TakeCertificateOfflineHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		var req Req
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		svc := service.NewCertificateService(ctx)
+		err = svc.TakeCertificateOffline(c.Request().Context(), id, []byte(req.OfflineKey), req.Passphrase)
+		if err != nil {
+			logger.Error("take offline failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusNoContent, nil)
+	}
+}
+
+// RevealPrivateKeyHandler answers with the plain private key, 403 when the
+// namespace has revealing turned off.
+func RevealPrivateKeyHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "RevealPrivateKeyHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		var req service.RevealKeyReq
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		svc := service.NewCertificateService(ctx)
+		keyPem, err := svc.RevealPrivateKey(c.Request().Context(), id, req)
+		if errors.Is(err, service.ErrKeyRevealDisabled) {
+			logger.Error("reveal refused", zap.Error(err))
+			return c.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+		}
+		if err != nil {
+			logger.Error("reveal failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		c.Response().Header().Set("Cache-Control", "no-store")
+		return c.JSON(http.StatusOK, map[string]string{"keyPem": keyPem})
+	}
+}
+
+func SearchCertificatesHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "SearchCertificatesHandler"))
+		req := service.SearchCertificatesReq{
+			CommonName:   c.QueryParam("cn"),
+			DNSName:      c.QueryParam("dnsName"),
+			IPAddress:    c.QueryParam("ip"),
+			SerialNumber: c.QueryParam("serial"),
+			Fingerprint:  c.QueryParam("fingerprint"),
+			KeyType:      c.QueryParam("keyType"),
+			Usage:        c.QueryParam("usage"),
+			Status:       c.QueryParam("status"),
+		}
+		if v := c.QueryParam("namespaceId"); v != "" {
+			nsID, err := strconv.Atoi(v)
+			if err != nil {
+				logger.Error("convert param failed", zap.String("namespaceId", v), zap.Error(err))
+				return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid namespace_id"})
+			}
+			req.NamespaceID = nsID
+		}
+		if v := c.QueryParam("ca"); v != "" {
+			isCA, err := strconv.ParseBool(v)
+			if err != nil {
+				logger.Error("convert param failed", zap.String("ca", v), zap.Error(err))
+				return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid ca"})
+			}
+			req.IsCA = &isCA
+		}
+		if v := c.QueryParam("within"); v != "" {
+			within, err := service.ParseWithin(v)
+			if err != nil {
+				logger.Error("parse within failed", zap.String("within", v), zap.Error(err))
+				return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			}
+			req.ExpiresWithin = within
+		}
+
+		svc := service.NewCertificateService(ctx)
+		certs, err := svc.SearchCertificates(c.Request().Context(), req)
+		if err != nil {
+			logger.Error("search failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusOK, certs)
+	}
+}
+
+func ChainDoctorHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "ChainDoctorHandler"))
+
+		var req service.ChainDoctorReq
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		svc := service.NewCertificateService(ctx)
+		result, err := svc.DiagnoseChain(c.Request().Context(), req)
+		if err != nil {
+			logger.Error("diagnose chain failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+func ProbeEndpointHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "ProbeEndpointHandler"))
+
+		var req service.ProbeReq
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.String("address", req.Address))
+		svc := service.NewCertificateService(ctx)
+		result, err := svc.ProbeEndpoint(c.Request().Context(), req)
+		if err != nil {
+			logger.Error("probe endpoint failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusOK, result)
 	}
 }
@@ -1,7 +1,11 @@
 package api
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/labstack/echo/v4"
@@ -10,17 +14,33 @@ import (
 	"go.uber.org/zap"
 )
 
+// NamespaceRequest creates or updates a namespace. An omitted
+// keyRevealDisabled keeps the stored value on update.
 type NamespaceRequest struct {
-	Name string `json:"name"`
-	Desc string `json:"desc"`
+	Name              string `json:"name"`
+	Desc              string `json:"desc"`
+	KeyRevealDisabled *bool  `json:"keyRevealDisabled"`
 }
 
+// NamespaceResponse is one item of the namespace list. Expiring counts the
+// certificates expiring within 30 days, NextExpiry is the earliest expiry
+// that has not passed yet, as a Unix timestamp. RevokedCount is always null
+// because revocation is not tracked.
 type NamespaceResponse struct {
-	ID        int    `json:"id"`
-	Name      string `json:"name"`
-	Desc      string `json:"desc"`
-	CreatedAt int64  `json:"createdAt"`
-	CertCount int    `json:"certCount"`
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Desc          string `json:"desc"`
+	UpdatedAt     int64  `json:"updatedAt"`
+	CreatedAt     int64  `json:"createdAt"`
+	CertCount     int    `json:"certCount"`
+	CACount       int    `json:"caCount"`
+	LeafCount     int    `json:"leafCount"`
+	ExpiredCount  int    `json:"expiredCount"`
+	ExpiringCount int    `json:"expiringCount"`
+	RevokedCount  *int   `json:"revokedCount"`
+	NextExpiry    *int64 `json:"nextExpiry"`
+
+	KeyRevealDisabled bool `json:"keyRevealDisabled"`
 }
 
 func RegisterNamespaceRoutes(g *echo.Group, ctx *service.ServiceContext) {
@@ -29,41 +49,66 @@ func RegisterNamespaceRoutes(g *echo.Group, ctx *service.ServiceContext) {
 	g.GET("/:id", GetNamespaceHandler(ctx))
 	g.PUT("/:id", UpdateNamespaceHandler(ctx))
 	g.DELETE("/:id", DeleteNamespaceHandler(ctx))
+	g.GET("/:id/tree", GetNamespaceTreeHandler(ctx))
+	g.POST("/:id/verify", VerifyCertificateHandler(ctx))
+	g.GET("/:id/bundle", ExportNamespaceBundleHandler(ctx))
+	g.POST("/import", ImportNamespaceBundleHandler(ctx))
+	g.POST("/:id/clone", CloneNamespaceHandler(ctx))
 }
 
 func ListNamespacesHandler(ctx *service.ServiceContext) echo.HandlerFunc {
-	type Response struct {
-		ID        int    `json:"id"`
-		Name      string `json:"name"`
-		Desc      string `json:"desc"`
-		UpdatedAt int64  `json:"updatedAt"`
-		CreatedAt int64  `json:"createdAt"`
-		CertCount int    `json:"certCount"`
-	}
-
 	return func(c echo.Context) error {
 		logger := zap.L().With(zap.String("handler", "ListNamespacesHandler"))
+		opts, err := parseListOptions(c)
+		if err != nil {
+			logger.Error("parse list options failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		if err := opts.CheckFields(NamespaceResponse{}); err != nil {
+			logger.Error("check field mask failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
 
 		svc := service.NewNamespaceService(ctx)
-		namespaces, err := svc.ListNamespaces(c.Request().Context())
+		namespaces, total, err := svc.ListNamespaces(c.Request().Context(), opts)
 		if err != nil {
 			logger.Error("list failed", zap.Error(err))
 			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
 		}
 
-		resp := make([]Response, 0, len(namespaces))
+		resp := make([]NamespaceResponse, 0, len(namespaces))
 		for _, namespace := range namespaces {
-			resp = append(resp, Response{
-				ID:        namespace.ID,
-				Name:      namespace.Name,
-				Desc:      namespace.Desc,
-				UpdatedAt: namespace.UpdatedAt.Unix(),
-				CreatedAt: namespace.CreatedAt.Unix(),
-				CertCount: namespace.CertCount,
+			stats := namespace.Certificates
+			var nextExpiry *int64
+			if stats.NextExpiry != nil {
+				unix := stats.NextExpiry.Unix()
+				nextExpiry = &unix
+			}
+			resp = append(resp, NamespaceResponse{
+				ID:            namespace.ID,
+				Name:          namespace.Name,
+				Desc:          namespace.Desc,
+				UpdatedAt:     namespace.UpdatedAt.Unix(),
+				CreatedAt:     namespace.CreatedAt.Unix(),
+				CertCount:     stats.Total,
+				CACount:       stats.CA,
+				LeafCount:     stats.Leaf,
+				ExpiredCount:  stats.Expired,
+				ExpiringCount: stats.Expiring,
+				RevokedCount:  stats.Revoked,
+				NextExpiry:    nextExpiry,
+
+				KeyRevealDisabled: namespace.KeyRevealDisabled,
 			})
 		}
 
-		return c.JSON(http.StatusOK, resp)
+		page, err := service.NewListPage(resp, total, opts)
+		if err != nil {
+			logger.Error("apply field mask failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusOK, page)
 	}
 }
 
@@ -79,8 +124,9 @@ func CreateNamespaceHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 
 		svc := service.NewNamespaceService(ctx)
 		namespace, err := svc.CreateNamespace(c.Request().Context(), ent.Namespace{
-			Name: req.Name,
-			Desc: req.Desc,
+			Name:              req.Name,
+			Desc:              req.Desc,
+			KeyRevealDisabled: req.KeyRevealDisabled != nil && *req.KeyRevealDisabled,
 		})
 		if err != nil {
 			logger.Error("create failed", zap.Error(err))
@@ -112,6 +158,27 @@ func GetNamespaceHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 	}
 }
 
+func GetNamespaceTreeHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "GetNamespaceTreeHandler"))
+
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		svc := service.NewNamespaceService(ctx)
+		tree, err := svc.CertificateTree(c.Request().Context(), id)
+		if err != nil {
+			logger.Error("get tree failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusOK, tree)
+	}
+}
+
 func UpdateNamespaceHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		logger := zap.L().With(zap.String("handler", "UpdateNamespaceHandler"))
@@ -130,9 +197,18 @@ func UpdateNamespaceHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 		}
 
 		svc := service.NewNamespaceService(ctx)
+		if req.KeyRevealDisabled == nil {
+			ns, err := svc.GetNamespace(c.Request().Context(), id)
+			if err != nil {
+				logger.Error("get failed", zap.Error(err))
+				return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+			}
+			req.KeyRevealDisabled = &ns.KeyRevealDisabled
+		}
 		updated, err := svc.UpdateNamespace(c.Request().Context(), id, ent.Namespace{
-			Name: req.Name,
-			Desc: req.Desc,
+			Name:              req.Name,
+			Desc:              req.Desc,
+			KeyRevealDisabled: *req.KeyRevealDisabled,
 		})
 		if err != nil {
 			logger.Error("update failed", zap.Error(err))
@@ -162,3 +238,130 @@ func DeleteNamespaceHandler(ctx *service.ServiceContext) echo.HandlerFunc {
 		return c.JSON(http.StatusNoContent, nil)
 	}
 }
+
+func ExportNamespaceBundleHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "ExportNamespaceBundleHandler"))
+
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		passphrase := c.Request().Header.Get(backupPassphraseHeader)
+		svc := service.NewNamespaceService(ctx)
+		name, data, err := svc.ExportNamespaceBundle(c.Request().Context(), id, passphrase)
+		if errors.Is(err, service.ErrBackupPassphraseRequired) {
+			logger.Error("export bundle refused", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		if err != nil {
+			logger.Error("export bundle failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		ext := "json.gz"
+		if passphrase != "" {
+			ext = "pem"
+		}
+		c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=namespace-%s.%s", url.PathEscape(name), ext))
+		return c.Blob(http.StatusOK, "application/octet-stream", data)
+	}
+}
+
+func ImportNamespaceBundleHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "ImportNamespaceBundleHandler"))
+
+		data, err := io.ReadAll(io.LimitReader(c.Request().Body, maxRestoreSize+1))
+		if err != nil {
+			logger.Error("read body failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		if len(data) > maxRestoreSize {
+			return c.JSON(http.StatusRequestEntityTooLarge, ErrorResponse{Error: "bundle too large"})
+		}
+
+		svc := service.NewNamespaceService(ctx)
+		namespace, err := svc.ImportNamespaceBundle(c.Request().Context(), data, c.Request().Header.Get(backupPassphraseHeader), c.QueryParam("name"))
+		if err != nil {
+			logger.Error("import bundle failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusCreated, map[string]string{"id": strconv.Itoa(namespace.ID), "name": namespace.Name})
+	}
+}
+
+func CloneNamespaceHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	type DomainRewrite struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	}
+	type Req struct {
+		Name           string          `json:"name"`
+		Desc           string          `json:"desc"`
+		DomainRewrites []DomainRewrite `json:"domainRewrites"`
+	}
+
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "CloneNamespaceHandler"))
+
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		var req Req
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		cloneReq := service.CloneNamespaceReq{Name: req.Name, Desc: req.Desc}
+		for _, rw := range req.DomainRewrites {
+			cloneReq.DomainRewrites = append(cloneReq.DomainRewrites, service.DomainRewrite{From: rw.From, To: rw.To})
+		}
+		svc := service.NewNamespaceService(ctx)
+		namespace, err := svc.CloneNamespace(c.Request().Context(), id, cloneReq)
+		if err != nil {
+			logger.Error("clone failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusCreated, map[string]string{"id": strconv.Itoa(namespace.ID)})
+	}
+}
+
+// VerifyCertificateHandler answers 200 for both passed and failed
+// verifications, the result tells them apart.
+func VerifyCertificateHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "VerifyCertificateHandler"))
+
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		var req service.VerifyReq
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		svc := service.NewNamespaceService(ctx)
+		result, err := svc.VerifyCertificate(c.Request().Context(), id, req)
+		if err != nil {
+			logger.Error("verify failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/enttest"
+	"github.com/logeable/certmgr/internal/service"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestUpdateNamespaceKeepsKeyRevealDisabled(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:api-namespace-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+	e := echo.New()
+	RegisterRoutes(e, service.NewServiceContext(client))
+
+	do := func(method, path, body string, code int, out any) {
+		t.Helper()
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != code {
+			t.Fatalf("%s %s: %d %s", method, path, rec.Code, rec.Body.String())
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var created map[string]string
+	do(http.MethodPost, "/api/v1/namespaces/", `{"name": "prod", "keyRevealDisabled": true}`, http.StatusCreated, &created)
+	path := "/api/v1/namespaces/" + created["id"]
+	var ns ent.Namespace
+	do(http.MethodPut, path, `{"name": "prod", "desc": "renamed"}`, http.StatusOK, &ns)
+	if !ns.KeyRevealDisabled || ns.Desc != "renamed" {
+		t.Fatalf("update without the flag gave %+v", ns)
+	}
+	ns = ent.Namespace{}
+	do(http.MethodPut, path, `{"name": "prod", "keyRevealDisabled": false}`, http.StatusOK, &ns)
+	if ns.KeyRevealDisabled {
+		t.Fatal("explicit false was ignored")
+	}
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/logeable/certmgr/internal/service"
+	"go.uber.org/zap"
+)
+
+func RegisterRenewalRoutes(g *echo.Group, ctx *service.ServiceContext) {
+	g.GET("/", ListRenewalAttemptsHandler(ctx))
+}
+
+func ListRenewalAttemptsHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "ListRenewalAttemptsHandler"))
+		nsID, err := strconv.Atoi(c.QueryParam("namespaceId"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("namespaceId", c.QueryParam("namespaceId")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid namespace_id"})
+		}
+		var certID, limit int
+		if v := c.QueryParam("certificateId"); v != "" {
+			certID, err = strconv.Atoi(v)
+			if err != nil {
+				logger.Error("convert param failed", zap.String("certificateId", v), zap.Error(err))
+				return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid certificate_id"})
+			}
+		}
+		if v := c.QueryParam("limit"); v != "" {
+			limit, err = strconv.Atoi(v)
+			if err != nil {
+				logger.Error("convert param failed", zap.String("limit", v), zap.Error(err))
+				return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid limit"})
+			}
+		}
+
+		logger = logger.With(zap.Int("namespaceId", nsID))
+		svc := service.NewRenewalService(ctx)
+		attempts, err := svc.ListRenewalAttempts(c.Request().Context(), nsID, certID, c.QueryParam("status"), limit)
+		if err != nil {
+			logger.Error("list failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusOK, attempts)
+	}
+}
+
+func SetRenewalPolicyHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "SetRenewalPolicyHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		req := service.RenewalPolicyReq{Enabled: true}
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		svc := service.NewRenewalService(ctx)
+		policy, err := svc.SetRenewalPolicy(c.Request().Context(), id, req)
+		if err != nil {
+			logger.Error("set renewal policy failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusOK, policy)
+	}
+}
+
+func DeleteRenewalPolicyHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "DeleteRenewalPolicyHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		svc := service.NewRenewalService(ctx)
+		if err := svc.DeleteRenewalPolicy(c.Request().Context(), id); err != nil {
+			logger.Error("delete renewal policy failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusOK, nil)
+	}
+}
+
+func RekeyCertificateHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	type Req struct {
+		ValidDays int `json:"validDays"`
+	}
+
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "RekeyCertificateHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		var req Req
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		svc := service.NewCertificateService(ctx)
+		signingRequest, err := svc.RekeyCertificate(c.Request().Context(), id, req.ValidDays)
+		if err != nil {
+			logger.Error("rekey failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		if signingRequest != nil {
+			return c.JSON(http.StatusAccepted, signingRequest)
+		}
+
+		return c.JSON(http.StatusOK, nil)
+	}
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/logeable/certmgr/internal/service"
+	"go.uber.org/zap"
+)
+
+func RegisterReportRoutes(g *echo.Group, ctx *service.ServiceContext) {
+	g.GET("/expiring", ExpiringReportHandler(ctx))
+}
+
+func StatsHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "StatsHandler"))
+		svc := service.NewReportService(ctx)
+		stats, err := svc.Stats(c.Request().Context())
+		if err != nil {
+			logger.Error("get stats failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusOK, stats)
+	}
+}
+
+// ExpiringReportHandler answers with CSV when format=csv is given or the
+// client only accepts text/csv, and with JSON otherwise.
+func ExpiringReportHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "ExpiringReportHandler"))
+		within, err := service.ParseWithin(c.QueryParam("within"))
+		if err != nil {
+			logger.Error("parse within failed", zap.String("within", c.QueryParam("within")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		svc := service.NewReportService(ctx)
+		rows, err := svc.ExpiringReport(c.Request().Context(), within)
+		if err != nil {
+			logger.Error("build report failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		format := c.QueryParam("format")
+		if format == "" && strings.HasPrefix(c.Request().Header.Get(echo.HeaderAccept), "text/csv") {
+			format = "csv"
+		}
+		switch format {
+		case "", "json":
+			return c.JSON(http.StatusOK, rows)
+		case "csv":
+			var buf bytes.Buffer
+			if err := service.WriteExpiringCSV(&buf, rows); err != nil {
+				logger.Error("write csv failed", zap.Error(err))
+				return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+			}
+			c.Response().Header().Set("Content-Disposition", "attachment; filename=expiring-"+time.Now().Format("20060102")+".csv")
+			return c.Blob(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+		default:
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "unsupported format: " + format})
+		}
+	}
+}
@@ -1,5 +1,30 @@
 package api
 
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/logeable/certmgr/internal/service"
+)
+
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
+
+// parseListOptions reads offset, limit, sort and fields from the query.
+func parseListOptions(c echo.Context) (service.ListOptions, error) {
+	var offset, limit int
+	var err error
+	if v := c.QueryParam("offset"); v != "" {
+		if offset, err = strconv.Atoi(v); err != nil {
+			return service.ListOptions{}, fmt.Errorf("invalid offset: %w", err)
+		}
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil {
+			return service.ListOptions{}, fmt.Errorf("invalid limit: %w", err)
+		}
+	}
+	return service.ParseListOptions(offset, limit, c.QueryParam("sort"), c.QueryParam("fields"))
+}
@@ -2,14 +2,18 @@ package api
 
 import (
 	"github.com/labstack/echo/v4"
-	"github.com/logeable/certmgr/internal/ent"
 	"github.com/logeable/certmgr/internal/service"
 )
 
-func RegisterRoutes(e *echo.Echo, client *ent.Client) {
-	ctx := service.NewServiceContext(client)
-
+func RegisterRoutes(e *echo.Echo, ctx *service.ServiceContext) {
 	apiGroup := e.Group("/api/v1")
+	apiGroup.Use(auditActor)
 	RegisterNamespaceRoutes(apiGroup.Group("/namespaces"), ctx)
 	RegisterCertificateRoutes(apiGroup.Group("/certificates"), ctx)
+	RegisterSigningRequestRoutes(apiGroup.Group("/signing-requests"), ctx)
+	RegisterRenewalRoutes(apiGroup.Group("/renewal-attempts"), ctx)
+	RegisterReportRoutes(apiGroup.Group("/reports"), ctx)
+	apiGroup.GET("/stats", StatsHandler(ctx))
+	RegisterBackupRoutes(apiGroup, ctx)
+	RegisterAuditRoutes(apiGroup.Group("/audit"), ctx)
 }
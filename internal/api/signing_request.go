@@ -0,0 +1,116 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/logeable/certmgr/internal/service"
+	"go.uber.org/zap"
+)
+
+func RegisterSigningRequestRoutes(g *echo.Group, ctx *service.ServiceContext) {
+	g.GET("/", ListSigningRequestsHandler(ctx))
+	g.GET("/:id/bundle", GetSigningBundleHandler(ctx))
+	g.POST("/:id/complete/", CompleteSigningRequestHandler(ctx))
+	g.DELETE("/:id", DeleteSigningRequestHandler(ctx))
+}
+
+func ListSigningRequestsHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "ListSigningRequestsHandler"))
+		nsID, err := strconv.Atoi(c.QueryParam("namespaceId"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("namespaceId", c.QueryParam("namespaceId")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid namespace_id"})
+		}
+
+		logger = logger.With(zap.Int("namespaceId", nsID))
+		svc := service.NewSigningRequestService(ctx)
+		srs, err := svc.ListSigningRequests(c.Request().Context(), nsID)
+		if err != nil {
+			logger.Error("list failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusOK, srs)
+	}
+}
+
+func GetSigningBundleHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "GetSigningBundleHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		svc := service.NewSigningRequestService(ctx)
+		bundle, err := svc.GetSigningBundle(c.Request().Context(), id)
+		if err != nil {
+			logger.Error("get bundle failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=signing-request-%d.json", id))
+		return c.JSON(http.StatusOK, bundle)
+	}
+}
+
+func CompleteSigningRequestHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	type Req struct {
+		CertPem string `json:"certPem"`
+	}
+	type Resp struct {
+		CertificateID int `json:"certificateId"`
+	}
+
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "CompleteSigningRequestHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		var req Req
+		if err := c.Bind(&req); err != nil {
+			logger.Error("bind failed", zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		svc := service.NewSigningRequestService(ctx)
+		certID, err := svc.CompleteSigningRequest(c.Request().Context(), id, req.CertPem)
+		if err != nil {
+			logger.Error("complete failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusOK, Resp{CertificateID: certID})
+	}
+}
+
+func DeleteSigningRequestHandler(ctx *service.ServiceContext) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		logger := zap.L().With(zap.String("handler", "DeleteSigningRequestHandler"))
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			logger.Error("convert param failed", zap.String("id", c.Param("id")), zap.Error(err))
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		}
+
+		logger = logger.With(zap.Int("id", id))
+		svc := service.NewSigningRequestService(ctx)
+		err = svc.DeleteSigningRequest(c.Request().Context(), id)
+		if err != nil {
+			logger.Error("delete failed", zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusNoContent, nil)
+	}
+}
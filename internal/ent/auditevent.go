@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/logeable/certmgr/internal/ent/auditevent"
+)
+
+// AuditEvent is the model entity for the AuditEvent schema.
+type AuditEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation string `json:"operation,omitempty"`
+	// TargetType holds the value of the "target_type" field.
+	TargetType string `json:"target_type,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID int `json:"target_id,omitempty"`
+	// NamespaceID holds the value of the "namespace_id" field.
+	NamespaceID int `json:"namespace_id,omitempty"`
+	// Params holds the value of the "params" field.
+	Params string `json:"params,omitempty"`
+	// Outcome holds the value of the "outcome" field.
+	Outcome auditevent.Outcome `json:"outcome,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// PrevHash holds the value of the "prev_hash" field.
+	PrevHash string `json:"prev_hash,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldID, auditevent.FieldTargetID, auditevent.FieldNamespaceID:
+			values[i] = new(sql.NullInt64)
+		case auditevent.FieldActor, auditevent.FieldSource, auditevent.FieldOperation, auditevent.FieldTargetType, auditevent.FieldParams, auditevent.FieldOutcome, auditevent.FieldError, auditevent.FieldPrevHash, auditevent.FieldHash:
+			values[i] = new(sql.NullString)
+		case auditevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditEvent fields.
+func (ae *AuditEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ae.ID = int(value.Int64)
+		case auditevent.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				ae.Actor = value.String
+			}
+		case auditevent.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				ae.Source = value.String
+			}
+		case auditevent.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				ae.Operation = value.String
+			}
+		case auditevent.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				ae.TargetType = value.String
+			}
+		case auditevent.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				ae.TargetID = int(value.Int64)
+			}
+		case auditevent.FieldNamespaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace_id", values[i])
+			} else if value.Valid {
+				ae.NamespaceID = int(value.Int64)
+			}
+		case auditevent.FieldParams:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field params", values[i])
+			} else if value.Valid {
+				ae.Params = value.String
+			}
+		case auditevent.FieldOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome", values[i])
+			} else if value.Valid {
+				ae.Outcome = auditevent.Outcome(value.String)
+			}
+		case auditevent.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				ae.Error = value.String
+			}
+		case auditevent.FieldPrevHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prev_hash", values[i])
+			} else if value.Valid {
+				ae.PrevHash = value.String
+			}
+		case auditevent.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				ae.Hash = value.String
+			}
+		case auditevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		default:
+			ae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditEvent.
+// This includes values selected through modifiers, order, etc.
+func (ae *AuditEvent) Value(name string) (ent.Value, error) {
+	return ae.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditEvent.
+// Note that you need to call AuditEvent.Unwrap() before calling this method if this AuditEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AuditEvent) Update() *AuditEventUpdateOne {
+	return NewAuditEventClient(ae.config).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AuditEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AuditEvent) Unwrap() *AuditEvent {
+	_tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditEvent is not a transactional entity")
+	}
+	ae.config.driver = _tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AuditEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ae.ID))
+	builder.WriteString("actor=")
+	builder.WriteString(ae.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(ae.Source)
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(ae.Operation)
+	builder.WriteString(", ")
+	builder.WriteString("target_type=")
+	builder.WriteString(ae.TargetType)
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("namespace_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.NamespaceID))
+	builder.WriteString(", ")
+	builder.WriteString("params=")
+	builder.WriteString(ae.Params)
+	builder.WriteString(", ")
+	builder.WriteString("outcome=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Outcome))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(ae.Error)
+	builder.WriteString(", ")
+	builder.WriteString("prev_hash=")
+	builder.WriteString(ae.PrevHash)
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(ae.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditEvents is a parsable slice of AuditEvent.
+type AuditEvents []*AuditEvent
@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/auditevent"
+)
+
+// AuditEventCreate is the builder for creating a AuditEvent entity.
+type AuditEventCreate struct {
+	config
+	mutation *AuditEventMutation
+	hooks    []Hook
+}
+
+// SetActor sets the "actor" field.
+func (aec *AuditEventCreate) SetActor(s string) *AuditEventCreate {
+	aec.mutation.SetActor(s)
+	return aec
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableActor(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetActor(*s)
+	}
+	return aec
+}
+
+// SetSource sets the "source" field.
+func (aec *AuditEventCreate) SetSource(s string) *AuditEventCreate {
+	aec.mutation.SetSource(s)
+	return aec
+}
+
+// SetOperation sets the "operation" field.
+func (aec *AuditEventCreate) SetOperation(s string) *AuditEventCreate {
+	aec.mutation.SetOperation(s)
+	return aec
+}
+
+// SetTargetType sets the "target_type" field.
+func (aec *AuditEventCreate) SetTargetType(s string) *AuditEventCreate {
+	aec.mutation.SetTargetType(s)
+	return aec
+}
+
+// SetTargetID sets the "target_id" field.
+func (aec *AuditEventCreate) SetTargetID(i int) *AuditEventCreate {
+	aec.mutation.SetTargetID(i)
+	return aec
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableTargetID(i *int) *AuditEventCreate {
+	if i != nil {
+		aec.SetTargetID(*i)
+	}
+	return aec
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (aec *AuditEventCreate) SetNamespaceID(i int) *AuditEventCreate {
+	aec.mutation.SetNamespaceID(i)
+	return aec
+}
+
+// SetNillableNamespaceID sets the "namespace_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableNamespaceID(i *int) *AuditEventCreate {
+	if i != nil {
+		aec.SetNamespaceID(*i)
+	}
+	return aec
+}
+
+// SetParams sets the "params" field.
+func (aec *AuditEventCreate) SetParams(s string) *AuditEventCreate {
+	aec.mutation.SetParams(s)
+	return aec
+}
+
+// SetNillableParams sets the "params" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableParams(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetParams(*s)
+	}
+	return aec
+}
+
+// SetOutcome sets the "outcome" field.
+func (aec *AuditEventCreate) SetOutcome(a auditevent.Outcome) *AuditEventCreate {
+	aec.mutation.SetOutcome(a)
+	return aec
+}
+
+// SetError sets the "error" field.
+func (aec *AuditEventCreate) SetError(s string) *AuditEventCreate {
+	aec.mutation.SetError(s)
+	return aec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableError(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetError(*s)
+	}
+	return aec
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (aec *AuditEventCreate) SetPrevHash(s string) *AuditEventCreate {
+	aec.mutation.SetPrevHash(s)
+	return aec
+}
+
+// SetHash sets the "hash" field.
+func (aec *AuditEventCreate) SetHash(s string) *AuditEventCreate {
+	aec.mutation.SetHash(s)
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuditEventCreate) SetCreatedAt(t time.Time) *AuditEventCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableCreatedAt(t *time.Time) *AuditEventCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AuditEventCreate) SetID(i int) *AuditEventCreate {
+	aec.mutation.SetID(i)
+	return aec
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aec *AuditEventCreate) Mutation() *AuditEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEvent in the database.
+func (aec *AuditEventCreate) Save(ctx context.Context) (*AuditEvent, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEventCreate) SaveX(ctx context.Context) *AuditEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEventCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEventCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEventCreate) defaults() {
+	if _, ok := aec.mutation.Actor(); !ok {
+		v := auditevent.DefaultActor
+		aec.mutation.SetActor(v)
+	}
+	if _, ok := aec.mutation.Params(); !ok {
+		v := auditevent.DefaultParams
+		aec.mutation.SetParams(v)
+	}
+	if _, ok := aec.mutation.Error(); !ok {
+		v := auditevent.DefaultError
+		aec.mutation.SetError(v)
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := auditevent.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEventCreate) check() error {
+	if _, ok := aec.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "AuditEvent.actor"`)}
+	}
+	if _, ok := aec.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "AuditEvent.source"`)}
+	}
+	if _, ok := aec.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "AuditEvent.operation"`)}
+	}
+	if _, ok := aec.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "AuditEvent.target_type"`)}
+	}
+	if _, ok := aec.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`ent: missing required field "AuditEvent.outcome"`)}
+	}
+	if v, ok := aec.mutation.Outcome(); ok {
+		if err := auditevent.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.outcome": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.PrevHash(); !ok {
+		return &ValidationError{Name: "prev_hash", err: errors.New(`ent: missing required field "AuditEvent.prev_hash"`)}
+	}
+	if _, ok := aec.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "AuditEvent.hash"`)}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditEvent.created_at"`)}
+	}
+	if v, ok := aec.mutation.ID(); ok {
+		if err := auditevent.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aec *AuditEventCreate) sqlSave(ctx context.Context) (*AuditEvent, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEvent{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(auditevent.Table, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
+	)
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := aec.mutation.Actor(); ok {
+		_spec.SetField(auditevent.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := aec.mutation.Source(); ok {
+		_spec.SetField(auditevent.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := aec.mutation.Operation(); ok {
+		_spec.SetField(auditevent.FieldOperation, field.TypeString, value)
+		_node.Operation = value
+	}
+	if value, ok := aec.mutation.TargetType(); ok {
+		_spec.SetField(auditevent.FieldTargetType, field.TypeString, value)
+		_node.TargetType = value
+	}
+	if value, ok := aec.mutation.TargetID(); ok {
+		_spec.SetField(auditevent.FieldTargetID, field.TypeInt, value)
+		_node.TargetID = value
+	}
+	if value, ok := aec.mutation.NamespaceID(); ok {
+		_spec.SetField(auditevent.FieldNamespaceID, field.TypeInt, value)
+		_node.NamespaceID = value
+	}
+	if value, ok := aec.mutation.Params(); ok {
+		_spec.SetField(auditevent.FieldParams, field.TypeString, value)
+		_node.Params = value
+	}
+	if value, ok := aec.mutation.Outcome(); ok {
+		_spec.SetField(auditevent.FieldOutcome, field.TypeEnum, value)
+		_node.Outcome = value
+	}
+	if value, ok := aec.mutation.Error(); ok {
+		_spec.SetField(auditevent.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := aec.mutation.PrevHash(); ok {
+		_spec.SetField(auditevent.FieldPrevHash, field.TypeString, value)
+		_node.PrevHash = value
+	}
+	if value, ok := aec.mutation.Hash(); ok {
+		_spec.SetField(auditevent.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.SetField(auditevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditEventCreateBulk is the builder for creating many AuditEvent entities in bulk.
+type AuditEventCreateBulk struct {
+	config
+	err      error
+	builders []*AuditEventCreate
+}
+
+// Save creates the AuditEvent entities in the database.
+func (aecb *AuditEventCreateBulk) Save(ctx context.Context) ([]*AuditEvent, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) SaveX(ctx context.Context) []*AuditEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/auditevent"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// AuditEventUpdate is the builder for updating AuditEvent entities.
+type AuditEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (aeu *AuditEventUpdate) Where(ps ...predicate.AuditEvent) *AuditEventUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// SetActor sets the "actor" field.
+func (aeu *AuditEventUpdate) SetActor(s string) *AuditEventUpdate {
+	aeu.mutation.SetActor(s)
+	return aeu
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableActor(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetActor(*s)
+	}
+	return aeu
+}
+
+// SetSource sets the "source" field.
+func (aeu *AuditEventUpdate) SetSource(s string) *AuditEventUpdate {
+	aeu.mutation.SetSource(s)
+	return aeu
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableSource(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetSource(*s)
+	}
+	return aeu
+}
+
+// SetOperation sets the "operation" field.
+func (aeu *AuditEventUpdate) SetOperation(s string) *AuditEventUpdate {
+	aeu.mutation.SetOperation(s)
+	return aeu
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableOperation(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetOperation(*s)
+	}
+	return aeu
+}
+
+// SetTargetType sets the "target_type" field.
+func (aeu *AuditEventUpdate) SetTargetType(s string) *AuditEventUpdate {
+	aeu.mutation.SetTargetType(s)
+	return aeu
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableTargetType(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetTargetType(*s)
+	}
+	return aeu
+}
+
+// SetTargetID sets the "target_id" field.
+func (aeu *AuditEventUpdate) SetTargetID(i int) *AuditEventUpdate {
+	aeu.mutation.ResetTargetID()
+	aeu.mutation.SetTargetID(i)
+	return aeu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableTargetID(i *int) *AuditEventUpdate {
+	if i != nil {
+		aeu.SetTargetID(*i)
+	}
+	return aeu
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (aeu *AuditEventUpdate) AddTargetID(i int) *AuditEventUpdate {
+	aeu.mutation.AddTargetID(i)
+	return aeu
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (aeu *AuditEventUpdate) ClearTargetID() *AuditEventUpdate {
+	aeu.mutation.ClearTargetID()
+	return aeu
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (aeu *AuditEventUpdate) SetNamespaceID(i int) *AuditEventUpdate {
+	aeu.mutation.ResetNamespaceID()
+	aeu.mutation.SetNamespaceID(i)
+	return aeu
+}
+
+// SetNillableNamespaceID sets the "namespace_id" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableNamespaceID(i *int) *AuditEventUpdate {
+	if i != nil {
+		aeu.SetNamespaceID(*i)
+	}
+	return aeu
+}
+
+// AddNamespaceID adds i to the "namespace_id" field.
+func (aeu *AuditEventUpdate) AddNamespaceID(i int) *AuditEventUpdate {
+	aeu.mutation.AddNamespaceID(i)
+	return aeu
+}
+
+// ClearNamespaceID clears the value of the "namespace_id" field.
+func (aeu *AuditEventUpdate) ClearNamespaceID() *AuditEventUpdate {
+	aeu.mutation.ClearNamespaceID()
+	return aeu
+}
+
+// SetParams sets the "params" field.
+func (aeu *AuditEventUpdate) SetParams(s string) *AuditEventUpdate {
+	aeu.mutation.SetParams(s)
+	return aeu
+}
+
+// SetNillableParams sets the "params" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableParams(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetParams(*s)
+	}
+	return aeu
+}
+
+// ClearParams clears the value of the "params" field.
+func (aeu *AuditEventUpdate) ClearParams() *AuditEventUpdate {
+	aeu.mutation.ClearParams()
+	return aeu
+}
+
+// SetOutcome sets the "outcome" field.
+func (aeu *AuditEventUpdate) SetOutcome(a auditevent.Outcome) *AuditEventUpdate {
+	aeu.mutation.SetOutcome(a)
+	return aeu
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableOutcome(a *auditevent.Outcome) *AuditEventUpdate {
+	if a != nil {
+		aeu.SetOutcome(*a)
+	}
+	return aeu
+}
+
+// SetError sets the "error" field.
+func (aeu *AuditEventUpdate) SetError(s string) *AuditEventUpdate {
+	aeu.mutation.SetError(s)
+	return aeu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableError(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetError(*s)
+	}
+	return aeu
+}
+
+// ClearError clears the value of the "error" field.
+func (aeu *AuditEventUpdate) ClearError() *AuditEventUpdate {
+	aeu.mutation.ClearError()
+	return aeu
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (aeu *AuditEventUpdate) SetPrevHash(s string) *AuditEventUpdate {
+	aeu.mutation.SetPrevHash(s)
+	return aeu
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillablePrevHash(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetPrevHash(*s)
+	}
+	return aeu
+}
+
+// SetHash sets the "hash" field.
+func (aeu *AuditEventUpdate) SetHash(s string) *AuditEventUpdate {
+	aeu.mutation.SetHash(s)
+	return aeu
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableHash(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetHash(*s)
+	}
+	return aeu
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aeu *AuditEventUpdate) Mutation() *AuditEventMutation {
+	return aeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AuditEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aeu.sqlSave, aeu.mutation, aeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AuditEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AuditEventUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AuditEventUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeu *AuditEventUpdate) check() error {
+	if v, ok := aeu.mutation.Outcome(); ok {
+		if err := auditevent.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aeu *AuditEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeu.mutation.Actor(); ok {
+		_spec.SetField(auditevent.FieldActor, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Source(); ok {
+		_spec.SetField(auditevent.FieldSource, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Operation(); ok {
+		_spec.SetField(auditevent.FieldOperation, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.TargetType(); ok {
+		_spec.SetField(auditevent.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.TargetID(); ok {
+		_spec.SetField(auditevent.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.AddedTargetID(); ok {
+		_spec.AddField(auditevent.FieldTargetID, field.TypeInt, value)
+	}
+	if aeu.mutation.TargetIDCleared() {
+		_spec.ClearField(auditevent.FieldTargetID, field.TypeInt)
+	}
+	if value, ok := aeu.mutation.NamespaceID(); ok {
+		_spec.SetField(auditevent.FieldNamespaceID, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.AddedNamespaceID(); ok {
+		_spec.AddField(auditevent.FieldNamespaceID, field.TypeInt, value)
+	}
+	if aeu.mutation.NamespaceIDCleared() {
+		_spec.ClearField(auditevent.FieldNamespaceID, field.TypeInt)
+	}
+	if value, ok := aeu.mutation.Params(); ok {
+		_spec.SetField(auditevent.FieldParams, field.TypeString, value)
+	}
+	if aeu.mutation.ParamsCleared() {
+		_spec.ClearField(auditevent.FieldParams, field.TypeString)
+	}
+	if value, ok := aeu.mutation.Outcome(); ok {
+		_spec.SetField(auditevent.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := aeu.mutation.Error(); ok {
+		_spec.SetField(auditevent.FieldError, field.TypeString, value)
+	}
+	if aeu.mutation.ErrorCleared() {
+		_spec.ClearField(auditevent.FieldError, field.TypeString)
+	}
+	if value, ok := aeu.mutation.PrevHash(); ok {
+		_spec.SetField(auditevent.FieldPrevHash, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Hash(); ok {
+		_spec.SetField(auditevent.FieldHash, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aeu.mutation.done = true
+	return n, nil
+}
+
+// AuditEventUpdateOne is the builder for updating a single AuditEvent entity.
+type AuditEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// SetActor sets the "actor" field.
+func (aeuo *AuditEventUpdateOne) SetActor(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetActor(s)
+	return aeuo
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableActor(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetActor(*s)
+	}
+	return aeuo
+}
+
+// SetSource sets the "source" field.
+func (aeuo *AuditEventUpdateOne) SetSource(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetSource(s)
+	return aeuo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableSource(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetSource(*s)
+	}
+	return aeuo
+}
+
+// SetOperation sets the "operation" field.
+func (aeuo *AuditEventUpdateOne) SetOperation(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetOperation(s)
+	return aeuo
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableOperation(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetOperation(*s)
+	}
+	return aeuo
+}
+
+// SetTargetType sets the "target_type" field.
+func (aeuo *AuditEventUpdateOne) SetTargetType(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetTargetType(s)
+	return aeuo
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableTargetType(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetTargetType(*s)
+	}
+	return aeuo
+}
+
+// SetTargetID sets the "target_id" field.
+func (aeuo *AuditEventUpdateOne) SetTargetID(i int) *AuditEventUpdateOne {
+	aeuo.mutation.ResetTargetID()
+	aeuo.mutation.SetTargetID(i)
+	return aeuo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableTargetID(i *int) *AuditEventUpdateOne {
+	if i != nil {
+		aeuo.SetTargetID(*i)
+	}
+	return aeuo
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (aeuo *AuditEventUpdateOne) AddTargetID(i int) *AuditEventUpdateOne {
+	aeuo.mutation.AddTargetID(i)
+	return aeuo
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (aeuo *AuditEventUpdateOne) ClearTargetID() *AuditEventUpdateOne {
+	aeuo.mutation.ClearTargetID()
+	return aeuo
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (aeuo *AuditEventUpdateOne) SetNamespaceID(i int) *AuditEventUpdateOne {
+	aeuo.mutation.ResetNamespaceID()
+	aeuo.mutation.SetNamespaceID(i)
+	return aeuo
+}
+
+// SetNillableNamespaceID sets the "namespace_id" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableNamespaceID(i *int) *AuditEventUpdateOne {
+	if i != nil {
+		aeuo.SetNamespaceID(*i)
+	}
+	return aeuo
+}
+
+// AddNamespaceID adds i to the "namespace_id" field.
+func (aeuo *AuditEventUpdateOne) AddNamespaceID(i int) *AuditEventUpdateOne {
+	aeuo.mutation.AddNamespaceID(i)
+	return aeuo
+}
+
+// ClearNamespaceID clears the value of the "namespace_id" field.
+func (aeuo *AuditEventUpdateOne) ClearNamespaceID() *AuditEventUpdateOne {
+	aeuo.mutation.ClearNamespaceID()
+	return aeuo
+}
+
+// SetParams sets the "params" field.
+func (aeuo *AuditEventUpdateOne) SetParams(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetParams(s)
+	return aeuo
+}
+
+// SetNillableParams sets the "params" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableParams(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetParams(*s)
+	}
+	return aeuo
+}
+
+// ClearParams clears the value of the "params" field.
+func (aeuo *AuditEventUpdateOne) ClearParams() *AuditEventUpdateOne {
+	aeuo.mutation.ClearParams()
+	return aeuo
+}
+
+// SetOutcome sets the "outcome" field.
+func (aeuo *AuditEventUpdateOne) SetOutcome(a auditevent.Outcome) *AuditEventUpdateOne {
+	aeuo.mutation.SetOutcome(a)
+	return aeuo
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableOutcome(a *auditevent.Outcome) *AuditEventUpdateOne {
+	if a != nil {
+		aeuo.SetOutcome(*a)
+	}
+	return aeuo
+}
+
+// SetError sets the "error" field.
+func (aeuo *AuditEventUpdateOne) SetError(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetError(s)
+	return aeuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableError(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetError(*s)
+	}
+	return aeuo
+}
+
+// ClearError clears the value of the "error" field.
+func (aeuo *AuditEventUpdateOne) ClearError() *AuditEventUpdateOne {
+	aeuo.mutation.ClearError()
+	return aeuo
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (aeuo *AuditEventUpdateOne) SetPrevHash(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetPrevHash(s)
+	return aeuo
+}
+
+// SetNillablePrevHash sets the "prev_hash" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillablePrevHash(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetPrevHash(*s)
+	}
+	return aeuo
+}
+
+// SetHash sets the "hash" field.
+func (aeuo *AuditEventUpdateOne) SetHash(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetHash(s)
+	return aeuo
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableHash(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetHash(*s)
+	}
+	return aeuo
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aeuo *AuditEventUpdateOne) Mutation() *AuditEventMutation {
+	return aeuo.mutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (aeuo *AuditEventUpdateOne) Where(ps ...predicate.AuditEvent) *AuditEventUpdateOne {
+	aeuo.mutation.Where(ps...)
+	return aeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *AuditEventUpdateOne) Select(field string, fields ...string) *AuditEventUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated AuditEvent entity.
+func (aeuo *AuditEventUpdateOne) Save(ctx context.Context) (*AuditEvent, error) {
+	return withHooks(ctx, aeuo.sqlSave, aeuo.mutation, aeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AuditEventUpdateOne) SaveX(ctx context.Context) *AuditEvent {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AuditEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AuditEventUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeuo *AuditEventUpdateOne) check() error {
+	if v, ok := aeuo.mutation.Outcome(); ok {
+		if err := auditevent.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aeuo *AuditEventUpdateOne) sqlSave(ctx context.Context) (_node *AuditEvent, err error) {
+	if err := aeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditevent.FieldID)
+		for _, f := range fields {
+			if !auditevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeuo.mutation.Actor(); ok {
+		_spec.SetField(auditevent.FieldActor, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Source(); ok {
+		_spec.SetField(auditevent.FieldSource, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Operation(); ok {
+		_spec.SetField(auditevent.FieldOperation, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.TargetType(); ok {
+		_spec.SetField(auditevent.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.TargetID(); ok {
+		_spec.SetField(auditevent.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.AddedTargetID(); ok {
+		_spec.AddField(auditevent.FieldTargetID, field.TypeInt, value)
+	}
+	if aeuo.mutation.TargetIDCleared() {
+		_spec.ClearField(auditevent.FieldTargetID, field.TypeInt)
+	}
+	if value, ok := aeuo.mutation.NamespaceID(); ok {
+		_spec.SetField(auditevent.FieldNamespaceID, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.AddedNamespaceID(); ok {
+		_spec.AddField(auditevent.FieldNamespaceID, field.TypeInt, value)
+	}
+	if aeuo.mutation.NamespaceIDCleared() {
+		_spec.ClearField(auditevent.FieldNamespaceID, field.TypeInt)
+	}
+	if value, ok := aeuo.mutation.Params(); ok {
+		_spec.SetField(auditevent.FieldParams, field.TypeString, value)
+	}
+	if aeuo.mutation.ParamsCleared() {
+		_spec.ClearField(auditevent.FieldParams, field.TypeString)
+	}
+	if value, ok := aeuo.mutation.Outcome(); ok {
+		_spec.SetField(auditevent.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := aeuo.mutation.Error(); ok {
+		_spec.SetField(auditevent.FieldError, field.TypeString, value)
+	}
+	if aeuo.mutation.ErrorCleared() {
+		_spec.ClearField(auditevent.FieldError, field.TypeString)
+	}
+	if value, ok := aeuo.mutation.PrevHash(); ok {
+		_spec.SetField(auditevent.FieldPrevHash, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Hash(); ok {
+		_spec.SetField(auditevent.FieldHash, field.TypeString, value)
+	}
+	_node = &AuditEvent{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aeuo.mutation.done = true
+	return _node, nil
+}
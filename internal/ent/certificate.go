@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/logeable/certmgr/internal/ent/certificate"
 	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
 )
 
 // Certificate is the model entity for the Certificate schema.
@@ -24,12 +26,44 @@ type Certificate struct {
 	CertPem string `json:"cert_pem,omitempty"`
 	// KeyPem holds the value of the "key_pem" field.
 	KeyPem string `json:"key_pem,omitempty"`
+	// KeyRef holds the value of the "key_ref" field.
+	KeyRef string `json:"key_ref,omitempty"`
+	// KeyExportable holds the value of the "key_exportable" field.
+	KeyExportable bool `json:"key_exportable,omitempty"`
+	// Offline holds the value of the "offline" field.
+	Offline bool `json:"offline,omitempty"`
 	// Desc holds the value of the "desc" field.
 	Desc string `json:"desc,omitempty"`
 	// IssuerID holds the value of the "issuer_id" field.
 	IssuerID int `json:"issuer_id,omitempty"`
 	// Usage holds the value of the "usage" field.
 	Usage string `json:"usage,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// CommonName holds the value of the "common_name" field.
+	CommonName string `json:"common_name,omitempty"`
+	// SerialNumber holds the value of the "serial_number" field.
+	SerialNumber string `json:"serial_number,omitempty"`
+	// Fingerprint holds the value of the "fingerprint" field.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// SubjectKeyID holds the value of the "subject_key_id" field.
+	SubjectKeyID string `json:"subject_key_id,omitempty"`
+	// AuthorityKeyID holds the value of the "authority_key_id" field.
+	AuthorityKeyID string `json:"authority_key_id,omitempty"`
+	// KeyType holds the value of the "key_type" field.
+	KeyType string `json:"key_type,omitempty"`
+	// SignatureAlgorithm holds the value of the "signature_algorithm" field.
+	SignatureAlgorithm string `json:"signature_algorithm,omitempty"`
+	// IsCa holds the value of the "is_ca" field.
+	IsCa bool `json:"is_ca,omitempty"`
+	// NotBefore holds the value of the "not_before" field.
+	NotBefore time.Time `json:"not_before,omitempty"`
+	// NotAfter holds the value of the "not_after" field.
+	NotAfter time.Time `json:"not_after,omitempty"`
+	// DNSNames holds the value of the "dns_names" field.
+	DNSNames []string `json:"dns_names,omitempty"`
+	// IPAddresses holds the value of the "ip_addresses" field.
+	IPAddresses []string `json:"ip_addresses,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -44,9 +78,13 @@ type Certificate struct {
 type CertificateEdges struct {
 	// Namespace holds the value of the namespace edge.
 	Namespace *Namespace `json:"namespace,omitempty"`
+	// ExpiryNotifications holds the value of the expiry_notifications edge.
+	ExpiryNotifications []*ExpiryNotification `json:"expiry_notifications,omitempty"`
+	// RenewalPolicy holds the value of the renewal_policy edge.
+	RenewalPolicy *RenewalPolicy `json:"renewal_policy,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // NamespaceOrErr returns the Namespace value or an error if the edge
@@ -60,16 +98,40 @@ func (e CertificateEdges) NamespaceOrErr() (*Namespace, error) {
 	return nil, &NotLoadedError{edge: "namespace"}
 }
 
+// ExpiryNotificationsOrErr returns the ExpiryNotifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e CertificateEdges) ExpiryNotificationsOrErr() ([]*ExpiryNotification, error) {
+	if e.loadedTypes[1] {
+		return e.ExpiryNotifications, nil
+	}
+	return nil, &NotLoadedError{edge: "expiry_notifications"}
+}
+
+// RenewalPolicyOrErr returns the RenewalPolicy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertificateEdges) RenewalPolicyOrErr() (*RenewalPolicy, error) {
+	if e.RenewalPolicy != nil {
+		return e.RenewalPolicy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: renewalpolicy.Label}
+	}
+	return nil, &NotLoadedError{edge: "renewal_policy"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Certificate) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case certificate.FieldDNSNames, certificate.FieldIPAddresses:
+			values[i] = new([]byte)
+		case certificate.FieldKeyExportable, certificate.FieldOffline, certificate.FieldIsCa:
+			values[i] = new(sql.NullBool)
 		case certificate.FieldID, certificate.FieldNamespaceID, certificate.FieldIssuerID:
 			values[i] = new(sql.NullInt64)
-		case certificate.FieldCertPem, certificate.FieldKeyPem, certificate.FieldDesc, certificate.FieldUsage:
+		case certificate.FieldCertPem, certificate.FieldKeyPem, certificate.FieldKeyRef, certificate.FieldDesc, certificate.FieldUsage, certificate.FieldSubject, certificate.FieldCommonName, certificate.FieldSerialNumber, certificate.FieldFingerprint, certificate.FieldSubjectKeyID, certificate.FieldAuthorityKeyID, certificate.FieldKeyType, certificate.FieldSignatureAlgorithm:
 			values[i] = new(sql.NullString)
-		case certificate.FieldUpdatedAt, certificate.FieldCreatedAt:
+		case certificate.FieldNotBefore, certificate.FieldNotAfter, certificate.FieldUpdatedAt, certificate.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -110,6 +172,24 @@ func (c *Certificate) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.KeyPem = value.String
 			}
+		case certificate.FieldKeyRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_ref", values[i])
+			} else if value.Valid {
+				c.KeyRef = value.String
+			}
+		case certificate.FieldKeyExportable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field key_exportable", values[i])
+			} else if value.Valid {
+				c.KeyExportable = value.Bool
+			}
+		case certificate.FieldOffline:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field offline", values[i])
+			} else if value.Valid {
+				c.Offline = value.Bool
+			}
 		case certificate.FieldDesc:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field desc", values[i])
@@ -128,6 +208,88 @@ func (c *Certificate) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.Usage = value.String
 			}
+		case certificate.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				c.Subject = value.String
+			}
+		case certificate.FieldCommonName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field common_name", values[i])
+			} else if value.Valid {
+				c.CommonName = value.String
+			}
+		case certificate.FieldSerialNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field serial_number", values[i])
+			} else if value.Valid {
+				c.SerialNumber = value.String
+			}
+		case certificate.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				c.Fingerprint = value.String
+			}
+		case certificate.FieldSubjectKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_key_id", values[i])
+			} else if value.Valid {
+				c.SubjectKeyID = value.String
+			}
+		case certificate.FieldAuthorityKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field authority_key_id", values[i])
+			} else if value.Valid {
+				c.AuthorityKeyID = value.String
+			}
+		case certificate.FieldKeyType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_type", values[i])
+			} else if value.Valid {
+				c.KeyType = value.String
+			}
+		case certificate.FieldSignatureAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signature_algorithm", values[i])
+			} else if value.Valid {
+				c.SignatureAlgorithm = value.String
+			}
+		case certificate.FieldIsCa:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_ca", values[i])
+			} else if value.Valid {
+				c.IsCa = value.Bool
+			}
+		case certificate.FieldNotBefore:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field not_before", values[i])
+			} else if value.Valid {
+				c.NotBefore = value.Time
+			}
+		case certificate.FieldNotAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field not_after", values[i])
+			} else if value.Valid {
+				c.NotAfter = value.Time
+			}
+		case certificate.FieldDNSNames:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field dns_names", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.DNSNames); err != nil {
+					return fmt.Errorf("unmarshal field dns_names: %w", err)
+				}
+			}
+		case certificate.FieldIPAddresses:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_addresses", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.IPAddresses); err != nil {
+					return fmt.Errorf("unmarshal field ip_addresses: %w", err)
+				}
+			}
 		case certificate.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -158,6 +320,16 @@ func (c *Certificate) QueryNamespace() *NamespaceQuery {
 	return NewCertificateClient(c.config).QueryNamespace(c)
 }
 
+// QueryExpiryNotifications queries the "expiry_notifications" edge of the Certificate entity.
+func (c *Certificate) QueryExpiryNotifications() *ExpiryNotificationQuery {
+	return NewCertificateClient(c.config).QueryExpiryNotifications(c)
+}
+
+// QueryRenewalPolicy queries the "renewal_policy" edge of the Certificate entity.
+func (c *Certificate) QueryRenewalPolicy() *RenewalPolicyQuery {
+	return NewCertificateClient(c.config).QueryRenewalPolicy(c)
+}
+
 // Update returns a builder for updating this Certificate.
 // Note that you need to call Certificate.Unwrap() before calling this method if this Certificate
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -190,6 +362,15 @@ func (c *Certificate) String() string {
 	builder.WriteString("key_pem=")
 	builder.WriteString(c.KeyPem)
 	builder.WriteString(", ")
+	builder.WriteString("key_ref=")
+	builder.WriteString(c.KeyRef)
+	builder.WriteString(", ")
+	builder.WriteString("key_exportable=")
+	builder.WriteString(fmt.Sprintf("%v", c.KeyExportable))
+	builder.WriteString(", ")
+	builder.WriteString("offline=")
+	builder.WriteString(fmt.Sprintf("%v", c.Offline))
+	builder.WriteString(", ")
 	builder.WriteString("desc=")
 	builder.WriteString(c.Desc)
 	builder.WriteString(", ")
@@ -199,6 +380,45 @@ func (c *Certificate) String() string {
 	builder.WriteString("usage=")
 	builder.WriteString(c.Usage)
 	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(c.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("common_name=")
+	builder.WriteString(c.CommonName)
+	builder.WriteString(", ")
+	builder.WriteString("serial_number=")
+	builder.WriteString(c.SerialNumber)
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(c.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("subject_key_id=")
+	builder.WriteString(c.SubjectKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("authority_key_id=")
+	builder.WriteString(c.AuthorityKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("key_type=")
+	builder.WriteString(c.KeyType)
+	builder.WriteString(", ")
+	builder.WriteString("signature_algorithm=")
+	builder.WriteString(c.SignatureAlgorithm)
+	builder.WriteString(", ")
+	builder.WriteString("is_ca=")
+	builder.WriteString(fmt.Sprintf("%v", c.IsCa))
+	builder.WriteString(", ")
+	builder.WriteString("not_before=")
+	builder.WriteString(c.NotBefore.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("not_after=")
+	builder.WriteString(c.NotAfter.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("dns_names=")
+	builder.WriteString(fmt.Sprintf("%v", c.DNSNames))
+	builder.WriteString(", ")
+	builder.WriteString("ip_addresses=")
+	builder.WriteString(fmt.Sprintf("%v", c.IPAddresses))
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -20,18 +20,54 @@ const (
 	FieldCertPem = "cert_pem"
 	// FieldKeyPem holds the string denoting the key_pem field in the database.
 	FieldKeyPem = "key_pem"
+	// FieldKeyRef holds the string denoting the key_ref field in the database.
+	FieldKeyRef = "key_ref"
+	// FieldKeyExportable holds the string denoting the key_exportable field in the database.
+	FieldKeyExportable = "key_exportable"
+	// FieldOffline holds the string denoting the offline field in the database.
+	FieldOffline = "offline"
 	// FieldDesc holds the string denoting the desc field in the database.
 	FieldDesc = "desc"
 	// FieldIssuerID holds the string denoting the issuer_id field in the database.
 	FieldIssuerID = "issuer_id"
 	// FieldUsage holds the string denoting the usage field in the database.
 	FieldUsage = "usage"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldCommonName holds the string denoting the common_name field in the database.
+	FieldCommonName = "common_name"
+	// FieldSerialNumber holds the string denoting the serial_number field in the database.
+	FieldSerialNumber = "serial_number"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldSubjectKeyID holds the string denoting the subject_key_id field in the database.
+	FieldSubjectKeyID = "subject_key_id"
+	// FieldAuthorityKeyID holds the string denoting the authority_key_id field in the database.
+	FieldAuthorityKeyID = "authority_key_id"
+	// FieldKeyType holds the string denoting the key_type field in the database.
+	FieldKeyType = "key_type"
+	// FieldSignatureAlgorithm holds the string denoting the signature_algorithm field in the database.
+	FieldSignatureAlgorithm = "signature_algorithm"
+	// FieldIsCa holds the string denoting the is_ca field in the database.
+	FieldIsCa = "is_ca"
+	// FieldNotBefore holds the string denoting the not_before field in the database.
+	FieldNotBefore = "not_before"
+	// FieldNotAfter holds the string denoting the not_after field in the database.
+	FieldNotAfter = "not_after"
+	// FieldDNSNames holds the string denoting the dns_names field in the database.
+	FieldDNSNames = "dns_names"
+	// FieldIPAddresses holds the string denoting the ip_addresses field in the database.
+	FieldIPAddresses = "ip_addresses"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeNamespace holds the string denoting the namespace edge name in mutations.
 	EdgeNamespace = "namespace"
+	// EdgeExpiryNotifications holds the string denoting the expiry_notifications edge name in mutations.
+	EdgeExpiryNotifications = "expiry_notifications"
+	// EdgeRenewalPolicy holds the string denoting the renewal_policy edge name in mutations.
+	EdgeRenewalPolicy = "renewal_policy"
 	// Table holds the table name of the certificate in the database.
 	Table = "certificates"
 	// NamespaceTable is the table that holds the namespace relation/edge.
@@ -41,6 +77,20 @@ const (
 	NamespaceInverseTable = "namespaces"
 	// NamespaceColumn is the table column denoting the namespace relation/edge.
 	NamespaceColumn = "namespace_id"
+	// ExpiryNotificationsTable is the table that holds the expiry_notifications relation/edge.
+	ExpiryNotificationsTable = "expiry_notifications"
+	// ExpiryNotificationsInverseTable is the table name for the ExpiryNotification entity.
+	// It exists in this package in order to avoid circular dependency with the "expirynotification" package.
+	ExpiryNotificationsInverseTable = "expiry_notifications"
+	// ExpiryNotificationsColumn is the table column denoting the expiry_notifications relation/edge.
+	ExpiryNotificationsColumn = "certificate_id"
+	// RenewalPolicyTable is the table that holds the renewal_policy relation/edge.
+	RenewalPolicyTable = "renewal_policies"
+	// RenewalPolicyInverseTable is the table name for the RenewalPolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "renewalpolicy" package.
+	RenewalPolicyInverseTable = "renewal_policies"
+	// RenewalPolicyColumn is the table column denoting the renewal_policy relation/edge.
+	RenewalPolicyColumn = "certificate_id"
 )
 
 // Columns holds all SQL columns for certificate fields.
@@ -49,9 +99,25 @@ var Columns = []string{
 	FieldNamespaceID,
 	FieldCertPem,
 	FieldKeyPem,
+	FieldKeyRef,
+	FieldKeyExportable,
+	FieldOffline,
 	FieldDesc,
 	FieldIssuerID,
 	FieldUsage,
+	FieldSubject,
+	FieldCommonName,
+	FieldSerialNumber,
+	FieldFingerprint,
+	FieldSubjectKeyID,
+	FieldAuthorityKeyID,
+	FieldKeyType,
+	FieldSignatureAlgorithm,
+	FieldIsCa,
+	FieldNotBefore,
+	FieldNotAfter,
+	FieldDNSNames,
+	FieldIPAddresses,
 	FieldUpdatedAt,
 	FieldCreatedAt,
 }
@@ -67,10 +133,34 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultKeyRef holds the default value on creation for the "key_ref" field.
+	DefaultKeyRef string
+	// DefaultKeyExportable holds the default value on creation for the "key_exportable" field.
+	DefaultKeyExportable bool
+	// DefaultOffline holds the default value on creation for the "offline" field.
+	DefaultOffline bool
 	// DefaultDesc holds the default value on creation for the "desc" field.
 	DefaultDesc string
 	// DefaultUsage holds the default value on creation for the "usage" field.
 	DefaultUsage string
+	// DefaultSubject holds the default value on creation for the "subject" field.
+	DefaultSubject string
+	// DefaultCommonName holds the default value on creation for the "common_name" field.
+	DefaultCommonName string
+	// DefaultSerialNumber holds the default value on creation for the "serial_number" field.
+	DefaultSerialNumber string
+	// DefaultFingerprint holds the default value on creation for the "fingerprint" field.
+	DefaultFingerprint string
+	// DefaultSubjectKeyID holds the default value on creation for the "subject_key_id" field.
+	DefaultSubjectKeyID string
+	// DefaultAuthorityKeyID holds the default value on creation for the "authority_key_id" field.
+	DefaultAuthorityKeyID string
+	// DefaultKeyType holds the default value on creation for the "key_type" field.
+	DefaultKeyType string
+	// DefaultSignatureAlgorithm holds the default value on creation for the "signature_algorithm" field.
+	DefaultSignatureAlgorithm string
+	// DefaultIsCa holds the default value on creation for the "is_ca" field.
+	DefaultIsCa bool
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
@@ -104,6 +194,21 @@ func ByKeyPem(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKeyPem, opts...).ToFunc()
 }
 
+// ByKeyRef orders the results by the key_ref field.
+func ByKeyRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyRef, opts...).ToFunc()
+}
+
+// ByKeyExportable orders the results by the key_exportable field.
+func ByKeyExportable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyExportable, opts...).ToFunc()
+}
+
+// ByOffline orders the results by the offline field.
+func ByOffline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOffline, opts...).ToFunc()
+}
+
 // ByDesc orders the results by the desc field.
 func ByDesc(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDesc, opts...).ToFunc()
@@ -119,6 +224,61 @@ func ByUsage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsage, opts...).ToFunc()
 }
 
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByCommonName orders the results by the common_name field.
+func ByCommonName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommonName, opts...).ToFunc()
+}
+
+// BySerialNumber orders the results by the serial_number field.
+func BySerialNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSerialNumber, opts...).ToFunc()
+}
+
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// BySubjectKeyID orders the results by the subject_key_id field.
+func BySubjectKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectKeyID, opts...).ToFunc()
+}
+
+// ByAuthorityKeyID orders the results by the authority_key_id field.
+func ByAuthorityKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorityKeyID, opts...).ToFunc()
+}
+
+// ByKeyType orders the results by the key_type field.
+func ByKeyType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyType, opts...).ToFunc()
+}
+
+// BySignatureAlgorithm orders the results by the signature_algorithm field.
+func BySignatureAlgorithm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignatureAlgorithm, opts...).ToFunc()
+}
+
+// ByIsCa orders the results by the is_ca field.
+func ByIsCa(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsCa, opts...).ToFunc()
+}
+
+// ByNotBefore orders the results by the not_before field.
+func ByNotBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotBefore, opts...).ToFunc()
+}
+
+// ByNotAfter orders the results by the not_after field.
+func ByNotAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotAfter, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -135,6 +295,27 @@ func ByNamespaceField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newNamespaceStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByExpiryNotificationsCount orders the results by expiry_notifications count.
+func ByExpiryNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExpiryNotificationsStep(), opts...)
+	}
+}
+
+// ByExpiryNotifications orders the results by expiry_notifications terms.
+func ByExpiryNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExpiryNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRenewalPolicyField orders the results by renewal_policy field.
+func ByRenewalPolicyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRenewalPolicyStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newNamespaceStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -142,3 +323,17 @@ func newNamespaceStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, NamespaceTable, NamespaceColumn),
 	)
 }
+func newExpiryNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExpiryNotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExpiryNotificationsTable, ExpiryNotificationsColumn),
+	)
+}
+func newRenewalPolicyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RenewalPolicyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, RenewalPolicyTable, RenewalPolicyColumn),
+	)
+}
@@ -70,6 +70,21 @@ func KeyPem(v string) predicate.Certificate {
 	return predicate.Certificate(sql.FieldEQ(FieldKeyPem, v))
 }
 
+// KeyRef applies equality check predicate on the "key_ref" field. It's identical to KeyRefEQ.
+func KeyRef(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldKeyRef, v))
+}
+
+// KeyExportable applies equality check predicate on the "key_exportable" field. It's identical to KeyExportableEQ.
+func KeyExportable(v bool) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldKeyExportable, v))
+}
+
+// Offline applies equality check predicate on the "offline" field. It's identical to OfflineEQ.
+func Offline(v bool) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldOffline, v))
+}
+
 // Desc applies equality check predicate on the "desc" field. It's identical to DescEQ.
 func Desc(v string) predicate.Certificate {
 	return predicate.Certificate(sql.FieldEQ(FieldDesc, v))
@@ -85,6 +100,61 @@ func Usage(v string) predicate.Certificate {
 	return predicate.Certificate(sql.FieldEQ(FieldUsage, v))
 }
 
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSubject, v))
+}
+
+// CommonName applies equality check predicate on the "common_name" field. It's identical to CommonNameEQ.
+func CommonName(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCommonName, v))
+}
+
+// SerialNumber applies equality check predicate on the "serial_number" field. It's identical to SerialNumberEQ.
+func SerialNumber(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSerialNumber, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// SubjectKeyID applies equality check predicate on the "subject_key_id" field. It's identical to SubjectKeyIDEQ.
+func SubjectKeyID(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSubjectKeyID, v))
+}
+
+// AuthorityKeyID applies equality check predicate on the "authority_key_id" field. It's identical to AuthorityKeyIDEQ.
+func AuthorityKeyID(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldAuthorityKeyID, v))
+}
+
+// KeyType applies equality check predicate on the "key_type" field. It's identical to KeyTypeEQ.
+func KeyType(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldKeyType, v))
+}
+
+// SignatureAlgorithm applies equality check predicate on the "signature_algorithm" field. It's identical to SignatureAlgorithmEQ.
+func SignatureAlgorithm(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSignatureAlgorithm, v))
+}
+
+// IsCa applies equality check predicate on the "is_ca" field. It's identical to IsCaEQ.
+func IsCa(v bool) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldIsCa, v))
+}
+
+// NotBefore applies equality check predicate on the "not_before" field. It's identical to NotBeforeEQ.
+func NotBefore(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldNotBefore, v))
+}
+
+// NotAfter applies equality check predicate on the "not_after" field. It's identical to NotAfterEQ.
+func NotAfter(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldNotAfter, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Certificate {
 	return predicate.Certificate(sql.FieldEQ(FieldUpdatedAt, v))
@@ -255,6 +325,101 @@ func KeyPemContainsFold(v string) predicate.Certificate {
 	return predicate.Certificate(sql.FieldContainsFold(FieldKeyPem, v))
 }
 
+// KeyRefEQ applies the EQ predicate on the "key_ref" field.
+func KeyRefEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldKeyRef, v))
+}
+
+// KeyRefNEQ applies the NEQ predicate on the "key_ref" field.
+func KeyRefNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldKeyRef, v))
+}
+
+// KeyRefIn applies the In predicate on the "key_ref" field.
+func KeyRefIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldKeyRef, vs...))
+}
+
+// KeyRefNotIn applies the NotIn predicate on the "key_ref" field.
+func KeyRefNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldKeyRef, vs...))
+}
+
+// KeyRefGT applies the GT predicate on the "key_ref" field.
+func KeyRefGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldKeyRef, v))
+}
+
+// KeyRefGTE applies the GTE predicate on the "key_ref" field.
+func KeyRefGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldKeyRef, v))
+}
+
+// KeyRefLT applies the LT predicate on the "key_ref" field.
+func KeyRefLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldKeyRef, v))
+}
+
+// KeyRefLTE applies the LTE predicate on the "key_ref" field.
+func KeyRefLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldKeyRef, v))
+}
+
+// KeyRefContains applies the Contains predicate on the "key_ref" field.
+func KeyRefContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldKeyRef, v))
+}
+
+// KeyRefHasPrefix applies the HasPrefix predicate on the "key_ref" field.
+func KeyRefHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldKeyRef, v))
+}
+
+// KeyRefHasSuffix applies the HasSuffix predicate on the "key_ref" field.
+func KeyRefHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldKeyRef, v))
+}
+
+// KeyRefIsNil applies the IsNil predicate on the "key_ref" field.
+func KeyRefIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldKeyRef))
+}
+
+// KeyRefNotNil applies the NotNil predicate on the "key_ref" field.
+func KeyRefNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldKeyRef))
+}
+
+// KeyRefEqualFold applies the EqualFold predicate on the "key_ref" field.
+func KeyRefEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldKeyRef, v))
+}
+
+// KeyRefContainsFold applies the ContainsFold predicate on the "key_ref" field.
+func KeyRefContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldKeyRef, v))
+}
+
+// KeyExportableEQ applies the EQ predicate on the "key_exportable" field.
+func KeyExportableEQ(v bool) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldKeyExportable, v))
+}
+
+// KeyExportableNEQ applies the NEQ predicate on the "key_exportable" field.
+func KeyExportableNEQ(v bool) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldKeyExportable, v))
+}
+
+// OfflineEQ applies the EQ predicate on the "offline" field.
+func OfflineEQ(v bool) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldOffline, v))
+}
+
+// OfflineNEQ applies the NEQ predicate on the "offline" field.
+func OfflineNEQ(v bool) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldOffline, v))
+}
+
 // DescEQ applies the EQ predicate on the "desc" field.
 func DescEQ(v string) predicate.Certificate {
 	return predicate.Certificate(sql.FieldEQ(FieldDesc, v))
@@ -455,6 +620,746 @@ func UsageContainsFold(v string) predicate.Certificate {
 	return predicate.Certificate(sql.FieldContainsFold(FieldUsage, v))
 }
 
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectIsNil applies the IsNil predicate on the "subject" field.
+func SubjectIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldSubject))
+}
+
+// SubjectNotNil applies the NotNil predicate on the "subject" field.
+func SubjectNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldSubject))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// CommonNameEQ applies the EQ predicate on the "common_name" field.
+func CommonNameEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldCommonName, v))
+}
+
+// CommonNameNEQ applies the NEQ predicate on the "common_name" field.
+func CommonNameNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldCommonName, v))
+}
+
+// CommonNameIn applies the In predicate on the "common_name" field.
+func CommonNameIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldCommonName, vs...))
+}
+
+// CommonNameNotIn applies the NotIn predicate on the "common_name" field.
+func CommonNameNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldCommonName, vs...))
+}
+
+// CommonNameGT applies the GT predicate on the "common_name" field.
+func CommonNameGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldCommonName, v))
+}
+
+// CommonNameGTE applies the GTE predicate on the "common_name" field.
+func CommonNameGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldCommonName, v))
+}
+
+// CommonNameLT applies the LT predicate on the "common_name" field.
+func CommonNameLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldCommonName, v))
+}
+
+// CommonNameLTE applies the LTE predicate on the "common_name" field.
+func CommonNameLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldCommonName, v))
+}
+
+// CommonNameContains applies the Contains predicate on the "common_name" field.
+func CommonNameContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldCommonName, v))
+}
+
+// CommonNameHasPrefix applies the HasPrefix predicate on the "common_name" field.
+func CommonNameHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldCommonName, v))
+}
+
+// CommonNameHasSuffix applies the HasSuffix predicate on the "common_name" field.
+func CommonNameHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldCommonName, v))
+}
+
+// CommonNameIsNil applies the IsNil predicate on the "common_name" field.
+func CommonNameIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldCommonName))
+}
+
+// CommonNameNotNil applies the NotNil predicate on the "common_name" field.
+func CommonNameNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldCommonName))
+}
+
+// CommonNameEqualFold applies the EqualFold predicate on the "common_name" field.
+func CommonNameEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldCommonName, v))
+}
+
+// CommonNameContainsFold applies the ContainsFold predicate on the "common_name" field.
+func CommonNameContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldCommonName, v))
+}
+
+// SerialNumberEQ applies the EQ predicate on the "serial_number" field.
+func SerialNumberEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSerialNumber, v))
+}
+
+// SerialNumberNEQ applies the NEQ predicate on the "serial_number" field.
+func SerialNumberNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSerialNumber, v))
+}
+
+// SerialNumberIn applies the In predicate on the "serial_number" field.
+func SerialNumberIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSerialNumber, vs...))
+}
+
+// SerialNumberNotIn applies the NotIn predicate on the "serial_number" field.
+func SerialNumberNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSerialNumber, vs...))
+}
+
+// SerialNumberGT applies the GT predicate on the "serial_number" field.
+func SerialNumberGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSerialNumber, v))
+}
+
+// SerialNumberGTE applies the GTE predicate on the "serial_number" field.
+func SerialNumberGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSerialNumber, v))
+}
+
+// SerialNumberLT applies the LT predicate on the "serial_number" field.
+func SerialNumberLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSerialNumber, v))
+}
+
+// SerialNumberLTE applies the LTE predicate on the "serial_number" field.
+func SerialNumberLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSerialNumber, v))
+}
+
+// SerialNumberContains applies the Contains predicate on the "serial_number" field.
+func SerialNumberContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSerialNumber, v))
+}
+
+// SerialNumberHasPrefix applies the HasPrefix predicate on the "serial_number" field.
+func SerialNumberHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSerialNumber, v))
+}
+
+// SerialNumberHasSuffix applies the HasSuffix predicate on the "serial_number" field.
+func SerialNumberHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSerialNumber, v))
+}
+
+// SerialNumberIsNil applies the IsNil predicate on the "serial_number" field.
+func SerialNumberIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldSerialNumber))
+}
+
+// SerialNumberNotNil applies the NotNil predicate on the "serial_number" field.
+func SerialNumberNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldSerialNumber))
+}
+
+// SerialNumberEqualFold applies the EqualFold predicate on the "serial_number" field.
+func SerialNumberEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSerialNumber, v))
+}
+
+// SerialNumberContainsFold applies the ContainsFold predicate on the "serial_number" field.
+func SerialNumberContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSerialNumber, v))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintIsNil applies the IsNil predicate on the "fingerprint" field.
+func FingerprintIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldFingerprint))
+}
+
+// FingerprintNotNil applies the NotNil predicate on the "fingerprint" field.
+func FingerprintNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldFingerprint))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// SubjectKeyIDEQ applies the EQ predicate on the "subject_key_id" field.
+func SubjectKeyIDEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSubjectKeyID, v))
+}
+
+// SubjectKeyIDNEQ applies the NEQ predicate on the "subject_key_id" field.
+func SubjectKeyIDNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSubjectKeyID, v))
+}
+
+// SubjectKeyIDIn applies the In predicate on the "subject_key_id" field.
+func SubjectKeyIDIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSubjectKeyID, vs...))
+}
+
+// SubjectKeyIDNotIn applies the NotIn predicate on the "subject_key_id" field.
+func SubjectKeyIDNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSubjectKeyID, vs...))
+}
+
+// SubjectKeyIDGT applies the GT predicate on the "subject_key_id" field.
+func SubjectKeyIDGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSubjectKeyID, v))
+}
+
+// SubjectKeyIDGTE applies the GTE predicate on the "subject_key_id" field.
+func SubjectKeyIDGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSubjectKeyID, v))
+}
+
+// SubjectKeyIDLT applies the LT predicate on the "subject_key_id" field.
+func SubjectKeyIDLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSubjectKeyID, v))
+}
+
+// SubjectKeyIDLTE applies the LTE predicate on the "subject_key_id" field.
+func SubjectKeyIDLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSubjectKeyID, v))
+}
+
+// SubjectKeyIDContains applies the Contains predicate on the "subject_key_id" field.
+func SubjectKeyIDContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSubjectKeyID, v))
+}
+
+// SubjectKeyIDHasPrefix applies the HasPrefix predicate on the "subject_key_id" field.
+func SubjectKeyIDHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSubjectKeyID, v))
+}
+
+// SubjectKeyIDHasSuffix applies the HasSuffix predicate on the "subject_key_id" field.
+func SubjectKeyIDHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSubjectKeyID, v))
+}
+
+// SubjectKeyIDIsNil applies the IsNil predicate on the "subject_key_id" field.
+func SubjectKeyIDIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldSubjectKeyID))
+}
+
+// SubjectKeyIDNotNil applies the NotNil predicate on the "subject_key_id" field.
+func SubjectKeyIDNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldSubjectKeyID))
+}
+
+// SubjectKeyIDEqualFold applies the EqualFold predicate on the "subject_key_id" field.
+func SubjectKeyIDEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSubjectKeyID, v))
+}
+
+// SubjectKeyIDContainsFold applies the ContainsFold predicate on the "subject_key_id" field.
+func SubjectKeyIDContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSubjectKeyID, v))
+}
+
+// AuthorityKeyIDEQ applies the EQ predicate on the "authority_key_id" field.
+func AuthorityKeyIDEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldAuthorityKeyID, v))
+}
+
+// AuthorityKeyIDNEQ applies the NEQ predicate on the "authority_key_id" field.
+func AuthorityKeyIDNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldAuthorityKeyID, v))
+}
+
+// AuthorityKeyIDIn applies the In predicate on the "authority_key_id" field.
+func AuthorityKeyIDIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldAuthorityKeyID, vs...))
+}
+
+// AuthorityKeyIDNotIn applies the NotIn predicate on the "authority_key_id" field.
+func AuthorityKeyIDNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldAuthorityKeyID, vs...))
+}
+
+// AuthorityKeyIDGT applies the GT predicate on the "authority_key_id" field.
+func AuthorityKeyIDGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldAuthorityKeyID, v))
+}
+
+// AuthorityKeyIDGTE applies the GTE predicate on the "authority_key_id" field.
+func AuthorityKeyIDGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldAuthorityKeyID, v))
+}
+
+// AuthorityKeyIDLT applies the LT predicate on the "authority_key_id" field.
+func AuthorityKeyIDLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldAuthorityKeyID, v))
+}
+
+// AuthorityKeyIDLTE applies the LTE predicate on the "authority_key_id" field.
+func AuthorityKeyIDLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldAuthorityKeyID, v))
+}
+
+// AuthorityKeyIDContains applies the Contains predicate on the "authority_key_id" field.
+func AuthorityKeyIDContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldAuthorityKeyID, v))
+}
+
+// AuthorityKeyIDHasPrefix applies the HasPrefix predicate on the "authority_key_id" field.
+func AuthorityKeyIDHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldAuthorityKeyID, v))
+}
+
+// AuthorityKeyIDHasSuffix applies the HasSuffix predicate on the "authority_key_id" field.
+func AuthorityKeyIDHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldAuthorityKeyID, v))
+}
+
+// AuthorityKeyIDIsNil applies the IsNil predicate on the "authority_key_id" field.
+func AuthorityKeyIDIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldAuthorityKeyID))
+}
+
+// AuthorityKeyIDNotNil applies the NotNil predicate on the "authority_key_id" field.
+func AuthorityKeyIDNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldAuthorityKeyID))
+}
+
+// AuthorityKeyIDEqualFold applies the EqualFold predicate on the "authority_key_id" field.
+func AuthorityKeyIDEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldAuthorityKeyID, v))
+}
+
+// AuthorityKeyIDContainsFold applies the ContainsFold predicate on the "authority_key_id" field.
+func AuthorityKeyIDContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldAuthorityKeyID, v))
+}
+
+// KeyTypeEQ applies the EQ predicate on the "key_type" field.
+func KeyTypeEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldKeyType, v))
+}
+
+// KeyTypeNEQ applies the NEQ predicate on the "key_type" field.
+func KeyTypeNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldKeyType, v))
+}
+
+// KeyTypeIn applies the In predicate on the "key_type" field.
+func KeyTypeIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldKeyType, vs...))
+}
+
+// KeyTypeNotIn applies the NotIn predicate on the "key_type" field.
+func KeyTypeNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldKeyType, vs...))
+}
+
+// KeyTypeGT applies the GT predicate on the "key_type" field.
+func KeyTypeGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldKeyType, v))
+}
+
+// KeyTypeGTE applies the GTE predicate on the "key_type" field.
+func KeyTypeGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldKeyType, v))
+}
+
+// KeyTypeLT applies the LT predicate on the "key_type" field.
+func KeyTypeLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldKeyType, v))
+}
+
+// KeyTypeLTE applies the LTE predicate on the "key_type" field.
+func KeyTypeLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldKeyType, v))
+}
+
+// KeyTypeContains applies the Contains predicate on the "key_type" field.
+func KeyTypeContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldKeyType, v))
+}
+
+// KeyTypeHasPrefix applies the HasPrefix predicate on the "key_type" field.
+func KeyTypeHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldKeyType, v))
+}
+
+// KeyTypeHasSuffix applies the HasSuffix predicate on the "key_type" field.
+func KeyTypeHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldKeyType, v))
+}
+
+// KeyTypeIsNil applies the IsNil predicate on the "key_type" field.
+func KeyTypeIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldKeyType))
+}
+
+// KeyTypeNotNil applies the NotNil predicate on the "key_type" field.
+func KeyTypeNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldKeyType))
+}
+
+// KeyTypeEqualFold applies the EqualFold predicate on the "key_type" field.
+func KeyTypeEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldKeyType, v))
+}
+
+// KeyTypeContainsFold applies the ContainsFold predicate on the "key_type" field.
+func KeyTypeContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldKeyType, v))
+}
+
+// SignatureAlgorithmEQ applies the EQ predicate on the "signature_algorithm" field.
+func SignatureAlgorithmEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldSignatureAlgorithm, v))
+}
+
+// SignatureAlgorithmNEQ applies the NEQ predicate on the "signature_algorithm" field.
+func SignatureAlgorithmNEQ(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldSignatureAlgorithm, v))
+}
+
+// SignatureAlgorithmIn applies the In predicate on the "signature_algorithm" field.
+func SignatureAlgorithmIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldSignatureAlgorithm, vs...))
+}
+
+// SignatureAlgorithmNotIn applies the NotIn predicate on the "signature_algorithm" field.
+func SignatureAlgorithmNotIn(vs ...string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldSignatureAlgorithm, vs...))
+}
+
+// SignatureAlgorithmGT applies the GT predicate on the "signature_algorithm" field.
+func SignatureAlgorithmGT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldSignatureAlgorithm, v))
+}
+
+// SignatureAlgorithmGTE applies the GTE predicate on the "signature_algorithm" field.
+func SignatureAlgorithmGTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldSignatureAlgorithm, v))
+}
+
+// SignatureAlgorithmLT applies the LT predicate on the "signature_algorithm" field.
+func SignatureAlgorithmLT(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldSignatureAlgorithm, v))
+}
+
+// SignatureAlgorithmLTE applies the LTE predicate on the "signature_algorithm" field.
+func SignatureAlgorithmLTE(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldSignatureAlgorithm, v))
+}
+
+// SignatureAlgorithmContains applies the Contains predicate on the "signature_algorithm" field.
+func SignatureAlgorithmContains(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContains(FieldSignatureAlgorithm, v))
+}
+
+// SignatureAlgorithmHasPrefix applies the HasPrefix predicate on the "signature_algorithm" field.
+func SignatureAlgorithmHasPrefix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasPrefix(FieldSignatureAlgorithm, v))
+}
+
+// SignatureAlgorithmHasSuffix applies the HasSuffix predicate on the "signature_algorithm" field.
+func SignatureAlgorithmHasSuffix(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldHasSuffix(FieldSignatureAlgorithm, v))
+}
+
+// SignatureAlgorithmIsNil applies the IsNil predicate on the "signature_algorithm" field.
+func SignatureAlgorithmIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldSignatureAlgorithm))
+}
+
+// SignatureAlgorithmNotNil applies the NotNil predicate on the "signature_algorithm" field.
+func SignatureAlgorithmNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldSignatureAlgorithm))
+}
+
+// SignatureAlgorithmEqualFold applies the EqualFold predicate on the "signature_algorithm" field.
+func SignatureAlgorithmEqualFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEqualFold(FieldSignatureAlgorithm, v))
+}
+
+// SignatureAlgorithmContainsFold applies the ContainsFold predicate on the "signature_algorithm" field.
+func SignatureAlgorithmContainsFold(v string) predicate.Certificate {
+	return predicate.Certificate(sql.FieldContainsFold(FieldSignatureAlgorithm, v))
+}
+
+// IsCaEQ applies the EQ predicate on the "is_ca" field.
+func IsCaEQ(v bool) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldIsCa, v))
+}
+
+// IsCaNEQ applies the NEQ predicate on the "is_ca" field.
+func IsCaNEQ(v bool) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldIsCa, v))
+}
+
+// IsCaIsNil applies the IsNil predicate on the "is_ca" field.
+func IsCaIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldIsCa))
+}
+
+// IsCaNotNil applies the NotNil predicate on the "is_ca" field.
+func IsCaNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldIsCa))
+}
+
+// NotBeforeEQ applies the EQ predicate on the "not_before" field.
+func NotBeforeEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldNotBefore, v))
+}
+
+// NotBeforeNEQ applies the NEQ predicate on the "not_before" field.
+func NotBeforeNEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldNotBefore, v))
+}
+
+// NotBeforeIn applies the In predicate on the "not_before" field.
+func NotBeforeIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldNotBefore, vs...))
+}
+
+// NotBeforeNotIn applies the NotIn predicate on the "not_before" field.
+func NotBeforeNotIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldNotBefore, vs...))
+}
+
+// NotBeforeGT applies the GT predicate on the "not_before" field.
+func NotBeforeGT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldNotBefore, v))
+}
+
+// NotBeforeGTE applies the GTE predicate on the "not_before" field.
+func NotBeforeGTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldNotBefore, v))
+}
+
+// NotBeforeLT applies the LT predicate on the "not_before" field.
+func NotBeforeLT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldNotBefore, v))
+}
+
+// NotBeforeLTE applies the LTE predicate on the "not_before" field.
+func NotBeforeLTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldNotBefore, v))
+}
+
+// NotBeforeIsNil applies the IsNil predicate on the "not_before" field.
+func NotBeforeIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldNotBefore))
+}
+
+// NotBeforeNotNil applies the NotNil predicate on the "not_before" field.
+func NotBeforeNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldNotBefore))
+}
+
+// NotAfterEQ applies the EQ predicate on the "not_after" field.
+func NotAfterEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldEQ(FieldNotAfter, v))
+}
+
+// NotAfterNEQ applies the NEQ predicate on the "not_after" field.
+func NotAfterNEQ(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNEQ(FieldNotAfter, v))
+}
+
+// NotAfterIn applies the In predicate on the "not_after" field.
+func NotAfterIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldIn(FieldNotAfter, vs...))
+}
+
+// NotAfterNotIn applies the NotIn predicate on the "not_after" field.
+func NotAfterNotIn(vs ...time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotIn(FieldNotAfter, vs...))
+}
+
+// NotAfterGT applies the GT predicate on the "not_after" field.
+func NotAfterGT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGT(FieldNotAfter, v))
+}
+
+// NotAfterGTE applies the GTE predicate on the "not_after" field.
+func NotAfterGTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldGTE(FieldNotAfter, v))
+}
+
+// NotAfterLT applies the LT predicate on the "not_after" field.
+func NotAfterLT(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLT(FieldNotAfter, v))
+}
+
+// NotAfterLTE applies the LTE predicate on the "not_after" field.
+func NotAfterLTE(v time.Time) predicate.Certificate {
+	return predicate.Certificate(sql.FieldLTE(FieldNotAfter, v))
+}
+
+// NotAfterIsNil applies the IsNil predicate on the "not_after" field.
+func NotAfterIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldNotAfter))
+}
+
+// NotAfterNotNil applies the NotNil predicate on the "not_after" field.
+func NotAfterNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldNotAfter))
+}
+
+// DNSNamesIsNil applies the IsNil predicate on the "dns_names" field.
+func DNSNamesIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldDNSNames))
+}
+
+// DNSNamesNotNil applies the NotNil predicate on the "dns_names" field.
+func DNSNamesNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldDNSNames))
+}
+
+// IPAddressesIsNil applies the IsNil predicate on the "ip_addresses" field.
+func IPAddressesIsNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldIsNull(FieldIPAddresses))
+}
+
+// IPAddressesNotNil applies the NotNil predicate on the "ip_addresses" field.
+func IPAddressesNotNil() predicate.Certificate {
+	return predicate.Certificate(sql.FieldNotNull(FieldIPAddresses))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Certificate {
 	return predicate.Certificate(sql.FieldEQ(FieldUpdatedAt, v))
@@ -558,6 +1463,52 @@ func HasNamespaceWith(preds ...predicate.Namespace) predicate.Certificate {
 	})
 }
 
+// HasExpiryNotifications applies the HasEdge predicate on the "expiry_notifications" edge.
+func HasExpiryNotifications() predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExpiryNotificationsTable, ExpiryNotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExpiryNotificationsWith applies the HasEdge predicate on the "expiry_notifications" edge with a given conditions (other predicates).
+func HasExpiryNotificationsWith(preds ...predicate.ExpiryNotification) predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := newExpiryNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRenewalPolicy applies the HasEdge predicate on the "renewal_policy" edge.
+func HasRenewalPolicy() predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, RenewalPolicyTable, RenewalPolicyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRenewalPolicyWith applies the HasEdge predicate on the "renewal_policy" edge with a given conditions (other predicates).
+func HasRenewalPolicyWith(preds ...predicate.RenewalPolicy) predicate.Certificate {
+	return predicate.Certificate(func(s *sql.Selector) {
+		step := newRenewalPolicyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Certificate) predicate.Certificate {
 	return predicate.Certificate(sql.AndPredicates(predicates...))
@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
 	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
 )
 
 // CertificateCreate is the builder for creating a Certificate entity.
@@ -47,6 +49,48 @@ func (cc *CertificateCreate) SetNillableKeyPem(s *string) *CertificateCreate {
 	return cc
 }
 
+// SetKeyRef sets the "key_ref" field.
+func (cc *CertificateCreate) SetKeyRef(s string) *CertificateCreate {
+	cc.mutation.SetKeyRef(s)
+	return cc
+}
+
+// SetNillableKeyRef sets the "key_ref" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableKeyRef(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetKeyRef(*s)
+	}
+	return cc
+}
+
+// SetKeyExportable sets the "key_exportable" field.
+func (cc *CertificateCreate) SetKeyExportable(b bool) *CertificateCreate {
+	cc.mutation.SetKeyExportable(b)
+	return cc
+}
+
+// SetNillableKeyExportable sets the "key_exportable" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableKeyExportable(b *bool) *CertificateCreate {
+	if b != nil {
+		cc.SetKeyExportable(*b)
+	}
+	return cc
+}
+
+// SetOffline sets the "offline" field.
+func (cc *CertificateCreate) SetOffline(b bool) *CertificateCreate {
+	cc.mutation.SetOffline(b)
+	return cc
+}
+
+// SetNillableOffline sets the "offline" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableOffline(b *bool) *CertificateCreate {
+	if b != nil {
+		cc.SetOffline(*b)
+	}
+	return cc
+}
+
 // SetDesc sets the "desc" field.
 func (cc *CertificateCreate) SetDesc(s string) *CertificateCreate {
 	cc.mutation.SetDesc(s)
@@ -89,6 +133,172 @@ func (cc *CertificateCreate) SetNillableUsage(s *string) *CertificateCreate {
 	return cc
 }
 
+// SetSubject sets the "subject" field.
+func (cc *CertificateCreate) SetSubject(s string) *CertificateCreate {
+	cc.mutation.SetSubject(s)
+	return cc
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableSubject(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetSubject(*s)
+	}
+	return cc
+}
+
+// SetCommonName sets the "common_name" field.
+func (cc *CertificateCreate) SetCommonName(s string) *CertificateCreate {
+	cc.mutation.SetCommonName(s)
+	return cc
+}
+
+// SetNillableCommonName sets the "common_name" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableCommonName(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetCommonName(*s)
+	}
+	return cc
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (cc *CertificateCreate) SetSerialNumber(s string) *CertificateCreate {
+	cc.mutation.SetSerialNumber(s)
+	return cc
+}
+
+// SetNillableSerialNumber sets the "serial_number" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableSerialNumber(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetSerialNumber(*s)
+	}
+	return cc
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (cc *CertificateCreate) SetFingerprint(s string) *CertificateCreate {
+	cc.mutation.SetFingerprint(s)
+	return cc
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableFingerprint(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetFingerprint(*s)
+	}
+	return cc
+}
+
+// SetSubjectKeyID sets the "subject_key_id" field.
+func (cc *CertificateCreate) SetSubjectKeyID(s string) *CertificateCreate {
+	cc.mutation.SetSubjectKeyID(s)
+	return cc
+}
+
+// SetNillableSubjectKeyID sets the "subject_key_id" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableSubjectKeyID(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetSubjectKeyID(*s)
+	}
+	return cc
+}
+
+// SetAuthorityKeyID sets the "authority_key_id" field.
+func (cc *CertificateCreate) SetAuthorityKeyID(s string) *CertificateCreate {
+	cc.mutation.SetAuthorityKeyID(s)
+	return cc
+}
+
+// SetNillableAuthorityKeyID sets the "authority_key_id" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableAuthorityKeyID(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetAuthorityKeyID(*s)
+	}
+	return cc
+}
+
+// SetKeyType sets the "key_type" field.
+func (cc *CertificateCreate) SetKeyType(s string) *CertificateCreate {
+	cc.mutation.SetKeyType(s)
+	return cc
+}
+
+// SetNillableKeyType sets the "key_type" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableKeyType(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetKeyType(*s)
+	}
+	return cc
+}
+
+// SetSignatureAlgorithm sets the "signature_algorithm" field.
+func (cc *CertificateCreate) SetSignatureAlgorithm(s string) *CertificateCreate {
+	cc.mutation.SetSignatureAlgorithm(s)
+	return cc
+}
+
+// SetNillableSignatureAlgorithm sets the "signature_algorithm" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableSignatureAlgorithm(s *string) *CertificateCreate {
+	if s != nil {
+		cc.SetSignatureAlgorithm(*s)
+	}
+	return cc
+}
+
+// SetIsCa sets the "is_ca" field.
+func (cc *CertificateCreate) SetIsCa(b bool) *CertificateCreate {
+	cc.mutation.SetIsCa(b)
+	return cc
+}
+
+// SetNillableIsCa sets the "is_ca" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableIsCa(b *bool) *CertificateCreate {
+	if b != nil {
+		cc.SetIsCa(*b)
+	}
+	return cc
+}
+
+// SetNotBefore sets the "not_before" field.
+func (cc *CertificateCreate) SetNotBefore(t time.Time) *CertificateCreate {
+	cc.mutation.SetNotBefore(t)
+	return cc
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableNotBefore(t *time.Time) *CertificateCreate {
+	if t != nil {
+		cc.SetNotBefore(*t)
+	}
+	return cc
+}
+
+// SetNotAfter sets the "not_after" field.
+func (cc *CertificateCreate) SetNotAfter(t time.Time) *CertificateCreate {
+	cc.mutation.SetNotAfter(t)
+	return cc
+}
+
+// SetNillableNotAfter sets the "not_after" field if the given value is not nil.
+func (cc *CertificateCreate) SetNillableNotAfter(t *time.Time) *CertificateCreate {
+	if t != nil {
+		cc.SetNotAfter(*t)
+	}
+	return cc
+}
+
+// SetDNSNames sets the "dns_names" field.
+func (cc *CertificateCreate) SetDNSNames(s []string) *CertificateCreate {
+	cc.mutation.SetDNSNames(s)
+	return cc
+}
+
+// SetIPAddresses sets the "ip_addresses" field.
+func (cc *CertificateCreate) SetIPAddresses(s []string) *CertificateCreate {
+	cc.mutation.SetIPAddresses(s)
+	return cc
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cc *CertificateCreate) SetUpdatedAt(t time.Time) *CertificateCreate {
 	cc.mutation.SetUpdatedAt(t)
@@ -128,6 +338,40 @@ func (cc *CertificateCreate) SetNamespace(n *Namespace) *CertificateCreate {
 	return cc.SetNamespaceID(n.ID)
 }
 
+// AddExpiryNotificationIDs adds the "expiry_notifications" edge to the ExpiryNotification entity by IDs.
+func (cc *CertificateCreate) AddExpiryNotificationIDs(ids ...int) *CertificateCreate {
+	cc.mutation.AddExpiryNotificationIDs(ids...)
+	return cc
+}
+
+// AddExpiryNotifications adds the "expiry_notifications" edges to the ExpiryNotification entity.
+func (cc *CertificateCreate) AddExpiryNotifications(e ...*ExpiryNotification) *CertificateCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return cc.AddExpiryNotificationIDs(ids...)
+}
+
+// SetRenewalPolicyID sets the "renewal_policy" edge to the RenewalPolicy entity by ID.
+func (cc *CertificateCreate) SetRenewalPolicyID(id int) *CertificateCreate {
+	cc.mutation.SetRenewalPolicyID(id)
+	return cc
+}
+
+// SetNillableRenewalPolicyID sets the "renewal_policy" edge to the RenewalPolicy entity by ID if the given value is not nil.
+func (cc *CertificateCreate) SetNillableRenewalPolicyID(id *int) *CertificateCreate {
+	if id != nil {
+		cc = cc.SetRenewalPolicyID(*id)
+	}
+	return cc
+}
+
+// SetRenewalPolicy sets the "renewal_policy" edge to the RenewalPolicy entity.
+func (cc *CertificateCreate) SetRenewalPolicy(r *RenewalPolicy) *CertificateCreate {
+	return cc.SetRenewalPolicyID(r.ID)
+}
+
 // Mutation returns the CertificateMutation object of the builder.
 func (cc *CertificateCreate) Mutation() *CertificateMutation {
 	return cc.mutation
@@ -163,6 +407,18 @@ func (cc *CertificateCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cc *CertificateCreate) defaults() {
+	if _, ok := cc.mutation.KeyRef(); !ok {
+		v := certificate.DefaultKeyRef
+		cc.mutation.SetKeyRef(v)
+	}
+	if _, ok := cc.mutation.KeyExportable(); !ok {
+		v := certificate.DefaultKeyExportable
+		cc.mutation.SetKeyExportable(v)
+	}
+	if _, ok := cc.mutation.Offline(); !ok {
+		v := certificate.DefaultOffline
+		cc.mutation.SetOffline(v)
+	}
 	if _, ok := cc.mutation.Desc(); !ok {
 		v := certificate.DefaultDesc
 		cc.mutation.SetDesc(v)
@@ -171,6 +427,42 @@ func (cc *CertificateCreate) defaults() {
 		v := certificate.DefaultUsage
 		cc.mutation.SetUsage(v)
 	}
+	if _, ok := cc.mutation.Subject(); !ok {
+		v := certificate.DefaultSubject
+		cc.mutation.SetSubject(v)
+	}
+	if _, ok := cc.mutation.CommonName(); !ok {
+		v := certificate.DefaultCommonName
+		cc.mutation.SetCommonName(v)
+	}
+	if _, ok := cc.mutation.SerialNumber(); !ok {
+		v := certificate.DefaultSerialNumber
+		cc.mutation.SetSerialNumber(v)
+	}
+	if _, ok := cc.mutation.Fingerprint(); !ok {
+		v := certificate.DefaultFingerprint
+		cc.mutation.SetFingerprint(v)
+	}
+	if _, ok := cc.mutation.SubjectKeyID(); !ok {
+		v := certificate.DefaultSubjectKeyID
+		cc.mutation.SetSubjectKeyID(v)
+	}
+	if _, ok := cc.mutation.AuthorityKeyID(); !ok {
+		v := certificate.DefaultAuthorityKeyID
+		cc.mutation.SetAuthorityKeyID(v)
+	}
+	if _, ok := cc.mutation.KeyType(); !ok {
+		v := certificate.DefaultKeyType
+		cc.mutation.SetKeyType(v)
+	}
+	if _, ok := cc.mutation.SignatureAlgorithm(); !ok {
+		v := certificate.DefaultSignatureAlgorithm
+		cc.mutation.SetSignatureAlgorithm(v)
+	}
+	if _, ok := cc.mutation.IsCa(); !ok {
+		v := certificate.DefaultIsCa
+		cc.mutation.SetIsCa(v)
+	}
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
 		v := certificate.DefaultUpdatedAt()
 		cc.mutation.SetUpdatedAt(v)
@@ -189,6 +481,12 @@ func (cc *CertificateCreate) check() error {
 	if _, ok := cc.mutation.CertPem(); !ok {
 		return &ValidationError{Name: "cert_pem", err: errors.New(`ent: missing required field "Certificate.cert_pem"`)}
 	}
+	if _, ok := cc.mutation.KeyExportable(); !ok {
+		return &ValidationError{Name: "key_exportable", err: errors.New(`ent: missing required field "Certificate.key_exportable"`)}
+	}
+	if _, ok := cc.mutation.Offline(); !ok {
+		return &ValidationError{Name: "offline", err: errors.New(`ent: missing required field "Certificate.offline"`)}
+	}
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Certificate.updated_at"`)}
 	}
@@ -243,6 +541,18 @@ func (cc *CertificateCreate) createSpec() (*Certificate, *sqlgraph.CreateSpec) {
 		_spec.SetField(certificate.FieldKeyPem, field.TypeString, value)
 		_node.KeyPem = value
 	}
+	if value, ok := cc.mutation.KeyRef(); ok {
+		_spec.SetField(certificate.FieldKeyRef, field.TypeString, value)
+		_node.KeyRef = value
+	}
+	if value, ok := cc.mutation.KeyExportable(); ok {
+		_spec.SetField(certificate.FieldKeyExportable, field.TypeBool, value)
+		_node.KeyExportable = value
+	}
+	if value, ok := cc.mutation.Offline(); ok {
+		_spec.SetField(certificate.FieldOffline, field.TypeBool, value)
+		_node.Offline = value
+	}
 	if value, ok := cc.mutation.Desc(); ok {
 		_spec.SetField(certificate.FieldDesc, field.TypeString, value)
 		_node.Desc = value
@@ -255,6 +565,58 @@ func (cc *CertificateCreate) createSpec() (*Certificate, *sqlgraph.CreateSpec) {
 		_spec.SetField(certificate.FieldUsage, field.TypeString, value)
 		_node.Usage = value
 	}
+	if value, ok := cc.mutation.Subject(); ok {
+		_spec.SetField(certificate.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := cc.mutation.CommonName(); ok {
+		_spec.SetField(certificate.FieldCommonName, field.TypeString, value)
+		_node.CommonName = value
+	}
+	if value, ok := cc.mutation.SerialNumber(); ok {
+		_spec.SetField(certificate.FieldSerialNumber, field.TypeString, value)
+		_node.SerialNumber = value
+	}
+	if value, ok := cc.mutation.Fingerprint(); ok {
+		_spec.SetField(certificate.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := cc.mutation.SubjectKeyID(); ok {
+		_spec.SetField(certificate.FieldSubjectKeyID, field.TypeString, value)
+		_node.SubjectKeyID = value
+	}
+	if value, ok := cc.mutation.AuthorityKeyID(); ok {
+		_spec.SetField(certificate.FieldAuthorityKeyID, field.TypeString, value)
+		_node.AuthorityKeyID = value
+	}
+	if value, ok := cc.mutation.KeyType(); ok {
+		_spec.SetField(certificate.FieldKeyType, field.TypeString, value)
+		_node.KeyType = value
+	}
+	if value, ok := cc.mutation.SignatureAlgorithm(); ok {
+		_spec.SetField(certificate.FieldSignatureAlgorithm, field.TypeString, value)
+		_node.SignatureAlgorithm = value
+	}
+	if value, ok := cc.mutation.IsCa(); ok {
+		_spec.SetField(certificate.FieldIsCa, field.TypeBool, value)
+		_node.IsCa = value
+	}
+	if value, ok := cc.mutation.NotBefore(); ok {
+		_spec.SetField(certificate.FieldNotBefore, field.TypeTime, value)
+		_node.NotBefore = value
+	}
+	if value, ok := cc.mutation.NotAfter(); ok {
+		_spec.SetField(certificate.FieldNotAfter, field.TypeTime, value)
+		_node.NotAfter = value
+	}
+	if value, ok := cc.mutation.DNSNames(); ok {
+		_spec.SetField(certificate.FieldDNSNames, field.TypeJSON, value)
+		_node.DNSNames = value
+	}
+	if value, ok := cc.mutation.IPAddresses(); ok {
+		_spec.SetField(certificate.FieldIPAddresses, field.TypeJSON, value)
+		_node.IPAddresses = value
+	}
 	if value, ok := cc.mutation.UpdatedAt(); ok {
 		_spec.SetField(certificate.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -280,6 +642,38 @@ func (cc *CertificateCreate) createSpec() (*Certificate, *sqlgraph.CreateSpec) {
 		_node.NamespaceID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.ExpiryNotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.ExpiryNotificationsTable,
+			Columns: []string{certificate.ExpiryNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.RenewalPolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   certificate.RenewalPolicyTable,
+			Columns: []string{certificate.RenewalPolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,18 +13,22 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
 	"github.com/logeable/certmgr/internal/ent/namespace"
 	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
 )
 
 // CertificateQuery is the builder for querying Certificate entities.
 type CertificateQuery struct {
 	config
-	ctx           *QueryContext
-	order         []certificate.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Certificate
-	withNamespace *NamespaceQuery
+	ctx                     *QueryContext
+	order                   []certificate.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Certificate
+	withNamespace           *NamespaceQuery
+	withExpiryNotifications *ExpiryNotificationQuery
+	withRenewalPolicy       *RenewalPolicyQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +87,50 @@ func (cq *CertificateQuery) QueryNamespace() *NamespaceQuery {
 	return query
 }
 
+// QueryExpiryNotifications chains the current query on the "expiry_notifications" edge.
+func (cq *CertificateQuery) QueryExpiryNotifications() *ExpiryNotificationQuery {
+	query := (&ExpiryNotificationClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certificate.Table, certificate.FieldID, selector),
+			sqlgraph.To(expirynotification.Table, expirynotification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, certificate.ExpiryNotificationsTable, certificate.ExpiryNotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRenewalPolicy chains the current query on the "renewal_policy" edge.
+func (cq *CertificateQuery) QueryRenewalPolicy() *RenewalPolicyQuery {
+	query := (&RenewalPolicyClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certificate.Table, certificate.FieldID, selector),
+			sqlgraph.To(renewalpolicy.Table, renewalpolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, certificate.RenewalPolicyTable, certificate.RenewalPolicyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Certificate entity from the query.
 // Returns a *NotFoundError when no Certificate was found.
 func (cq *CertificateQuery) First(ctx context.Context) (*Certificate, error) {
@@ -269,12 +318,14 @@ func (cq *CertificateQuery) Clone() *CertificateQuery {
 		return nil
 	}
 	return &CertificateQuery{
-		config:        cq.config,
-		ctx:           cq.ctx.Clone(),
-		order:         append([]certificate.OrderOption{}, cq.order...),
-		inters:        append([]Interceptor{}, cq.inters...),
-		predicates:    append([]predicate.Certificate{}, cq.predicates...),
-		withNamespace: cq.withNamespace.Clone(),
+		config:                  cq.config,
+		ctx:                     cq.ctx.Clone(),
+		order:                   append([]certificate.OrderOption{}, cq.order...),
+		inters:                  append([]Interceptor{}, cq.inters...),
+		predicates:              append([]predicate.Certificate{}, cq.predicates...),
+		withNamespace:           cq.withNamespace.Clone(),
+		withExpiryNotifications: cq.withExpiryNotifications.Clone(),
+		withRenewalPolicy:       cq.withRenewalPolicy.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -292,6 +343,28 @@ func (cq *CertificateQuery) WithNamespace(opts ...func(*NamespaceQuery)) *Certif
 	return cq
 }
 
+// WithExpiryNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "expiry_notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CertificateQuery) WithExpiryNotifications(opts ...func(*ExpiryNotificationQuery)) *CertificateQuery {
+	query := (&ExpiryNotificationClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withExpiryNotifications = query
+	return cq
+}
+
+// WithRenewalPolicy tells the query-builder to eager-load the nodes that are connected to
+// the "renewal_policy" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CertificateQuery) WithRenewalPolicy(opts ...func(*RenewalPolicyQuery)) *CertificateQuery {
+	query := (&RenewalPolicyClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withRenewalPolicy = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +443,10 @@ func (cq *CertificateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*Certificate{}
 		_spec       = cq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			cq.withNamespace != nil,
+			cq.withExpiryNotifications != nil,
+			cq.withRenewalPolicy != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -398,6 +473,21 @@ func (cq *CertificateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := cq.withExpiryNotifications; query != nil {
+		if err := cq.loadExpiryNotifications(ctx, query, nodes,
+			func(n *Certificate) { n.Edges.ExpiryNotifications = []*ExpiryNotification{} },
+			func(n *Certificate, e *ExpiryNotification) {
+				n.Edges.ExpiryNotifications = append(n.Edges.ExpiryNotifications, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withRenewalPolicy; query != nil {
+		if err := cq.loadRenewalPolicy(ctx, query, nodes, nil,
+			func(n *Certificate, e *RenewalPolicy) { n.Edges.RenewalPolicy = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,6 +520,63 @@ func (cq *CertificateQuery) loadNamespace(ctx context.Context, query *NamespaceQ
 	}
 	return nil
 }
+func (cq *CertificateQuery) loadExpiryNotifications(ctx context.Context, query *ExpiryNotificationQuery, nodes []*Certificate, init func(*Certificate), assign func(*Certificate, *ExpiryNotification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Certificate)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(expirynotification.FieldCertificateID)
+	}
+	query.Where(predicate.ExpiryNotification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(certificate.ExpiryNotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CertificateID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "certificate_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (cq *CertificateQuery) loadRenewalPolicy(ctx context.Context, query *RenewalPolicyQuery, nodes []*Certificate, init func(*Certificate), assign func(*Certificate, *RenewalPolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Certificate)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(renewalpolicy.FieldCertificateID)
+	}
+	query.Where(predicate.RenewalPolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(certificate.RenewalPolicyColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CertificateID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "certificate_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (cq *CertificateQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
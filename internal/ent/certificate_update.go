@@ -10,10 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
 	"github.com/logeable/certmgr/internal/ent/namespace"
 	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
 )
 
 // CertificateUpdate is the builder for updating Certificate entities.
@@ -77,6 +80,54 @@ func (cu *CertificateUpdate) ClearKeyPem() *CertificateUpdate {
 	return cu
 }
 
+// SetKeyRef sets the "key_ref" field.
+func (cu *CertificateUpdate) SetKeyRef(s string) *CertificateUpdate {
+	cu.mutation.SetKeyRef(s)
+	return cu
+}
+
+// SetNillableKeyRef sets the "key_ref" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableKeyRef(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetKeyRef(*s)
+	}
+	return cu
+}
+
+// ClearKeyRef clears the value of the "key_ref" field.
+func (cu *CertificateUpdate) ClearKeyRef() *CertificateUpdate {
+	cu.mutation.ClearKeyRef()
+	return cu
+}
+
+// SetKeyExportable sets the "key_exportable" field.
+func (cu *CertificateUpdate) SetKeyExportable(b bool) *CertificateUpdate {
+	cu.mutation.SetKeyExportable(b)
+	return cu
+}
+
+// SetNillableKeyExportable sets the "key_exportable" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableKeyExportable(b *bool) *CertificateUpdate {
+	if b != nil {
+		cu.SetKeyExportable(*b)
+	}
+	return cu
+}
+
+// SetOffline sets the "offline" field.
+func (cu *CertificateUpdate) SetOffline(b bool) *CertificateUpdate {
+	cu.mutation.SetOffline(b)
+	return cu
+}
+
+// SetNillableOffline sets the "offline" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableOffline(b *bool) *CertificateUpdate {
+	if b != nil {
+		cu.SetOffline(*b)
+	}
+	return cu
+}
+
 // SetDesc sets the "desc" field.
 func (cu *CertificateUpdate) SetDesc(s string) *CertificateUpdate {
 	cu.mutation.SetDesc(s)
@@ -144,6 +195,262 @@ func (cu *CertificateUpdate) ClearUsage() *CertificateUpdate {
 	return cu
 }
 
+// SetSubject sets the "subject" field.
+func (cu *CertificateUpdate) SetSubject(s string) *CertificateUpdate {
+	cu.mutation.SetSubject(s)
+	return cu
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableSubject(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetSubject(*s)
+	}
+	return cu
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (cu *CertificateUpdate) ClearSubject() *CertificateUpdate {
+	cu.mutation.ClearSubject()
+	return cu
+}
+
+// SetCommonName sets the "common_name" field.
+func (cu *CertificateUpdate) SetCommonName(s string) *CertificateUpdate {
+	cu.mutation.SetCommonName(s)
+	return cu
+}
+
+// SetNillableCommonName sets the "common_name" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableCommonName(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetCommonName(*s)
+	}
+	return cu
+}
+
+// ClearCommonName clears the value of the "common_name" field.
+func (cu *CertificateUpdate) ClearCommonName() *CertificateUpdate {
+	cu.mutation.ClearCommonName()
+	return cu
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (cu *CertificateUpdate) SetSerialNumber(s string) *CertificateUpdate {
+	cu.mutation.SetSerialNumber(s)
+	return cu
+}
+
+// SetNillableSerialNumber sets the "serial_number" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableSerialNumber(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetSerialNumber(*s)
+	}
+	return cu
+}
+
+// ClearSerialNumber clears the value of the "serial_number" field.
+func (cu *CertificateUpdate) ClearSerialNumber() *CertificateUpdate {
+	cu.mutation.ClearSerialNumber()
+	return cu
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (cu *CertificateUpdate) SetFingerprint(s string) *CertificateUpdate {
+	cu.mutation.SetFingerprint(s)
+	return cu
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableFingerprint(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetFingerprint(*s)
+	}
+	return cu
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (cu *CertificateUpdate) ClearFingerprint() *CertificateUpdate {
+	cu.mutation.ClearFingerprint()
+	return cu
+}
+
+// SetSubjectKeyID sets the "subject_key_id" field.
+func (cu *CertificateUpdate) SetSubjectKeyID(s string) *CertificateUpdate {
+	cu.mutation.SetSubjectKeyID(s)
+	return cu
+}
+
+// SetNillableSubjectKeyID sets the "subject_key_id" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableSubjectKeyID(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetSubjectKeyID(*s)
+	}
+	return cu
+}
+
+// ClearSubjectKeyID clears the value of the "subject_key_id" field.
+func (cu *CertificateUpdate) ClearSubjectKeyID() *CertificateUpdate {
+	cu.mutation.ClearSubjectKeyID()
+	return cu
+}
+
+// SetAuthorityKeyID sets the "authority_key_id" field.
+func (cu *CertificateUpdate) SetAuthorityKeyID(s string) *CertificateUpdate {
+	cu.mutation.SetAuthorityKeyID(s)
+	return cu
+}
+
+// SetNillableAuthorityKeyID sets the "authority_key_id" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableAuthorityKeyID(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetAuthorityKeyID(*s)
+	}
+	return cu
+}
+
+// ClearAuthorityKeyID clears the value of the "authority_key_id" field.
+func (cu *CertificateUpdate) ClearAuthorityKeyID() *CertificateUpdate {
+	cu.mutation.ClearAuthorityKeyID()
+	return cu
+}
+
+// SetKeyType sets the "key_type" field.
+func (cu *CertificateUpdate) SetKeyType(s string) *CertificateUpdate {
+	cu.mutation.SetKeyType(s)
+	return cu
+}
+
+// SetNillableKeyType sets the "key_type" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableKeyType(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetKeyType(*s)
+	}
+	return cu
+}
+
+// ClearKeyType clears the value of the "key_type" field.
+func (cu *CertificateUpdate) ClearKeyType() *CertificateUpdate {
+	cu.mutation.ClearKeyType()
+	return cu
+}
+
+// SetSignatureAlgorithm sets the "signature_algorithm" field.
+func (cu *CertificateUpdate) SetSignatureAlgorithm(s string) *CertificateUpdate {
+	cu.mutation.SetSignatureAlgorithm(s)
+	return cu
+}
+
+// SetNillableSignatureAlgorithm sets the "signature_algorithm" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableSignatureAlgorithm(s *string) *CertificateUpdate {
+	if s != nil {
+		cu.SetSignatureAlgorithm(*s)
+	}
+	return cu
+}
+
+// ClearSignatureAlgorithm clears the value of the "signature_algorithm" field.
+func (cu *CertificateUpdate) ClearSignatureAlgorithm() *CertificateUpdate {
+	cu.mutation.ClearSignatureAlgorithm()
+	return cu
+}
+
+// SetIsCa sets the "is_ca" field.
+func (cu *CertificateUpdate) SetIsCa(b bool) *CertificateUpdate {
+	cu.mutation.SetIsCa(b)
+	return cu
+}
+
+// SetNillableIsCa sets the "is_ca" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableIsCa(b *bool) *CertificateUpdate {
+	if b != nil {
+		cu.SetIsCa(*b)
+	}
+	return cu
+}
+
+// ClearIsCa clears the value of the "is_ca" field.
+func (cu *CertificateUpdate) ClearIsCa() *CertificateUpdate {
+	cu.mutation.ClearIsCa()
+	return cu
+}
+
+// SetNotBefore sets the "not_before" field.
+func (cu *CertificateUpdate) SetNotBefore(t time.Time) *CertificateUpdate {
+	cu.mutation.SetNotBefore(t)
+	return cu
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableNotBefore(t *time.Time) *CertificateUpdate {
+	if t != nil {
+		cu.SetNotBefore(*t)
+	}
+	return cu
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (cu *CertificateUpdate) ClearNotBefore() *CertificateUpdate {
+	cu.mutation.ClearNotBefore()
+	return cu
+}
+
+// SetNotAfter sets the "not_after" field.
+func (cu *CertificateUpdate) SetNotAfter(t time.Time) *CertificateUpdate {
+	cu.mutation.SetNotAfter(t)
+	return cu
+}
+
+// SetNillableNotAfter sets the "not_after" field if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableNotAfter(t *time.Time) *CertificateUpdate {
+	if t != nil {
+		cu.SetNotAfter(*t)
+	}
+	return cu
+}
+
+// ClearNotAfter clears the value of the "not_after" field.
+func (cu *CertificateUpdate) ClearNotAfter() *CertificateUpdate {
+	cu.mutation.ClearNotAfter()
+	return cu
+}
+
+// SetDNSNames sets the "dns_names" field.
+func (cu *CertificateUpdate) SetDNSNames(s []string) *CertificateUpdate {
+	cu.mutation.SetDNSNames(s)
+	return cu
+}
+
+// AppendDNSNames appends s to the "dns_names" field.
+func (cu *CertificateUpdate) AppendDNSNames(s []string) *CertificateUpdate {
+	cu.mutation.AppendDNSNames(s)
+	return cu
+}
+
+// ClearDNSNames clears the value of the "dns_names" field.
+func (cu *CertificateUpdate) ClearDNSNames() *CertificateUpdate {
+	cu.mutation.ClearDNSNames()
+	return cu
+}
+
+// SetIPAddresses sets the "ip_addresses" field.
+func (cu *CertificateUpdate) SetIPAddresses(s []string) *CertificateUpdate {
+	cu.mutation.SetIPAddresses(s)
+	return cu
+}
+
+// AppendIPAddresses appends s to the "ip_addresses" field.
+func (cu *CertificateUpdate) AppendIPAddresses(s []string) *CertificateUpdate {
+	cu.mutation.AppendIPAddresses(s)
+	return cu
+}
+
+// ClearIPAddresses clears the value of the "ip_addresses" field.
+func (cu *CertificateUpdate) ClearIPAddresses() *CertificateUpdate {
+	cu.mutation.ClearIPAddresses()
+	return cu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cu *CertificateUpdate) SetUpdatedAt(t time.Time) *CertificateUpdate {
 	cu.mutation.SetUpdatedAt(t)
@@ -155,6 +462,40 @@ func (cu *CertificateUpdate) SetNamespace(n *Namespace) *CertificateUpdate {
 	return cu.SetNamespaceID(n.ID)
 }
 
+// AddExpiryNotificationIDs adds the "expiry_notifications" edge to the ExpiryNotification entity by IDs.
+func (cu *CertificateUpdate) AddExpiryNotificationIDs(ids ...int) *CertificateUpdate {
+	cu.mutation.AddExpiryNotificationIDs(ids...)
+	return cu
+}
+
+// AddExpiryNotifications adds the "expiry_notifications" edges to the ExpiryNotification entity.
+func (cu *CertificateUpdate) AddExpiryNotifications(e ...*ExpiryNotification) *CertificateUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return cu.AddExpiryNotificationIDs(ids...)
+}
+
+// SetRenewalPolicyID sets the "renewal_policy" edge to the RenewalPolicy entity by ID.
+func (cu *CertificateUpdate) SetRenewalPolicyID(id int) *CertificateUpdate {
+	cu.mutation.SetRenewalPolicyID(id)
+	return cu
+}
+
+// SetNillableRenewalPolicyID sets the "renewal_policy" edge to the RenewalPolicy entity by ID if the given value is not nil.
+func (cu *CertificateUpdate) SetNillableRenewalPolicyID(id *int) *CertificateUpdate {
+	if id != nil {
+		cu = cu.SetRenewalPolicyID(*id)
+	}
+	return cu
+}
+
+// SetRenewalPolicy sets the "renewal_policy" edge to the RenewalPolicy entity.
+func (cu *CertificateUpdate) SetRenewalPolicy(r *RenewalPolicy) *CertificateUpdate {
+	return cu.SetRenewalPolicyID(r.ID)
+}
+
 // Mutation returns the CertificateMutation object of the builder.
 func (cu *CertificateUpdate) Mutation() *CertificateMutation {
 	return cu.mutation
@@ -166,6 +507,33 @@ func (cu *CertificateUpdate) ClearNamespace() *CertificateUpdate {
 	return cu
 }
 
+// ClearExpiryNotifications clears all "expiry_notifications" edges to the ExpiryNotification entity.
+func (cu *CertificateUpdate) ClearExpiryNotifications() *CertificateUpdate {
+	cu.mutation.ClearExpiryNotifications()
+	return cu
+}
+
+// RemoveExpiryNotificationIDs removes the "expiry_notifications" edge to ExpiryNotification entities by IDs.
+func (cu *CertificateUpdate) RemoveExpiryNotificationIDs(ids ...int) *CertificateUpdate {
+	cu.mutation.RemoveExpiryNotificationIDs(ids...)
+	return cu
+}
+
+// RemoveExpiryNotifications removes "expiry_notifications" edges to ExpiryNotification entities.
+func (cu *CertificateUpdate) RemoveExpiryNotifications(e ...*ExpiryNotification) *CertificateUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return cu.RemoveExpiryNotificationIDs(ids...)
+}
+
+// ClearRenewalPolicy clears the "renewal_policy" edge to the RenewalPolicy entity.
+func (cu *CertificateUpdate) ClearRenewalPolicy() *CertificateUpdate {
+	cu.mutation.ClearRenewalPolicy()
+	return cu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CertificateUpdate) Save(ctx context.Context) (int, error) {
 	cu.defaults()
@@ -231,6 +599,18 @@ func (cu *CertificateUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.KeyPemCleared() {
 		_spec.ClearField(certificate.FieldKeyPem, field.TypeString)
 	}
+	if value, ok := cu.mutation.KeyRef(); ok {
+		_spec.SetField(certificate.FieldKeyRef, field.TypeString, value)
+	}
+	if cu.mutation.KeyRefCleared() {
+		_spec.ClearField(certificate.FieldKeyRef, field.TypeString)
+	}
+	if value, ok := cu.mutation.KeyExportable(); ok {
+		_spec.SetField(certificate.FieldKeyExportable, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.Offline(); ok {
+		_spec.SetField(certificate.FieldOffline, field.TypeBool, value)
+	}
 	if value, ok := cu.mutation.Desc(); ok {
 		_spec.SetField(certificate.FieldDesc, field.TypeString, value)
 	}
@@ -252,6 +632,94 @@ func (cu *CertificateUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.UsageCleared() {
 		_spec.ClearField(certificate.FieldUsage, field.TypeString)
 	}
+	if value, ok := cu.mutation.Subject(); ok {
+		_spec.SetField(certificate.FieldSubject, field.TypeString, value)
+	}
+	if cu.mutation.SubjectCleared() {
+		_spec.ClearField(certificate.FieldSubject, field.TypeString)
+	}
+	if value, ok := cu.mutation.CommonName(); ok {
+		_spec.SetField(certificate.FieldCommonName, field.TypeString, value)
+	}
+	if cu.mutation.CommonNameCleared() {
+		_spec.ClearField(certificate.FieldCommonName, field.TypeString)
+	}
+	if value, ok := cu.mutation.SerialNumber(); ok {
+		_spec.SetField(certificate.FieldSerialNumber, field.TypeString, value)
+	}
+	if cu.mutation.SerialNumberCleared() {
+		_spec.ClearField(certificate.FieldSerialNumber, field.TypeString)
+	}
+	if value, ok := cu.mutation.Fingerprint(); ok {
+		_spec.SetField(certificate.FieldFingerprint, field.TypeString, value)
+	}
+	if cu.mutation.FingerprintCleared() {
+		_spec.ClearField(certificate.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := cu.mutation.SubjectKeyID(); ok {
+		_spec.SetField(certificate.FieldSubjectKeyID, field.TypeString, value)
+	}
+	if cu.mutation.SubjectKeyIDCleared() {
+		_spec.ClearField(certificate.FieldSubjectKeyID, field.TypeString)
+	}
+	if value, ok := cu.mutation.AuthorityKeyID(); ok {
+		_spec.SetField(certificate.FieldAuthorityKeyID, field.TypeString, value)
+	}
+	if cu.mutation.AuthorityKeyIDCleared() {
+		_spec.ClearField(certificate.FieldAuthorityKeyID, field.TypeString)
+	}
+	if value, ok := cu.mutation.KeyType(); ok {
+		_spec.SetField(certificate.FieldKeyType, field.TypeString, value)
+	}
+	if cu.mutation.KeyTypeCleared() {
+		_spec.ClearField(certificate.FieldKeyType, field.TypeString)
+	}
+	if value, ok := cu.mutation.SignatureAlgorithm(); ok {
+		_spec.SetField(certificate.FieldSignatureAlgorithm, field.TypeString, value)
+	}
+	if cu.mutation.SignatureAlgorithmCleared() {
+		_spec.ClearField(certificate.FieldSignatureAlgorithm, field.TypeString)
+	}
+	if value, ok := cu.mutation.IsCa(); ok {
+		_spec.SetField(certificate.FieldIsCa, field.TypeBool, value)
+	}
+	if cu.mutation.IsCaCleared() {
+		_spec.ClearField(certificate.FieldIsCa, field.TypeBool)
+	}
+	if value, ok := cu.mutation.NotBefore(); ok {
+		_spec.SetField(certificate.FieldNotBefore, field.TypeTime, value)
+	}
+	if cu.mutation.NotBeforeCleared() {
+		_spec.ClearField(certificate.FieldNotBefore, field.TypeTime)
+	}
+	if value, ok := cu.mutation.NotAfter(); ok {
+		_spec.SetField(certificate.FieldNotAfter, field.TypeTime, value)
+	}
+	if cu.mutation.NotAfterCleared() {
+		_spec.ClearField(certificate.FieldNotAfter, field.TypeTime)
+	}
+	if value, ok := cu.mutation.DNSNames(); ok {
+		_spec.SetField(certificate.FieldDNSNames, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedDNSNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, certificate.FieldDNSNames, value)
+		})
+	}
+	if cu.mutation.DNSNamesCleared() {
+		_spec.ClearField(certificate.FieldDNSNames, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.IPAddresses(); ok {
+		_spec.SetField(certificate.FieldIPAddresses, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedIPAddresses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, certificate.FieldIPAddresses, value)
+		})
+	}
+	if cu.mutation.IPAddressesCleared() {
+		_spec.ClearField(certificate.FieldIPAddresses, field.TypeJSON)
+	}
 	if value, ok := cu.mutation.UpdatedAt(); ok {
 		_spec.SetField(certificate.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -284,6 +752,80 @@ func (cu *CertificateUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.ExpiryNotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.ExpiryNotificationsTable,
+			Columns: []string{certificate.ExpiryNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedExpiryNotificationsIDs(); len(nodes) > 0 && !cu.mutation.ExpiryNotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.ExpiryNotificationsTable,
+			Columns: []string{certificate.ExpiryNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ExpiryNotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.ExpiryNotificationsTable,
+			Columns: []string{certificate.ExpiryNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.RenewalPolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   certificate.RenewalPolicyTable,
+			Columns: []string{certificate.RenewalPolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RenewalPolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   certificate.RenewalPolicyTable,
+			Columns: []string{certificate.RenewalPolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{certificate.Label}
@@ -332,23 +874,71 @@ func (cuo *CertificateUpdateOne) SetNillableCertPem(s *string) *CertificateUpdat
 	return cuo
 }
 
-// SetKeyPem sets the "key_pem" field.
-func (cuo *CertificateUpdateOne) SetKeyPem(s string) *CertificateUpdateOne {
-	cuo.mutation.SetKeyPem(s)
+// SetKeyPem sets the "key_pem" field.
+func (cuo *CertificateUpdateOne) SetKeyPem(s string) *CertificateUpdateOne {
+	cuo.mutation.SetKeyPem(s)
+	return cuo
+}
+
+// SetNillableKeyPem sets the "key_pem" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableKeyPem(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetKeyPem(*s)
+	}
+	return cuo
+}
+
+// ClearKeyPem clears the value of the "key_pem" field.
+func (cuo *CertificateUpdateOne) ClearKeyPem() *CertificateUpdateOne {
+	cuo.mutation.ClearKeyPem()
+	return cuo
+}
+
+// SetKeyRef sets the "key_ref" field.
+func (cuo *CertificateUpdateOne) SetKeyRef(s string) *CertificateUpdateOne {
+	cuo.mutation.SetKeyRef(s)
+	return cuo
+}
+
+// SetNillableKeyRef sets the "key_ref" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableKeyRef(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetKeyRef(*s)
+	}
+	return cuo
+}
+
+// ClearKeyRef clears the value of the "key_ref" field.
+func (cuo *CertificateUpdateOne) ClearKeyRef() *CertificateUpdateOne {
+	cuo.mutation.ClearKeyRef()
 	return cuo
 }
 
-// SetNillableKeyPem sets the "key_pem" field if the given value is not nil.
-func (cuo *CertificateUpdateOne) SetNillableKeyPem(s *string) *CertificateUpdateOne {
-	if s != nil {
-		cuo.SetKeyPem(*s)
+// SetKeyExportable sets the "key_exportable" field.
+func (cuo *CertificateUpdateOne) SetKeyExportable(b bool) *CertificateUpdateOne {
+	cuo.mutation.SetKeyExportable(b)
+	return cuo
+}
+
+// SetNillableKeyExportable sets the "key_exportable" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableKeyExportable(b *bool) *CertificateUpdateOne {
+	if b != nil {
+		cuo.SetKeyExportable(*b)
 	}
 	return cuo
 }
 
-// ClearKeyPem clears the value of the "key_pem" field.
-func (cuo *CertificateUpdateOne) ClearKeyPem() *CertificateUpdateOne {
-	cuo.mutation.ClearKeyPem()
+// SetOffline sets the "offline" field.
+func (cuo *CertificateUpdateOne) SetOffline(b bool) *CertificateUpdateOne {
+	cuo.mutation.SetOffline(b)
+	return cuo
+}
+
+// SetNillableOffline sets the "offline" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableOffline(b *bool) *CertificateUpdateOne {
+	if b != nil {
+		cuo.SetOffline(*b)
+	}
 	return cuo
 }
 
@@ -419,6 +1009,262 @@ func (cuo *CertificateUpdateOne) ClearUsage() *CertificateUpdateOne {
 	return cuo
 }
 
+// SetSubject sets the "subject" field.
+func (cuo *CertificateUpdateOne) SetSubject(s string) *CertificateUpdateOne {
+	cuo.mutation.SetSubject(s)
+	return cuo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableSubject(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetSubject(*s)
+	}
+	return cuo
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (cuo *CertificateUpdateOne) ClearSubject() *CertificateUpdateOne {
+	cuo.mutation.ClearSubject()
+	return cuo
+}
+
+// SetCommonName sets the "common_name" field.
+func (cuo *CertificateUpdateOne) SetCommonName(s string) *CertificateUpdateOne {
+	cuo.mutation.SetCommonName(s)
+	return cuo
+}
+
+// SetNillableCommonName sets the "common_name" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableCommonName(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetCommonName(*s)
+	}
+	return cuo
+}
+
+// ClearCommonName clears the value of the "common_name" field.
+func (cuo *CertificateUpdateOne) ClearCommonName() *CertificateUpdateOne {
+	cuo.mutation.ClearCommonName()
+	return cuo
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (cuo *CertificateUpdateOne) SetSerialNumber(s string) *CertificateUpdateOne {
+	cuo.mutation.SetSerialNumber(s)
+	return cuo
+}
+
+// SetNillableSerialNumber sets the "serial_number" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableSerialNumber(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetSerialNumber(*s)
+	}
+	return cuo
+}
+
+// ClearSerialNumber clears the value of the "serial_number" field.
+func (cuo *CertificateUpdateOne) ClearSerialNumber() *CertificateUpdateOne {
+	cuo.mutation.ClearSerialNumber()
+	return cuo
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (cuo *CertificateUpdateOne) SetFingerprint(s string) *CertificateUpdateOne {
+	cuo.mutation.SetFingerprint(s)
+	return cuo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableFingerprint(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetFingerprint(*s)
+	}
+	return cuo
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (cuo *CertificateUpdateOne) ClearFingerprint() *CertificateUpdateOne {
+	cuo.mutation.ClearFingerprint()
+	return cuo
+}
+
+// SetSubjectKeyID sets the "subject_key_id" field.
+func (cuo *CertificateUpdateOne) SetSubjectKeyID(s string) *CertificateUpdateOne {
+	cuo.mutation.SetSubjectKeyID(s)
+	return cuo
+}
+
+// SetNillableSubjectKeyID sets the "subject_key_id" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableSubjectKeyID(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetSubjectKeyID(*s)
+	}
+	return cuo
+}
+
+// ClearSubjectKeyID clears the value of the "subject_key_id" field.
+func (cuo *CertificateUpdateOne) ClearSubjectKeyID() *CertificateUpdateOne {
+	cuo.mutation.ClearSubjectKeyID()
+	return cuo
+}
+
+// SetAuthorityKeyID sets the "authority_key_id" field.
+func (cuo *CertificateUpdateOne) SetAuthorityKeyID(s string) *CertificateUpdateOne {
+	cuo.mutation.SetAuthorityKeyID(s)
+	return cuo
+}
+
+// SetNillableAuthorityKeyID sets the "authority_key_id" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableAuthorityKeyID(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetAuthorityKeyID(*s)
+	}
+	return cuo
+}
+
+// ClearAuthorityKeyID clears the value of the "authority_key_id" field.
+func (cuo *CertificateUpdateOne) ClearAuthorityKeyID() *CertificateUpdateOne {
+	cuo.mutation.ClearAuthorityKeyID()
+	return cuo
+}
+
+// SetKeyType sets the "key_type" field.
+func (cuo *CertificateUpdateOne) SetKeyType(s string) *CertificateUpdateOne {
+	cuo.mutation.SetKeyType(s)
+	return cuo
+}
+
+// SetNillableKeyType sets the "key_type" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableKeyType(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetKeyType(*s)
+	}
+	return cuo
+}
+
+// ClearKeyType clears the value of the "key_type" field.
+func (cuo *CertificateUpdateOne) ClearKeyType() *CertificateUpdateOne {
+	cuo.mutation.ClearKeyType()
+	return cuo
+}
+
+// SetSignatureAlgorithm sets the "signature_algorithm" field.
+func (cuo *CertificateUpdateOne) SetSignatureAlgorithm(s string) *CertificateUpdateOne {
+	cuo.mutation.SetSignatureAlgorithm(s)
+	return cuo
+}
+
+// SetNillableSignatureAlgorithm sets the "signature_algorithm" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableSignatureAlgorithm(s *string) *CertificateUpdateOne {
+	if s != nil {
+		cuo.SetSignatureAlgorithm(*s)
+	}
+	return cuo
+}
+
+// ClearSignatureAlgorithm clears the value of the "signature_algorithm" field.
+func (cuo *CertificateUpdateOne) ClearSignatureAlgorithm() *CertificateUpdateOne {
+	cuo.mutation.ClearSignatureAlgorithm()
+	return cuo
+}
+
+// SetIsCa sets the "is_ca" field.
+func (cuo *CertificateUpdateOne) SetIsCa(b bool) *CertificateUpdateOne {
+	cuo.mutation.SetIsCa(b)
+	return cuo
+}
+
+// SetNillableIsCa sets the "is_ca" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableIsCa(b *bool) *CertificateUpdateOne {
+	if b != nil {
+		cuo.SetIsCa(*b)
+	}
+	return cuo
+}
+
+// ClearIsCa clears the value of the "is_ca" field.
+func (cuo *CertificateUpdateOne) ClearIsCa() *CertificateUpdateOne {
+	cuo.mutation.ClearIsCa()
+	return cuo
+}
+
+// SetNotBefore sets the "not_before" field.
+func (cuo *CertificateUpdateOne) SetNotBefore(t time.Time) *CertificateUpdateOne {
+	cuo.mutation.SetNotBefore(t)
+	return cuo
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableNotBefore(t *time.Time) *CertificateUpdateOne {
+	if t != nil {
+		cuo.SetNotBefore(*t)
+	}
+	return cuo
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (cuo *CertificateUpdateOne) ClearNotBefore() *CertificateUpdateOne {
+	cuo.mutation.ClearNotBefore()
+	return cuo
+}
+
+// SetNotAfter sets the "not_after" field.
+func (cuo *CertificateUpdateOne) SetNotAfter(t time.Time) *CertificateUpdateOne {
+	cuo.mutation.SetNotAfter(t)
+	return cuo
+}
+
+// SetNillableNotAfter sets the "not_after" field if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableNotAfter(t *time.Time) *CertificateUpdateOne {
+	if t != nil {
+		cuo.SetNotAfter(*t)
+	}
+	return cuo
+}
+
+// ClearNotAfter clears the value of the "not_after" field.
+func (cuo *CertificateUpdateOne) ClearNotAfter() *CertificateUpdateOne {
+	cuo.mutation.ClearNotAfter()
+	return cuo
+}
+
+// SetDNSNames sets the "dns_names" field.
+func (cuo *CertificateUpdateOne) SetDNSNames(s []string) *CertificateUpdateOne {
+	cuo.mutation.SetDNSNames(s)
+	return cuo
+}
+
+// AppendDNSNames appends s to the "dns_names" field.
+func (cuo *CertificateUpdateOne) AppendDNSNames(s []string) *CertificateUpdateOne {
+	cuo.mutation.AppendDNSNames(s)
+	return cuo
+}
+
+// ClearDNSNames clears the value of the "dns_names" field.
+func (cuo *CertificateUpdateOne) ClearDNSNames() *CertificateUpdateOne {
+	cuo.mutation.ClearDNSNames()
+	return cuo
+}
+
+// SetIPAddresses sets the "ip_addresses" field.
+func (cuo *CertificateUpdateOne) SetIPAddresses(s []string) *CertificateUpdateOne {
+	cuo.mutation.SetIPAddresses(s)
+	return cuo
+}
+
+// AppendIPAddresses appends s to the "ip_addresses" field.
+func (cuo *CertificateUpdateOne) AppendIPAddresses(s []string) *CertificateUpdateOne {
+	cuo.mutation.AppendIPAddresses(s)
+	return cuo
+}
+
+// ClearIPAddresses clears the value of the "ip_addresses" field.
+func (cuo *CertificateUpdateOne) ClearIPAddresses() *CertificateUpdateOne {
+	cuo.mutation.ClearIPAddresses()
+	return cuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cuo *CertificateUpdateOne) SetUpdatedAt(t time.Time) *CertificateUpdateOne {
 	cuo.mutation.SetUpdatedAt(t)
@@ -430,6 +1276,40 @@ func (cuo *CertificateUpdateOne) SetNamespace(n *Namespace) *CertificateUpdateOn
 	return cuo.SetNamespaceID(n.ID)
 }
 
+// AddExpiryNotificationIDs adds the "expiry_notifications" edge to the ExpiryNotification entity by IDs.
+func (cuo *CertificateUpdateOne) AddExpiryNotificationIDs(ids ...int) *CertificateUpdateOne {
+	cuo.mutation.AddExpiryNotificationIDs(ids...)
+	return cuo
+}
+
+// AddExpiryNotifications adds the "expiry_notifications" edges to the ExpiryNotification entity.
+func (cuo *CertificateUpdateOne) AddExpiryNotifications(e ...*ExpiryNotification) *CertificateUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return cuo.AddExpiryNotificationIDs(ids...)
+}
+
+// SetRenewalPolicyID sets the "renewal_policy" edge to the RenewalPolicy entity by ID.
+func (cuo *CertificateUpdateOne) SetRenewalPolicyID(id int) *CertificateUpdateOne {
+	cuo.mutation.SetRenewalPolicyID(id)
+	return cuo
+}
+
+// SetNillableRenewalPolicyID sets the "renewal_policy" edge to the RenewalPolicy entity by ID if the given value is not nil.
+func (cuo *CertificateUpdateOne) SetNillableRenewalPolicyID(id *int) *CertificateUpdateOne {
+	if id != nil {
+		cuo = cuo.SetRenewalPolicyID(*id)
+	}
+	return cuo
+}
+
+// SetRenewalPolicy sets the "renewal_policy" edge to the RenewalPolicy entity.
+func (cuo *CertificateUpdateOne) SetRenewalPolicy(r *RenewalPolicy) *CertificateUpdateOne {
+	return cuo.SetRenewalPolicyID(r.ID)
+}
+
 // Mutation returns the CertificateMutation object of the builder.
 func (cuo *CertificateUpdateOne) Mutation() *CertificateMutation {
 	return cuo.mutation
@@ -441,6 +1321,33 @@ func (cuo *CertificateUpdateOne) ClearNamespace() *CertificateUpdateOne {
 	return cuo
 }
 
+// ClearExpiryNotifications clears all "expiry_notifications" edges to the ExpiryNotification entity.
+func (cuo *CertificateUpdateOne) ClearExpiryNotifications() *CertificateUpdateOne {
+	cuo.mutation.ClearExpiryNotifications()
+	return cuo
+}
+
+// RemoveExpiryNotificationIDs removes the "expiry_notifications" edge to ExpiryNotification entities by IDs.
+func (cuo *CertificateUpdateOne) RemoveExpiryNotificationIDs(ids ...int) *CertificateUpdateOne {
+	cuo.mutation.RemoveExpiryNotificationIDs(ids...)
+	return cuo
+}
+
+// RemoveExpiryNotifications removes "expiry_notifications" edges to ExpiryNotification entities.
+func (cuo *CertificateUpdateOne) RemoveExpiryNotifications(e ...*ExpiryNotification) *CertificateUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return cuo.RemoveExpiryNotificationIDs(ids...)
+}
+
+// ClearRenewalPolicy clears the "renewal_policy" edge to the RenewalPolicy entity.
+func (cuo *CertificateUpdateOne) ClearRenewalPolicy() *CertificateUpdateOne {
+	cuo.mutation.ClearRenewalPolicy()
+	return cuo
+}
+
 // Where appends a list predicates to the CertificateUpdate builder.
 func (cuo *CertificateUpdateOne) Where(ps ...predicate.Certificate) *CertificateUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -536,6 +1443,18 @@ func (cuo *CertificateUpdateOne) sqlSave(ctx context.Context) (_node *Certificat
 	if cuo.mutation.KeyPemCleared() {
 		_spec.ClearField(certificate.FieldKeyPem, field.TypeString)
 	}
+	if value, ok := cuo.mutation.KeyRef(); ok {
+		_spec.SetField(certificate.FieldKeyRef, field.TypeString, value)
+	}
+	if cuo.mutation.KeyRefCleared() {
+		_spec.ClearField(certificate.FieldKeyRef, field.TypeString)
+	}
+	if value, ok := cuo.mutation.KeyExportable(); ok {
+		_spec.SetField(certificate.FieldKeyExportable, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.Offline(); ok {
+		_spec.SetField(certificate.FieldOffline, field.TypeBool, value)
+	}
 	if value, ok := cuo.mutation.Desc(); ok {
 		_spec.SetField(certificate.FieldDesc, field.TypeString, value)
 	}
@@ -557,6 +1476,94 @@ func (cuo *CertificateUpdateOne) sqlSave(ctx context.Context) (_node *Certificat
 	if cuo.mutation.UsageCleared() {
 		_spec.ClearField(certificate.FieldUsage, field.TypeString)
 	}
+	if value, ok := cuo.mutation.Subject(); ok {
+		_spec.SetField(certificate.FieldSubject, field.TypeString, value)
+	}
+	if cuo.mutation.SubjectCleared() {
+		_spec.ClearField(certificate.FieldSubject, field.TypeString)
+	}
+	if value, ok := cuo.mutation.CommonName(); ok {
+		_spec.SetField(certificate.FieldCommonName, field.TypeString, value)
+	}
+	if cuo.mutation.CommonNameCleared() {
+		_spec.ClearField(certificate.FieldCommonName, field.TypeString)
+	}
+	if value, ok := cuo.mutation.SerialNumber(); ok {
+		_spec.SetField(certificate.FieldSerialNumber, field.TypeString, value)
+	}
+	if cuo.mutation.SerialNumberCleared() {
+		_spec.ClearField(certificate.FieldSerialNumber, field.TypeString)
+	}
+	if value, ok := cuo.mutation.Fingerprint(); ok {
+		_spec.SetField(certificate.FieldFingerprint, field.TypeString, value)
+	}
+	if cuo.mutation.FingerprintCleared() {
+		_spec.ClearField(certificate.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := cuo.mutation.SubjectKeyID(); ok {
+		_spec.SetField(certificate.FieldSubjectKeyID, field.TypeString, value)
+	}
+	if cuo.mutation.SubjectKeyIDCleared() {
+		_spec.ClearField(certificate.FieldSubjectKeyID, field.TypeString)
+	}
+	if value, ok := cuo.mutation.AuthorityKeyID(); ok {
+		_spec.SetField(certificate.FieldAuthorityKeyID, field.TypeString, value)
+	}
+	if cuo.mutation.AuthorityKeyIDCleared() {
+		_spec.ClearField(certificate.FieldAuthorityKeyID, field.TypeString)
+	}
+	if value, ok := cuo.mutation.KeyType(); ok {
+		_spec.SetField(certificate.FieldKeyType, field.TypeString, value)
+	}
+	if cuo.mutation.KeyTypeCleared() {
+		_spec.ClearField(certificate.FieldKeyType, field.TypeString)
+	}
+	if value, ok := cuo.mutation.SignatureAlgorithm(); ok {
+		_spec.SetField(certificate.FieldSignatureAlgorithm, field.TypeString, value)
+	}
+	if cuo.mutation.SignatureAlgorithmCleared() {
+		_spec.ClearField(certificate.FieldSignatureAlgorithm, field.TypeString)
+	}
+	if value, ok := cuo.mutation.IsCa(); ok {
+		_spec.SetField(certificate.FieldIsCa, field.TypeBool, value)
+	}
+	if cuo.mutation.IsCaCleared() {
+		_spec.ClearField(certificate.FieldIsCa, field.TypeBool)
+	}
+	if value, ok := cuo.mutation.NotBefore(); ok {
+		_spec.SetField(certificate.FieldNotBefore, field.TypeTime, value)
+	}
+	if cuo.mutation.NotBeforeCleared() {
+		_spec.ClearField(certificate.FieldNotBefore, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.NotAfter(); ok {
+		_spec.SetField(certificate.FieldNotAfter, field.TypeTime, value)
+	}
+	if cuo.mutation.NotAfterCleared() {
+		_spec.ClearField(certificate.FieldNotAfter, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.DNSNames(); ok {
+		_spec.SetField(certificate.FieldDNSNames, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedDNSNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, certificate.FieldDNSNames, value)
+		})
+	}
+	if cuo.mutation.DNSNamesCleared() {
+		_spec.ClearField(certificate.FieldDNSNames, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.IPAddresses(); ok {
+		_spec.SetField(certificate.FieldIPAddresses, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedIPAddresses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, certificate.FieldIPAddresses, value)
+		})
+	}
+	if cuo.mutation.IPAddressesCleared() {
+		_spec.ClearField(certificate.FieldIPAddresses, field.TypeJSON)
+	}
 	if value, ok := cuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(certificate.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -589,6 +1596,80 @@ func (cuo *CertificateUpdateOne) sqlSave(ctx context.Context) (_node *Certificat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.ExpiryNotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.ExpiryNotificationsTable,
+			Columns: []string{certificate.ExpiryNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedExpiryNotificationsIDs(); len(nodes) > 0 && !cuo.mutation.ExpiryNotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.ExpiryNotificationsTable,
+			Columns: []string{certificate.ExpiryNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ExpiryNotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   certificate.ExpiryNotificationsTable,
+			Columns: []string{certificate.ExpiryNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.RenewalPolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   certificate.RenewalPolicyTable,
+			Columns: []string{certificate.RenewalPolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RenewalPolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   certificate.RenewalPolicyTable,
+			Columns: []string{certificate.RenewalPolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Certificate{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
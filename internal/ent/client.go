@@ -15,8 +15,14 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/logeable/certmgr/internal/ent/auditevent"
 	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+	"github.com/logeable/certmgr/internal/ent/keyring"
 	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
 )
 
 // Client is the client that holds all ent builders.
@@ -24,10 +30,22 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
 	// Certificate is the client for interacting with the Certificate builders.
 	Certificate *CertificateClient
+	// ExpiryNotification is the client for interacting with the ExpiryNotification builders.
+	ExpiryNotification *ExpiryNotificationClient
+	// Keyring is the client for interacting with the Keyring builders.
+	Keyring *KeyringClient
 	// Namespace is the client for interacting with the Namespace builders.
 	Namespace *NamespaceClient
+	// RenewalAttempt is the client for interacting with the RenewalAttempt builders.
+	RenewalAttempt *RenewalAttemptClient
+	// RenewalPolicy is the client for interacting with the RenewalPolicy builders.
+	RenewalPolicy *RenewalPolicyClient
+	// SigningRequest is the client for interacting with the SigningRequest builders.
+	SigningRequest *SigningRequestClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -39,8 +57,14 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditEvent = NewAuditEventClient(c.config)
 	c.Certificate = NewCertificateClient(c.config)
+	c.ExpiryNotification = NewExpiryNotificationClient(c.config)
+	c.Keyring = NewKeyringClient(c.config)
 	c.Namespace = NewNamespaceClient(c.config)
+	c.RenewalAttempt = NewRenewalAttemptClient(c.config)
+	c.RenewalPolicy = NewRenewalPolicyClient(c.config)
+	c.SigningRequest = NewSigningRequestClient(c.config)
 }
 
 type (
@@ -131,10 +155,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Certificate: NewCertificateClient(cfg),
-		Namespace:   NewNamespaceClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		AuditEvent:         NewAuditEventClient(cfg),
+		Certificate:        NewCertificateClient(cfg),
+		ExpiryNotification: NewExpiryNotificationClient(cfg),
+		Keyring:            NewKeyringClient(cfg),
+		Namespace:          NewNamespaceClient(cfg),
+		RenewalAttempt:     NewRenewalAttemptClient(cfg),
+		RenewalPolicy:      NewRenewalPolicyClient(cfg),
+		SigningRequest:     NewSigningRequestClient(cfg),
 	}, nil
 }
 
@@ -152,17 +182,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Certificate: NewCertificateClient(cfg),
-		Namespace:   NewNamespaceClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		AuditEvent:         NewAuditEventClient(cfg),
+		Certificate:        NewCertificateClient(cfg),
+		ExpiryNotification: NewExpiryNotificationClient(cfg),
+		Keyring:            NewKeyringClient(cfg),
+		Namespace:          NewNamespaceClient(cfg),
+		RenewalAttempt:     NewRenewalAttemptClient(cfg),
+		RenewalPolicy:      NewRenewalPolicyClient(cfg),
+		SigningRequest:     NewSigningRequestClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Certificate.
+//		AuditEvent.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -184,29 +220,182 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Certificate.Use(hooks...)
-	c.Namespace.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuditEvent, c.Certificate, c.ExpiryNotification, c.Keyring, c.Namespace,
+		c.RenewalAttempt, c.RenewalPolicy, c.SigningRequest,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Certificate.Intercept(interceptors...)
-	c.Namespace.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuditEvent, c.Certificate, c.ExpiryNotification, c.Keyring, c.Namespace,
+		c.RenewalAttempt, c.RenewalPolicy, c.SigningRequest,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditEventMutation:
+		return c.AuditEvent.mutate(ctx, m)
 	case *CertificateMutation:
 		return c.Certificate.mutate(ctx, m)
+	case *ExpiryNotificationMutation:
+		return c.ExpiryNotification.mutate(ctx, m)
+	case *KeyringMutation:
+		return c.Keyring.mutate(ctx, m)
 	case *NamespaceMutation:
 		return c.Namespace.mutate(ctx, m)
+	case *RenewalAttemptMutation:
+		return c.RenewalAttempt.mutate(ctx, m)
+	case *RenewalPolicyMutation:
+		return c.RenewalPolicy.mutate(ctx, m)
+	case *SigningRequestMutation:
+		return c.SigningRequest.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
+	config
+}
+
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditevent.Intercept(f(g(h())))`.
+func (c *AuditEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEvent = append(c.inters.AuditEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEvent entity.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEventClient) MapCreateBulk(slice any, setFunc func(*AuditEventCreate, int)) *AuditEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEventCreateBulk{err: fmt.Errorf("calling to AuditEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventClient) UpdateOne(ae *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(ae))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventClient) UpdateOneID(id int) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEventClient) DeleteOne(ae *AuditEvent) *AuditEventDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEventClient) DeleteOneID(id int) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id int) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventClient) GetX(ctx context.Context, id int) *AuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventClient) Hooks() []Hook {
+	return c.hooks.AuditEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEventClient) Interceptors() []Interceptor {
+	return c.inters.AuditEvent
+}
+
+func (c *AuditEventClient) mutate(ctx context.Context, m *AuditEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditEvent mutation op: %q", m.Op())
+	}
+}
+
 // CertificateClient is a client for the Certificate schema.
 type CertificateClient struct {
 	config
@@ -331,6 +520,38 @@ func (c *CertificateClient) QueryNamespace(ce *Certificate) *NamespaceQuery {
 	return query
 }
 
+// QueryExpiryNotifications queries the expiry_notifications edge of a Certificate.
+func (c *CertificateClient) QueryExpiryNotifications(ce *Certificate) *ExpiryNotificationQuery {
+	query := (&ExpiryNotificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ce.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certificate.Table, certificate.FieldID, id),
+			sqlgraph.To(expirynotification.Table, expirynotification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, certificate.ExpiryNotificationsTable, certificate.ExpiryNotificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRenewalPolicy queries the renewal_policy edge of a Certificate.
+func (c *CertificateClient) QueryRenewalPolicy(ce *Certificate) *RenewalPolicyQuery {
+	query := (&RenewalPolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ce.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certificate.Table, certificate.FieldID, id),
+			sqlgraph.To(renewalpolicy.Table, renewalpolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, certificate.RenewalPolicyTable, certificate.RenewalPolicyColumn),
+		)
+		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CertificateClient) Hooks() []Hook {
 	return c.hooks.Certificate
@@ -356,6 +577,288 @@ func (c *CertificateClient) mutate(ctx context.Context, m *CertificateMutation)
 	}
 }
 
+// ExpiryNotificationClient is a client for the ExpiryNotification schema.
+type ExpiryNotificationClient struct {
+	config
+}
+
+// NewExpiryNotificationClient returns a client for the ExpiryNotification from the given config.
+func NewExpiryNotificationClient(c config) *ExpiryNotificationClient {
+	return &ExpiryNotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `expirynotification.Hooks(f(g(h())))`.
+func (c *ExpiryNotificationClient) Use(hooks ...Hook) {
+	c.hooks.ExpiryNotification = append(c.hooks.ExpiryNotification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `expirynotification.Intercept(f(g(h())))`.
+func (c *ExpiryNotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExpiryNotification = append(c.inters.ExpiryNotification, interceptors...)
+}
+
+// Create returns a builder for creating a ExpiryNotification entity.
+func (c *ExpiryNotificationClient) Create() *ExpiryNotificationCreate {
+	mutation := newExpiryNotificationMutation(c.config, OpCreate)
+	return &ExpiryNotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExpiryNotification entities.
+func (c *ExpiryNotificationClient) CreateBulk(builders ...*ExpiryNotificationCreate) *ExpiryNotificationCreateBulk {
+	return &ExpiryNotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExpiryNotificationClient) MapCreateBulk(slice any, setFunc func(*ExpiryNotificationCreate, int)) *ExpiryNotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExpiryNotificationCreateBulk{err: fmt.Errorf("calling to ExpiryNotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExpiryNotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExpiryNotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExpiryNotification.
+func (c *ExpiryNotificationClient) Update() *ExpiryNotificationUpdate {
+	mutation := newExpiryNotificationMutation(c.config, OpUpdate)
+	return &ExpiryNotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExpiryNotificationClient) UpdateOne(en *ExpiryNotification) *ExpiryNotificationUpdateOne {
+	mutation := newExpiryNotificationMutation(c.config, OpUpdateOne, withExpiryNotification(en))
+	return &ExpiryNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExpiryNotificationClient) UpdateOneID(id int) *ExpiryNotificationUpdateOne {
+	mutation := newExpiryNotificationMutation(c.config, OpUpdateOne, withExpiryNotificationID(id))
+	return &ExpiryNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExpiryNotification.
+func (c *ExpiryNotificationClient) Delete() *ExpiryNotificationDelete {
+	mutation := newExpiryNotificationMutation(c.config, OpDelete)
+	return &ExpiryNotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExpiryNotificationClient) DeleteOne(en *ExpiryNotification) *ExpiryNotificationDeleteOne {
+	return c.DeleteOneID(en.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExpiryNotificationClient) DeleteOneID(id int) *ExpiryNotificationDeleteOne {
+	builder := c.Delete().Where(expirynotification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExpiryNotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for ExpiryNotification.
+func (c *ExpiryNotificationClient) Query() *ExpiryNotificationQuery {
+	return &ExpiryNotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExpiryNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExpiryNotification entity by its id.
+func (c *ExpiryNotificationClient) Get(ctx context.Context, id int) (*ExpiryNotification, error) {
+	return c.Query().Where(expirynotification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExpiryNotificationClient) GetX(ctx context.Context, id int) *ExpiryNotification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCertificate queries the certificate edge of a ExpiryNotification.
+func (c *ExpiryNotificationClient) QueryCertificate(en *ExpiryNotification) *CertificateQuery {
+	query := (&CertificateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := en.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(expirynotification.Table, expirynotification.FieldID, id),
+			sqlgraph.To(certificate.Table, certificate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, expirynotification.CertificateTable, expirynotification.CertificateColumn),
+		)
+		fromV = sqlgraph.Neighbors(en.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExpiryNotificationClient) Hooks() []Hook {
+	return c.hooks.ExpiryNotification
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExpiryNotificationClient) Interceptors() []Interceptor {
+	return c.inters.ExpiryNotification
+}
+
+func (c *ExpiryNotificationClient) mutate(ctx context.Context, m *ExpiryNotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExpiryNotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExpiryNotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExpiryNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExpiryNotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExpiryNotification mutation op: %q", m.Op())
+	}
+}
+
+// KeyringClient is a client for the Keyring schema.
+type KeyringClient struct {
+	config
+}
+
+// NewKeyringClient returns a client for the Keyring from the given config.
+func NewKeyringClient(c config) *KeyringClient {
+	return &KeyringClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keyring.Hooks(f(g(h())))`.
+func (c *KeyringClient) Use(hooks ...Hook) {
+	c.hooks.Keyring = append(c.hooks.Keyring, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keyring.Intercept(f(g(h())))`.
+func (c *KeyringClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Keyring = append(c.inters.Keyring, interceptors...)
+}
+
+// Create returns a builder for creating a Keyring entity.
+func (c *KeyringClient) Create() *KeyringCreate {
+	mutation := newKeyringMutation(c.config, OpCreate)
+	return &KeyringCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Keyring entities.
+func (c *KeyringClient) CreateBulk(builders ...*KeyringCreate) *KeyringCreateBulk {
+	return &KeyringCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyringClient) MapCreateBulk(slice any, setFunc func(*KeyringCreate, int)) *KeyringCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyringCreateBulk{err: fmt.Errorf("calling to KeyringClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyringCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyringCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Keyring.
+func (c *KeyringClient) Update() *KeyringUpdate {
+	mutation := newKeyringMutation(c.config, OpUpdate)
+	return &KeyringUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyringClient) UpdateOne(k *Keyring) *KeyringUpdateOne {
+	mutation := newKeyringMutation(c.config, OpUpdateOne, withKeyring(k))
+	return &KeyringUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyringClient) UpdateOneID(id int) *KeyringUpdateOne {
+	mutation := newKeyringMutation(c.config, OpUpdateOne, withKeyringID(id))
+	return &KeyringUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Keyring.
+func (c *KeyringClient) Delete() *KeyringDelete {
+	mutation := newKeyringMutation(c.config, OpDelete)
+	return &KeyringDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyringClient) DeleteOne(k *Keyring) *KeyringDeleteOne {
+	return c.DeleteOneID(k.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyringClient) DeleteOneID(id int) *KeyringDeleteOne {
+	builder := c.Delete().Where(keyring.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyringDeleteOne{builder}
+}
+
+// Query returns a query builder for Keyring.
+func (c *KeyringClient) Query() *KeyringQuery {
+	return &KeyringQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyring},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Keyring entity by its id.
+func (c *KeyringClient) Get(ctx context.Context, id int) (*Keyring, error) {
+	return c.Query().Where(keyring.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyringClient) GetX(ctx context.Context, id int) *Keyring {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KeyringClient) Hooks() []Hook {
+	return c.hooks.Keyring
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyringClient) Interceptors() []Interceptor {
+	return c.inters.Keyring
+}
+
+func (c *KeyringClient) mutate(ctx context.Context, m *KeyringMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyringCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyringUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyringUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyringDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Keyring mutation op: %q", m.Op())
+	}
+}
+
 // NamespaceClient is a client for the Namespace schema.
 type NamespaceClient struct {
 	config
@@ -480,6 +983,22 @@ func (c *NamespaceClient) QueryCertificates(n *Namespace) *CertificateQuery {
 	return query
 }
 
+// QuerySigningRequests queries the signing_requests edge of a Namespace.
+func (c *NamespaceClient) QuerySigningRequests(n *Namespace) *SigningRequestQuery {
+	query := (&SigningRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(namespace.Table, namespace.FieldID, id),
+			sqlgraph.To(signingrequest.Table, signingrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, namespace.SigningRequestsTable, namespace.SigningRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *NamespaceClient) Hooks() []Hook {
 	return c.hooks.Namespace
@@ -505,12 +1024,477 @@ func (c *NamespaceClient) mutate(ctx context.Context, m *NamespaceMutation) (Val
 	}
 }
 
+// RenewalAttemptClient is a client for the RenewalAttempt schema.
+type RenewalAttemptClient struct {
+	config
+}
+
+// NewRenewalAttemptClient returns a client for the RenewalAttempt from the given config.
+func NewRenewalAttemptClient(c config) *RenewalAttemptClient {
+	return &RenewalAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `renewalattempt.Hooks(f(g(h())))`.
+func (c *RenewalAttemptClient) Use(hooks ...Hook) {
+	c.hooks.RenewalAttempt = append(c.hooks.RenewalAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `renewalattempt.Intercept(f(g(h())))`.
+func (c *RenewalAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RenewalAttempt = append(c.inters.RenewalAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a RenewalAttempt entity.
+func (c *RenewalAttemptClient) Create() *RenewalAttemptCreate {
+	mutation := newRenewalAttemptMutation(c.config, OpCreate)
+	return &RenewalAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RenewalAttempt entities.
+func (c *RenewalAttemptClient) CreateBulk(builders ...*RenewalAttemptCreate) *RenewalAttemptCreateBulk {
+	return &RenewalAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RenewalAttemptClient) MapCreateBulk(slice any, setFunc func(*RenewalAttemptCreate, int)) *RenewalAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RenewalAttemptCreateBulk{err: fmt.Errorf("calling to RenewalAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RenewalAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RenewalAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RenewalAttempt.
+func (c *RenewalAttemptClient) Update() *RenewalAttemptUpdate {
+	mutation := newRenewalAttemptMutation(c.config, OpUpdate)
+	return &RenewalAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RenewalAttemptClient) UpdateOne(ra *RenewalAttempt) *RenewalAttemptUpdateOne {
+	mutation := newRenewalAttemptMutation(c.config, OpUpdateOne, withRenewalAttempt(ra))
+	return &RenewalAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RenewalAttemptClient) UpdateOneID(id int) *RenewalAttemptUpdateOne {
+	mutation := newRenewalAttemptMutation(c.config, OpUpdateOne, withRenewalAttemptID(id))
+	return &RenewalAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RenewalAttempt.
+func (c *RenewalAttemptClient) Delete() *RenewalAttemptDelete {
+	mutation := newRenewalAttemptMutation(c.config, OpDelete)
+	return &RenewalAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RenewalAttemptClient) DeleteOne(ra *RenewalAttempt) *RenewalAttemptDeleteOne {
+	return c.DeleteOneID(ra.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RenewalAttemptClient) DeleteOneID(id int) *RenewalAttemptDeleteOne {
+	builder := c.Delete().Where(renewalattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RenewalAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for RenewalAttempt.
+func (c *RenewalAttemptClient) Query() *RenewalAttemptQuery {
+	return &RenewalAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRenewalAttempt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RenewalAttempt entity by its id.
+func (c *RenewalAttemptClient) Get(ctx context.Context, id int) (*RenewalAttempt, error) {
+	return c.Query().Where(renewalattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RenewalAttemptClient) GetX(ctx context.Context, id int) *RenewalAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPolicy queries the policy edge of a RenewalAttempt.
+func (c *RenewalAttemptClient) QueryPolicy(ra *RenewalAttempt) *RenewalPolicyQuery {
+	query := (&RenewalPolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ra.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(renewalattempt.Table, renewalattempt.FieldID, id),
+			sqlgraph.To(renewalpolicy.Table, renewalpolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, renewalattempt.PolicyTable, renewalattempt.PolicyColumn),
+		)
+		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RenewalAttemptClient) Hooks() []Hook {
+	return c.hooks.RenewalAttempt
+}
+
+// Interceptors returns the client interceptors.
+func (c *RenewalAttemptClient) Interceptors() []Interceptor {
+	return c.inters.RenewalAttempt
+}
+
+func (c *RenewalAttemptClient) mutate(ctx context.Context, m *RenewalAttemptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RenewalAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RenewalAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RenewalAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RenewalAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RenewalAttempt mutation op: %q", m.Op())
+	}
+}
+
+// RenewalPolicyClient is a client for the RenewalPolicy schema.
+type RenewalPolicyClient struct {
+	config
+}
+
+// NewRenewalPolicyClient returns a client for the RenewalPolicy from the given config.
+func NewRenewalPolicyClient(c config) *RenewalPolicyClient {
+	return &RenewalPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `renewalpolicy.Hooks(f(g(h())))`.
+func (c *RenewalPolicyClient) Use(hooks ...Hook) {
+	c.hooks.RenewalPolicy = append(c.hooks.RenewalPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `renewalpolicy.Intercept(f(g(h())))`.
+func (c *RenewalPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RenewalPolicy = append(c.inters.RenewalPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a RenewalPolicy entity.
+func (c *RenewalPolicyClient) Create() *RenewalPolicyCreate {
+	mutation := newRenewalPolicyMutation(c.config, OpCreate)
+	return &RenewalPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RenewalPolicy entities.
+func (c *RenewalPolicyClient) CreateBulk(builders ...*RenewalPolicyCreate) *RenewalPolicyCreateBulk {
+	return &RenewalPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RenewalPolicyClient) MapCreateBulk(slice any, setFunc func(*RenewalPolicyCreate, int)) *RenewalPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RenewalPolicyCreateBulk{err: fmt.Errorf("calling to RenewalPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RenewalPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RenewalPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RenewalPolicy.
+func (c *RenewalPolicyClient) Update() *RenewalPolicyUpdate {
+	mutation := newRenewalPolicyMutation(c.config, OpUpdate)
+	return &RenewalPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RenewalPolicyClient) UpdateOne(rp *RenewalPolicy) *RenewalPolicyUpdateOne {
+	mutation := newRenewalPolicyMutation(c.config, OpUpdateOne, withRenewalPolicy(rp))
+	return &RenewalPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RenewalPolicyClient) UpdateOneID(id int) *RenewalPolicyUpdateOne {
+	mutation := newRenewalPolicyMutation(c.config, OpUpdateOne, withRenewalPolicyID(id))
+	return &RenewalPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RenewalPolicy.
+func (c *RenewalPolicyClient) Delete() *RenewalPolicyDelete {
+	mutation := newRenewalPolicyMutation(c.config, OpDelete)
+	return &RenewalPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RenewalPolicyClient) DeleteOne(rp *RenewalPolicy) *RenewalPolicyDeleteOne {
+	return c.DeleteOneID(rp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RenewalPolicyClient) DeleteOneID(id int) *RenewalPolicyDeleteOne {
+	builder := c.Delete().Where(renewalpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RenewalPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for RenewalPolicy.
+func (c *RenewalPolicyClient) Query() *RenewalPolicyQuery {
+	return &RenewalPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRenewalPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RenewalPolicy entity by its id.
+func (c *RenewalPolicyClient) Get(ctx context.Context, id int) (*RenewalPolicy, error) {
+	return c.Query().Where(renewalpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RenewalPolicyClient) GetX(ctx context.Context, id int) *RenewalPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCertificate queries the certificate edge of a RenewalPolicy.
+func (c *RenewalPolicyClient) QueryCertificate(rp *RenewalPolicy) *CertificateQuery {
+	query := (&CertificateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(renewalpolicy.Table, renewalpolicy.FieldID, id),
+			sqlgraph.To(certificate.Table, certificate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, renewalpolicy.CertificateTable, renewalpolicy.CertificateColumn),
+		)
+		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttempts queries the attempts edge of a RenewalPolicy.
+func (c *RenewalPolicyClient) QueryAttempts(rp *RenewalPolicy) *RenewalAttemptQuery {
+	query := (&RenewalAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(renewalpolicy.Table, renewalpolicy.FieldID, id),
+			sqlgraph.To(renewalattempt.Table, renewalattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, renewalpolicy.AttemptsTable, renewalpolicy.AttemptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RenewalPolicyClient) Hooks() []Hook {
+	return c.hooks.RenewalPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *RenewalPolicyClient) Interceptors() []Interceptor {
+	return c.inters.RenewalPolicy
+}
+
+func (c *RenewalPolicyClient) mutate(ctx context.Context, m *RenewalPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RenewalPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RenewalPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RenewalPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RenewalPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RenewalPolicy mutation op: %q", m.Op())
+	}
+}
+
+// SigningRequestClient is a client for the SigningRequest schema.
+type SigningRequestClient struct {
+	config
+}
+
+// NewSigningRequestClient returns a client for the SigningRequest from the given config.
+func NewSigningRequestClient(c config) *SigningRequestClient {
+	return &SigningRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `signingrequest.Hooks(f(g(h())))`.
+func (c *SigningRequestClient) Use(hooks ...Hook) {
+	c.hooks.SigningRequest = append(c.hooks.SigningRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `signingrequest.Intercept(f(g(h())))`.
+func (c *SigningRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SigningRequest = append(c.inters.SigningRequest, interceptors...)
+}
+
+// Create returns a builder for creating a SigningRequest entity.
+func (c *SigningRequestClient) Create() *SigningRequestCreate {
+	mutation := newSigningRequestMutation(c.config, OpCreate)
+	return &SigningRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SigningRequest entities.
+func (c *SigningRequestClient) CreateBulk(builders ...*SigningRequestCreate) *SigningRequestCreateBulk {
+	return &SigningRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SigningRequestClient) MapCreateBulk(slice any, setFunc func(*SigningRequestCreate, int)) *SigningRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SigningRequestCreateBulk{err: fmt.Errorf("calling to SigningRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SigningRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SigningRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SigningRequest.
+func (c *SigningRequestClient) Update() *SigningRequestUpdate {
+	mutation := newSigningRequestMutation(c.config, OpUpdate)
+	return &SigningRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SigningRequestClient) UpdateOne(sr *SigningRequest) *SigningRequestUpdateOne {
+	mutation := newSigningRequestMutation(c.config, OpUpdateOne, withSigningRequest(sr))
+	return &SigningRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SigningRequestClient) UpdateOneID(id int) *SigningRequestUpdateOne {
+	mutation := newSigningRequestMutation(c.config, OpUpdateOne, withSigningRequestID(id))
+	return &SigningRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SigningRequest.
+func (c *SigningRequestClient) Delete() *SigningRequestDelete {
+	mutation := newSigningRequestMutation(c.config, OpDelete)
+	return &SigningRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SigningRequestClient) DeleteOne(sr *SigningRequest) *SigningRequestDeleteOne {
+	return c.DeleteOneID(sr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SigningRequestClient) DeleteOneID(id int) *SigningRequestDeleteOne {
+	builder := c.Delete().Where(signingrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SigningRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for SigningRequest.
+func (c *SigningRequestClient) Query() *SigningRequestQuery {
+	return &SigningRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSigningRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SigningRequest entity by its id.
+func (c *SigningRequestClient) Get(ctx context.Context, id int) (*SigningRequest, error) {
+	return c.Query().Where(signingrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SigningRequestClient) GetX(ctx context.Context, id int) *SigningRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNamespace queries the namespace edge of a SigningRequest.
+func (c *SigningRequestClient) QueryNamespace(sr *SigningRequest) *NamespaceQuery {
+	query := (&NamespaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(signingrequest.Table, signingrequest.FieldID, id),
+			sqlgraph.To(namespace.Table, namespace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, signingrequest.NamespaceTable, signingrequest.NamespaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(sr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SigningRequestClient) Hooks() []Hook {
+	return c.hooks.SigningRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *SigningRequestClient) Interceptors() []Interceptor {
+	return c.inters.SigningRequest
+}
+
+func (c *SigningRequestClient) mutate(ctx context.Context, m *SigningRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SigningRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SigningRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SigningRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SigningRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SigningRequest mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Certificate, Namespace []ent.Hook
+		AuditEvent, Certificate, ExpiryNotification, Keyring, Namespace, RenewalAttempt,
+		RenewalPolicy, SigningRequest []ent.Hook
 	}
 	inters struct {
-		Certificate, Namespace []ent.Interceptor
+		AuditEvent, Certificate, ExpiryNotification, Keyring, Namespace, RenewalAttempt,
+		RenewalPolicy, SigningRequest []ent.Interceptor
 	}
 )
@@ -12,8 +12,14 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/logeable/certmgr/internal/ent/auditevent"
 	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+	"github.com/logeable/certmgr/internal/ent/keyring"
 	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +80,14 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			certificate.Table: certificate.ValidColumn,
-			namespace.Table:   namespace.ValidColumn,
+			auditevent.Table:         auditevent.ValidColumn,
+			certificate.Table:        certificate.ValidColumn,
+			expirynotification.Table: expirynotification.ValidColumn,
+			keyring.Table:            keyring.ValidColumn,
+			namespace.Table:          namespace.ValidColumn,
+			renewalattempt.Table:     renewalattempt.ValidColumn,
+			renewalpolicy.Table:      renewalpolicy.ValidColumn,
+			signingrequest.Table:     signingrequest.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+)
+
+// ExpiryNotification is the model entity for the ExpiryNotification schema.
+type ExpiryNotification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CertificateID holds the value of the "certificate_id" field.
+	CertificateID int `json:"certificate_id,omitempty"`
+	// Fingerprint holds the value of the "fingerprint" field.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// ThresholdDays holds the value of the "threshold_days" field.
+	ThresholdDays int `json:"threshold_days,omitempty"`
+	// NotAfter holds the value of the "not_after" field.
+	NotAfter time.Time `json:"not_after,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExpiryNotificationQuery when eager-loading is set.
+	Edges        ExpiryNotificationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExpiryNotificationEdges holds the relations/edges for other nodes in the graph.
+type ExpiryNotificationEdges struct {
+	// Certificate holds the value of the certificate edge.
+	Certificate *Certificate `json:"certificate,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CertificateOrErr returns the Certificate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExpiryNotificationEdges) CertificateOrErr() (*Certificate, error) {
+	if e.Certificate != nil {
+		return e.Certificate, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: certificate.Label}
+	}
+	return nil, &NotLoadedError{edge: "certificate"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExpiryNotification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case expirynotification.FieldID, expirynotification.FieldCertificateID, expirynotification.FieldThresholdDays:
+			values[i] = new(sql.NullInt64)
+		case expirynotification.FieldFingerprint:
+			values[i] = new(sql.NullString)
+		case expirynotification.FieldNotAfter, expirynotification.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExpiryNotification fields.
+func (en *ExpiryNotification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case expirynotification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			en.ID = int(value.Int64)
+		case expirynotification.FieldCertificateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_id", values[i])
+			} else if value.Valid {
+				en.CertificateID = int(value.Int64)
+			}
+		case expirynotification.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				en.Fingerprint = value.String
+			}
+		case expirynotification.FieldThresholdDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold_days", values[i])
+			} else if value.Valid {
+				en.ThresholdDays = int(value.Int64)
+			}
+		case expirynotification.FieldNotAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field not_after", values[i])
+			} else if value.Valid {
+				en.NotAfter = value.Time
+			}
+		case expirynotification.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				en.CreatedAt = value.Time
+			}
+		default:
+			en.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExpiryNotification.
+// This includes values selected through modifiers, order, etc.
+func (en *ExpiryNotification) Value(name string) (ent.Value, error) {
+	return en.selectValues.Get(name)
+}
+
+// QueryCertificate queries the "certificate" edge of the ExpiryNotification entity.
+func (en *ExpiryNotification) QueryCertificate() *CertificateQuery {
+	return NewExpiryNotificationClient(en.config).QueryCertificate(en)
+}
+
+// Update returns a builder for updating this ExpiryNotification.
+// Note that you need to call ExpiryNotification.Unwrap() before calling this method if this ExpiryNotification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (en *ExpiryNotification) Update() *ExpiryNotificationUpdateOne {
+	return NewExpiryNotificationClient(en.config).UpdateOne(en)
+}
+
+// Unwrap unwraps the ExpiryNotification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (en *ExpiryNotification) Unwrap() *ExpiryNotification {
+	_tx, ok := en.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExpiryNotification is not a transactional entity")
+	}
+	en.config.driver = _tx.drv
+	return en
+}
+
+// String implements the fmt.Stringer.
+func (en *ExpiryNotification) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExpiryNotification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", en.ID))
+	builder.WriteString("certificate_id=")
+	builder.WriteString(fmt.Sprintf("%v", en.CertificateID))
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(en.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("threshold_days=")
+	builder.WriteString(fmt.Sprintf("%v", en.ThresholdDays))
+	builder.WriteString(", ")
+	builder.WriteString("not_after=")
+	builder.WriteString(en.NotAfter.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(en.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExpiryNotifications is a parsable slice of ExpiryNotification.
+type ExpiryNotifications []*ExpiryNotification
@@ -0,0 +1,112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package expirynotification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the expirynotification type in the database.
+	Label = "expiry_notification"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCertificateID holds the string denoting the certificate_id field in the database.
+	FieldCertificateID = "certificate_id"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldThresholdDays holds the string denoting the threshold_days field in the database.
+	FieldThresholdDays = "threshold_days"
+	// FieldNotAfter holds the string denoting the not_after field in the database.
+	FieldNotAfter = "not_after"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCertificate holds the string denoting the certificate edge name in mutations.
+	EdgeCertificate = "certificate"
+	// Table holds the table name of the expirynotification in the database.
+	Table = "expiry_notifications"
+	// CertificateTable is the table that holds the certificate relation/edge.
+	CertificateTable = "expiry_notifications"
+	// CertificateInverseTable is the table name for the Certificate entity.
+	// It exists in this package in order to avoid circular dependency with the "certificate" package.
+	CertificateInverseTable = "certificates"
+	// CertificateColumn is the table column denoting the certificate relation/edge.
+	CertificateColumn = "certificate_id"
+)
+
+// Columns holds all SQL columns for expirynotification fields.
+var Columns = []string{
+	FieldID,
+	FieldCertificateID,
+	FieldFingerprint,
+	FieldThresholdDays,
+	FieldNotAfter,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the ExpiryNotification queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCertificateID orders the results by the certificate_id field.
+func ByCertificateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateID, opts...).ToFunc()
+}
+
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// ByThresholdDays orders the results by the threshold_days field.
+func ByThresholdDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThresholdDays, opts...).ToFunc()
+}
+
+// ByNotAfter orders the results by the not_after field.
+func ByNotAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotAfter, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCertificateField orders the results by certificate field.
+func ByCertificateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCertificateStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCertificateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CertificateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CertificateTable, CertificateColumn),
+	)
+}
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package expirynotification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldLTE(FieldID, id))
+}
+
+// CertificateID applies equality check predicate on the "certificate_id" field. It's identical to CertificateIDEQ.
+func CertificateID(v int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// ThresholdDays applies equality check predicate on the "threshold_days" field. It's identical to ThresholdDaysEQ.
+func ThresholdDays(v int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldThresholdDays, v))
+}
+
+// NotAfter applies equality check predicate on the "not_after" field. It's identical to NotAfterEQ.
+func NotAfter(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldNotAfter, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CertificateIDEQ applies the EQ predicate on the "certificate_id" field.
+func CertificateIDEQ(v int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// CertificateIDNEQ applies the NEQ predicate on the "certificate_id" field.
+func CertificateIDNEQ(v int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNEQ(FieldCertificateID, v))
+}
+
+// CertificateIDIn applies the In predicate on the "certificate_id" field.
+func CertificateIDIn(vs ...int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDNotIn applies the NotIn predicate on the "certificate_id" field.
+func CertificateIDNotIn(vs ...int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNotIn(FieldCertificateID, vs...))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// ThresholdDaysEQ applies the EQ predicate on the "threshold_days" field.
+func ThresholdDaysEQ(v int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldThresholdDays, v))
+}
+
+// ThresholdDaysNEQ applies the NEQ predicate on the "threshold_days" field.
+func ThresholdDaysNEQ(v int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNEQ(FieldThresholdDays, v))
+}
+
+// ThresholdDaysIn applies the In predicate on the "threshold_days" field.
+func ThresholdDaysIn(vs ...int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldIn(FieldThresholdDays, vs...))
+}
+
+// ThresholdDaysNotIn applies the NotIn predicate on the "threshold_days" field.
+func ThresholdDaysNotIn(vs ...int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNotIn(FieldThresholdDays, vs...))
+}
+
+// ThresholdDaysGT applies the GT predicate on the "threshold_days" field.
+func ThresholdDaysGT(v int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldGT(FieldThresholdDays, v))
+}
+
+// ThresholdDaysGTE applies the GTE predicate on the "threshold_days" field.
+func ThresholdDaysGTE(v int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldGTE(FieldThresholdDays, v))
+}
+
+// ThresholdDaysLT applies the LT predicate on the "threshold_days" field.
+func ThresholdDaysLT(v int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldLT(FieldThresholdDays, v))
+}
+
+// ThresholdDaysLTE applies the LTE predicate on the "threshold_days" field.
+func ThresholdDaysLTE(v int) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldLTE(FieldThresholdDays, v))
+}
+
+// NotAfterEQ applies the EQ predicate on the "not_after" field.
+func NotAfterEQ(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldNotAfter, v))
+}
+
+// NotAfterNEQ applies the NEQ predicate on the "not_after" field.
+func NotAfterNEQ(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNEQ(FieldNotAfter, v))
+}
+
+// NotAfterIn applies the In predicate on the "not_after" field.
+func NotAfterIn(vs ...time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldIn(FieldNotAfter, vs...))
+}
+
+// NotAfterNotIn applies the NotIn predicate on the "not_after" field.
+func NotAfterNotIn(vs ...time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNotIn(FieldNotAfter, vs...))
+}
+
+// NotAfterGT applies the GT predicate on the "not_after" field.
+func NotAfterGT(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldGT(FieldNotAfter, v))
+}
+
+// NotAfterGTE applies the GTE predicate on the "not_after" field.
+func NotAfterGTE(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldGTE(FieldNotAfter, v))
+}
+
+// NotAfterLT applies the LT predicate on the "not_after" field.
+func NotAfterLT(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldLT(FieldNotAfter, v))
+}
+
+// NotAfterLTE applies the LTE predicate on the "not_after" field.
+func NotAfterLTE(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldLTE(FieldNotAfter, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCertificate applies the HasEdge predicate on the "certificate" edge.
+func HasCertificate() predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CertificateTable, CertificateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCertificateWith applies the HasEdge predicate on the "certificate" edge with a given conditions (other predicates).
+func HasCertificateWith(preds ...predicate.Certificate) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(func(s *sql.Selector) {
+		step := newCertificateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExpiryNotification) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExpiryNotification) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExpiryNotification) predicate.ExpiryNotification {
+	return predicate.ExpiryNotification(sql.NotPredicates(p))
+}
@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+)
+
+// ExpiryNotificationCreate is the builder for creating a ExpiryNotification entity.
+type ExpiryNotificationCreate struct {
+	config
+	mutation *ExpiryNotificationMutation
+	hooks    []Hook
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (enc *ExpiryNotificationCreate) SetCertificateID(i int) *ExpiryNotificationCreate {
+	enc.mutation.SetCertificateID(i)
+	return enc
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (enc *ExpiryNotificationCreate) SetFingerprint(s string) *ExpiryNotificationCreate {
+	enc.mutation.SetFingerprint(s)
+	return enc
+}
+
+// SetThresholdDays sets the "threshold_days" field.
+func (enc *ExpiryNotificationCreate) SetThresholdDays(i int) *ExpiryNotificationCreate {
+	enc.mutation.SetThresholdDays(i)
+	return enc
+}
+
+// SetNotAfter sets the "not_after" field.
+func (enc *ExpiryNotificationCreate) SetNotAfter(t time.Time) *ExpiryNotificationCreate {
+	enc.mutation.SetNotAfter(t)
+	return enc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (enc *ExpiryNotificationCreate) SetCreatedAt(t time.Time) *ExpiryNotificationCreate {
+	enc.mutation.SetCreatedAt(t)
+	return enc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (enc *ExpiryNotificationCreate) SetNillableCreatedAt(t *time.Time) *ExpiryNotificationCreate {
+	if t != nil {
+		enc.SetCreatedAt(*t)
+	}
+	return enc
+}
+
+// SetID sets the "id" field.
+func (enc *ExpiryNotificationCreate) SetID(i int) *ExpiryNotificationCreate {
+	enc.mutation.SetID(i)
+	return enc
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (enc *ExpiryNotificationCreate) SetCertificate(c *Certificate) *ExpiryNotificationCreate {
+	return enc.SetCertificateID(c.ID)
+}
+
+// Mutation returns the ExpiryNotificationMutation object of the builder.
+func (enc *ExpiryNotificationCreate) Mutation() *ExpiryNotificationMutation {
+	return enc.mutation
+}
+
+// Save creates the ExpiryNotification in the database.
+func (enc *ExpiryNotificationCreate) Save(ctx context.Context) (*ExpiryNotification, error) {
+	enc.defaults()
+	return withHooks(ctx, enc.sqlSave, enc.mutation, enc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (enc *ExpiryNotificationCreate) SaveX(ctx context.Context) *ExpiryNotification {
+	v, err := enc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (enc *ExpiryNotificationCreate) Exec(ctx context.Context) error {
+	_, err := enc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (enc *ExpiryNotificationCreate) ExecX(ctx context.Context) {
+	if err := enc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (enc *ExpiryNotificationCreate) defaults() {
+	if _, ok := enc.mutation.CreatedAt(); !ok {
+		v := expirynotification.DefaultCreatedAt()
+		enc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (enc *ExpiryNotificationCreate) check() error {
+	if _, ok := enc.mutation.CertificateID(); !ok {
+		return &ValidationError{Name: "certificate_id", err: errors.New(`ent: missing required field "ExpiryNotification.certificate_id"`)}
+	}
+	if _, ok := enc.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "ExpiryNotification.fingerprint"`)}
+	}
+	if _, ok := enc.mutation.ThresholdDays(); !ok {
+		return &ValidationError{Name: "threshold_days", err: errors.New(`ent: missing required field "ExpiryNotification.threshold_days"`)}
+	}
+	if _, ok := enc.mutation.NotAfter(); !ok {
+		return &ValidationError{Name: "not_after", err: errors.New(`ent: missing required field "ExpiryNotification.not_after"`)}
+	}
+	if _, ok := enc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExpiryNotification.created_at"`)}
+	}
+	if v, ok := enc.mutation.ID(); ok {
+		if err := expirynotification.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ExpiryNotification.id": %w`, err)}
+		}
+	}
+	if len(enc.mutation.CertificateIDs()) == 0 {
+		return &ValidationError{Name: "certificate", err: errors.New(`ent: missing required edge "ExpiryNotification.certificate"`)}
+	}
+	return nil
+}
+
+func (enc *ExpiryNotificationCreate) sqlSave(ctx context.Context) (*ExpiryNotification, error) {
+	if err := enc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := enc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, enc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	enc.mutation.id = &_node.ID
+	enc.mutation.done = true
+	return _node, nil
+}
+
+func (enc *ExpiryNotificationCreate) createSpec() (*ExpiryNotification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExpiryNotification{config: enc.config}
+		_spec = sqlgraph.NewCreateSpec(expirynotification.Table, sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt))
+	)
+	if id, ok := enc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := enc.mutation.Fingerprint(); ok {
+		_spec.SetField(expirynotification.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := enc.mutation.ThresholdDays(); ok {
+		_spec.SetField(expirynotification.FieldThresholdDays, field.TypeInt, value)
+		_node.ThresholdDays = value
+	}
+	if value, ok := enc.mutation.NotAfter(); ok {
+		_spec.SetField(expirynotification.FieldNotAfter, field.TypeTime, value)
+		_node.NotAfter = value
+	}
+	if value, ok := enc.mutation.CreatedAt(); ok {
+		_spec.SetField(expirynotification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := enc.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   expirynotification.CertificateTable,
+			Columns: []string{expirynotification.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CertificateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ExpiryNotificationCreateBulk is the builder for creating many ExpiryNotification entities in bulk.
+type ExpiryNotificationCreateBulk struct {
+	config
+	err      error
+	builders []*ExpiryNotificationCreate
+}
+
+// Save creates the ExpiryNotification entities in the database.
+func (encb *ExpiryNotificationCreateBulk) Save(ctx context.Context) ([]*ExpiryNotification, error) {
+	if encb.err != nil {
+		return nil, encb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(encb.builders))
+	nodes := make([]*ExpiryNotification, len(encb.builders))
+	mutators := make([]Mutator, len(encb.builders))
+	for i := range encb.builders {
+		func(i int, root context.Context) {
+			builder := encb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExpiryNotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, encb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, encb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, encb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (encb *ExpiryNotificationCreateBulk) SaveX(ctx context.Context) []*ExpiryNotification {
+	v, err := encb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (encb *ExpiryNotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := encb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (encb *ExpiryNotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := encb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
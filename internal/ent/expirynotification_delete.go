@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// ExpiryNotificationDelete is the builder for deleting a ExpiryNotification entity.
+type ExpiryNotificationDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExpiryNotificationMutation
+}
+
+// Where appends a list predicates to the ExpiryNotificationDelete builder.
+func (end *ExpiryNotificationDelete) Where(ps ...predicate.ExpiryNotification) *ExpiryNotificationDelete {
+	end.mutation.Where(ps...)
+	return end
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (end *ExpiryNotificationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, end.sqlExec, end.mutation, end.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (end *ExpiryNotificationDelete) ExecX(ctx context.Context) int {
+	n, err := end.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (end *ExpiryNotificationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(expirynotification.Table, sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt))
+	if ps := end.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, end.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	end.mutation.done = true
+	return affected, err
+}
+
+// ExpiryNotificationDeleteOne is the builder for deleting a single ExpiryNotification entity.
+type ExpiryNotificationDeleteOne struct {
+	end *ExpiryNotificationDelete
+}
+
+// Where appends a list predicates to the ExpiryNotificationDelete builder.
+func (endo *ExpiryNotificationDeleteOne) Where(ps ...predicate.ExpiryNotification) *ExpiryNotificationDeleteOne {
+	endo.end.mutation.Where(ps...)
+	return endo
+}
+
+// Exec executes the deletion query.
+func (endo *ExpiryNotificationDeleteOne) Exec(ctx context.Context) error {
+	n, err := endo.end.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{expirynotification.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (endo *ExpiryNotificationDeleteOne) ExecX(ctx context.Context) {
+	if err := endo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
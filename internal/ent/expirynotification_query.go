@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// ExpiryNotificationQuery is the builder for querying ExpiryNotification entities.
+type ExpiryNotificationQuery struct {
+	config
+	ctx             *QueryContext
+	order           []expirynotification.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.ExpiryNotification
+	withCertificate *CertificateQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ExpiryNotificationQuery builder.
+func (enq *ExpiryNotificationQuery) Where(ps ...predicate.ExpiryNotification) *ExpiryNotificationQuery {
+	enq.predicates = append(enq.predicates, ps...)
+	return enq
+}
+
+// Limit the number of records to be returned by this query.
+func (enq *ExpiryNotificationQuery) Limit(limit int) *ExpiryNotificationQuery {
+	enq.ctx.Limit = &limit
+	return enq
+}
+
+// Offset to start from.
+func (enq *ExpiryNotificationQuery) Offset(offset int) *ExpiryNotificationQuery {
+	enq.ctx.Offset = &offset
+	return enq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (enq *ExpiryNotificationQuery) Unique(unique bool) *ExpiryNotificationQuery {
+	enq.ctx.Unique = &unique
+	return enq
+}
+
+// Order specifies how the records should be ordered.
+func (enq *ExpiryNotificationQuery) Order(o ...expirynotification.OrderOption) *ExpiryNotificationQuery {
+	enq.order = append(enq.order, o...)
+	return enq
+}
+
+// QueryCertificate chains the current query on the "certificate" edge.
+func (enq *ExpiryNotificationQuery) QueryCertificate() *CertificateQuery {
+	query := (&CertificateClient{config: enq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := enq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := enq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(expirynotification.Table, expirynotification.FieldID, selector),
+			sqlgraph.To(certificate.Table, certificate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, expirynotification.CertificateTable, expirynotification.CertificateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(enq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ExpiryNotification entity from the query.
+// Returns a *NotFoundError when no ExpiryNotification was found.
+func (enq *ExpiryNotificationQuery) First(ctx context.Context) (*ExpiryNotification, error) {
+	nodes, err := enq.Limit(1).All(setContextOp(ctx, enq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{expirynotification.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (enq *ExpiryNotificationQuery) FirstX(ctx context.Context) *ExpiryNotification {
+	node, err := enq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ExpiryNotification ID from the query.
+// Returns a *NotFoundError when no ExpiryNotification ID was found.
+func (enq *ExpiryNotificationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = enq.Limit(1).IDs(setContextOp(ctx, enq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{expirynotification.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (enq *ExpiryNotificationQuery) FirstIDX(ctx context.Context) int {
+	id, err := enq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ExpiryNotification entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ExpiryNotification entity is found.
+// Returns a *NotFoundError when no ExpiryNotification entities are found.
+func (enq *ExpiryNotificationQuery) Only(ctx context.Context) (*ExpiryNotification, error) {
+	nodes, err := enq.Limit(2).All(setContextOp(ctx, enq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{expirynotification.Label}
+	default:
+		return nil, &NotSingularError{expirynotification.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (enq *ExpiryNotificationQuery) OnlyX(ctx context.Context) *ExpiryNotification {
+	node, err := enq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ExpiryNotification ID in the query.
+// Returns a *NotSingularError when more than one ExpiryNotification ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (enq *ExpiryNotificationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = enq.Limit(2).IDs(setContextOp(ctx, enq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{expirynotification.Label}
+	default:
+		err = &NotSingularError{expirynotification.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (enq *ExpiryNotificationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := enq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ExpiryNotifications.
+func (enq *ExpiryNotificationQuery) All(ctx context.Context) ([]*ExpiryNotification, error) {
+	ctx = setContextOp(ctx, enq.ctx, ent.OpQueryAll)
+	if err := enq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ExpiryNotification, *ExpiryNotificationQuery]()
+	return withInterceptors[[]*ExpiryNotification](ctx, enq, qr, enq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (enq *ExpiryNotificationQuery) AllX(ctx context.Context) []*ExpiryNotification {
+	nodes, err := enq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ExpiryNotification IDs.
+func (enq *ExpiryNotificationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if enq.ctx.Unique == nil && enq.path != nil {
+		enq.Unique(true)
+	}
+	ctx = setContextOp(ctx, enq.ctx, ent.OpQueryIDs)
+	if err = enq.Select(expirynotification.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (enq *ExpiryNotificationQuery) IDsX(ctx context.Context) []int {
+	ids, err := enq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (enq *ExpiryNotificationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, enq.ctx, ent.OpQueryCount)
+	if err := enq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, enq, querierCount[*ExpiryNotificationQuery](), enq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (enq *ExpiryNotificationQuery) CountX(ctx context.Context) int {
+	count, err := enq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (enq *ExpiryNotificationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, enq.ctx, ent.OpQueryExist)
+	switch _, err := enq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (enq *ExpiryNotificationQuery) ExistX(ctx context.Context) bool {
+	exist, err := enq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ExpiryNotificationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (enq *ExpiryNotificationQuery) Clone() *ExpiryNotificationQuery {
+	if enq == nil {
+		return nil
+	}
+	return &ExpiryNotificationQuery{
+		config:          enq.config,
+		ctx:             enq.ctx.Clone(),
+		order:           append([]expirynotification.OrderOption{}, enq.order...),
+		inters:          append([]Interceptor{}, enq.inters...),
+		predicates:      append([]predicate.ExpiryNotification{}, enq.predicates...),
+		withCertificate: enq.withCertificate.Clone(),
+		// clone intermediate query.
+		sql:  enq.sql.Clone(),
+		path: enq.path,
+	}
+}
+
+// WithCertificate tells the query-builder to eager-load the nodes that are connected to
+// the "certificate" edge. The optional arguments are used to configure the query builder of the edge.
+func (enq *ExpiryNotificationQuery) WithCertificate(opts ...func(*CertificateQuery)) *ExpiryNotificationQuery {
+	query := (&CertificateClient{config: enq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	enq.withCertificate = query
+	return enq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CertificateID int `json:"certificate_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ExpiryNotification.Query().
+//		GroupBy(expirynotification.FieldCertificateID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (enq *ExpiryNotificationQuery) GroupBy(field string, fields ...string) *ExpiryNotificationGroupBy {
+	enq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ExpiryNotificationGroupBy{build: enq}
+	grbuild.flds = &enq.ctx.Fields
+	grbuild.label = expirynotification.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CertificateID int `json:"certificate_id,omitempty"`
+//	}
+//
+//	client.ExpiryNotification.Query().
+//		Select(expirynotification.FieldCertificateID).
+//		Scan(ctx, &v)
+func (enq *ExpiryNotificationQuery) Select(fields ...string) *ExpiryNotificationSelect {
+	enq.ctx.Fields = append(enq.ctx.Fields, fields...)
+	sbuild := &ExpiryNotificationSelect{ExpiryNotificationQuery: enq}
+	sbuild.label = expirynotification.Label
+	sbuild.flds, sbuild.scan = &enq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ExpiryNotificationSelect configured with the given aggregations.
+func (enq *ExpiryNotificationQuery) Aggregate(fns ...AggregateFunc) *ExpiryNotificationSelect {
+	return enq.Select().Aggregate(fns...)
+}
+
+func (enq *ExpiryNotificationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range enq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, enq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range enq.ctx.Fields {
+		if !expirynotification.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if enq.path != nil {
+		prev, err := enq.path(ctx)
+		if err != nil {
+			return err
+		}
+		enq.sql = prev
+	}
+	return nil
+}
+
+func (enq *ExpiryNotificationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ExpiryNotification, error) {
+	var (
+		nodes       = []*ExpiryNotification{}
+		_spec       = enq.querySpec()
+		loadedTypes = [1]bool{
+			enq.withCertificate != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ExpiryNotification).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ExpiryNotification{config: enq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, enq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := enq.withCertificate; query != nil {
+		if err := enq.loadCertificate(ctx, query, nodes, nil,
+			func(n *ExpiryNotification, e *Certificate) { n.Edges.Certificate = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (enq *ExpiryNotificationQuery) loadCertificate(ctx context.Context, query *CertificateQuery, nodes []*ExpiryNotification, init func(*ExpiryNotification), assign func(*ExpiryNotification, *Certificate)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ExpiryNotification)
+	for i := range nodes {
+		fk := nodes[i].CertificateID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(certificate.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "certificate_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (enq *ExpiryNotificationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := enq.querySpec()
+	_spec.Node.Columns = enq.ctx.Fields
+	if len(enq.ctx.Fields) > 0 {
+		_spec.Unique = enq.ctx.Unique != nil && *enq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, enq.driver, _spec)
+}
+
+func (enq *ExpiryNotificationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(expirynotification.Table, expirynotification.Columns, sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt))
+	_spec.From = enq.sql
+	if unique := enq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if enq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := enq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, expirynotification.FieldID)
+		for i := range fields {
+			if fields[i] != expirynotification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if enq.withCertificate != nil {
+			_spec.Node.AddColumnOnce(expirynotification.FieldCertificateID)
+		}
+	}
+	if ps := enq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := enq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := enq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := enq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (enq *ExpiryNotificationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(enq.driver.Dialect())
+	t1 := builder.Table(expirynotification.Table)
+	columns := enq.ctx.Fields
+	if len(columns) == 0 {
+		columns = expirynotification.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if enq.sql != nil {
+		selector = enq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if enq.ctx.Unique != nil && *enq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range enq.predicates {
+		p(selector)
+	}
+	for _, p := range enq.order {
+		p(selector)
+	}
+	if offset := enq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := enq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ExpiryNotificationGroupBy is the group-by builder for ExpiryNotification entities.
+type ExpiryNotificationGroupBy struct {
+	selector
+	build *ExpiryNotificationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (engb *ExpiryNotificationGroupBy) Aggregate(fns ...AggregateFunc) *ExpiryNotificationGroupBy {
+	engb.fns = append(engb.fns, fns...)
+	return engb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (engb *ExpiryNotificationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, engb.build.ctx, ent.OpQueryGroupBy)
+	if err := engb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExpiryNotificationQuery, *ExpiryNotificationGroupBy](ctx, engb.build, engb, engb.build.inters, v)
+}
+
+func (engb *ExpiryNotificationGroupBy) sqlScan(ctx context.Context, root *ExpiryNotificationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(engb.fns))
+	for _, fn := range engb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*engb.flds)+len(engb.fns))
+		for _, f := range *engb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*engb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := engb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ExpiryNotificationSelect is the builder for selecting fields of ExpiryNotification entities.
+type ExpiryNotificationSelect struct {
+	*ExpiryNotificationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ens *ExpiryNotificationSelect) Aggregate(fns ...AggregateFunc) *ExpiryNotificationSelect {
+	ens.fns = append(ens.fns, fns...)
+	return ens
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ens *ExpiryNotificationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ens.ctx, ent.OpQuerySelect)
+	if err := ens.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExpiryNotificationQuery, *ExpiryNotificationSelect](ctx, ens.ExpiryNotificationQuery, ens, ens.inters, v)
+}
+
+func (ens *ExpiryNotificationSelect) sqlScan(ctx context.Context, root *ExpiryNotificationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ens.fns))
+	for _, fn := range ens.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ens.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ens.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// ExpiryNotificationUpdate is the builder for updating ExpiryNotification entities.
+type ExpiryNotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExpiryNotificationMutation
+}
+
+// Where appends a list predicates to the ExpiryNotificationUpdate builder.
+func (enu *ExpiryNotificationUpdate) Where(ps ...predicate.ExpiryNotification) *ExpiryNotificationUpdate {
+	enu.mutation.Where(ps...)
+	return enu
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (enu *ExpiryNotificationUpdate) SetCertificateID(i int) *ExpiryNotificationUpdate {
+	enu.mutation.SetCertificateID(i)
+	return enu
+}
+
+// SetNillableCertificateID sets the "certificate_id" field if the given value is not nil.
+func (enu *ExpiryNotificationUpdate) SetNillableCertificateID(i *int) *ExpiryNotificationUpdate {
+	if i != nil {
+		enu.SetCertificateID(*i)
+	}
+	return enu
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (enu *ExpiryNotificationUpdate) SetFingerprint(s string) *ExpiryNotificationUpdate {
+	enu.mutation.SetFingerprint(s)
+	return enu
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (enu *ExpiryNotificationUpdate) SetNillableFingerprint(s *string) *ExpiryNotificationUpdate {
+	if s != nil {
+		enu.SetFingerprint(*s)
+	}
+	return enu
+}
+
+// SetThresholdDays sets the "threshold_days" field.
+func (enu *ExpiryNotificationUpdate) SetThresholdDays(i int) *ExpiryNotificationUpdate {
+	enu.mutation.ResetThresholdDays()
+	enu.mutation.SetThresholdDays(i)
+	return enu
+}
+
+// SetNillableThresholdDays sets the "threshold_days" field if the given value is not nil.
+func (enu *ExpiryNotificationUpdate) SetNillableThresholdDays(i *int) *ExpiryNotificationUpdate {
+	if i != nil {
+		enu.SetThresholdDays(*i)
+	}
+	return enu
+}
+
+// AddThresholdDays adds i to the "threshold_days" field.
+func (enu *ExpiryNotificationUpdate) AddThresholdDays(i int) *ExpiryNotificationUpdate {
+	enu.mutation.AddThresholdDays(i)
+	return enu
+}
+
+// SetNotAfter sets the "not_after" field.
+func (enu *ExpiryNotificationUpdate) SetNotAfter(t time.Time) *ExpiryNotificationUpdate {
+	enu.mutation.SetNotAfter(t)
+	return enu
+}
+
+// SetNillableNotAfter sets the "not_after" field if the given value is not nil.
+func (enu *ExpiryNotificationUpdate) SetNillableNotAfter(t *time.Time) *ExpiryNotificationUpdate {
+	if t != nil {
+		enu.SetNotAfter(*t)
+	}
+	return enu
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (enu *ExpiryNotificationUpdate) SetCertificate(c *Certificate) *ExpiryNotificationUpdate {
+	return enu.SetCertificateID(c.ID)
+}
+
+// Mutation returns the ExpiryNotificationMutation object of the builder.
+func (enu *ExpiryNotificationUpdate) Mutation() *ExpiryNotificationMutation {
+	return enu.mutation
+}
+
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (enu *ExpiryNotificationUpdate) ClearCertificate() *ExpiryNotificationUpdate {
+	enu.mutation.ClearCertificate()
+	return enu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (enu *ExpiryNotificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, enu.sqlSave, enu.mutation, enu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (enu *ExpiryNotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := enu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (enu *ExpiryNotificationUpdate) Exec(ctx context.Context) error {
+	_, err := enu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (enu *ExpiryNotificationUpdate) ExecX(ctx context.Context) {
+	if err := enu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (enu *ExpiryNotificationUpdate) check() error {
+	if enu.mutation.CertificateCleared() && len(enu.mutation.CertificateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExpiryNotification.certificate"`)
+	}
+	return nil
+}
+
+func (enu *ExpiryNotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := enu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(expirynotification.Table, expirynotification.Columns, sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt))
+	if ps := enu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := enu.mutation.Fingerprint(); ok {
+		_spec.SetField(expirynotification.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := enu.mutation.ThresholdDays(); ok {
+		_spec.SetField(expirynotification.FieldThresholdDays, field.TypeInt, value)
+	}
+	if value, ok := enu.mutation.AddedThresholdDays(); ok {
+		_spec.AddField(expirynotification.FieldThresholdDays, field.TypeInt, value)
+	}
+	if value, ok := enu.mutation.NotAfter(); ok {
+		_spec.SetField(expirynotification.FieldNotAfter, field.TypeTime, value)
+	}
+	if enu.mutation.CertificateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   expirynotification.CertificateTable,
+			Columns: []string{expirynotification.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := enu.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   expirynotification.CertificateTable,
+			Columns: []string{expirynotification.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, enu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{expirynotification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	enu.mutation.done = true
+	return n, nil
+}
+
+// ExpiryNotificationUpdateOne is the builder for updating a single ExpiryNotification entity.
+type ExpiryNotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExpiryNotificationMutation
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (enuo *ExpiryNotificationUpdateOne) SetCertificateID(i int) *ExpiryNotificationUpdateOne {
+	enuo.mutation.SetCertificateID(i)
+	return enuo
+}
+
+// SetNillableCertificateID sets the "certificate_id" field if the given value is not nil.
+func (enuo *ExpiryNotificationUpdateOne) SetNillableCertificateID(i *int) *ExpiryNotificationUpdateOne {
+	if i != nil {
+		enuo.SetCertificateID(*i)
+	}
+	return enuo
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (enuo *ExpiryNotificationUpdateOne) SetFingerprint(s string) *ExpiryNotificationUpdateOne {
+	enuo.mutation.SetFingerprint(s)
+	return enuo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (enuo *ExpiryNotificationUpdateOne) SetNillableFingerprint(s *string) *ExpiryNotificationUpdateOne {
+	if s != nil {
+		enuo.SetFingerprint(*s)
+	}
+	return enuo
+}
+
+// SetThresholdDays sets the "threshold_days" field.
+func (enuo *ExpiryNotificationUpdateOne) SetThresholdDays(i int) *ExpiryNotificationUpdateOne {
+	enuo.mutation.ResetThresholdDays()
+	enuo.mutation.SetThresholdDays(i)
+	return enuo
+}
+
+// SetNillableThresholdDays sets the "threshold_days" field if the given value is not nil.
+func (enuo *ExpiryNotificationUpdateOne) SetNillableThresholdDays(i *int) *ExpiryNotificationUpdateOne {
+	if i != nil {
+		enuo.SetThresholdDays(*i)
+	}
+	return enuo
+}
+
+// AddThresholdDays adds i to the "threshold_days" field.
+func (enuo *ExpiryNotificationUpdateOne) AddThresholdDays(i int) *ExpiryNotificationUpdateOne {
+	enuo.mutation.AddThresholdDays(i)
+	return enuo
+}
+
+// SetNotAfter sets the "not_after" field.
+func (enuo *ExpiryNotificationUpdateOne) SetNotAfter(t time.Time) *ExpiryNotificationUpdateOne {
+	enuo.mutation.SetNotAfter(t)
+	return enuo
+}
+
+// SetNillableNotAfter sets the "not_after" field if the given value is not nil.
+func (enuo *ExpiryNotificationUpdateOne) SetNillableNotAfter(t *time.Time) *ExpiryNotificationUpdateOne {
+	if t != nil {
+		enuo.SetNotAfter(*t)
+	}
+	return enuo
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (enuo *ExpiryNotificationUpdateOne) SetCertificate(c *Certificate) *ExpiryNotificationUpdateOne {
+	return enuo.SetCertificateID(c.ID)
+}
+
+// Mutation returns the ExpiryNotificationMutation object of the builder.
+func (enuo *ExpiryNotificationUpdateOne) Mutation() *ExpiryNotificationMutation {
+	return enuo.mutation
+}
+
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (enuo *ExpiryNotificationUpdateOne) ClearCertificate() *ExpiryNotificationUpdateOne {
+	enuo.mutation.ClearCertificate()
+	return enuo
+}
+
+// Where appends a list predicates to the ExpiryNotificationUpdate builder.
+func (enuo *ExpiryNotificationUpdateOne) Where(ps ...predicate.ExpiryNotification) *ExpiryNotificationUpdateOne {
+	enuo.mutation.Where(ps...)
+	return enuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (enuo *ExpiryNotificationUpdateOne) Select(field string, fields ...string) *ExpiryNotificationUpdateOne {
+	enuo.fields = append([]string{field}, fields...)
+	return enuo
+}
+
+// Save executes the query and returns the updated ExpiryNotification entity.
+func (enuo *ExpiryNotificationUpdateOne) Save(ctx context.Context) (*ExpiryNotification, error) {
+	return withHooks(ctx, enuo.sqlSave, enuo.mutation, enuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (enuo *ExpiryNotificationUpdateOne) SaveX(ctx context.Context) *ExpiryNotification {
+	node, err := enuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (enuo *ExpiryNotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := enuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (enuo *ExpiryNotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := enuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (enuo *ExpiryNotificationUpdateOne) check() error {
+	if enuo.mutation.CertificateCleared() && len(enuo.mutation.CertificateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExpiryNotification.certificate"`)
+	}
+	return nil
+}
+
+func (enuo *ExpiryNotificationUpdateOne) sqlSave(ctx context.Context) (_node *ExpiryNotification, err error) {
+	if err := enuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(expirynotification.Table, expirynotification.Columns, sqlgraph.NewFieldSpec(expirynotification.FieldID, field.TypeInt))
+	id, ok := enuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExpiryNotification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := enuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, expirynotification.FieldID)
+		for _, f := range fields {
+			if !expirynotification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != expirynotification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := enuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := enuo.mutation.Fingerprint(); ok {
+		_spec.SetField(expirynotification.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := enuo.mutation.ThresholdDays(); ok {
+		_spec.SetField(expirynotification.FieldThresholdDays, field.TypeInt, value)
+	}
+	if value, ok := enuo.mutation.AddedThresholdDays(); ok {
+		_spec.AddField(expirynotification.FieldThresholdDays, field.TypeInt, value)
+	}
+	if value, ok := enuo.mutation.NotAfter(); ok {
+		_spec.SetField(expirynotification.FieldNotAfter, field.TypeTime, value)
+	}
+	if enuo.mutation.CertificateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   expirynotification.CertificateTable,
+			Columns: []string{expirynotification.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := enuo.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   expirynotification.CertificateTable,
+			Columns: []string{expirynotification.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ExpiryNotification{config: enuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, enuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{expirynotification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	enuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/logeable/certmgr/internal/ent"
 )
 
+// The AuditEventFunc type is an adapter to allow the use of ordinary
+// function as AuditEvent mutator.
+type AuditEventFunc func(context.Context, *ent.AuditEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditEventMutation", m)
+}
+
 // The CertificateFunc type is an adapter to allow the use of ordinary
 // function as Certificate mutator.
 type CertificateFunc func(context.Context, *ent.CertificateMutation) (ent.Value, error)
@@ -21,6 +33,30 @@ func (f CertificateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CertificateMutation", m)
 }
 
+// The ExpiryNotificationFunc type is an adapter to allow the use of ordinary
+// function as ExpiryNotification mutator.
+type ExpiryNotificationFunc func(context.Context, *ent.ExpiryNotificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExpiryNotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExpiryNotificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExpiryNotificationMutation", m)
+}
+
+// The KeyringFunc type is an adapter to allow the use of ordinary
+// function as Keyring mutator.
+type KeyringFunc func(context.Context, *ent.KeyringMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeyringFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeyringMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyringMutation", m)
+}
+
 // The NamespaceFunc type is an adapter to allow the use of ordinary
 // function as Namespace mutator.
 type NamespaceFunc func(context.Context, *ent.NamespaceMutation) (ent.Value, error)
@@ -33,6 +69,42 @@ func (f NamespaceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NamespaceMutation", m)
 }
 
+// The RenewalAttemptFunc type is an adapter to allow the use of ordinary
+// function as RenewalAttempt mutator.
+type RenewalAttemptFunc func(context.Context, *ent.RenewalAttemptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RenewalAttemptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RenewalAttemptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RenewalAttemptMutation", m)
+}
+
+// The RenewalPolicyFunc type is an adapter to allow the use of ordinary
+// function as RenewalPolicy mutator.
+type RenewalPolicyFunc func(context.Context, *ent.RenewalPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RenewalPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RenewalPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RenewalPolicyMutation", m)
+}
+
+// The SigningRequestFunc type is an adapter to allow the use of ordinary
+// function as SigningRequest mutator.
+type SigningRequestFunc func(context.Context, *ent.SigningRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SigningRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SigningRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SigningRequestMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
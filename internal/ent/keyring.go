@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/logeable/certmgr/internal/ent/keyring"
+)
+
+// Keyring is the model entity for the Keyring schema.
+type Keyring struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source keyring.Source `json:"source,omitempty"`
+	// Salt holds the value of the "salt" field.
+	Salt []byte `json:"salt,omitempty"`
+	// KdfTime holds the value of the "kdf_time" field.
+	KdfTime uint32 `json:"kdf_time,omitempty"`
+	// KdfMemory holds the value of the "kdf_memory" field.
+	KdfMemory uint32 `json:"kdf_memory,omitempty"`
+	// KdfThreads holds the value of the "kdf_threads" field.
+	KdfThreads uint8 `json:"kdf_threads,omitempty"`
+	// Check holds the value of the "check" field.
+	Check []byte `json:"check,omitempty"`
+	// KeysBound holds the value of the "keys_bound" field.
+	KeysBound bool `json:"keys_bound,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Keyring) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case keyring.FieldSalt, keyring.FieldCheck:
+			values[i] = new([]byte)
+		case keyring.FieldKeysBound:
+			values[i] = new(sql.NullBool)
+		case keyring.FieldID, keyring.FieldKdfTime, keyring.FieldKdfMemory, keyring.FieldKdfThreads:
+			values[i] = new(sql.NullInt64)
+		case keyring.FieldSource:
+			values[i] = new(sql.NullString)
+		case keyring.FieldUpdatedAt, keyring.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Keyring fields.
+func (k *Keyring) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case keyring.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			k.ID = int(value.Int64)
+		case keyring.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				k.Source = keyring.Source(value.String)
+			}
+		case keyring.FieldSalt:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field salt", values[i])
+			} else if value != nil {
+				k.Salt = *value
+			}
+		case keyring.FieldKdfTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kdf_time", values[i])
+			} else if value.Valid {
+				k.KdfTime = uint32(value.Int64)
+			}
+		case keyring.FieldKdfMemory:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kdf_memory", values[i])
+			} else if value.Valid {
+				k.KdfMemory = uint32(value.Int64)
+			}
+		case keyring.FieldKdfThreads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kdf_threads", values[i])
+			} else if value.Valid {
+				k.KdfThreads = uint8(value.Int64)
+			}
+		case keyring.FieldCheck:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field check", values[i])
+			} else if value != nil {
+				k.Check = *value
+			}
+		case keyring.FieldKeysBound:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field keys_bound", values[i])
+			} else if value.Valid {
+				k.KeysBound = value.Bool
+			}
+		case keyring.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				k.UpdatedAt = value.Time
+			}
+		case keyring.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				k.CreatedAt = value.Time
+			}
+		default:
+			k.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Keyring.
+// This includes values selected through modifiers, order, etc.
+func (k *Keyring) Value(name string) (ent.Value, error) {
+	return k.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Keyring.
+// Note that you need to call Keyring.Unwrap() before calling this method if this Keyring
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (k *Keyring) Update() *KeyringUpdateOne {
+	return NewKeyringClient(k.config).UpdateOne(k)
+}
+
+// Unwrap unwraps the Keyring entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (k *Keyring) Unwrap() *Keyring {
+	_tx, ok := k.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Keyring is not a transactional entity")
+	}
+	k.config.driver = _tx.drv
+	return k
+}
+
+// String implements the fmt.Stringer.
+func (k *Keyring) String() string {
+	var builder strings.Builder
+	builder.WriteString("Keyring(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", k.ID))
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", k.Source))
+	builder.WriteString(", ")
+	builder.WriteString("salt=")
+	builder.WriteString(fmt.Sprintf("%v", k.Salt))
+	builder.WriteString(", ")
+	builder.WriteString("kdf_time=")
+	builder.WriteString(fmt.Sprintf("%v", k.KdfTime))
+	builder.WriteString(", ")
+	builder.WriteString("kdf_memory=")
+	builder.WriteString(fmt.Sprintf("%v", k.KdfMemory))
+	builder.WriteString(", ")
+	builder.WriteString("kdf_threads=")
+	builder.WriteString(fmt.Sprintf("%v", k.KdfThreads))
+	builder.WriteString(", ")
+	builder.WriteString("check=")
+	builder.WriteString(fmt.Sprintf("%v", k.Check))
+	builder.WriteString(", ")
+	builder.WriteString("keys_bound=")
+	builder.WriteString(fmt.Sprintf("%v", k.KeysBound))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(k.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(k.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Keyrings is a parsable slice of Keyring.
+type Keyrings []*Keyring
@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keyring
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the keyring type in the database.
+	Label = "keyring"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldSalt holds the string denoting the salt field in the database.
+	FieldSalt = "salt"
+	// FieldKdfTime holds the string denoting the kdf_time field in the database.
+	FieldKdfTime = "kdf_time"
+	// FieldKdfMemory holds the string denoting the kdf_memory field in the database.
+	FieldKdfMemory = "kdf_memory"
+	// FieldKdfThreads holds the string denoting the kdf_threads field in the database.
+	FieldKdfThreads = "kdf_threads"
+	// FieldCheck holds the string denoting the check field in the database.
+	FieldCheck = "check"
+	// FieldKeysBound holds the string denoting the keys_bound field in the database.
+	FieldKeysBound = "keys_bound"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the keyring in the database.
+	Table = "keyrings"
+)
+
+// Columns holds all SQL columns for keyring fields.
+var Columns = []string{
+	FieldID,
+	FieldSource,
+	FieldSalt,
+	FieldKdfTime,
+	FieldKdfMemory,
+	FieldKdfThreads,
+	FieldCheck,
+	FieldKeysBound,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultKeysBound holds the default value on creation for the "keys_bound" field.
+	DefaultKeysBound bool
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// Source defines the type for the "source" enum field.
+type Source string
+
+// Source values.
+const (
+	SourcePassphrase Source = "passphrase"
+	SourceKeyfile    Source = "keyfile"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourcePassphrase, SourceKeyfile:
+		return nil
+	default:
+		return fmt.Errorf("keyring: invalid enum value for source field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Keyring queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByKdfTime orders the results by the kdf_time field.
+func ByKdfTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKdfTime, opts...).ToFunc()
+}
+
+// ByKdfMemory orders the results by the kdf_memory field.
+func ByKdfMemory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKdfMemory, opts...).ToFunc()
+}
+
+// ByKdfThreads orders the results by the kdf_threads field.
+func ByKdfThreads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKdfThreads, opts...).ToFunc()
+}
+
+// ByKeysBound orders the results by the keys_bound field.
+func ByKeysBound(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeysBound, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
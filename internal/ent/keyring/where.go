@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keyring
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Keyring {
+	return predicate.Keyring(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLTE(FieldID, id))
+}
+
+// Salt applies equality check predicate on the "salt" field. It's identical to SaltEQ.
+func Salt(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldSalt, v))
+}
+
+// KdfTime applies equality check predicate on the "kdf_time" field. It's identical to KdfTimeEQ.
+func KdfTime(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldKdfTime, v))
+}
+
+// KdfMemory applies equality check predicate on the "kdf_memory" field. It's identical to KdfMemoryEQ.
+func KdfMemory(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldKdfMemory, v))
+}
+
+// KdfThreads applies equality check predicate on the "kdf_threads" field. It's identical to KdfThreadsEQ.
+func KdfThreads(v uint8) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldKdfThreads, v))
+}
+
+// Check applies equality check predicate on the "check" field. It's identical to CheckEQ.
+func Check(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldCheck, v))
+}
+
+// KeysBound applies equality check predicate on the "keys_bound" field. It's identical to KeysBoundEQ.
+func KeysBound(v bool) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldKeysBound, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.Keyring {
+	return predicate.Keyring(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SaltEQ applies the EQ predicate on the "salt" field.
+func SaltEQ(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldSalt, v))
+}
+
+// SaltNEQ applies the NEQ predicate on the "salt" field.
+func SaltNEQ(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNEQ(FieldSalt, v))
+}
+
+// SaltIn applies the In predicate on the "salt" field.
+func SaltIn(vs ...[]byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldIn(FieldSalt, vs...))
+}
+
+// SaltNotIn applies the NotIn predicate on the "salt" field.
+func SaltNotIn(vs ...[]byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotIn(FieldSalt, vs...))
+}
+
+// SaltGT applies the GT predicate on the "salt" field.
+func SaltGT(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGT(FieldSalt, v))
+}
+
+// SaltGTE applies the GTE predicate on the "salt" field.
+func SaltGTE(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGTE(FieldSalt, v))
+}
+
+// SaltLT applies the LT predicate on the "salt" field.
+func SaltLT(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLT(FieldSalt, v))
+}
+
+// SaltLTE applies the LTE predicate on the "salt" field.
+func SaltLTE(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLTE(FieldSalt, v))
+}
+
+// SaltIsNil applies the IsNil predicate on the "salt" field.
+func SaltIsNil() predicate.Keyring {
+	return predicate.Keyring(sql.FieldIsNull(FieldSalt))
+}
+
+// SaltNotNil applies the NotNil predicate on the "salt" field.
+func SaltNotNil() predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotNull(FieldSalt))
+}
+
+// KdfTimeEQ applies the EQ predicate on the "kdf_time" field.
+func KdfTimeEQ(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldKdfTime, v))
+}
+
+// KdfTimeNEQ applies the NEQ predicate on the "kdf_time" field.
+func KdfTimeNEQ(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNEQ(FieldKdfTime, v))
+}
+
+// KdfTimeIn applies the In predicate on the "kdf_time" field.
+func KdfTimeIn(vs ...uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldIn(FieldKdfTime, vs...))
+}
+
+// KdfTimeNotIn applies the NotIn predicate on the "kdf_time" field.
+func KdfTimeNotIn(vs ...uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotIn(FieldKdfTime, vs...))
+}
+
+// KdfTimeGT applies the GT predicate on the "kdf_time" field.
+func KdfTimeGT(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGT(FieldKdfTime, v))
+}
+
+// KdfTimeGTE applies the GTE predicate on the "kdf_time" field.
+func KdfTimeGTE(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGTE(FieldKdfTime, v))
+}
+
+// KdfTimeLT applies the LT predicate on the "kdf_time" field.
+func KdfTimeLT(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLT(FieldKdfTime, v))
+}
+
+// KdfTimeLTE applies the LTE predicate on the "kdf_time" field.
+func KdfTimeLTE(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLTE(FieldKdfTime, v))
+}
+
+// KdfTimeIsNil applies the IsNil predicate on the "kdf_time" field.
+func KdfTimeIsNil() predicate.Keyring {
+	return predicate.Keyring(sql.FieldIsNull(FieldKdfTime))
+}
+
+// KdfTimeNotNil applies the NotNil predicate on the "kdf_time" field.
+func KdfTimeNotNil() predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotNull(FieldKdfTime))
+}
+
+// KdfMemoryEQ applies the EQ predicate on the "kdf_memory" field.
+func KdfMemoryEQ(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldKdfMemory, v))
+}
+
+// KdfMemoryNEQ applies the NEQ predicate on the "kdf_memory" field.
+func KdfMemoryNEQ(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNEQ(FieldKdfMemory, v))
+}
+
+// KdfMemoryIn applies the In predicate on the "kdf_memory" field.
+func KdfMemoryIn(vs ...uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldIn(FieldKdfMemory, vs...))
+}
+
+// KdfMemoryNotIn applies the NotIn predicate on the "kdf_memory" field.
+func KdfMemoryNotIn(vs ...uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotIn(FieldKdfMemory, vs...))
+}
+
+// KdfMemoryGT applies the GT predicate on the "kdf_memory" field.
+func KdfMemoryGT(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGT(FieldKdfMemory, v))
+}
+
+// KdfMemoryGTE applies the GTE predicate on the "kdf_memory" field.
+func KdfMemoryGTE(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGTE(FieldKdfMemory, v))
+}
+
+// KdfMemoryLT applies the LT predicate on the "kdf_memory" field.
+func KdfMemoryLT(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLT(FieldKdfMemory, v))
+}
+
+// KdfMemoryLTE applies the LTE predicate on the "kdf_memory" field.
+func KdfMemoryLTE(v uint32) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLTE(FieldKdfMemory, v))
+}
+
+// KdfMemoryIsNil applies the IsNil predicate on the "kdf_memory" field.
+func KdfMemoryIsNil() predicate.Keyring {
+	return predicate.Keyring(sql.FieldIsNull(FieldKdfMemory))
+}
+
+// KdfMemoryNotNil applies the NotNil predicate on the "kdf_memory" field.
+func KdfMemoryNotNil() predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotNull(FieldKdfMemory))
+}
+
+// KdfThreadsEQ applies the EQ predicate on the "kdf_threads" field.
+func KdfThreadsEQ(v uint8) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldKdfThreads, v))
+}
+
+// KdfThreadsNEQ applies the NEQ predicate on the "kdf_threads" field.
+func KdfThreadsNEQ(v uint8) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNEQ(FieldKdfThreads, v))
+}
+
+// KdfThreadsIn applies the In predicate on the "kdf_threads" field.
+func KdfThreadsIn(vs ...uint8) predicate.Keyring {
+	return predicate.Keyring(sql.FieldIn(FieldKdfThreads, vs...))
+}
+
+// KdfThreadsNotIn applies the NotIn predicate on the "kdf_threads" field.
+func KdfThreadsNotIn(vs ...uint8) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotIn(FieldKdfThreads, vs...))
+}
+
+// KdfThreadsGT applies the GT predicate on the "kdf_threads" field.
+func KdfThreadsGT(v uint8) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGT(FieldKdfThreads, v))
+}
+
+// KdfThreadsGTE applies the GTE predicate on the "kdf_threads" field.
+func KdfThreadsGTE(v uint8) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGTE(FieldKdfThreads, v))
+}
+
+// KdfThreadsLT applies the LT predicate on the "kdf_threads" field.
+func KdfThreadsLT(v uint8) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLT(FieldKdfThreads, v))
+}
+
+// KdfThreadsLTE applies the LTE predicate on the "kdf_threads" field.
+func KdfThreadsLTE(v uint8) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLTE(FieldKdfThreads, v))
+}
+
+// KdfThreadsIsNil applies the IsNil predicate on the "kdf_threads" field.
+func KdfThreadsIsNil() predicate.Keyring {
+	return predicate.Keyring(sql.FieldIsNull(FieldKdfThreads))
+}
+
+// KdfThreadsNotNil applies the NotNil predicate on the "kdf_threads" field.
+func KdfThreadsNotNil() predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotNull(FieldKdfThreads))
+}
+
+// CheckEQ applies the EQ predicate on the "check" field.
+func CheckEQ(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldCheck, v))
+}
+
+// CheckNEQ applies the NEQ predicate on the "check" field.
+func CheckNEQ(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNEQ(FieldCheck, v))
+}
+
+// CheckIn applies the In predicate on the "check" field.
+func CheckIn(vs ...[]byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldIn(FieldCheck, vs...))
+}
+
+// CheckNotIn applies the NotIn predicate on the "check" field.
+func CheckNotIn(vs ...[]byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotIn(FieldCheck, vs...))
+}
+
+// CheckGT applies the GT predicate on the "check" field.
+func CheckGT(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGT(FieldCheck, v))
+}
+
+// CheckGTE applies the GTE predicate on the "check" field.
+func CheckGTE(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGTE(FieldCheck, v))
+}
+
+// CheckLT applies the LT predicate on the "check" field.
+func CheckLT(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLT(FieldCheck, v))
+}
+
+// CheckLTE applies the LTE predicate on the "check" field.
+func CheckLTE(v []byte) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLTE(FieldCheck, v))
+}
+
+// KeysBoundEQ applies the EQ predicate on the "keys_bound" field.
+func KeysBoundEQ(v bool) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldKeysBound, v))
+}
+
+// KeysBoundNEQ applies the NEQ predicate on the "keys_bound" field.
+func KeysBoundNEQ(v bool) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNEQ(FieldKeysBound, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Keyring {
+	return predicate.Keyring(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Keyring) predicate.Keyring {
+	return predicate.Keyring(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Keyring) predicate.Keyring {
+	return predicate.Keyring(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Keyring) predicate.Keyring {
+	return predicate.Keyring(sql.NotPredicates(p))
+}
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/keyring"
+)
+
+// KeyringCreate is the builder for creating a Keyring entity.
+type KeyringCreate struct {
+	config
+	mutation *KeyringMutation
+	hooks    []Hook
+}
+
+// SetSource sets the "source" field.
+func (kc *KeyringCreate) SetSource(k keyring.Source) *KeyringCreate {
+	kc.mutation.SetSource(k)
+	return kc
+}
+
+// SetSalt sets the "salt" field.
+func (kc *KeyringCreate) SetSalt(b []byte) *KeyringCreate {
+	kc.mutation.SetSalt(b)
+	return kc
+}
+
+// SetKdfTime sets the "kdf_time" field.
+func (kc *KeyringCreate) SetKdfTime(u uint32) *KeyringCreate {
+	kc.mutation.SetKdfTime(u)
+	return kc
+}
+
+// SetNillableKdfTime sets the "kdf_time" field if the given value is not nil.
+func (kc *KeyringCreate) SetNillableKdfTime(u *uint32) *KeyringCreate {
+	if u != nil {
+		kc.SetKdfTime(*u)
+	}
+	return kc
+}
+
+// SetKdfMemory sets the "kdf_memory" field.
+func (kc *KeyringCreate) SetKdfMemory(u uint32) *KeyringCreate {
+	kc.mutation.SetKdfMemory(u)
+	return kc
+}
+
+// SetNillableKdfMemory sets the "kdf_memory" field if the given value is not nil.
+func (kc *KeyringCreate) SetNillableKdfMemory(u *uint32) *KeyringCreate {
+	if u != nil {
+		kc.SetKdfMemory(*u)
+	}
+	return kc
+}
+
+// SetKdfThreads sets the "kdf_threads" field.
+func (kc *KeyringCreate) SetKdfThreads(u uint8) *KeyringCreate {
+	kc.mutation.SetKdfThreads(u)
+	return kc
+}
+
+// SetNillableKdfThreads sets the "kdf_threads" field if the given value is not nil.
+func (kc *KeyringCreate) SetNillableKdfThreads(u *uint8) *KeyringCreate {
+	if u != nil {
+		kc.SetKdfThreads(*u)
+	}
+	return kc
+}
+
+// SetCheck sets the "check" field.
+func (kc *KeyringCreate) SetCheck(b []byte) *KeyringCreate {
+	kc.mutation.SetCheck(b)
+	return kc
+}
+
+// SetKeysBound sets the "keys_bound" field.
+func (kc *KeyringCreate) SetKeysBound(b bool) *KeyringCreate {
+	kc.mutation.SetKeysBound(b)
+	return kc
+}
+
+// SetNillableKeysBound sets the "keys_bound" field if the given value is not nil.
+func (kc *KeyringCreate) SetNillableKeysBound(b *bool) *KeyringCreate {
+	if b != nil {
+		kc.SetKeysBound(*b)
+	}
+	return kc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (kc *KeyringCreate) SetUpdatedAt(t time.Time) *KeyringCreate {
+	kc.mutation.SetUpdatedAt(t)
+	return kc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (kc *KeyringCreate) SetNillableUpdatedAt(t *time.Time) *KeyringCreate {
+	if t != nil {
+		kc.SetUpdatedAt(*t)
+	}
+	return kc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (kc *KeyringCreate) SetCreatedAt(t time.Time) *KeyringCreate {
+	kc.mutation.SetCreatedAt(t)
+	return kc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (kc *KeyringCreate) SetNillableCreatedAt(t *time.Time) *KeyringCreate {
+	if t != nil {
+		kc.SetCreatedAt(*t)
+	}
+	return kc
+}
+
+// SetID sets the "id" field.
+func (kc *KeyringCreate) SetID(i int) *KeyringCreate {
+	kc.mutation.SetID(i)
+	return kc
+}
+
+// Mutation returns the KeyringMutation object of the builder.
+func (kc *KeyringCreate) Mutation() *KeyringMutation {
+	return kc.mutation
+}
+
+// Save creates the Keyring in the database.
+func (kc *KeyringCreate) Save(ctx context.Context) (*Keyring, error) {
+	kc.defaults()
+	return withHooks(ctx, kc.sqlSave, kc.mutation, kc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (kc *KeyringCreate) SaveX(ctx context.Context) *Keyring {
+	v, err := kc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kc *KeyringCreate) Exec(ctx context.Context) error {
+	_, err := kc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kc *KeyringCreate) ExecX(ctx context.Context) {
+	if err := kc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kc *KeyringCreate) defaults() {
+	if _, ok := kc.mutation.KeysBound(); !ok {
+		v := keyring.DefaultKeysBound
+		kc.mutation.SetKeysBound(v)
+	}
+	if _, ok := kc.mutation.UpdatedAt(); !ok {
+		v := keyring.DefaultUpdatedAt()
+		kc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := kc.mutation.CreatedAt(); !ok {
+		v := keyring.DefaultCreatedAt()
+		kc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kc *KeyringCreate) check() error {
+	if _, ok := kc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Keyring.source"`)}
+	}
+	if v, ok := kc.mutation.Source(); ok {
+		if err := keyring.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Keyring.source": %w`, err)}
+		}
+	}
+	if _, ok := kc.mutation.Check(); !ok {
+		return &ValidationError{Name: "check", err: errors.New(`ent: missing required field "Keyring.check"`)}
+	}
+	if _, ok := kc.mutation.KeysBound(); !ok {
+		return &ValidationError{Name: "keys_bound", err: errors.New(`ent: missing required field "Keyring.keys_bound"`)}
+	}
+	if _, ok := kc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Keyring.updated_at"`)}
+	}
+	if _, ok := kc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Keyring.created_at"`)}
+	}
+	if v, ok := kc.mutation.ID(); ok {
+		if err := keyring.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Keyring.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (kc *KeyringCreate) sqlSave(ctx context.Context) (*Keyring, error) {
+	if err := kc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := kc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, kc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	kc.mutation.id = &_node.ID
+	kc.mutation.done = true
+	return _node, nil
+}
+
+func (kc *KeyringCreate) createSpec() (*Keyring, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Keyring{config: kc.config}
+		_spec = sqlgraph.NewCreateSpec(keyring.Table, sqlgraph.NewFieldSpec(keyring.FieldID, field.TypeInt))
+	)
+	if id, ok := kc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := kc.mutation.Source(); ok {
+		_spec.SetField(keyring.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := kc.mutation.Salt(); ok {
+		_spec.SetField(keyring.FieldSalt, field.TypeBytes, value)
+		_node.Salt = value
+	}
+	if value, ok := kc.mutation.KdfTime(); ok {
+		_spec.SetField(keyring.FieldKdfTime, field.TypeUint32, value)
+		_node.KdfTime = value
+	}
+	if value, ok := kc.mutation.KdfMemory(); ok {
+		_spec.SetField(keyring.FieldKdfMemory, field.TypeUint32, value)
+		_node.KdfMemory = value
+	}
+	if value, ok := kc.mutation.KdfThreads(); ok {
+		_spec.SetField(keyring.FieldKdfThreads, field.TypeUint8, value)
+		_node.KdfThreads = value
+	}
+	if value, ok := kc.mutation.Check(); ok {
+		_spec.SetField(keyring.FieldCheck, field.TypeBytes, value)
+		_node.Check = value
+	}
+	if value, ok := kc.mutation.KeysBound(); ok {
+		_spec.SetField(keyring.FieldKeysBound, field.TypeBool, value)
+		_node.KeysBound = value
+	}
+	if value, ok := kc.mutation.UpdatedAt(); ok {
+		_spec.SetField(keyring.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := kc.mutation.CreatedAt(); ok {
+		_spec.SetField(keyring.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// KeyringCreateBulk is the builder for creating many Keyring entities in bulk.
+type KeyringCreateBulk struct {
+	config
+	err      error
+	builders []*KeyringCreate
+}
+
+// Save creates the Keyring entities in the database.
+func (kcb *KeyringCreateBulk) Save(ctx context.Context) ([]*Keyring, error) {
+	if kcb.err != nil {
+		return nil, kcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(kcb.builders))
+	nodes := make([]*Keyring, len(kcb.builders))
+	mutators := make([]Mutator, len(kcb.builders))
+	for i := range kcb.builders {
+		func(i int, root context.Context) {
+			builder := kcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KeyringMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, kcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, kcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, kcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kcb *KeyringCreateBulk) SaveX(ctx context.Context) []*Keyring {
+	v, err := kcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kcb *KeyringCreateBulk) Exec(ctx context.Context) error {
+	_, err := kcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kcb *KeyringCreateBulk) ExecX(ctx context.Context) {
+	if err := kcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
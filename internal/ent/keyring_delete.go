@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/keyring"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// KeyringDelete is the builder for deleting a Keyring entity.
+type KeyringDelete struct {
+	config
+	hooks    []Hook
+	mutation *KeyringMutation
+}
+
+// Where appends a list predicates to the KeyringDelete builder.
+func (kd *KeyringDelete) Where(ps ...predicate.Keyring) *KeyringDelete {
+	kd.mutation.Where(ps...)
+	return kd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (kd *KeyringDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, kd.sqlExec, kd.mutation, kd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kd *KeyringDelete) ExecX(ctx context.Context) int {
+	n, err := kd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (kd *KeyringDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(keyring.Table, sqlgraph.NewFieldSpec(keyring.FieldID, field.TypeInt))
+	if ps := kd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, kd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	kd.mutation.done = true
+	return affected, err
+}
+
+// KeyringDeleteOne is the builder for deleting a single Keyring entity.
+type KeyringDeleteOne struct {
+	kd *KeyringDelete
+}
+
+// Where appends a list predicates to the KeyringDelete builder.
+func (kdo *KeyringDeleteOne) Where(ps ...predicate.Keyring) *KeyringDeleteOne {
+	kdo.kd.mutation.Where(ps...)
+	return kdo
+}
+
+// Exec executes the deletion query.
+func (kdo *KeyringDeleteOne) Exec(ctx context.Context) error {
+	n, err := kdo.kd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{keyring.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kdo *KeyringDeleteOne) ExecX(ctx context.Context) {
+	if err := kdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/keyring"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// KeyringQuery is the builder for querying Keyring entities.
+type KeyringQuery struct {
+	config
+	ctx        *QueryContext
+	order      []keyring.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Keyring
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KeyringQuery builder.
+func (kq *KeyringQuery) Where(ps ...predicate.Keyring) *KeyringQuery {
+	kq.predicates = append(kq.predicates, ps...)
+	return kq
+}
+
+// Limit the number of records to be returned by this query.
+func (kq *KeyringQuery) Limit(limit int) *KeyringQuery {
+	kq.ctx.Limit = &limit
+	return kq
+}
+
+// Offset to start from.
+func (kq *KeyringQuery) Offset(offset int) *KeyringQuery {
+	kq.ctx.Offset = &offset
+	return kq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (kq *KeyringQuery) Unique(unique bool) *KeyringQuery {
+	kq.ctx.Unique = &unique
+	return kq
+}
+
+// Order specifies how the records should be ordered.
+func (kq *KeyringQuery) Order(o ...keyring.OrderOption) *KeyringQuery {
+	kq.order = append(kq.order, o...)
+	return kq
+}
+
+// First returns the first Keyring entity from the query.
+// Returns a *NotFoundError when no Keyring was found.
+func (kq *KeyringQuery) First(ctx context.Context) (*Keyring, error) {
+	nodes, err := kq.Limit(1).All(setContextOp(ctx, kq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{keyring.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (kq *KeyringQuery) FirstX(ctx context.Context) *Keyring {
+	node, err := kq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Keyring ID from the query.
+// Returns a *NotFoundError when no Keyring ID was found.
+func (kq *KeyringQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kq.Limit(1).IDs(setContextOp(ctx, kq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{keyring.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (kq *KeyringQuery) FirstIDX(ctx context.Context) int {
+	id, err := kq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Keyring entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Keyring entity is found.
+// Returns a *NotFoundError when no Keyring entities are found.
+func (kq *KeyringQuery) Only(ctx context.Context) (*Keyring, error) {
+	nodes, err := kq.Limit(2).All(setContextOp(ctx, kq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{keyring.Label}
+	default:
+		return nil, &NotSingularError{keyring.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (kq *KeyringQuery) OnlyX(ctx context.Context) *Keyring {
+	node, err := kq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Keyring ID in the query.
+// Returns a *NotSingularError when more than one Keyring ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (kq *KeyringQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kq.Limit(2).IDs(setContextOp(ctx, kq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{keyring.Label}
+	default:
+		err = &NotSingularError{keyring.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (kq *KeyringQuery) OnlyIDX(ctx context.Context) int {
+	id, err := kq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Keyrings.
+func (kq *KeyringQuery) All(ctx context.Context) ([]*Keyring, error) {
+	ctx = setContextOp(ctx, kq.ctx, ent.OpQueryAll)
+	if err := kq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Keyring, *KeyringQuery]()
+	return withInterceptors[[]*Keyring](ctx, kq, qr, kq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (kq *KeyringQuery) AllX(ctx context.Context) []*Keyring {
+	nodes, err := kq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Keyring IDs.
+func (kq *KeyringQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if kq.ctx.Unique == nil && kq.path != nil {
+		kq.Unique(true)
+	}
+	ctx = setContextOp(ctx, kq.ctx, ent.OpQueryIDs)
+	if err = kq.Select(keyring.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (kq *KeyringQuery) IDsX(ctx context.Context) []int {
+	ids, err := kq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (kq *KeyringQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, kq.ctx, ent.OpQueryCount)
+	if err := kq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, kq, querierCount[*KeyringQuery](), kq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (kq *KeyringQuery) CountX(ctx context.Context) int {
+	count, err := kq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (kq *KeyringQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, kq.ctx, ent.OpQueryExist)
+	switch _, err := kq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (kq *KeyringQuery) ExistX(ctx context.Context) bool {
+	exist, err := kq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KeyringQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (kq *KeyringQuery) Clone() *KeyringQuery {
+	if kq == nil {
+		return nil
+	}
+	return &KeyringQuery{
+		config:     kq.config,
+		ctx:        kq.ctx.Clone(),
+		order:      append([]keyring.OrderOption{}, kq.order...),
+		inters:     append([]Interceptor{}, kq.inters...),
+		predicates: append([]predicate.Keyring{}, kq.predicates...),
+		// clone intermediate query.
+		sql:  kq.sql.Clone(),
+		path: kq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Source keyring.Source `json:"source,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Keyring.Query().
+//		GroupBy(keyring.FieldSource).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (kq *KeyringQuery) GroupBy(field string, fields ...string) *KeyringGroupBy {
+	kq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KeyringGroupBy{build: kq}
+	grbuild.flds = &kq.ctx.Fields
+	grbuild.label = keyring.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Source keyring.Source `json:"source,omitempty"`
+//	}
+//
+//	client.Keyring.Query().
+//		Select(keyring.FieldSource).
+//		Scan(ctx, &v)
+func (kq *KeyringQuery) Select(fields ...string) *KeyringSelect {
+	kq.ctx.Fields = append(kq.ctx.Fields, fields...)
+	sbuild := &KeyringSelect{KeyringQuery: kq}
+	sbuild.label = keyring.Label
+	sbuild.flds, sbuild.scan = &kq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KeyringSelect configured with the given aggregations.
+func (kq *KeyringQuery) Aggregate(fns ...AggregateFunc) *KeyringSelect {
+	return kq.Select().Aggregate(fns...)
+}
+
+func (kq *KeyringQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range kq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, kq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range kq.ctx.Fields {
+		if !keyring.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if kq.path != nil {
+		prev, err := kq.path(ctx)
+		if err != nil {
+			return err
+		}
+		kq.sql = prev
+	}
+	return nil
+}
+
+func (kq *KeyringQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Keyring, error) {
+	var (
+		nodes = []*Keyring{}
+		_spec = kq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Keyring).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Keyring{config: kq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, kq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (kq *KeyringQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := kq.querySpec()
+	_spec.Node.Columns = kq.ctx.Fields
+	if len(kq.ctx.Fields) > 0 {
+		_spec.Unique = kq.ctx.Unique != nil && *kq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, kq.driver, _spec)
+}
+
+func (kq *KeyringQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(keyring.Table, keyring.Columns, sqlgraph.NewFieldSpec(keyring.FieldID, field.TypeInt))
+	_spec.From = kq.sql
+	if unique := kq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if kq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := kq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keyring.FieldID)
+		for i := range fields {
+			if fields[i] != keyring.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := kq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := kq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := kq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := kq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (kq *KeyringQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(kq.driver.Dialect())
+	t1 := builder.Table(keyring.Table)
+	columns := kq.ctx.Fields
+	if len(columns) == 0 {
+		columns = keyring.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if kq.sql != nil {
+		selector = kq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if kq.ctx.Unique != nil && *kq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range kq.predicates {
+		p(selector)
+	}
+	for _, p := range kq.order {
+		p(selector)
+	}
+	if offset := kq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := kq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// KeyringGroupBy is the group-by builder for Keyring entities.
+type KeyringGroupBy struct {
+	selector
+	build *KeyringQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (kgb *KeyringGroupBy) Aggregate(fns ...AggregateFunc) *KeyringGroupBy {
+	kgb.fns = append(kgb.fns, fns...)
+	return kgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kgb *KeyringGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kgb.build.ctx, ent.OpQueryGroupBy)
+	if err := kgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyringQuery, *KeyringGroupBy](ctx, kgb.build, kgb, kgb.build.inters, v)
+}
+
+func (kgb *KeyringGroupBy) sqlScan(ctx context.Context, root *KeyringQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(kgb.fns))
+	for _, fn := range kgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*kgb.flds)+len(kgb.fns))
+		for _, f := range *kgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*kgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KeyringSelect is the builder for selecting fields of Keyring entities.
+type KeyringSelect struct {
+	*KeyringQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ks *KeyringSelect) Aggregate(fns ...AggregateFunc) *KeyringSelect {
+	ks.fns = append(ks.fns, fns...)
+	return ks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ks *KeyringSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ks.ctx, ent.OpQuerySelect)
+	if err := ks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyringQuery, *KeyringSelect](ctx, ks.KeyringQuery, ks, ks.inters, v)
+}
+
+func (ks *KeyringSelect) sqlScan(ctx context.Context, root *KeyringQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ks.fns))
+	for _, fn := range ks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,576 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/keyring"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// KeyringUpdate is the builder for updating Keyring entities.
+type KeyringUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KeyringMutation
+}
+
+// Where appends a list predicates to the KeyringUpdate builder.
+func (ku *KeyringUpdate) Where(ps ...predicate.Keyring) *KeyringUpdate {
+	ku.mutation.Where(ps...)
+	return ku
+}
+
+// SetSource sets the "source" field.
+func (ku *KeyringUpdate) SetSource(k keyring.Source) *KeyringUpdate {
+	ku.mutation.SetSource(k)
+	return ku
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (ku *KeyringUpdate) SetNillableSource(k *keyring.Source) *KeyringUpdate {
+	if k != nil {
+		ku.SetSource(*k)
+	}
+	return ku
+}
+
+// SetSalt sets the "salt" field.
+func (ku *KeyringUpdate) SetSalt(b []byte) *KeyringUpdate {
+	ku.mutation.SetSalt(b)
+	return ku
+}
+
+// ClearSalt clears the value of the "salt" field.
+func (ku *KeyringUpdate) ClearSalt() *KeyringUpdate {
+	ku.mutation.ClearSalt()
+	return ku
+}
+
+// SetKdfTime sets the "kdf_time" field.
+func (ku *KeyringUpdate) SetKdfTime(u uint32) *KeyringUpdate {
+	ku.mutation.ResetKdfTime()
+	ku.mutation.SetKdfTime(u)
+	return ku
+}
+
+// SetNillableKdfTime sets the "kdf_time" field if the given value is not nil.
+func (ku *KeyringUpdate) SetNillableKdfTime(u *uint32) *KeyringUpdate {
+	if u != nil {
+		ku.SetKdfTime(*u)
+	}
+	return ku
+}
+
+// AddKdfTime adds u to the "kdf_time" field.
+func (ku *KeyringUpdate) AddKdfTime(u int32) *KeyringUpdate {
+	ku.mutation.AddKdfTime(u)
+	return ku
+}
+
+// ClearKdfTime clears the value of the "kdf_time" field.
+func (ku *KeyringUpdate) ClearKdfTime() *KeyringUpdate {
+	ku.mutation.ClearKdfTime()
+	return ku
+}
+
+// SetKdfMemory sets the "kdf_memory" field.
+func (ku *KeyringUpdate) SetKdfMemory(u uint32) *KeyringUpdate {
+	ku.mutation.ResetKdfMemory()
+	ku.mutation.SetKdfMemory(u)
+	return ku
+}
+
+// SetNillableKdfMemory sets the "kdf_memory" field if the given value is not nil.
+func (ku *KeyringUpdate) SetNillableKdfMemory(u *uint32) *KeyringUpdate {
+	if u != nil {
+		ku.SetKdfMemory(*u)
+	}
+	return ku
+}
+
+// AddKdfMemory adds u to the "kdf_memory" field.
+func (ku *KeyringUpdate) AddKdfMemory(u int32) *KeyringUpdate {
+	ku.mutation.AddKdfMemory(u)
+	return ku
+}
+
+// ClearKdfMemory clears the value of the "kdf_memory" field.
+func (ku *KeyringUpdate) ClearKdfMemory() *KeyringUpdate {
+	ku.mutation.ClearKdfMemory()
+	return ku
+}
+
+// SetKdfThreads sets the "kdf_threads" field.
+func (ku *KeyringUpdate) SetKdfThreads(u uint8) *KeyringUpdate {
+	ku.mutation.ResetKdfThreads()
+	ku.mutation.SetKdfThreads(u)
+	return ku
+}
+
+// SetNillableKdfThreads sets the "kdf_threads" field if the given value is not nil.
+func (ku *KeyringUpdate) SetNillableKdfThreads(u *uint8) *KeyringUpdate {
+	if u != nil {
+		ku.SetKdfThreads(*u)
+	}
+	return ku
+}
+
+// AddKdfThreads adds u to the "kdf_threads" field.
+func (ku *KeyringUpdate) AddKdfThreads(u int8) *KeyringUpdate {
+	ku.mutation.AddKdfThreads(u)
+	return ku
+}
+
+// ClearKdfThreads clears the value of the "kdf_threads" field.
+func (ku *KeyringUpdate) ClearKdfThreads() *KeyringUpdate {
+	ku.mutation.ClearKdfThreads()
+	return ku
+}
+
+// SetCheck sets the "check" field.
+func (ku *KeyringUpdate) SetCheck(b []byte) *KeyringUpdate {
+	ku.mutation.SetCheck(b)
+	return ku
+}
+
+// SetKeysBound sets the "keys_bound" field.
+func (ku *KeyringUpdate) SetKeysBound(b bool) *KeyringUpdate {
+	ku.mutation.SetKeysBound(b)
+	return ku
+}
+
+// SetNillableKeysBound sets the "keys_bound" field if the given value is not nil.
+func (ku *KeyringUpdate) SetNillableKeysBound(b *bool) *KeyringUpdate {
+	if b != nil {
+		ku.SetKeysBound(*b)
+	}
+	return ku
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ku *KeyringUpdate) SetUpdatedAt(t time.Time) *KeyringUpdate {
+	ku.mutation.SetUpdatedAt(t)
+	return ku
+}
+
+// Mutation returns the KeyringMutation object of the builder.
+func (ku *KeyringUpdate) Mutation() *KeyringMutation {
+	return ku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ku *KeyringUpdate) Save(ctx context.Context) (int, error) {
+	ku.defaults()
+	return withHooks(ctx, ku.sqlSave, ku.mutation, ku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ku *KeyringUpdate) SaveX(ctx context.Context) int {
+	affected, err := ku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ku *KeyringUpdate) Exec(ctx context.Context) error {
+	_, err := ku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ku *KeyringUpdate) ExecX(ctx context.Context) {
+	if err := ku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ku *KeyringUpdate) defaults() {
+	if _, ok := ku.mutation.UpdatedAt(); !ok {
+		v := keyring.UpdateDefaultUpdatedAt()
+		ku.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ku *KeyringUpdate) check() error {
+	if v, ok := ku.mutation.Source(); ok {
+		if err := keyring.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Keyring.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ku *KeyringUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keyring.Table, keyring.Columns, sqlgraph.NewFieldSpec(keyring.FieldID, field.TypeInt))
+	if ps := ku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ku.mutation.Source(); ok {
+		_spec.SetField(keyring.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := ku.mutation.Salt(); ok {
+		_spec.SetField(keyring.FieldSalt, field.TypeBytes, value)
+	}
+	if ku.mutation.SaltCleared() {
+		_spec.ClearField(keyring.FieldSalt, field.TypeBytes)
+	}
+	if value, ok := ku.mutation.KdfTime(); ok {
+		_spec.SetField(keyring.FieldKdfTime, field.TypeUint32, value)
+	}
+	if value, ok := ku.mutation.AddedKdfTime(); ok {
+		_spec.AddField(keyring.FieldKdfTime, field.TypeUint32, value)
+	}
+	if ku.mutation.KdfTimeCleared() {
+		_spec.ClearField(keyring.FieldKdfTime, field.TypeUint32)
+	}
+	if value, ok := ku.mutation.KdfMemory(); ok {
+		_spec.SetField(keyring.FieldKdfMemory, field.TypeUint32, value)
+	}
+	if value, ok := ku.mutation.AddedKdfMemory(); ok {
+		_spec.AddField(keyring.FieldKdfMemory, field.TypeUint32, value)
+	}
+	if ku.mutation.KdfMemoryCleared() {
+		_spec.ClearField(keyring.FieldKdfMemory, field.TypeUint32)
+	}
+	if value, ok := ku.mutation.KdfThreads(); ok {
+		_spec.SetField(keyring.FieldKdfThreads, field.TypeUint8, value)
+	}
+	if value, ok := ku.mutation.AddedKdfThreads(); ok {
+		_spec.AddField(keyring.FieldKdfThreads, field.TypeUint8, value)
+	}
+	if ku.mutation.KdfThreadsCleared() {
+		_spec.ClearField(keyring.FieldKdfThreads, field.TypeUint8)
+	}
+	if value, ok := ku.mutation.Check(); ok {
+		_spec.SetField(keyring.FieldCheck, field.TypeBytes, value)
+	}
+	if value, ok := ku.mutation.KeysBound(); ok {
+		_spec.SetField(keyring.FieldKeysBound, field.TypeBool, value)
+	}
+	if value, ok := ku.mutation.UpdatedAt(); ok {
+		_spec.SetField(keyring.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keyring.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ku.mutation.done = true
+	return n, nil
+}
+
+// KeyringUpdateOne is the builder for updating a single Keyring entity.
+type KeyringUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KeyringMutation
+}
+
+// SetSource sets the "source" field.
+func (kuo *KeyringUpdateOne) SetSource(k keyring.Source) *KeyringUpdateOne {
+	kuo.mutation.SetSource(k)
+	return kuo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (kuo *KeyringUpdateOne) SetNillableSource(k *keyring.Source) *KeyringUpdateOne {
+	if k != nil {
+		kuo.SetSource(*k)
+	}
+	return kuo
+}
+
+// SetSalt sets the "salt" field.
+func (kuo *KeyringUpdateOne) SetSalt(b []byte) *KeyringUpdateOne {
+	kuo.mutation.SetSalt(b)
+	return kuo
+}
+
+// ClearSalt clears the value of the "salt" field.
+func (kuo *KeyringUpdateOne) ClearSalt() *KeyringUpdateOne {
+	kuo.mutation.ClearSalt()
+	return kuo
+}
+
+// SetKdfTime sets the "kdf_time" field.
+func (kuo *KeyringUpdateOne) SetKdfTime(u uint32) *KeyringUpdateOne {
+	kuo.mutation.ResetKdfTime()
+	kuo.mutation.SetKdfTime(u)
+	return kuo
+}
+
+// SetNillableKdfTime sets the "kdf_time" field if the given value is not nil.
+func (kuo *KeyringUpdateOne) SetNillableKdfTime(u *uint32) *KeyringUpdateOne {
+	if u != nil {
+		kuo.SetKdfTime(*u)
+	}
+	return kuo
+}
+
+// AddKdfTime adds u to the "kdf_time" field.
+func (kuo *KeyringUpdateOne) AddKdfTime(u int32) *KeyringUpdateOne {
+	kuo.mutation.AddKdfTime(u)
+	return kuo
+}
+
+// ClearKdfTime clears the value of the "kdf_time" field.
+func (kuo *KeyringUpdateOne) ClearKdfTime() *KeyringUpdateOne {
+	kuo.mutation.ClearKdfTime()
+	return kuo
+}
+
+// SetKdfMemory sets the "kdf_memory" field.
+func (kuo *KeyringUpdateOne) SetKdfMemory(u uint32) *KeyringUpdateOne {
+	kuo.mutation.ResetKdfMemory()
+	kuo.mutation.SetKdfMemory(u)
+	return kuo
+}
+
+// SetNillableKdfMemory sets the "kdf_memory" field if the given value is not nil.
+func (kuo *KeyringUpdateOne) SetNillableKdfMemory(u *uint32) *KeyringUpdateOne {
+	if u != nil {
+		kuo.SetKdfMemory(*u)
+	}
+	return kuo
+}
+
+// AddKdfMemory adds u to the "kdf_memory" field.
+func (kuo *KeyringUpdateOne) AddKdfMemory(u int32) *KeyringUpdateOne {
+	kuo.mutation.AddKdfMemory(u)
+	return kuo
+}
+
+// ClearKdfMemory clears the value of the "kdf_memory" field.
+func (kuo *KeyringUpdateOne) ClearKdfMemory() *KeyringUpdateOne {
+	kuo.mutation.ClearKdfMemory()
+	return kuo
+}
+
+// SetKdfThreads sets the "kdf_threads" field.
+func (kuo *KeyringUpdateOne) SetKdfThreads(u uint8) *KeyringUpdateOne {
+	kuo.mutation.ResetKdfThreads()
+	kuo.mutation.SetKdfThreads(u)
+	return kuo
+}
+
+// SetNillableKdfThreads sets the "kdf_threads" field if the given value is not nil.
+func (kuo *KeyringUpdateOne) SetNillableKdfThreads(u *uint8) *KeyringUpdateOne {
+	if u != nil {
+		kuo.SetKdfThreads(*u)
+	}
+	return kuo
+}
+
+// AddKdfThreads adds u to the "kdf_threads" field.
+func (kuo *KeyringUpdateOne) AddKdfThreads(u int8) *KeyringUpdateOne {
+	kuo.mutation.AddKdfThreads(u)
+	return kuo
+}
+
+// ClearKdfThreads clears the value of the "kdf_threads" field.
+func (kuo *KeyringUpdateOne) ClearKdfThreads() *KeyringUpdateOne {
+	kuo.mutation.ClearKdfThreads()
+	return kuo
+}
+
+// SetCheck sets the "check" field.
+func (kuo *KeyringUpdateOne) SetCheck(b []byte) *KeyringUpdateOne {
+	kuo.mutation.SetCheck(b)
+	return kuo
+}
+
+// SetKeysBound sets the "keys_bound" field.
+func (kuo *KeyringUpdateOne) SetKeysBound(b bool) *KeyringUpdateOne {
+	kuo.mutation.SetKeysBound(b)
+	return kuo
+}
+
+// SetNillableKeysBound sets the "keys_bound" field if the given value is not nil.
+func (kuo *KeyringUpdateOne) SetNillableKeysBound(b *bool) *KeyringUpdateOne {
+	if b != nil {
+		kuo.SetKeysBound(*b)
+	}
+	return kuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (kuo *KeyringUpdateOne) SetUpdatedAt(t time.Time) *KeyringUpdateOne {
+	kuo.mutation.SetUpdatedAt(t)
+	return kuo
+}
+
+// Mutation returns the KeyringMutation object of the builder.
+func (kuo *KeyringUpdateOne) Mutation() *KeyringMutation {
+	return kuo.mutation
+}
+
+// Where appends a list predicates to the KeyringUpdate builder.
+func (kuo *KeyringUpdateOne) Where(ps ...predicate.Keyring) *KeyringUpdateOne {
+	kuo.mutation.Where(ps...)
+	return kuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (kuo *KeyringUpdateOne) Select(field string, fields ...string) *KeyringUpdateOne {
+	kuo.fields = append([]string{field}, fields...)
+	return kuo
+}
+
+// Save executes the query and returns the updated Keyring entity.
+func (kuo *KeyringUpdateOne) Save(ctx context.Context) (*Keyring, error) {
+	kuo.defaults()
+	return withHooks(ctx, kuo.sqlSave, kuo.mutation, kuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kuo *KeyringUpdateOne) SaveX(ctx context.Context) *Keyring {
+	node, err := kuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (kuo *KeyringUpdateOne) Exec(ctx context.Context) error {
+	_, err := kuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kuo *KeyringUpdateOne) ExecX(ctx context.Context) {
+	if err := kuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kuo *KeyringUpdateOne) defaults() {
+	if _, ok := kuo.mutation.UpdatedAt(); !ok {
+		v := keyring.UpdateDefaultUpdatedAt()
+		kuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kuo *KeyringUpdateOne) check() error {
+	if v, ok := kuo.mutation.Source(); ok {
+		if err := keyring.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Keyring.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (kuo *KeyringUpdateOne) sqlSave(ctx context.Context) (_node *Keyring, err error) {
+	if err := kuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keyring.Table, keyring.Columns, sqlgraph.NewFieldSpec(keyring.FieldID, field.TypeInt))
+	id, ok := kuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Keyring.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := kuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keyring.FieldID)
+		for _, f := range fields {
+			if !keyring.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != keyring.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := kuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kuo.mutation.Source(); ok {
+		_spec.SetField(keyring.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := kuo.mutation.Salt(); ok {
+		_spec.SetField(keyring.FieldSalt, field.TypeBytes, value)
+	}
+	if kuo.mutation.SaltCleared() {
+		_spec.ClearField(keyring.FieldSalt, field.TypeBytes)
+	}
+	if value, ok := kuo.mutation.KdfTime(); ok {
+		_spec.SetField(keyring.FieldKdfTime, field.TypeUint32, value)
+	}
+	if value, ok := kuo.mutation.AddedKdfTime(); ok {
+		_spec.AddField(keyring.FieldKdfTime, field.TypeUint32, value)
+	}
+	if kuo.mutation.KdfTimeCleared() {
+		_spec.ClearField(keyring.FieldKdfTime, field.TypeUint32)
+	}
+	if value, ok := kuo.mutation.KdfMemory(); ok {
+		_spec.SetField(keyring.FieldKdfMemory, field.TypeUint32, value)
+	}
+	if value, ok := kuo.mutation.AddedKdfMemory(); ok {
+		_spec.AddField(keyring.FieldKdfMemory, field.TypeUint32, value)
+	}
+	if kuo.mutation.KdfMemoryCleared() {
+		_spec.ClearField(keyring.FieldKdfMemory, field.TypeUint32)
+	}
+	if value, ok := kuo.mutation.KdfThreads(); ok {
+		_spec.SetField(keyring.FieldKdfThreads, field.TypeUint8, value)
+	}
+	if value, ok := kuo.mutation.AddedKdfThreads(); ok {
+		_spec.AddField(keyring.FieldKdfThreads, field.TypeUint8, value)
+	}
+	if kuo.mutation.KdfThreadsCleared() {
+		_spec.ClearField(keyring.FieldKdfThreads, field.TypeUint8)
+	}
+	if value, ok := kuo.mutation.Check(); ok {
+		_spec.SetField(keyring.FieldCheck, field.TypeBytes, value)
+	}
+	if value, ok := kuo.mutation.KeysBound(); ok {
+		_spec.SetField(keyring.FieldKeysBound, field.TypeBool, value)
+	}
+	if value, ok := kuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(keyring.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Keyring{config: kuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, kuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keyring.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	kuo.mutation.done = true
+	return _node, nil
+}
@@ -8,14 +8,79 @@ import (
 )
 
 var (
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "actor", Type: field.TypeString, Default: ""},
+		{Name: "source", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeInt, Nullable: true},
+		{Name: "namespace_id", Type: field.TypeInt, Nullable: true},
+		{Name: "params", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "outcome", Type: field.TypeEnum, Enums: []string{"success", "failure"}},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "prev_hash", Type: field.TypeString, Unique: true},
+		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[12]},
+			},
+			{
+				Name:    "auditevent_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[4], AuditEventsColumns[5]},
+			},
+			{
+				Name:    "auditevent_namespace_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[6]},
+			},
+			{
+				Name:    "auditevent_operation",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[3]},
+			},
+			{
+				Name:    "auditevent_actor",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1]},
+			},
+		},
+	}
 	// CertificatesColumns holds the columns for the "certificates" table.
 	CertificatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "cert_pem", Type: field.TypeString, Size: 2147483647},
 		{Name: "key_pem", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "key_ref", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "key_exportable", Type: field.TypeBool, Default: true},
+		{Name: "offline", Type: field.TypeBool, Default: false},
 		{Name: "desc", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
 		{Name: "issuer_id", Type: field.TypeInt, Nullable: true},
 		{Name: "usage", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "subject", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "common_name", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "serial_number", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "fingerprint", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "subject_key_id", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "authority_key_id", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "key_type", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "signature_algorithm", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "is_ca", Type: field.TypeBool, Nullable: true, Default: false},
+		{Name: "not_before", Type: field.TypeTime, Nullable: true},
+		{Name: "not_after", Type: field.TypeTime, Nullable: true},
+		{Name: "dns_names", Type: field.TypeJSON, Nullable: true},
+		{Name: "ip_addresses", Type: field.TypeJSON, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "namespace_id", Type: field.TypeInt},
@@ -28,7 +93,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certificates_namespaces_certificates",
-				Columns:    []*schema.Column{CertificatesColumns[8]},
+				Columns:    []*schema.Column{CertificatesColumns[24]},
 				RefColumns: []*schema.Column{NamespacesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -37,15 +102,100 @@ var (
 			{
 				Name:    "certificate_namespace_id",
 				Unique:  false,
-				Columns: []*schema.Column{CertificatesColumns[8]},
+				Columns: []*schema.Column{CertificatesColumns[24]},
+			},
+			{
+				Name:    "certificate_namespace_id_not_after",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[24], CertificatesColumns[19]},
+			},
+			{
+				Name:    "certificate_not_after",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[19]},
+			},
+			{
+				Name:    "certificate_common_name",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[10]},
+			},
+			{
+				Name:    "certificate_serial_number",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[11]},
+			},
+			{
+				Name:    "certificate_fingerprint",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[12]},
+			},
+			{
+				Name:    "certificate_subject_key_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[13]},
+			},
+			{
+				Name:    "certificate_authority_key_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertificatesColumns[14]},
+			},
+		},
+	}
+	// ExpiryNotificationsColumns holds the columns for the "expiry_notifications" table.
+	ExpiryNotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "threshold_days", Type: field.TypeInt},
+		{Name: "not_after", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "certificate_id", Type: field.TypeInt},
+	}
+	// ExpiryNotificationsTable holds the schema information for the "expiry_notifications" table.
+	ExpiryNotificationsTable = &schema.Table{
+		Name:       "expiry_notifications",
+		Columns:    ExpiryNotificationsColumns,
+		PrimaryKey: []*schema.Column{ExpiryNotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "expiry_notifications_certificates_expiry_notifications",
+				Columns:    []*schema.Column{ExpiryNotificationsColumns[5]},
+				RefColumns: []*schema.Column{CertificatesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "expirynotification_certificate_id_fingerprint_threshold_days",
+				Unique:  true,
+				Columns: []*schema.Column{ExpiryNotificationsColumns[5], ExpiryNotificationsColumns[1], ExpiryNotificationsColumns[2]},
 			},
 		},
 	}
+	// KeyringsColumns holds the columns for the "keyrings" table.
+	KeyringsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"passphrase", "keyfile"}},
+		{Name: "salt", Type: field.TypeBytes, Nullable: true},
+		{Name: "kdf_time", Type: field.TypeUint32, Nullable: true},
+		{Name: "kdf_memory", Type: field.TypeUint32, Nullable: true},
+		{Name: "kdf_threads", Type: field.TypeUint8, Nullable: true},
+		{Name: "check", Type: field.TypeBytes},
+		{Name: "keys_bound", Type: field.TypeBool, Default: false},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// KeyringsTable holds the schema information for the "keyrings" table.
+	KeyringsTable = &schema.Table{
+		Name:       "keyrings",
+		Columns:    KeyringsColumns,
+		PrimaryKey: []*schema.Column{KeyringsColumns[0]},
+	}
 	// NamespacesColumns holds the columns for the "namespaces" table.
 	NamespacesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true, Size: 2147483647},
 		{Name: "desc", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "key_reveal_disabled", Type: field.TypeBool, Default: false},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 	}
@@ -62,13 +212,128 @@ var (
 			},
 		},
 	}
+	// RenewalAttemptsColumns holds the columns for the "renewal_attempts" table.
+	RenewalAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "certificate_id", Type: field.TypeInt},
+		{Name: "namespace_id", Type: field.TypeInt},
+		{Name: "rekey", Type: field.TypeBool},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"succeeded", "failed", "pending_signature"}},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "signing_request_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "policy_id", Type: field.TypeInt},
+	}
+	// RenewalAttemptsTable holds the schema information for the "renewal_attempts" table.
+	RenewalAttemptsTable = &schema.Table{
+		Name:       "renewal_attempts",
+		Columns:    RenewalAttemptsColumns,
+		PrimaryKey: []*schema.Column{RenewalAttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "renewal_attempts_renewal_policies_attempts",
+				Columns:    []*schema.Column{RenewalAttemptsColumns[8]},
+				RefColumns: []*schema.Column{RenewalPoliciesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "renewalattempt_namespace_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{RenewalAttemptsColumns[2], RenewalAttemptsColumns[4]},
+			},
+			{
+				Name:    "renewalattempt_certificate_id",
+				Unique:  false,
+				Columns: []*schema.Column{RenewalAttemptsColumns[1]},
+			},
+		},
+	}
+	// RenewalPoliciesColumns holds the columns for the "renewal_policies" table.
+	RenewalPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"remaining_fraction", "interval"}},
+		{Name: "remaining_fraction", Type: field.TypeFloat64, Nullable: true},
+		{Name: "interval_days", Type: field.TypeInt, Nullable: true},
+		{Name: "rekey", Type: field.TypeBool, Default: false},
+		{Name: "valid_days", Type: field.TypeInt, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "certificate_id", Type: field.TypeInt, Unique: true},
+	}
+	// RenewalPoliciesTable holds the schema information for the "renewal_policies" table.
+	RenewalPoliciesTable = &schema.Table{
+		Name:       "renewal_policies",
+		Columns:    RenewalPoliciesColumns,
+		PrimaryKey: []*schema.Column{RenewalPoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "renewal_policies_certificates_renewal_policy",
+				Columns:    []*schema.Column{RenewalPoliciesColumns[12]},
+				RefColumns: []*schema.Column{CertificatesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SigningRequestsColumns holds the columns for the "signing_requests" table.
+	SigningRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "issuer_id", Type: field.TypeInt},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"create", "renew"}},
+		{Name: "certificate_id", Type: field.TypeInt, Nullable: true},
+		{Name: "template", Type: field.TypeString, Size: 2147483647},
+		{Name: "key_pem", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "key_ref", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "desc", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "usage", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "completed"}, Default: "pending"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "namespace_id", Type: field.TypeInt},
+	}
+	// SigningRequestsTable holds the schema information for the "signing_requests" table.
+	SigningRequestsTable = &schema.Table{
+		Name:       "signing_requests",
+		Columns:    SigningRequestsColumns,
+		PrimaryKey: []*schema.Column{SigningRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "signing_requests_namespaces_signing_requests",
+				Columns:    []*schema.Column{SigningRequestsColumns[12]},
+				RefColumns: []*schema.Column{NamespacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "signingrequest_namespace_id",
+				Unique:  false,
+				Columns: []*schema.Column{SigningRequestsColumns[12]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEventsTable,
 		CertificatesTable,
+		ExpiryNotificationsTable,
+		KeyringsTable,
 		NamespacesTable,
+		RenewalAttemptsTable,
+		RenewalPoliciesTable,
+		SigningRequestsTable,
 	}
 )
 
 func init() {
 	CertificatesTable.ForeignKeys[0].RefTable = NamespacesTable
+	ExpiryNotificationsTable.ForeignKeys[0].RefTable = CertificatesTable
+	RenewalAttemptsTable.ForeignKeys[0].RefTable = RenewalPoliciesTable
+	RenewalPoliciesTable.ForeignKeys[0].RefTable = CertificatesTable
+	SigningRequestsTable.ForeignKeys[0].RefTable = NamespacesTable
 }
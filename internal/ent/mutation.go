@@ -11,9 +11,15 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/logeable/certmgr/internal/ent/auditevent"
 	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+	"github.com/logeable/certmgr/internal/ent/keyring"
 	"github.com/logeable/certmgr/internal/ent/namespace"
 	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
 )
 
 const (
@@ -25,43 +31,53 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCertificate = "Certificate"
-	TypeNamespace   = "Namespace"
+	TypeAuditEvent         = "AuditEvent"
+	TypeCertificate        = "Certificate"
+	TypeExpiryNotification = "ExpiryNotification"
+	TypeKeyring            = "Keyring"
+	TypeNamespace          = "Namespace"
+	TypeRenewalAttempt     = "RenewalAttempt"
+	TypeRenewalPolicy      = "RenewalPolicy"
+	TypeSigningRequest     = "SigningRequest"
 )
 
-// CertificateMutation represents an operation that mutates the Certificate nodes in the graph.
-type CertificateMutation struct {
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	cert_pem         *string
-	key_pem          *string
-	desc             *string
-	issuer_id        *int
-	addissuer_id     *int
-	usage            *string
-	updated_at       *time.Time
-	created_at       *time.Time
-	clearedFields    map[string]struct{}
-	namespace        *int
-	clearednamespace bool
-	done             bool
-	oldValue         func(context.Context) (*Certificate, error)
-	predicates       []predicate.Certificate
-}
-
-var _ ent.Mutation = (*CertificateMutation)(nil)
-
-// certificateOption allows management of the mutation configuration using functional options.
-type certificateOption func(*CertificateMutation)
-
-// newCertificateMutation creates new mutation for the Certificate entity.
-func newCertificateMutation(c config, op Op, opts ...certificateOption) *CertificateMutation {
-	m := &CertificateMutation{
+	op              Op
+	typ             string
+	id              *int
+	actor           *string
+	source          *string
+	operation       *string
+	target_type     *string
+	target_id       *int
+	addtarget_id    *int
+	namespace_id    *int
+	addnamespace_id *int
+	params          *string
+	outcome         *auditevent.Outcome
+	error           *string
+	prev_hash       *string
+	hash            *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*AuditEvent, error)
+	predicates      []predicate.AuditEvent
+}
+
+var _ ent.Mutation = (*AuditEventMutation)(nil)
+
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
+
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCertificate,
+		typ:           TypeAuditEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -70,20 +86,20 @@ func newCertificateMutation(c config, op Op, opts ...certificateOption) *Certifi
 	return m
 }
 
-// withCertificateID sets the ID field of the mutation.
-func withCertificateID(id int) certificateOption {
-	return func(m *CertificateMutation) {
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id int) auditeventOption {
+	return func(m *AuditEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Certificate
+			value *AuditEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Certificate, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Certificate.Get(ctx, id)
+					value, err = m.Client().AuditEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -92,10 +108,10 @@ func withCertificateID(id int) certificateOption {
 	}
 }
 
-// withCertificate sets the old Certificate of the mutation.
-func withCertificate(node *Certificate) certificateOption {
-	return func(m *CertificateMutation) {
-		m.oldValue = func(context.Context) (*Certificate, error) {
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -104,7 +120,7 @@ func withCertificate(node *Certificate) certificateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CertificateMutation) Client() *Client {
+func (m AuditEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -112,7 +128,7 @@ func (m CertificateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CertificateMutation) Tx() (*Tx, error) {
+func (m AuditEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -122,14 +138,14 @@ func (m CertificateMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Certificate entities.
-func (m *CertificateMutation) SetID(id int) {
+// operation is only accepted on creation of AuditEvent entities.
+func (m *AuditEventMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CertificateMutation) ID() (id int, exists bool) {
+func (m *AuditEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -140,7 +156,7 @@ func (m *CertificateMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CertificateMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -149,409 +165,547 @@ func (m *CertificateMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Certificate.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetNamespaceID sets the "namespace_id" field.
-func (m *CertificateMutation) SetNamespaceID(i int) {
-	m.namespace = &i
+// SetActor sets the "actor" field.
+func (m *AuditEventMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// NamespaceID returns the value of the "namespace_id" field in the mutation.
-func (m *CertificateMutation) NamespaceID() (r int, exists bool) {
-	v := m.namespace
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditEventMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNamespaceID returns the old "namespace_id" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldNamespaceID(ctx context.Context) (v int, err error) {
+func (m *AuditEventMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNamespaceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNamespaceID requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNamespaceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.NamespaceID, nil
+	return oldValue.Actor, nil
 }
 
-// ResetNamespaceID resets all changes to the "namespace_id" field.
-func (m *CertificateMutation) ResetNamespaceID() {
-	m.namespace = nil
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditEventMutation) ResetActor() {
+	m.actor = nil
 }
 
-// SetCertPem sets the "cert_pem" field.
-func (m *CertificateMutation) SetCertPem(s string) {
-	m.cert_pem = &s
+// SetSource sets the "source" field.
+func (m *AuditEventMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// CertPem returns the value of the "cert_pem" field in the mutation.
-func (m *CertificateMutation) CertPem() (r string, exists bool) {
-	v := m.cert_pem
+// Source returns the value of the "source" field in the mutation.
+func (m *AuditEventMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCertPem returns the old "cert_pem" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldCertPem(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCertPem is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCertPem requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCertPem: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.CertPem, nil
+	return oldValue.Source, nil
 }
 
-// ResetCertPem resets all changes to the "cert_pem" field.
-func (m *CertificateMutation) ResetCertPem() {
-	m.cert_pem = nil
+// ResetSource resets all changes to the "source" field.
+func (m *AuditEventMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetKeyPem sets the "key_pem" field.
-func (m *CertificateMutation) SetKeyPem(s string) {
-	m.key_pem = &s
+// SetOperation sets the "operation" field.
+func (m *AuditEventMutation) SetOperation(s string) {
+	m.operation = &s
 }
 
-// KeyPem returns the value of the "key_pem" field in the mutation.
-func (m *CertificateMutation) KeyPem() (r string, exists bool) {
-	v := m.key_pem
+// Operation returns the value of the "operation" field in the mutation.
+func (m *AuditEventMutation) Operation() (r string, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKeyPem returns the old "key_pem" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldOperation returns the old "operation" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldKeyPem(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldOperation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeyPem is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeyPem requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeyPem: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return oldValue.KeyPem, nil
+	return oldValue.Operation, nil
 }
 
-// ClearKeyPem clears the value of the "key_pem" field.
-func (m *CertificateMutation) ClearKeyPem() {
-	m.key_pem = nil
-	m.clearedFields[certificate.FieldKeyPem] = struct{}{}
+// ResetOperation resets all changes to the "operation" field.
+func (m *AuditEventMutation) ResetOperation() {
+	m.operation = nil
 }
 
-// KeyPemCleared returns if the "key_pem" field was cleared in this mutation.
-func (m *CertificateMutation) KeyPemCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldKeyPem]
-	return ok
+// SetTargetType sets the "target_type" field.
+func (m *AuditEventMutation) SetTargetType(s string) {
+	m.target_type = &s
 }
 
-// ResetKeyPem resets all changes to the "key_pem" field.
-func (m *CertificateMutation) ResetKeyPem() {
-	m.key_pem = nil
-	delete(m.clearedFields, certificate.FieldKeyPem)
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *AuditEventMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetDesc sets the "desc" field.
-func (m *CertificateMutation) SetDesc(s string) {
-	m.desc = &s
+// OldTargetType returns the old "target_type" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldTargetType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
+	}
+	return oldValue.TargetType, nil
 }
 
-// Desc returns the value of the "desc" field in the mutation.
-func (m *CertificateMutation) Desc() (r string, exists bool) {
-	v := m.desc
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *AuditEventMutation) ResetTargetType() {
+	m.target_type = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *AuditEventMutation) SetTargetID(i int) {
+	m.target_id = &i
+	m.addtarget_id = nil
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *AuditEventMutation) TargetID() (r int, exists bool) {
+	v := m.target_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDesc returns the old "desc" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetID returns the old "target_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldDesc(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldTargetID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDesc is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDesc requires an ID field in the mutation")
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDesc: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	return oldValue.Desc, nil
+	return oldValue.TargetID, nil
 }
 
-// ClearDesc clears the value of the "desc" field.
-func (m *CertificateMutation) ClearDesc() {
-	m.desc = nil
-	m.clearedFields[certificate.FieldDesc] = struct{}{}
+// AddTargetID adds i to the "target_id" field.
+func (m *AuditEventMutation) AddTargetID(i int) {
+	if m.addtarget_id != nil {
+		*m.addtarget_id += i
+	} else {
+		m.addtarget_id = &i
+	}
 }
 
-// DescCleared returns if the "desc" field was cleared in this mutation.
-func (m *CertificateMutation) DescCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldDesc]
+// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
+func (m *AuditEventMutation) AddedTargetID() (r int, exists bool) {
+	v := m.addtarget_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (m *AuditEventMutation) ClearTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
+	m.clearedFields[auditevent.FieldTargetID] = struct{}{}
+}
+
+// TargetIDCleared returns if the "target_id" field was cleared in this mutation.
+func (m *AuditEventMutation) TargetIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldTargetID]
 	return ok
 }
 
-// ResetDesc resets all changes to the "desc" field.
-func (m *CertificateMutation) ResetDesc() {
-	m.desc = nil
-	delete(m.clearedFields, certificate.FieldDesc)
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *AuditEventMutation) ResetTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
+	delete(m.clearedFields, auditevent.FieldTargetID)
 }
 
-// SetIssuerID sets the "issuer_id" field.
-func (m *CertificateMutation) SetIssuerID(i int) {
-	m.issuer_id = &i
-	m.addissuer_id = nil
+// SetNamespaceID sets the "namespace_id" field.
+func (m *AuditEventMutation) SetNamespaceID(i int) {
+	m.namespace_id = &i
+	m.addnamespace_id = nil
 }
 
-// IssuerID returns the value of the "issuer_id" field in the mutation.
-func (m *CertificateMutation) IssuerID() (r int, exists bool) {
-	v := m.issuer_id
+// NamespaceID returns the value of the "namespace_id" field in the mutation.
+func (m *AuditEventMutation) NamespaceID() (r int, exists bool) {
+	v := m.namespace_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIssuerID returns the old "issuer_id" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldNamespaceID returns the old "namespace_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldIssuerID(ctx context.Context) (v int, err error) {
+func (m *AuditEventMutation) OldNamespaceID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssuerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldNamespaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssuerID requires an ID field in the mutation")
+		return v, errors.New("OldNamespaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssuerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldNamespaceID: %w", err)
 	}
-	return oldValue.IssuerID, nil
+	return oldValue.NamespaceID, nil
 }
 
-// AddIssuerID adds i to the "issuer_id" field.
-func (m *CertificateMutation) AddIssuerID(i int) {
-	if m.addissuer_id != nil {
-		*m.addissuer_id += i
+// AddNamespaceID adds i to the "namespace_id" field.
+func (m *AuditEventMutation) AddNamespaceID(i int) {
+	if m.addnamespace_id != nil {
+		*m.addnamespace_id += i
 	} else {
-		m.addissuer_id = &i
+		m.addnamespace_id = &i
 	}
 }
 
-// AddedIssuerID returns the value that was added to the "issuer_id" field in this mutation.
-func (m *CertificateMutation) AddedIssuerID() (r int, exists bool) {
-	v := m.addissuer_id
+// AddedNamespaceID returns the value that was added to the "namespace_id" field in this mutation.
+func (m *AuditEventMutation) AddedNamespaceID() (r int, exists bool) {
+	v := m.addnamespace_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearIssuerID clears the value of the "issuer_id" field.
-func (m *CertificateMutation) ClearIssuerID() {
-	m.issuer_id = nil
-	m.addissuer_id = nil
-	m.clearedFields[certificate.FieldIssuerID] = struct{}{}
+// ClearNamespaceID clears the value of the "namespace_id" field.
+func (m *AuditEventMutation) ClearNamespaceID() {
+	m.namespace_id = nil
+	m.addnamespace_id = nil
+	m.clearedFields[auditevent.FieldNamespaceID] = struct{}{}
 }
 
-// IssuerIDCleared returns if the "issuer_id" field was cleared in this mutation.
-func (m *CertificateMutation) IssuerIDCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldIssuerID]
+// NamespaceIDCleared returns if the "namespace_id" field was cleared in this mutation.
+func (m *AuditEventMutation) NamespaceIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldNamespaceID]
 	return ok
 }
 
-// ResetIssuerID resets all changes to the "issuer_id" field.
-func (m *CertificateMutation) ResetIssuerID() {
-	m.issuer_id = nil
-	m.addissuer_id = nil
-	delete(m.clearedFields, certificate.FieldIssuerID)
+// ResetNamespaceID resets all changes to the "namespace_id" field.
+func (m *AuditEventMutation) ResetNamespaceID() {
+	m.namespace_id = nil
+	m.addnamespace_id = nil
+	delete(m.clearedFields, auditevent.FieldNamespaceID)
 }
 
-// SetUsage sets the "usage" field.
-func (m *CertificateMutation) SetUsage(s string) {
-	m.usage = &s
+// SetParams sets the "params" field.
+func (m *AuditEventMutation) SetParams(s string) {
+	m.params = &s
 }
 
-// Usage returns the value of the "usage" field in the mutation.
-func (m *CertificateMutation) Usage() (r string, exists bool) {
-	v := m.usage
+// Params returns the value of the "params" field in the mutation.
+func (m *AuditEventMutation) Params() (r string, exists bool) {
+	v := m.params
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsage returns the old "usage" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldParams returns the old "params" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldUsage(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldParams(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsage is only allowed on UpdateOne operations")
+		return v, errors.New("OldParams is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsage requires an ID field in the mutation")
+		return v, errors.New("OldParams requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsage: %w", err)
+		return v, fmt.Errorf("querying old value for OldParams: %w", err)
 	}
-	return oldValue.Usage, nil
+	return oldValue.Params, nil
 }
 
-// ClearUsage clears the value of the "usage" field.
-func (m *CertificateMutation) ClearUsage() {
-	m.usage = nil
-	m.clearedFields[certificate.FieldUsage] = struct{}{}
+// ClearParams clears the value of the "params" field.
+func (m *AuditEventMutation) ClearParams() {
+	m.params = nil
+	m.clearedFields[auditevent.FieldParams] = struct{}{}
 }
 
-// UsageCleared returns if the "usage" field was cleared in this mutation.
-func (m *CertificateMutation) UsageCleared() bool {
-	_, ok := m.clearedFields[certificate.FieldUsage]
+// ParamsCleared returns if the "params" field was cleared in this mutation.
+func (m *AuditEventMutation) ParamsCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldParams]
 	return ok
 }
 
-// ResetUsage resets all changes to the "usage" field.
-func (m *CertificateMutation) ResetUsage() {
-	m.usage = nil
-	delete(m.clearedFields, certificate.FieldUsage)
+// ResetParams resets all changes to the "params" field.
+func (m *AuditEventMutation) ResetParams() {
+	m.params = nil
+	delete(m.clearedFields, auditevent.FieldParams)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CertificateMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetOutcome sets the "outcome" field.
+func (m *AuditEventMutation) SetOutcome(a auditevent.Outcome) {
+	m.outcome = &a
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CertificateMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Outcome returns the value of the "outcome" field in the mutation.
+func (m *AuditEventMutation) Outcome() (r auditevent.Outcome, exists bool) {
+	v := m.outcome
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldOutcome returns the old "outcome" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldOutcome(ctx context.Context) (v auditevent.Outcome, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOutcome is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldOutcome requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOutcome: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Outcome, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CertificateMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetOutcome resets all changes to the "outcome" field.
+func (m *AuditEventMutation) ResetOutcome() {
+	m.outcome = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CertificateMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetError sets the "error" field.
+func (m *AuditEventMutation) SetError(s string) {
+	m.error = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CertificateMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Error returns the value of the "error" field in the mutation.
+func (m *AuditEventMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Certificate entity.
-// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CertificateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Error, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CertificateMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearError clears the value of the "error" field.
+func (m *AuditEventMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[auditevent.FieldError] = struct{}{}
 }
 
-// ClearNamespace clears the "namespace" edge to the Namespace entity.
-func (m *CertificateMutation) ClearNamespace() {
-	m.clearednamespace = true
-	m.clearedFields[certificate.FieldNamespaceID] = struct{}{}
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *AuditEventMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldError]
+	return ok
 }
 
-// NamespaceCleared reports if the "namespace" edge to the Namespace entity was cleared.
-func (m *CertificateMutation) NamespaceCleared() bool {
-	return m.clearednamespace
+// ResetError resets all changes to the "error" field.
+func (m *AuditEventMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, auditevent.FieldError)
 }
 
-// NamespaceIDs returns the "namespace" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NamespaceID instead. It exists only for internal usage by the builders.
-func (m *CertificateMutation) NamespaceIDs() (ids []int) {
-	if id := m.namespace; id != nil {
-		ids = append(ids, *id)
+// SetPrevHash sets the "prev_hash" field.
+func (m *AuditEventMutation) SetPrevHash(s string) {
+	m.prev_hash = &s
+}
+
+// PrevHash returns the value of the "prev_hash" field in the mutation.
+func (m *AuditEventMutation) PrevHash() (r string, exists bool) {
+	v := m.prev_hash
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetNamespace resets all changes to the "namespace" edge.
-func (m *CertificateMutation) ResetNamespace() {
-	m.namespace = nil
-	m.clearednamespace = false
+// OldPrevHash returns the old "prev_hash" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldPrevHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrevHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrevHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrevHash: %w", err)
+	}
+	return oldValue.PrevHash, nil
 }
 
-// Where appends a list predicates to the CertificateMutation builder.
-func (m *CertificateMutation) Where(ps ...predicate.Certificate) {
+// ResetPrevHash resets all changes to the "prev_hash" field.
+func (m *AuditEventMutation) ResetPrevHash() {
+	m.prev_hash = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *AuditEventMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *AuditEventMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *AuditEventMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CertificateMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CertificateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Certificate, len(ps))
+func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -559,48 +713,60 @@ func (m *CertificateMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CertificateMutation) Op() Op {
+func (m *AuditEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CertificateMutation) SetOp(op Op) {
+func (m *AuditEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Certificate).
-func (m *CertificateMutation) Type() string {
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CertificateMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.namespace != nil {
-		fields = append(fields, certificate.FieldNamespaceID)
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.actor != nil {
+		fields = append(fields, auditevent.FieldActor)
 	}
-	if m.cert_pem != nil {
-		fields = append(fields, certificate.FieldCertPem)
+	if m.source != nil {
+		fields = append(fields, auditevent.FieldSource)
 	}
-	if m.key_pem != nil {
-		fields = append(fields, certificate.FieldKeyPem)
+	if m.operation != nil {
+		fields = append(fields, auditevent.FieldOperation)
 	}
-	if m.desc != nil {
-		fields = append(fields, certificate.FieldDesc)
+	if m.target_type != nil {
+		fields = append(fields, auditevent.FieldTargetType)
 	}
-	if m.issuer_id != nil {
-		fields = append(fields, certificate.FieldIssuerID)
+	if m.target_id != nil {
+		fields = append(fields, auditevent.FieldTargetID)
 	}
-	if m.usage != nil {
-		fields = append(fields, certificate.FieldUsage)
+	if m.namespace_id != nil {
+		fields = append(fields, auditevent.FieldNamespaceID)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, certificate.FieldUpdatedAt)
+	if m.params != nil {
+		fields = append(fields, auditevent.FieldParams)
+	}
+	if m.outcome != nil {
+		fields = append(fields, auditevent.FieldOutcome)
+	}
+	if m.error != nil {
+		fields = append(fields, auditevent.FieldError)
+	}
+	if m.prev_hash != nil {
+		fields = append(fields, auditevent.FieldPrevHash)
+	}
+	if m.hash != nil {
+		fields = append(fields, auditevent.FieldHash)
 	}
 	if m.created_at != nil {
-		fields = append(fields, certificate.FieldCreatedAt)
+		fields = append(fields, auditevent.FieldCreatedAt)
 	}
 	return fields
 }
@@ -608,23 +774,31 @@ func (m *CertificateMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CertificateMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case certificate.FieldNamespaceID:
+	case auditevent.FieldActor:
+		return m.Actor()
+	case auditevent.FieldSource:
+		return m.Source()
+	case auditevent.FieldOperation:
+		return m.Operation()
+	case auditevent.FieldTargetType:
+		return m.TargetType()
+	case auditevent.FieldTargetID:
+		return m.TargetID()
+	case auditevent.FieldNamespaceID:
 		return m.NamespaceID()
-	case certificate.FieldCertPem:
-		return m.CertPem()
-	case certificate.FieldKeyPem:
-		return m.KeyPem()
-	case certificate.FieldDesc:
-		return m.Desc()
-	case certificate.FieldIssuerID:
-		return m.IssuerID()
-	case certificate.FieldUsage:
-		return m.Usage()
-	case certificate.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case certificate.FieldCreatedAt:
+	case auditevent.FieldParams:
+		return m.Params()
+	case auditevent.FieldOutcome:
+		return m.Outcome()
+	case auditevent.FieldError:
+		return m.Error()
+	case auditevent.FieldPrevHash:
+		return m.PrevHash()
+	case auditevent.FieldHash:
+		return m.Hash()
+	case auditevent.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -633,83 +807,119 @@ func (m *CertificateMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CertificateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case certificate.FieldNamespaceID:
+	case auditevent.FieldActor:
+		return m.OldActor(ctx)
+	case auditevent.FieldSource:
+		return m.OldSource(ctx)
+	case auditevent.FieldOperation:
+		return m.OldOperation(ctx)
+	case auditevent.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case auditevent.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case auditevent.FieldNamespaceID:
 		return m.OldNamespaceID(ctx)
-	case certificate.FieldCertPem:
-		return m.OldCertPem(ctx)
-	case certificate.FieldKeyPem:
-		return m.OldKeyPem(ctx)
-	case certificate.FieldDesc:
-		return m.OldDesc(ctx)
-	case certificate.FieldIssuerID:
-		return m.OldIssuerID(ctx)
-	case certificate.FieldUsage:
-		return m.OldUsage(ctx)
-	case certificate.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case certificate.FieldCreatedAt:
+	case auditevent.FieldParams:
+		return m.OldParams(ctx)
+	case auditevent.FieldOutcome:
+		return m.OldOutcome(ctx)
+	case auditevent.FieldError:
+		return m.OldError(ctx)
+	case auditevent.FieldPrevHash:
+		return m.OldPrevHash(ctx)
+	case auditevent.FieldHash:
+		return m.OldHash(ctx)
+	case auditevent.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Certificate field %s", name)
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CertificateMutation) SetField(name string, value ent.Value) error {
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case certificate.FieldNamespaceID:
-		v, ok := value.(int)
+	case auditevent.FieldActor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNamespaceID(v)
+		m.SetActor(v)
 		return nil
-	case certificate.FieldCertPem:
+	case auditevent.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCertPem(v)
+		m.SetSource(v)
 		return nil
-	case certificate.FieldKeyPem:
+	case auditevent.FieldOperation:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKeyPem(v)
+		m.SetOperation(v)
 		return nil
-	case certificate.FieldDesc:
+	case auditevent.FieldTargetType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDesc(v)
+		m.SetTargetType(v)
 		return nil
-	case certificate.FieldIssuerID:
+	case auditevent.FieldTargetID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIssuerID(v)
+		m.SetTargetID(v)
 		return nil
-	case certificate.FieldUsage:
+	case auditevent.FieldNamespaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespaceID(v)
+		return nil
+	case auditevent.FieldParams:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsage(v)
+		m.SetParams(v)
 		return nil
-	case certificate.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case auditevent.FieldOutcome:
+		v, ok := value.(auditevent.Outcome)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetOutcome(v)
 		return nil
-	case certificate.FieldCreatedAt:
+	case auditevent.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case auditevent.FieldPrevHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrevHash(v)
+		return nil
+	case auditevent.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case auditevent.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -717,15 +927,18 @@ func (m *CertificateMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Certificate field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CertificateMutation) AddedFields() []string {
+func (m *AuditEventMutation) AddedFields() []string {
 	var fields []string
-	if m.addissuer_id != nil {
-		fields = append(fields, certificate.FieldIssuerID)
+	if m.addtarget_id != nil {
+		fields = append(fields, auditevent.FieldTargetID)
+	}
+	if m.addnamespace_id != nil {
+		fields = append(fields, auditevent.FieldNamespaceID)
 	}
 	return fields
 }
@@ -733,10 +946,12 @@ func (m *CertificateMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CertificateMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case certificate.FieldIssuerID:
-		return m.AddedIssuerID()
+	case auditevent.FieldTargetID:
+		return m.AddedTargetID()
+	case auditevent.FieldNamespaceID:
+		return m.AddedNamespaceID()
 	}
 	return nil, false
 }
@@ -744,201 +959,220 @@ func (m *CertificateMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CertificateMutation) AddField(name string, value ent.Value) error {
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case certificate.FieldIssuerID:
+	case auditevent.FieldTargetID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddIssuerID(v)
+		m.AddTargetID(v)
+		return nil
+	case auditevent.FieldNamespaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNamespaceID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Certificate numeric field %s", name)
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CertificateMutation) ClearedFields() []string {
+func (m *AuditEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(certificate.FieldKeyPem) {
-		fields = append(fields, certificate.FieldKeyPem)
+	if m.FieldCleared(auditevent.FieldTargetID) {
+		fields = append(fields, auditevent.FieldTargetID)
 	}
-	if m.FieldCleared(certificate.FieldDesc) {
-		fields = append(fields, certificate.FieldDesc)
+	if m.FieldCleared(auditevent.FieldNamespaceID) {
+		fields = append(fields, auditevent.FieldNamespaceID)
 	}
-	if m.FieldCleared(certificate.FieldIssuerID) {
-		fields = append(fields, certificate.FieldIssuerID)
+	if m.FieldCleared(auditevent.FieldParams) {
+		fields = append(fields, auditevent.FieldParams)
 	}
-	if m.FieldCleared(certificate.FieldUsage) {
-		fields = append(fields, certificate.FieldUsage)
+	if m.FieldCleared(auditevent.FieldError) {
+		fields = append(fields, auditevent.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CertificateMutation) FieldCleared(name string) bool {
+func (m *AuditEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CertificateMutation) ClearField(name string) error {
+func (m *AuditEventMutation) ClearField(name string) error {
 	switch name {
-	case certificate.FieldKeyPem:
-		m.ClearKeyPem()
+	case auditevent.FieldTargetID:
+		m.ClearTargetID()
 		return nil
-	case certificate.FieldDesc:
-		m.ClearDesc()
+	case auditevent.FieldNamespaceID:
+		m.ClearNamespaceID()
 		return nil
-	case certificate.FieldIssuerID:
-		m.ClearIssuerID()
+	case auditevent.FieldParams:
+		m.ClearParams()
 		return nil
-	case certificate.FieldUsage:
-		m.ClearUsage()
+	case auditevent.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown Certificate nullable field %s", name)
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CertificateMutation) ResetField(name string) error {
+func (m *AuditEventMutation) ResetField(name string) error {
 	switch name {
-	case certificate.FieldNamespaceID:
-		m.ResetNamespaceID()
+	case auditevent.FieldActor:
+		m.ResetActor()
 		return nil
-	case certificate.FieldCertPem:
-		m.ResetCertPem()
+	case auditevent.FieldSource:
+		m.ResetSource()
 		return nil
-	case certificate.FieldKeyPem:
-		m.ResetKeyPem()
+	case auditevent.FieldOperation:
+		m.ResetOperation()
 		return nil
-	case certificate.FieldDesc:
-		m.ResetDesc()
+	case auditevent.FieldTargetType:
+		m.ResetTargetType()
 		return nil
-	case certificate.FieldIssuerID:
-		m.ResetIssuerID()
+	case auditevent.FieldTargetID:
+		m.ResetTargetID()
 		return nil
-	case certificate.FieldUsage:
-		m.ResetUsage()
+	case auditevent.FieldNamespaceID:
+		m.ResetNamespaceID()
 		return nil
-	case certificate.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case auditevent.FieldParams:
+		m.ResetParams()
 		return nil
-	case certificate.FieldCreatedAt:
+	case auditevent.FieldOutcome:
+		m.ResetOutcome()
+		return nil
+	case auditevent.FieldError:
+		m.ResetError()
+		return nil
+	case auditevent.FieldPrevHash:
+		m.ResetPrevHash()
+		return nil
+	case auditevent.FieldHash:
+		m.ResetHash()
+		return nil
+	case auditevent.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Certificate field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CertificateMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.namespace != nil {
-		edges = append(edges, certificate.EdgeNamespace)
-	}
+func (m *AuditEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CertificateMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case certificate.EdgeNamespace:
-		if id := m.namespace; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CertificateMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AuditEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CertificateMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CertificateMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearednamespace {
-		edges = append(edges, certificate.EdgeNamespace)
-	}
+func (m *AuditEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CertificateMutation) EdgeCleared(name string) bool {
-	switch name {
-	case certificate.EdgeNamespace:
-		return m.clearednamespace
-	}
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CertificateMutation) ClearEdge(name string) error {
-	switch name {
-	case certificate.EdgeNamespace:
-		m.ClearNamespace()
-		return nil
-	}
-	return fmt.Errorf("unknown Certificate unique edge %s", name)
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CertificateMutation) ResetEdge(name string) error {
-	switch name {
-	case certificate.EdgeNamespace:
-		m.ResetNamespace()
-		return nil
-	}
-	return fmt.Errorf("unknown Certificate edge %s", name)
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
 }
 
-// NamespaceMutation represents an operation that mutates the Namespace nodes in the graph.
-type NamespaceMutation struct {
+// CertificateMutation represents an operation that mutates the Certificate nodes in the graph.
+type CertificateMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	name                *string
-	desc                *string
-	updated_at          *time.Time
-	created_at          *time.Time
-	clearedFields       map[string]struct{}
-	certificates        map[int]struct{}
-	removedcertificates map[int]struct{}
-	clearedcertificates bool
-	done                bool
-	oldValue            func(context.Context) (*Namespace, error)
-	predicates          []predicate.Namespace
+	op                          Op
+	typ                         string
+	id                          *int
+	cert_pem                    *string
+	key_pem                     *string
+	key_ref                     *string
+	key_exportable              *bool
+	offline                     *bool
+	desc                        *string
+	issuer_id                   *int
+	addissuer_id                *int
+	usage                       *string
+	subject                     *string
+	common_name                 *string
+	serial_number               *string
+	fingerprint                 *string
+	subject_key_id              *string
+	authority_key_id            *string
+	key_type                    *string
+	signature_algorithm         *string
+	is_ca                       *bool
+	not_before                  *time.Time
+	not_after                   *time.Time
+	dns_names                   *[]string
+	appenddns_names             []string
+	ip_addresses                *[]string
+	appendip_addresses          []string
+	updated_at                  *time.Time
+	created_at                  *time.Time
+	clearedFields               map[string]struct{}
+	namespace                   *int
+	clearednamespace            bool
+	expiry_notifications        map[int]struct{}
+	removedexpiry_notifications map[int]struct{}
+	clearedexpiry_notifications bool
+	renewal_policy              *int
+	clearedrenewal_policy       bool
+	done                        bool
+	oldValue                    func(context.Context) (*Certificate, error)
+	predicates                  []predicate.Certificate
 }
 
-var _ ent.Mutation = (*NamespaceMutation)(nil)
+var _ ent.Mutation = (*CertificateMutation)(nil)
 
-// namespaceOption allows management of the mutation configuration using functional options.
-type namespaceOption func(*NamespaceMutation)
+// certificateOption allows management of the mutation configuration using functional options.
+type certificateOption func(*CertificateMutation)
 
-// newNamespaceMutation creates new mutation for the Namespace entity.
-func newNamespaceMutation(c config, op Op, opts ...namespaceOption) *NamespaceMutation {
-	m := &NamespaceMutation{
+// newCertificateMutation creates new mutation for the Certificate entity.
+func newCertificateMutation(c config, op Op, opts ...certificateOption) *CertificateMutation {
+	m := &CertificateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNamespace,
+		typ:           TypeCertificate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -947,20 +1181,20 @@ func newNamespaceMutation(c config, op Op, opts ...namespaceOption) *NamespaceMu
 	return m
 }
 
-// withNamespaceID sets the ID field of the mutation.
-func withNamespaceID(id int) namespaceOption {
-	return func(m *NamespaceMutation) {
+// withCertificateID sets the ID field of the mutation.
+func withCertificateID(id int) certificateOption {
+	return func(m *CertificateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Namespace
+			value *Certificate
 		)
-		m.oldValue = func(ctx context.Context) (*Namespace, error) {
+		m.oldValue = func(ctx context.Context) (*Certificate, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Namespace.Get(ctx, id)
+					value, err = m.Client().Certificate.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -969,10 +1203,10 @@ func withNamespaceID(id int) namespaceOption {
 	}
 }
 
-// withNamespace sets the old Namespace of the mutation.
-func withNamespace(node *Namespace) namespaceOption {
-	return func(m *NamespaceMutation) {
-		m.oldValue = func(context.Context) (*Namespace, error) {
+// withCertificate sets the old Certificate of the mutation.
+func withCertificate(node *Certificate) certificateOption {
+	return func(m *CertificateMutation) {
+		m.oldValue = func(context.Context) (*Certificate, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -981,7 +1215,7 @@ func withNamespace(node *Namespace) namespaceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NamespaceMutation) Client() *Client {
+func (m CertificateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -989,7 +1223,7 @@ func (m NamespaceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NamespaceMutation) Tx() (*Tx, error) {
+func (m CertificateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -999,14 +1233,14 @@ func (m NamespaceMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Namespace entities.
-func (m *NamespaceMutation) SetID(id int) {
+// operation is only accepted on creation of Certificate entities.
+func (m *CertificateMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NamespaceMutation) ID() (id int, exists bool) {
+func (m *CertificateMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1017,7 +1251,7 @@ func (m *NamespaceMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NamespaceMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CertificateMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1026,151 +1260,7243 @@ func (m *NamespaceMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Namespace.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Certificate.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *NamespaceMutation) SetName(s string) {
-	m.name = &s
+// SetNamespaceID sets the "namespace_id" field.
+func (m *CertificateMutation) SetNamespaceID(i int) {
+	m.namespace = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *NamespaceMutation) Name() (r string, exists bool) {
-	v := m.name
+// NamespaceID returns the value of the "namespace_id" field in the mutation.
+func (m *CertificateMutation) NamespaceID() (r int, exists bool) {
+	v := m.namespace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Namespace entity.
-// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// OldNamespaceID returns the old "namespace_id" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NamespaceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CertificateMutation) OldNamespaceID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldNamespaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldNamespaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldNamespaceID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.NamespaceID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *NamespaceMutation) ResetName() {
-	m.name = nil
+// ResetNamespaceID resets all changes to the "namespace_id" field.
+func (m *CertificateMutation) ResetNamespaceID() {
+	m.namespace = nil
 }
 
-// SetDesc sets the "desc" field.
-func (m *NamespaceMutation) SetDesc(s string) {
-	m.desc = &s
+// SetCertPem sets the "cert_pem" field.
+func (m *CertificateMutation) SetCertPem(s string) {
+	m.cert_pem = &s
 }
 
-// Desc returns the value of the "desc" field in the mutation.
-func (m *NamespaceMutation) Desc() (r string, exists bool) {
-	v := m.desc
+// CertPem returns the value of the "cert_pem" field in the mutation.
+func (m *CertificateMutation) CertPem() (r string, exists bool) {
+	v := m.cert_pem
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDesc returns the old "desc" field's value of the Namespace entity.
-// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// OldCertPem returns the old "cert_pem" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NamespaceMutation) OldDesc(ctx context.Context) (v string, err error) {
+func (m *CertificateMutation) OldCertPem(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDesc is only allowed on UpdateOne operations")
+		return v, errors.New("OldCertPem is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDesc requires an ID field in the mutation")
+		return v, errors.New("OldCertPem requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDesc: %w", err)
+		return v, fmt.Errorf("querying old value for OldCertPem: %w", err)
 	}
-	return oldValue.Desc, nil
+	return oldValue.CertPem, nil
 }
 
-// ClearDesc clears the value of the "desc" field.
-func (m *NamespaceMutation) ClearDesc() {
-	m.desc = nil
-	m.clearedFields[namespace.FieldDesc] = struct{}{}
+// ResetCertPem resets all changes to the "cert_pem" field.
+func (m *CertificateMutation) ResetCertPem() {
+	m.cert_pem = nil
 }
 
-// DescCleared returns if the "desc" field was cleared in this mutation.
-func (m *NamespaceMutation) DescCleared() bool {
-	_, ok := m.clearedFields[namespace.FieldDesc]
+// SetKeyPem sets the "key_pem" field.
+func (m *CertificateMutation) SetKeyPem(s string) {
+	m.key_pem = &s
+}
+
+// KeyPem returns the value of the "key_pem" field in the mutation.
+func (m *CertificateMutation) KeyPem() (r string, exists bool) {
+	v := m.key_pem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyPem returns the old "key_pem" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldKeyPem(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyPem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyPem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyPem: %w", err)
+	}
+	return oldValue.KeyPem, nil
+}
+
+// ClearKeyPem clears the value of the "key_pem" field.
+func (m *CertificateMutation) ClearKeyPem() {
+	m.key_pem = nil
+	m.clearedFields[certificate.FieldKeyPem] = struct{}{}
+}
+
+// KeyPemCleared returns if the "key_pem" field was cleared in this mutation.
+func (m *CertificateMutation) KeyPemCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldKeyPem]
 	return ok
 }
 
-// ResetDesc resets all changes to the "desc" field.
-func (m *NamespaceMutation) ResetDesc() {
-	m.desc = nil
-	delete(m.clearedFields, namespace.FieldDesc)
+// ResetKeyPem resets all changes to the "key_pem" field.
+func (m *CertificateMutation) ResetKeyPem() {
+	m.key_pem = nil
+	delete(m.clearedFields, certificate.FieldKeyPem)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *NamespaceMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetKeyRef sets the "key_ref" field.
+func (m *CertificateMutation) SetKeyRef(s string) {
+	m.key_ref = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NamespaceMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// KeyRef returns the value of the "key_ref" field in the mutation.
+func (m *CertificateMutation) KeyRef() (r string, exists bool) {
+	v := m.key_ref
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Namespace entity.
-// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyRef returns the old "key_ref" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NamespaceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CertificateMutation) OldKeyRef(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyRef is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldKeyRef requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyRef: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.KeyRef, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NamespaceMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearKeyRef clears the value of the "key_ref" field.
+func (m *CertificateMutation) ClearKeyRef() {
+	m.key_ref = nil
+	m.clearedFields[certificate.FieldKeyRef] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NamespaceMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// KeyRefCleared returns if the "key_ref" field was cleared in this mutation.
+func (m *CertificateMutation) KeyRefCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldKeyRef]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NamespaceMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetKeyRef resets all changes to the "key_ref" field.
+func (m *CertificateMutation) ResetKeyRef() {
+	m.key_ref = nil
+	delete(m.clearedFields, certificate.FieldKeyRef)
+}
+
+// SetKeyExportable sets the "key_exportable" field.
+func (m *CertificateMutation) SetKeyExportable(b bool) {
+	m.key_exportable = &b
+}
+
+// KeyExportable returns the value of the "key_exportable" field in the mutation.
+func (m *CertificateMutation) KeyExportable() (r bool, exists bool) {
+	v := m.key_exportable
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Namespace entity.
-// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyExportable returns the old "key_exportable" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NamespaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CertificateMutation) OldKeyExportable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyExportable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyExportable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyExportable: %w", err)
+	}
+	return oldValue.KeyExportable, nil
+}
+
+// ResetKeyExportable resets all changes to the "key_exportable" field.
+func (m *CertificateMutation) ResetKeyExportable() {
+	m.key_exportable = nil
+}
+
+// SetOffline sets the "offline" field.
+func (m *CertificateMutation) SetOffline(b bool) {
+	m.offline = &b
+}
+
+// Offline returns the value of the "offline" field in the mutation.
+func (m *CertificateMutation) Offline() (r bool, exists bool) {
+	v := m.offline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOffline returns the old "offline" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldOffline(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOffline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOffline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOffline: %w", err)
+	}
+	return oldValue.Offline, nil
+}
+
+// ResetOffline resets all changes to the "offline" field.
+func (m *CertificateMutation) ResetOffline() {
+	m.offline = nil
+}
+
+// SetDesc sets the "desc" field.
+func (m *CertificateMutation) SetDesc(s string) {
+	m.desc = &s
+}
+
+// Desc returns the value of the "desc" field in the mutation.
+func (m *CertificateMutation) Desc() (r string, exists bool) {
+	v := m.desc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDesc returns the old "desc" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldDesc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDesc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDesc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDesc: %w", err)
+	}
+	return oldValue.Desc, nil
+}
+
+// ClearDesc clears the value of the "desc" field.
+func (m *CertificateMutation) ClearDesc() {
+	m.desc = nil
+	m.clearedFields[certificate.FieldDesc] = struct{}{}
+}
+
+// DescCleared returns if the "desc" field was cleared in this mutation.
+func (m *CertificateMutation) DescCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldDesc]
+	return ok
+}
+
+// ResetDesc resets all changes to the "desc" field.
+func (m *CertificateMutation) ResetDesc() {
+	m.desc = nil
+	delete(m.clearedFields, certificate.FieldDesc)
+}
+
+// SetIssuerID sets the "issuer_id" field.
+func (m *CertificateMutation) SetIssuerID(i int) {
+	m.issuer_id = &i
+	m.addissuer_id = nil
+}
+
+// IssuerID returns the value of the "issuer_id" field in the mutation.
+func (m *CertificateMutation) IssuerID() (r int, exists bool) {
+	v := m.issuer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuerID returns the old "issuer_id" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldIssuerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuerID: %w", err)
+	}
+	return oldValue.IssuerID, nil
+}
+
+// AddIssuerID adds i to the "issuer_id" field.
+func (m *CertificateMutation) AddIssuerID(i int) {
+	if m.addissuer_id != nil {
+		*m.addissuer_id += i
+	} else {
+		m.addissuer_id = &i
+	}
+}
+
+// AddedIssuerID returns the value that was added to the "issuer_id" field in this mutation.
+func (m *CertificateMutation) AddedIssuerID() (r int, exists bool) {
+	v := m.addissuer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIssuerID clears the value of the "issuer_id" field.
+func (m *CertificateMutation) ClearIssuerID() {
+	m.issuer_id = nil
+	m.addissuer_id = nil
+	m.clearedFields[certificate.FieldIssuerID] = struct{}{}
+}
+
+// IssuerIDCleared returns if the "issuer_id" field was cleared in this mutation.
+func (m *CertificateMutation) IssuerIDCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldIssuerID]
+	return ok
+}
+
+// ResetIssuerID resets all changes to the "issuer_id" field.
+func (m *CertificateMutation) ResetIssuerID() {
+	m.issuer_id = nil
+	m.addissuer_id = nil
+	delete(m.clearedFields, certificate.FieldIssuerID)
+}
+
+// SetUsage sets the "usage" field.
+func (m *CertificateMutation) SetUsage(s string) {
+	m.usage = &s
+}
+
+// Usage returns the value of the "usage" field in the mutation.
+func (m *CertificateMutation) Usage() (r string, exists bool) {
+	v := m.usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsage returns the old "usage" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldUsage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsage: %w", err)
+	}
+	return oldValue.Usage, nil
+}
+
+// ClearUsage clears the value of the "usage" field.
+func (m *CertificateMutation) ClearUsage() {
+	m.usage = nil
+	m.clearedFields[certificate.FieldUsage] = struct{}{}
+}
+
+// UsageCleared returns if the "usage" field was cleared in this mutation.
+func (m *CertificateMutation) UsageCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldUsage]
+	return ok
+}
+
+// ResetUsage resets all changes to the "usage" field.
+func (m *CertificateMutation) ResetUsage() {
+	m.usage = nil
+	delete(m.clearedFields, certificate.FieldUsage)
+}
+
+// SetSubject sets the "subject" field.
+func (m *CertificateMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *CertificateMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (m *CertificateMutation) ClearSubject() {
+	m.subject = nil
+	m.clearedFields[certificate.FieldSubject] = struct{}{}
+}
+
+// SubjectCleared returns if the "subject" field was cleared in this mutation.
+func (m *CertificateMutation) SubjectCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSubject]
+	return ok
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *CertificateMutation) ResetSubject() {
+	m.subject = nil
+	delete(m.clearedFields, certificate.FieldSubject)
+}
+
+// SetCommonName sets the "common_name" field.
+func (m *CertificateMutation) SetCommonName(s string) {
+	m.common_name = &s
+}
+
+// CommonName returns the value of the "common_name" field in the mutation.
+func (m *CertificateMutation) CommonName() (r string, exists bool) {
+	v := m.common_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommonName returns the old "common_name" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldCommonName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommonName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommonName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommonName: %w", err)
+	}
+	return oldValue.CommonName, nil
+}
+
+// ClearCommonName clears the value of the "common_name" field.
+func (m *CertificateMutation) ClearCommonName() {
+	m.common_name = nil
+	m.clearedFields[certificate.FieldCommonName] = struct{}{}
+}
+
+// CommonNameCleared returns if the "common_name" field was cleared in this mutation.
+func (m *CertificateMutation) CommonNameCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldCommonName]
+	return ok
+}
+
+// ResetCommonName resets all changes to the "common_name" field.
+func (m *CertificateMutation) ResetCommonName() {
+	m.common_name = nil
+	delete(m.clearedFields, certificate.FieldCommonName)
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (m *CertificateMutation) SetSerialNumber(s string) {
+	m.serial_number = &s
+}
+
+// SerialNumber returns the value of the "serial_number" field in the mutation.
+func (m *CertificateMutation) SerialNumber() (r string, exists bool) {
+	v := m.serial_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSerialNumber returns the old "serial_number" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSerialNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSerialNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSerialNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSerialNumber: %w", err)
+	}
+	return oldValue.SerialNumber, nil
+}
+
+// ClearSerialNumber clears the value of the "serial_number" field.
+func (m *CertificateMutation) ClearSerialNumber() {
+	m.serial_number = nil
+	m.clearedFields[certificate.FieldSerialNumber] = struct{}{}
+}
+
+// SerialNumberCleared returns if the "serial_number" field was cleared in this mutation.
+func (m *CertificateMutation) SerialNumberCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSerialNumber]
+	return ok
+}
+
+// ResetSerialNumber resets all changes to the "serial_number" field.
+func (m *CertificateMutation) ResetSerialNumber() {
+	m.serial_number = nil
+	delete(m.clearedFields, certificate.FieldSerialNumber)
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (m *CertificateMutation) SetFingerprint(s string) {
+	m.fingerprint = &s
+}
+
+// Fingerprint returns the value of the "fingerprint" field in the mutation.
+func (m *CertificateMutation) Fingerprint() (r string, exists bool) {
+	v := m.fingerprint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFingerprint returns the old "fingerprint" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldFingerprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFingerprint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFingerprint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFingerprint: %w", err)
+	}
+	return oldValue.Fingerprint, nil
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (m *CertificateMutation) ClearFingerprint() {
+	m.fingerprint = nil
+	m.clearedFields[certificate.FieldFingerprint] = struct{}{}
+}
+
+// FingerprintCleared returns if the "fingerprint" field was cleared in this mutation.
+func (m *CertificateMutation) FingerprintCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldFingerprint]
+	return ok
+}
+
+// ResetFingerprint resets all changes to the "fingerprint" field.
+func (m *CertificateMutation) ResetFingerprint() {
+	m.fingerprint = nil
+	delete(m.clearedFields, certificate.FieldFingerprint)
+}
+
+// SetSubjectKeyID sets the "subject_key_id" field.
+func (m *CertificateMutation) SetSubjectKeyID(s string) {
+	m.subject_key_id = &s
+}
+
+// SubjectKeyID returns the value of the "subject_key_id" field in the mutation.
+func (m *CertificateMutation) SubjectKeyID() (r string, exists bool) {
+	v := m.subject_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectKeyID returns the old "subject_key_id" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSubjectKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectKeyID: %w", err)
+	}
+	return oldValue.SubjectKeyID, nil
+}
+
+// ClearSubjectKeyID clears the value of the "subject_key_id" field.
+func (m *CertificateMutation) ClearSubjectKeyID() {
+	m.subject_key_id = nil
+	m.clearedFields[certificate.FieldSubjectKeyID] = struct{}{}
+}
+
+// SubjectKeyIDCleared returns if the "subject_key_id" field was cleared in this mutation.
+func (m *CertificateMutation) SubjectKeyIDCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSubjectKeyID]
+	return ok
+}
+
+// ResetSubjectKeyID resets all changes to the "subject_key_id" field.
+func (m *CertificateMutation) ResetSubjectKeyID() {
+	m.subject_key_id = nil
+	delete(m.clearedFields, certificate.FieldSubjectKeyID)
+}
+
+// SetAuthorityKeyID sets the "authority_key_id" field.
+func (m *CertificateMutation) SetAuthorityKeyID(s string) {
+	m.authority_key_id = &s
+}
+
+// AuthorityKeyID returns the value of the "authority_key_id" field in the mutation.
+func (m *CertificateMutation) AuthorityKeyID() (r string, exists bool) {
+	v := m.authority_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorityKeyID returns the old "authority_key_id" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldAuthorityKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorityKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorityKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorityKeyID: %w", err)
+	}
+	return oldValue.AuthorityKeyID, nil
+}
+
+// ClearAuthorityKeyID clears the value of the "authority_key_id" field.
+func (m *CertificateMutation) ClearAuthorityKeyID() {
+	m.authority_key_id = nil
+	m.clearedFields[certificate.FieldAuthorityKeyID] = struct{}{}
+}
+
+// AuthorityKeyIDCleared returns if the "authority_key_id" field was cleared in this mutation.
+func (m *CertificateMutation) AuthorityKeyIDCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldAuthorityKeyID]
+	return ok
+}
+
+// ResetAuthorityKeyID resets all changes to the "authority_key_id" field.
+func (m *CertificateMutation) ResetAuthorityKeyID() {
+	m.authority_key_id = nil
+	delete(m.clearedFields, certificate.FieldAuthorityKeyID)
+}
+
+// SetKeyType sets the "key_type" field.
+func (m *CertificateMutation) SetKeyType(s string) {
+	m.key_type = &s
+}
+
+// KeyType returns the value of the "key_type" field in the mutation.
+func (m *CertificateMutation) KeyType() (r string, exists bool) {
+	v := m.key_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyType returns the old "key_type" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldKeyType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyType: %w", err)
+	}
+	return oldValue.KeyType, nil
+}
+
+// ClearKeyType clears the value of the "key_type" field.
+func (m *CertificateMutation) ClearKeyType() {
+	m.key_type = nil
+	m.clearedFields[certificate.FieldKeyType] = struct{}{}
+}
+
+// KeyTypeCleared returns if the "key_type" field was cleared in this mutation.
+func (m *CertificateMutation) KeyTypeCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldKeyType]
+	return ok
+}
+
+// ResetKeyType resets all changes to the "key_type" field.
+func (m *CertificateMutation) ResetKeyType() {
+	m.key_type = nil
+	delete(m.clearedFields, certificate.FieldKeyType)
+}
+
+// SetSignatureAlgorithm sets the "signature_algorithm" field.
+func (m *CertificateMutation) SetSignatureAlgorithm(s string) {
+	m.signature_algorithm = &s
+}
+
+// SignatureAlgorithm returns the value of the "signature_algorithm" field in the mutation.
+func (m *CertificateMutation) SignatureAlgorithm() (r string, exists bool) {
+	v := m.signature_algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignatureAlgorithm returns the old "signature_algorithm" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldSignatureAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignatureAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignatureAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignatureAlgorithm: %w", err)
+	}
+	return oldValue.SignatureAlgorithm, nil
+}
+
+// ClearSignatureAlgorithm clears the value of the "signature_algorithm" field.
+func (m *CertificateMutation) ClearSignatureAlgorithm() {
+	m.signature_algorithm = nil
+	m.clearedFields[certificate.FieldSignatureAlgorithm] = struct{}{}
+}
+
+// SignatureAlgorithmCleared returns if the "signature_algorithm" field was cleared in this mutation.
+func (m *CertificateMutation) SignatureAlgorithmCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldSignatureAlgorithm]
+	return ok
+}
+
+// ResetSignatureAlgorithm resets all changes to the "signature_algorithm" field.
+func (m *CertificateMutation) ResetSignatureAlgorithm() {
+	m.signature_algorithm = nil
+	delete(m.clearedFields, certificate.FieldSignatureAlgorithm)
+}
+
+// SetIsCa sets the "is_ca" field.
+func (m *CertificateMutation) SetIsCa(b bool) {
+	m.is_ca = &b
+}
+
+// IsCa returns the value of the "is_ca" field in the mutation.
+func (m *CertificateMutation) IsCa() (r bool, exists bool) {
+	v := m.is_ca
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsCa returns the old "is_ca" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldIsCa(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsCa is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsCa requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsCa: %w", err)
+	}
+	return oldValue.IsCa, nil
+}
+
+// ClearIsCa clears the value of the "is_ca" field.
+func (m *CertificateMutation) ClearIsCa() {
+	m.is_ca = nil
+	m.clearedFields[certificate.FieldIsCa] = struct{}{}
+}
+
+// IsCaCleared returns if the "is_ca" field was cleared in this mutation.
+func (m *CertificateMutation) IsCaCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldIsCa]
+	return ok
+}
+
+// ResetIsCa resets all changes to the "is_ca" field.
+func (m *CertificateMutation) ResetIsCa() {
+	m.is_ca = nil
+	delete(m.clearedFields, certificate.FieldIsCa)
+}
+
+// SetNotBefore sets the "not_before" field.
+func (m *CertificateMutation) SetNotBefore(t time.Time) {
+	m.not_before = &t
+}
+
+// NotBefore returns the value of the "not_before" field in the mutation.
+func (m *CertificateMutation) NotBefore() (r time.Time, exists bool) {
+	v := m.not_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotBefore returns the old "not_before" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldNotBefore(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotBefore: %w", err)
+	}
+	return oldValue.NotBefore, nil
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (m *CertificateMutation) ClearNotBefore() {
+	m.not_before = nil
+	m.clearedFields[certificate.FieldNotBefore] = struct{}{}
+}
+
+// NotBeforeCleared returns if the "not_before" field was cleared in this mutation.
+func (m *CertificateMutation) NotBeforeCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldNotBefore]
+	return ok
+}
+
+// ResetNotBefore resets all changes to the "not_before" field.
+func (m *CertificateMutation) ResetNotBefore() {
+	m.not_before = nil
+	delete(m.clearedFields, certificate.FieldNotBefore)
+}
+
+// SetNotAfter sets the "not_after" field.
+func (m *CertificateMutation) SetNotAfter(t time.Time) {
+	m.not_after = &t
+}
+
+// NotAfter returns the value of the "not_after" field in the mutation.
+func (m *CertificateMutation) NotAfter() (r time.Time, exists bool) {
+	v := m.not_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotAfter returns the old "not_after" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldNotAfter(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotAfter: %w", err)
+	}
+	return oldValue.NotAfter, nil
+}
+
+// ClearNotAfter clears the value of the "not_after" field.
+func (m *CertificateMutation) ClearNotAfter() {
+	m.not_after = nil
+	m.clearedFields[certificate.FieldNotAfter] = struct{}{}
+}
+
+// NotAfterCleared returns if the "not_after" field was cleared in this mutation.
+func (m *CertificateMutation) NotAfterCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldNotAfter]
+	return ok
+}
+
+// ResetNotAfter resets all changes to the "not_after" field.
+func (m *CertificateMutation) ResetNotAfter() {
+	m.not_after = nil
+	delete(m.clearedFields, certificate.FieldNotAfter)
+}
+
+// SetDNSNames sets the "dns_names" field.
+func (m *CertificateMutation) SetDNSNames(s []string) {
+	m.dns_names = &s
+	m.appenddns_names = nil
+}
+
+// DNSNames returns the value of the "dns_names" field in the mutation.
+func (m *CertificateMutation) DNSNames() (r []string, exists bool) {
+	v := m.dns_names
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDNSNames returns the old "dns_names" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldDNSNames(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDNSNames is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDNSNames requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDNSNames: %w", err)
+	}
+	return oldValue.DNSNames, nil
+}
+
+// AppendDNSNames adds s to the "dns_names" field.
+func (m *CertificateMutation) AppendDNSNames(s []string) {
+	m.appenddns_names = append(m.appenddns_names, s...)
+}
+
+// AppendedDNSNames returns the list of values that were appended to the "dns_names" field in this mutation.
+func (m *CertificateMutation) AppendedDNSNames() ([]string, bool) {
+	if len(m.appenddns_names) == 0 {
+		return nil, false
+	}
+	return m.appenddns_names, true
+}
+
+// ClearDNSNames clears the value of the "dns_names" field.
+func (m *CertificateMutation) ClearDNSNames() {
+	m.dns_names = nil
+	m.appenddns_names = nil
+	m.clearedFields[certificate.FieldDNSNames] = struct{}{}
+}
+
+// DNSNamesCleared returns if the "dns_names" field was cleared in this mutation.
+func (m *CertificateMutation) DNSNamesCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldDNSNames]
+	return ok
+}
+
+// ResetDNSNames resets all changes to the "dns_names" field.
+func (m *CertificateMutation) ResetDNSNames() {
+	m.dns_names = nil
+	m.appenddns_names = nil
+	delete(m.clearedFields, certificate.FieldDNSNames)
+}
+
+// SetIPAddresses sets the "ip_addresses" field.
+func (m *CertificateMutation) SetIPAddresses(s []string) {
+	m.ip_addresses = &s
+	m.appendip_addresses = nil
+}
+
+// IPAddresses returns the value of the "ip_addresses" field in the mutation.
+func (m *CertificateMutation) IPAddresses() (r []string, exists bool) {
+	v := m.ip_addresses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddresses returns the old "ip_addresses" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldIPAddresses(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddresses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddresses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddresses: %w", err)
+	}
+	return oldValue.IPAddresses, nil
+}
+
+// AppendIPAddresses adds s to the "ip_addresses" field.
+func (m *CertificateMutation) AppendIPAddresses(s []string) {
+	m.appendip_addresses = append(m.appendip_addresses, s...)
+}
+
+// AppendedIPAddresses returns the list of values that were appended to the "ip_addresses" field in this mutation.
+func (m *CertificateMutation) AppendedIPAddresses() ([]string, bool) {
+	if len(m.appendip_addresses) == 0 {
+		return nil, false
+	}
+	return m.appendip_addresses, true
+}
+
+// ClearIPAddresses clears the value of the "ip_addresses" field.
+func (m *CertificateMutation) ClearIPAddresses() {
+	m.ip_addresses = nil
+	m.appendip_addresses = nil
+	m.clearedFields[certificate.FieldIPAddresses] = struct{}{}
+}
+
+// IPAddressesCleared returns if the "ip_addresses" field was cleared in this mutation.
+func (m *CertificateMutation) IPAddressesCleared() bool {
+	_, ok := m.clearedFields[certificate.FieldIPAddresses]
+	return ok
+}
+
+// ResetIPAddresses resets all changes to the "ip_addresses" field.
+func (m *CertificateMutation) ResetIPAddresses() {
+	m.ip_addresses = nil
+	m.appendip_addresses = nil
+	delete(m.clearedFields, certificate.FieldIPAddresses)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CertificateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CertificateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CertificateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CertificateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CertificateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Certificate entity.
+// If the Certificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CertificateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearNamespace clears the "namespace" edge to the Namespace entity.
+func (m *CertificateMutation) ClearNamespace() {
+	m.clearednamespace = true
+	m.clearedFields[certificate.FieldNamespaceID] = struct{}{}
+}
+
+// NamespaceCleared reports if the "namespace" edge to the Namespace entity was cleared.
+func (m *CertificateMutation) NamespaceCleared() bool {
+	return m.clearednamespace
+}
+
+// NamespaceIDs returns the "namespace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NamespaceID instead. It exists only for internal usage by the builders.
+func (m *CertificateMutation) NamespaceIDs() (ids []int) {
+	if id := m.namespace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNamespace resets all changes to the "namespace" edge.
+func (m *CertificateMutation) ResetNamespace() {
+	m.namespace = nil
+	m.clearednamespace = false
+}
+
+// AddExpiryNotificationIDs adds the "expiry_notifications" edge to the ExpiryNotification entity by ids.
+func (m *CertificateMutation) AddExpiryNotificationIDs(ids ...int) {
+	if m.expiry_notifications == nil {
+		m.expiry_notifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.expiry_notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearExpiryNotifications clears the "expiry_notifications" edge to the ExpiryNotification entity.
+func (m *CertificateMutation) ClearExpiryNotifications() {
+	m.clearedexpiry_notifications = true
+}
+
+// ExpiryNotificationsCleared reports if the "expiry_notifications" edge to the ExpiryNotification entity was cleared.
+func (m *CertificateMutation) ExpiryNotificationsCleared() bool {
+	return m.clearedexpiry_notifications
+}
+
+// RemoveExpiryNotificationIDs removes the "expiry_notifications" edge to the ExpiryNotification entity by IDs.
+func (m *CertificateMutation) RemoveExpiryNotificationIDs(ids ...int) {
+	if m.removedexpiry_notifications == nil {
+		m.removedexpiry_notifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.expiry_notifications, ids[i])
+		m.removedexpiry_notifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedExpiryNotifications returns the removed IDs of the "expiry_notifications" edge to the ExpiryNotification entity.
+func (m *CertificateMutation) RemovedExpiryNotificationsIDs() (ids []int) {
+	for id := range m.removedexpiry_notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ExpiryNotificationsIDs returns the "expiry_notifications" edge IDs in the mutation.
+func (m *CertificateMutation) ExpiryNotificationsIDs() (ids []int) {
+	for id := range m.expiry_notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetExpiryNotifications resets all changes to the "expiry_notifications" edge.
+func (m *CertificateMutation) ResetExpiryNotifications() {
+	m.expiry_notifications = nil
+	m.clearedexpiry_notifications = false
+	m.removedexpiry_notifications = nil
+}
+
+// SetRenewalPolicyID sets the "renewal_policy" edge to the RenewalPolicy entity by id.
+func (m *CertificateMutation) SetRenewalPolicyID(id int) {
+	m.renewal_policy = &id
+}
+
+// ClearRenewalPolicy clears the "renewal_policy" edge to the RenewalPolicy entity.
+func (m *CertificateMutation) ClearRenewalPolicy() {
+	m.clearedrenewal_policy = true
+}
+
+// RenewalPolicyCleared reports if the "renewal_policy" edge to the RenewalPolicy entity was cleared.
+func (m *CertificateMutation) RenewalPolicyCleared() bool {
+	return m.clearedrenewal_policy
+}
+
+// RenewalPolicyID returns the "renewal_policy" edge ID in the mutation.
+func (m *CertificateMutation) RenewalPolicyID() (id int, exists bool) {
+	if m.renewal_policy != nil {
+		return *m.renewal_policy, true
+	}
+	return
+}
+
+// RenewalPolicyIDs returns the "renewal_policy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RenewalPolicyID instead. It exists only for internal usage by the builders.
+func (m *CertificateMutation) RenewalPolicyIDs() (ids []int) {
+	if id := m.renewal_policy; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRenewalPolicy resets all changes to the "renewal_policy" edge.
+func (m *CertificateMutation) ResetRenewalPolicy() {
+	m.renewal_policy = nil
+	m.clearedrenewal_policy = false
+}
+
+// Where appends a list predicates to the CertificateMutation builder.
+func (m *CertificateMutation) Where(ps ...predicate.Certificate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CertificateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CertificateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Certificate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CertificateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CertificateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Certificate).
+func (m *CertificateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CertificateMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.namespace != nil {
+		fields = append(fields, certificate.FieldNamespaceID)
+	}
+	if m.cert_pem != nil {
+		fields = append(fields, certificate.FieldCertPem)
+	}
+	if m.key_pem != nil {
+		fields = append(fields, certificate.FieldKeyPem)
+	}
+	if m.key_ref != nil {
+		fields = append(fields, certificate.FieldKeyRef)
+	}
+	if m.key_exportable != nil {
+		fields = append(fields, certificate.FieldKeyExportable)
+	}
+	if m.offline != nil {
+		fields = append(fields, certificate.FieldOffline)
+	}
+	if m.desc != nil {
+		fields = append(fields, certificate.FieldDesc)
+	}
+	if m.issuer_id != nil {
+		fields = append(fields, certificate.FieldIssuerID)
+	}
+	if m.usage != nil {
+		fields = append(fields, certificate.FieldUsage)
+	}
+	if m.subject != nil {
+		fields = append(fields, certificate.FieldSubject)
+	}
+	if m.common_name != nil {
+		fields = append(fields, certificate.FieldCommonName)
+	}
+	if m.serial_number != nil {
+		fields = append(fields, certificate.FieldSerialNumber)
+	}
+	if m.fingerprint != nil {
+		fields = append(fields, certificate.FieldFingerprint)
+	}
+	if m.subject_key_id != nil {
+		fields = append(fields, certificate.FieldSubjectKeyID)
+	}
+	if m.authority_key_id != nil {
+		fields = append(fields, certificate.FieldAuthorityKeyID)
+	}
+	if m.key_type != nil {
+		fields = append(fields, certificate.FieldKeyType)
+	}
+	if m.signature_algorithm != nil {
+		fields = append(fields, certificate.FieldSignatureAlgorithm)
+	}
+	if m.is_ca != nil {
+		fields = append(fields, certificate.FieldIsCa)
+	}
+	if m.not_before != nil {
+		fields = append(fields, certificate.FieldNotBefore)
+	}
+	if m.not_after != nil {
+		fields = append(fields, certificate.FieldNotAfter)
+	}
+	if m.dns_names != nil {
+		fields = append(fields, certificate.FieldDNSNames)
+	}
+	if m.ip_addresses != nil {
+		fields = append(fields, certificate.FieldIPAddresses)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, certificate.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, certificate.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CertificateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case certificate.FieldNamespaceID:
+		return m.NamespaceID()
+	case certificate.FieldCertPem:
+		return m.CertPem()
+	case certificate.FieldKeyPem:
+		return m.KeyPem()
+	case certificate.FieldKeyRef:
+		return m.KeyRef()
+	case certificate.FieldKeyExportable:
+		return m.KeyExportable()
+	case certificate.FieldOffline:
+		return m.Offline()
+	case certificate.FieldDesc:
+		return m.Desc()
+	case certificate.FieldIssuerID:
+		return m.IssuerID()
+	case certificate.FieldUsage:
+		return m.Usage()
+	case certificate.FieldSubject:
+		return m.Subject()
+	case certificate.FieldCommonName:
+		return m.CommonName()
+	case certificate.FieldSerialNumber:
+		return m.SerialNumber()
+	case certificate.FieldFingerprint:
+		return m.Fingerprint()
+	case certificate.FieldSubjectKeyID:
+		return m.SubjectKeyID()
+	case certificate.FieldAuthorityKeyID:
+		return m.AuthorityKeyID()
+	case certificate.FieldKeyType:
+		return m.KeyType()
+	case certificate.FieldSignatureAlgorithm:
+		return m.SignatureAlgorithm()
+	case certificate.FieldIsCa:
+		return m.IsCa()
+	case certificate.FieldNotBefore:
+		return m.NotBefore()
+	case certificate.FieldNotAfter:
+		return m.NotAfter()
+	case certificate.FieldDNSNames:
+		return m.DNSNames()
+	case certificate.FieldIPAddresses:
+		return m.IPAddresses()
+	case certificate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case certificate.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CertificateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case certificate.FieldNamespaceID:
+		return m.OldNamespaceID(ctx)
+	case certificate.FieldCertPem:
+		return m.OldCertPem(ctx)
+	case certificate.FieldKeyPem:
+		return m.OldKeyPem(ctx)
+	case certificate.FieldKeyRef:
+		return m.OldKeyRef(ctx)
+	case certificate.FieldKeyExportable:
+		return m.OldKeyExportable(ctx)
+	case certificate.FieldOffline:
+		return m.OldOffline(ctx)
+	case certificate.FieldDesc:
+		return m.OldDesc(ctx)
+	case certificate.FieldIssuerID:
+		return m.OldIssuerID(ctx)
+	case certificate.FieldUsage:
+		return m.OldUsage(ctx)
+	case certificate.FieldSubject:
+		return m.OldSubject(ctx)
+	case certificate.FieldCommonName:
+		return m.OldCommonName(ctx)
+	case certificate.FieldSerialNumber:
+		return m.OldSerialNumber(ctx)
+	case certificate.FieldFingerprint:
+		return m.OldFingerprint(ctx)
+	case certificate.FieldSubjectKeyID:
+		return m.OldSubjectKeyID(ctx)
+	case certificate.FieldAuthorityKeyID:
+		return m.OldAuthorityKeyID(ctx)
+	case certificate.FieldKeyType:
+		return m.OldKeyType(ctx)
+	case certificate.FieldSignatureAlgorithm:
+		return m.OldSignatureAlgorithm(ctx)
+	case certificate.FieldIsCa:
+		return m.OldIsCa(ctx)
+	case certificate.FieldNotBefore:
+		return m.OldNotBefore(ctx)
+	case certificate.FieldNotAfter:
+		return m.OldNotAfter(ctx)
+	case certificate.FieldDNSNames:
+		return m.OldDNSNames(ctx)
+	case certificate.FieldIPAddresses:
+		return m.OldIPAddresses(ctx)
+	case certificate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case certificate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Certificate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertificateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case certificate.FieldNamespaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespaceID(v)
+		return nil
+	case certificate.FieldCertPem:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertPem(v)
+		return nil
+	case certificate.FieldKeyPem:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyPem(v)
+		return nil
+	case certificate.FieldKeyRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyRef(v)
+		return nil
+	case certificate.FieldKeyExportable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyExportable(v)
+		return nil
+	case certificate.FieldOffline:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOffline(v)
+		return nil
+	case certificate.FieldDesc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDesc(v)
+		return nil
+	case certificate.FieldIssuerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuerID(v)
+		return nil
+	case certificate.FieldUsage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsage(v)
+		return nil
+	case certificate.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case certificate.FieldCommonName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommonName(v)
+		return nil
+	case certificate.FieldSerialNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSerialNumber(v)
+		return nil
+	case certificate.FieldFingerprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFingerprint(v)
+		return nil
+	case certificate.FieldSubjectKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectKeyID(v)
+		return nil
+	case certificate.FieldAuthorityKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorityKeyID(v)
+		return nil
+	case certificate.FieldKeyType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyType(v)
+		return nil
+	case certificate.FieldSignatureAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignatureAlgorithm(v)
+		return nil
+	case certificate.FieldIsCa:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsCa(v)
+		return nil
+	case certificate.FieldNotBefore:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotBefore(v)
+		return nil
+	case certificate.FieldNotAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotAfter(v)
+		return nil
+	case certificate.FieldDNSNames:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDNSNames(v)
+		return nil
+	case certificate.FieldIPAddresses:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddresses(v)
+		return nil
+	case certificate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case certificate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CertificateMutation) AddedFields() []string {
+	var fields []string
+	if m.addissuer_id != nil {
+		fields = append(fields, certificate.FieldIssuerID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CertificateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case certificate.FieldIssuerID:
+		return m.AddedIssuerID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CertificateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case certificate.FieldIssuerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssuerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CertificateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(certificate.FieldKeyPem) {
+		fields = append(fields, certificate.FieldKeyPem)
+	}
+	if m.FieldCleared(certificate.FieldKeyRef) {
+		fields = append(fields, certificate.FieldKeyRef)
+	}
+	if m.FieldCleared(certificate.FieldDesc) {
+		fields = append(fields, certificate.FieldDesc)
+	}
+	if m.FieldCleared(certificate.FieldIssuerID) {
+		fields = append(fields, certificate.FieldIssuerID)
+	}
+	if m.FieldCleared(certificate.FieldUsage) {
+		fields = append(fields, certificate.FieldUsage)
+	}
+	if m.FieldCleared(certificate.FieldSubject) {
+		fields = append(fields, certificate.FieldSubject)
+	}
+	if m.FieldCleared(certificate.FieldCommonName) {
+		fields = append(fields, certificate.FieldCommonName)
+	}
+	if m.FieldCleared(certificate.FieldSerialNumber) {
+		fields = append(fields, certificate.FieldSerialNumber)
+	}
+	if m.FieldCleared(certificate.FieldFingerprint) {
+		fields = append(fields, certificate.FieldFingerprint)
+	}
+	if m.FieldCleared(certificate.FieldSubjectKeyID) {
+		fields = append(fields, certificate.FieldSubjectKeyID)
+	}
+	if m.FieldCleared(certificate.FieldAuthorityKeyID) {
+		fields = append(fields, certificate.FieldAuthorityKeyID)
+	}
+	if m.FieldCleared(certificate.FieldKeyType) {
+		fields = append(fields, certificate.FieldKeyType)
+	}
+	if m.FieldCleared(certificate.FieldSignatureAlgorithm) {
+		fields = append(fields, certificate.FieldSignatureAlgorithm)
+	}
+	if m.FieldCleared(certificate.FieldIsCa) {
+		fields = append(fields, certificate.FieldIsCa)
+	}
+	if m.FieldCleared(certificate.FieldNotBefore) {
+		fields = append(fields, certificate.FieldNotBefore)
+	}
+	if m.FieldCleared(certificate.FieldNotAfter) {
+		fields = append(fields, certificate.FieldNotAfter)
+	}
+	if m.FieldCleared(certificate.FieldDNSNames) {
+		fields = append(fields, certificate.FieldDNSNames)
+	}
+	if m.FieldCleared(certificate.FieldIPAddresses) {
+		fields = append(fields, certificate.FieldIPAddresses)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CertificateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CertificateMutation) ClearField(name string) error {
+	switch name {
+	case certificate.FieldKeyPem:
+		m.ClearKeyPem()
+		return nil
+	case certificate.FieldKeyRef:
+		m.ClearKeyRef()
+		return nil
+	case certificate.FieldDesc:
+		m.ClearDesc()
+		return nil
+	case certificate.FieldIssuerID:
+		m.ClearIssuerID()
+		return nil
+	case certificate.FieldUsage:
+		m.ClearUsage()
+		return nil
+	case certificate.FieldSubject:
+		m.ClearSubject()
+		return nil
+	case certificate.FieldCommonName:
+		m.ClearCommonName()
+		return nil
+	case certificate.FieldSerialNumber:
+		m.ClearSerialNumber()
+		return nil
+	case certificate.FieldFingerprint:
+		m.ClearFingerprint()
+		return nil
+	case certificate.FieldSubjectKeyID:
+		m.ClearSubjectKeyID()
+		return nil
+	case certificate.FieldAuthorityKeyID:
+		m.ClearAuthorityKeyID()
+		return nil
+	case certificate.FieldKeyType:
+		m.ClearKeyType()
+		return nil
+	case certificate.FieldSignatureAlgorithm:
+		m.ClearSignatureAlgorithm()
+		return nil
+	case certificate.FieldIsCa:
+		m.ClearIsCa()
+		return nil
+	case certificate.FieldNotBefore:
+		m.ClearNotBefore()
+		return nil
+	case certificate.FieldNotAfter:
+		m.ClearNotAfter()
+		return nil
+	case certificate.FieldDNSNames:
+		m.ClearDNSNames()
+		return nil
+	case certificate.FieldIPAddresses:
+		m.ClearIPAddresses()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CertificateMutation) ResetField(name string) error {
+	switch name {
+	case certificate.FieldNamespaceID:
+		m.ResetNamespaceID()
+		return nil
+	case certificate.FieldCertPem:
+		m.ResetCertPem()
+		return nil
+	case certificate.FieldKeyPem:
+		m.ResetKeyPem()
+		return nil
+	case certificate.FieldKeyRef:
+		m.ResetKeyRef()
+		return nil
+	case certificate.FieldKeyExportable:
+		m.ResetKeyExportable()
+		return nil
+	case certificate.FieldOffline:
+		m.ResetOffline()
+		return nil
+	case certificate.FieldDesc:
+		m.ResetDesc()
+		return nil
+	case certificate.FieldIssuerID:
+		m.ResetIssuerID()
+		return nil
+	case certificate.FieldUsage:
+		m.ResetUsage()
+		return nil
+	case certificate.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case certificate.FieldCommonName:
+		m.ResetCommonName()
+		return nil
+	case certificate.FieldSerialNumber:
+		m.ResetSerialNumber()
+		return nil
+	case certificate.FieldFingerprint:
+		m.ResetFingerprint()
+		return nil
+	case certificate.FieldSubjectKeyID:
+		m.ResetSubjectKeyID()
+		return nil
+	case certificate.FieldAuthorityKeyID:
+		m.ResetAuthorityKeyID()
+		return nil
+	case certificate.FieldKeyType:
+		m.ResetKeyType()
+		return nil
+	case certificate.FieldSignatureAlgorithm:
+		m.ResetSignatureAlgorithm()
+		return nil
+	case certificate.FieldIsCa:
+		m.ResetIsCa()
+		return nil
+	case certificate.FieldNotBefore:
+		m.ResetNotBefore()
+		return nil
+	case certificate.FieldNotAfter:
+		m.ResetNotAfter()
+		return nil
+	case certificate.FieldDNSNames:
+		m.ResetDNSNames()
+		return nil
+	case certificate.FieldIPAddresses:
+		m.ResetIPAddresses()
+		return nil
+	case certificate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case certificate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CertificateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.namespace != nil {
+		edges = append(edges, certificate.EdgeNamespace)
+	}
+	if m.expiry_notifications != nil {
+		edges = append(edges, certificate.EdgeExpiryNotifications)
+	}
+	if m.renewal_policy != nil {
+		edges = append(edges, certificate.EdgeRenewalPolicy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CertificateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case certificate.EdgeNamespace:
+		if id := m.namespace; id != nil {
+			return []ent.Value{*id}
+		}
+	case certificate.EdgeExpiryNotifications:
+		ids := make([]ent.Value, 0, len(m.expiry_notifications))
+		for id := range m.expiry_notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case certificate.EdgeRenewalPolicy:
+		if id := m.renewal_policy; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CertificateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedexpiry_notifications != nil {
+		edges = append(edges, certificate.EdgeExpiryNotifications)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CertificateMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case certificate.EdgeExpiryNotifications:
+		ids := make([]ent.Value, 0, len(m.removedexpiry_notifications))
+		for id := range m.removedexpiry_notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CertificateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearednamespace {
+		edges = append(edges, certificate.EdgeNamespace)
+	}
+	if m.clearedexpiry_notifications {
+		edges = append(edges, certificate.EdgeExpiryNotifications)
+	}
+	if m.clearedrenewal_policy {
+		edges = append(edges, certificate.EdgeRenewalPolicy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CertificateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case certificate.EdgeNamespace:
+		return m.clearednamespace
+	case certificate.EdgeExpiryNotifications:
+		return m.clearedexpiry_notifications
+	case certificate.EdgeRenewalPolicy:
+		return m.clearedrenewal_policy
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CertificateMutation) ClearEdge(name string) error {
+	switch name {
+	case certificate.EdgeNamespace:
+		m.ClearNamespace()
+		return nil
+	case certificate.EdgeRenewalPolicy:
+		m.ClearRenewalPolicy()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CertificateMutation) ResetEdge(name string) error {
+	switch name {
+	case certificate.EdgeNamespace:
+		m.ResetNamespace()
+		return nil
+	case certificate.EdgeExpiryNotifications:
+		m.ResetExpiryNotifications()
+		return nil
+	case certificate.EdgeRenewalPolicy:
+		m.ResetRenewalPolicy()
+		return nil
+	}
+	return fmt.Errorf("unknown Certificate edge %s", name)
+}
+
+// ExpiryNotificationMutation represents an operation that mutates the ExpiryNotification nodes in the graph.
+type ExpiryNotificationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	fingerprint        *string
+	threshold_days     *int
+	addthreshold_days  *int
+	not_after          *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	certificate        *int
+	clearedcertificate bool
+	done               bool
+	oldValue           func(context.Context) (*ExpiryNotification, error)
+	predicates         []predicate.ExpiryNotification
+}
+
+var _ ent.Mutation = (*ExpiryNotificationMutation)(nil)
+
+// expirynotificationOption allows management of the mutation configuration using functional options.
+type expirynotificationOption func(*ExpiryNotificationMutation)
+
+// newExpiryNotificationMutation creates new mutation for the ExpiryNotification entity.
+func newExpiryNotificationMutation(c config, op Op, opts ...expirynotificationOption) *ExpiryNotificationMutation {
+	m := &ExpiryNotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExpiryNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExpiryNotificationID sets the ID field of the mutation.
+func withExpiryNotificationID(id int) expirynotificationOption {
+	return func(m *ExpiryNotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExpiryNotification
+		)
+		m.oldValue = func(ctx context.Context) (*ExpiryNotification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExpiryNotification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExpiryNotification sets the old ExpiryNotification of the mutation.
+func withExpiryNotification(node *ExpiryNotification) expirynotificationOption {
+	return func(m *ExpiryNotificationMutation) {
+		m.oldValue = func(context.Context) (*ExpiryNotification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExpiryNotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExpiryNotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExpiryNotification entities.
+func (m *ExpiryNotificationMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExpiryNotificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExpiryNotificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExpiryNotification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (m *ExpiryNotificationMutation) SetCertificateID(i int) {
+	m.certificate = &i
+}
+
+// CertificateID returns the value of the "certificate_id" field in the mutation.
+func (m *ExpiryNotificationMutation) CertificateID() (r int, exists bool) {
+	v := m.certificate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateID returns the old "certificate_id" field's value of the ExpiryNotification entity.
+// If the ExpiryNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExpiryNotificationMutation) OldCertificateID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateID: %w", err)
+	}
+	return oldValue.CertificateID, nil
+}
+
+// ResetCertificateID resets all changes to the "certificate_id" field.
+func (m *ExpiryNotificationMutation) ResetCertificateID() {
+	m.certificate = nil
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (m *ExpiryNotificationMutation) SetFingerprint(s string) {
+	m.fingerprint = &s
+}
+
+// Fingerprint returns the value of the "fingerprint" field in the mutation.
+func (m *ExpiryNotificationMutation) Fingerprint() (r string, exists bool) {
+	v := m.fingerprint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFingerprint returns the old "fingerprint" field's value of the ExpiryNotification entity.
+// If the ExpiryNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExpiryNotificationMutation) OldFingerprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFingerprint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFingerprint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFingerprint: %w", err)
+	}
+	return oldValue.Fingerprint, nil
+}
+
+// ResetFingerprint resets all changes to the "fingerprint" field.
+func (m *ExpiryNotificationMutation) ResetFingerprint() {
+	m.fingerprint = nil
+}
+
+// SetThresholdDays sets the "threshold_days" field.
+func (m *ExpiryNotificationMutation) SetThresholdDays(i int) {
+	m.threshold_days = &i
+	m.addthreshold_days = nil
+}
+
+// ThresholdDays returns the value of the "threshold_days" field in the mutation.
+func (m *ExpiryNotificationMutation) ThresholdDays() (r int, exists bool) {
+	v := m.threshold_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThresholdDays returns the old "threshold_days" field's value of the ExpiryNotification entity.
+// If the ExpiryNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExpiryNotificationMutation) OldThresholdDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThresholdDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThresholdDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThresholdDays: %w", err)
+	}
+	return oldValue.ThresholdDays, nil
+}
+
+// AddThresholdDays adds i to the "threshold_days" field.
+func (m *ExpiryNotificationMutation) AddThresholdDays(i int) {
+	if m.addthreshold_days != nil {
+		*m.addthreshold_days += i
+	} else {
+		m.addthreshold_days = &i
+	}
+}
+
+// AddedThresholdDays returns the value that was added to the "threshold_days" field in this mutation.
+func (m *ExpiryNotificationMutation) AddedThresholdDays() (r int, exists bool) {
+	v := m.addthreshold_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetThresholdDays resets all changes to the "threshold_days" field.
+func (m *ExpiryNotificationMutation) ResetThresholdDays() {
+	m.threshold_days = nil
+	m.addthreshold_days = nil
+}
+
+// SetNotAfter sets the "not_after" field.
+func (m *ExpiryNotificationMutation) SetNotAfter(t time.Time) {
+	m.not_after = &t
+}
+
+// NotAfter returns the value of the "not_after" field in the mutation.
+func (m *ExpiryNotificationMutation) NotAfter() (r time.Time, exists bool) {
+	v := m.not_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotAfter returns the old "not_after" field's value of the ExpiryNotification entity.
+// If the ExpiryNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExpiryNotificationMutation) OldNotAfter(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotAfter: %w", err)
+	}
+	return oldValue.NotAfter, nil
+}
+
+// ResetNotAfter resets all changes to the "not_after" field.
+func (m *ExpiryNotificationMutation) ResetNotAfter() {
+	m.not_after = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExpiryNotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExpiryNotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExpiryNotification entity.
+// If the ExpiryNotification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExpiryNotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExpiryNotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (m *ExpiryNotificationMutation) ClearCertificate() {
+	m.clearedcertificate = true
+	m.clearedFields[expirynotification.FieldCertificateID] = struct{}{}
+}
+
+// CertificateCleared reports if the "certificate" edge to the Certificate entity was cleared.
+func (m *ExpiryNotificationMutation) CertificateCleared() bool {
+	return m.clearedcertificate
+}
+
+// CertificateIDs returns the "certificate" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CertificateID instead. It exists only for internal usage by the builders.
+func (m *ExpiryNotificationMutation) CertificateIDs() (ids []int) {
+	if id := m.certificate; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCertificate resets all changes to the "certificate" edge.
+func (m *ExpiryNotificationMutation) ResetCertificate() {
+	m.certificate = nil
+	m.clearedcertificate = false
+}
+
+// Where appends a list predicates to the ExpiryNotificationMutation builder.
+func (m *ExpiryNotificationMutation) Where(ps ...predicate.ExpiryNotification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExpiryNotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExpiryNotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExpiryNotification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExpiryNotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExpiryNotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExpiryNotification).
+func (m *ExpiryNotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExpiryNotificationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.certificate != nil {
+		fields = append(fields, expirynotification.FieldCertificateID)
+	}
+	if m.fingerprint != nil {
+		fields = append(fields, expirynotification.FieldFingerprint)
+	}
+	if m.threshold_days != nil {
+		fields = append(fields, expirynotification.FieldThresholdDays)
+	}
+	if m.not_after != nil {
+		fields = append(fields, expirynotification.FieldNotAfter)
+	}
+	if m.created_at != nil {
+		fields = append(fields, expirynotification.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExpiryNotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case expirynotification.FieldCertificateID:
+		return m.CertificateID()
+	case expirynotification.FieldFingerprint:
+		return m.Fingerprint()
+	case expirynotification.FieldThresholdDays:
+		return m.ThresholdDays()
+	case expirynotification.FieldNotAfter:
+		return m.NotAfter()
+	case expirynotification.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExpiryNotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case expirynotification.FieldCertificateID:
+		return m.OldCertificateID(ctx)
+	case expirynotification.FieldFingerprint:
+		return m.OldFingerprint(ctx)
+	case expirynotification.FieldThresholdDays:
+		return m.OldThresholdDays(ctx)
+	case expirynotification.FieldNotAfter:
+		return m.OldNotAfter(ctx)
+	case expirynotification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExpiryNotification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExpiryNotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case expirynotification.FieldCertificateID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateID(v)
+		return nil
+	case expirynotification.FieldFingerprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFingerprint(v)
+		return nil
+	case expirynotification.FieldThresholdDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThresholdDays(v)
+		return nil
+	case expirynotification.FieldNotAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotAfter(v)
+		return nil
+	case expirynotification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExpiryNotification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExpiryNotificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addthreshold_days != nil {
+		fields = append(fields, expirynotification.FieldThresholdDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExpiryNotificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case expirynotification.FieldThresholdDays:
+		return m.AddedThresholdDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExpiryNotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case expirynotification.FieldThresholdDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThresholdDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExpiryNotification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExpiryNotificationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExpiryNotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExpiryNotificationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExpiryNotification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExpiryNotificationMutation) ResetField(name string) error {
+	switch name {
+	case expirynotification.FieldCertificateID:
+		m.ResetCertificateID()
+		return nil
+	case expirynotification.FieldFingerprint:
+		m.ResetFingerprint()
+		return nil
+	case expirynotification.FieldThresholdDays:
+		m.ResetThresholdDays()
+		return nil
+	case expirynotification.FieldNotAfter:
+		m.ResetNotAfter()
+		return nil
+	case expirynotification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExpiryNotification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExpiryNotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.certificate != nil {
+		edges = append(edges, expirynotification.EdgeCertificate)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExpiryNotificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case expirynotification.EdgeCertificate:
+		if id := m.certificate; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExpiryNotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExpiryNotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExpiryNotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcertificate {
+		edges = append(edges, expirynotification.EdgeCertificate)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExpiryNotificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case expirynotification.EdgeCertificate:
+		return m.clearedcertificate
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExpiryNotificationMutation) ClearEdge(name string) error {
+	switch name {
+	case expirynotification.EdgeCertificate:
+		m.ClearCertificate()
+		return nil
+	}
+	return fmt.Errorf("unknown ExpiryNotification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExpiryNotificationMutation) ResetEdge(name string) error {
+	switch name {
+	case expirynotification.EdgeCertificate:
+		m.ResetCertificate()
+		return nil
+	}
+	return fmt.Errorf("unknown ExpiryNotification edge %s", name)
+}
+
+// KeyringMutation represents an operation that mutates the Keyring nodes in the graph.
+type KeyringMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	source         *keyring.Source
+	salt           *[]byte
+	kdf_time       *uint32
+	addkdf_time    *int32
+	kdf_memory     *uint32
+	addkdf_memory  *int32
+	kdf_threads    *uint8
+	addkdf_threads *int8
+	check          *[]byte
+	keys_bound     *bool
+	updated_at     *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Keyring, error)
+	predicates     []predicate.Keyring
+}
+
+var _ ent.Mutation = (*KeyringMutation)(nil)
+
+// keyringOption allows management of the mutation configuration using functional options.
+type keyringOption func(*KeyringMutation)
+
+// newKeyringMutation creates new mutation for the Keyring entity.
+func newKeyringMutation(c config, op Op, opts ...keyringOption) *KeyringMutation {
+	m := &KeyringMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyring,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyringID sets the ID field of the mutation.
+func withKeyringID(id int) keyringOption {
+	return func(m *KeyringMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Keyring
+		)
+		m.oldValue = func(ctx context.Context) (*Keyring, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Keyring.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyring sets the old Keyring of the mutation.
+func withKeyring(node *Keyring) keyringOption {
+	return func(m *KeyringMutation) {
+		m.oldValue = func(context.Context) (*Keyring, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyringMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyringMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Keyring entities.
+func (m *KeyringMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyringMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyringMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Keyring.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSource sets the "source" field.
+func (m *KeyringMutation) SetSource(k keyring.Source) {
+	m.source = &k
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *KeyringMutation) Source() (r keyring.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Keyring entity.
+// If the Keyring object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyringMutation) OldSource(ctx context.Context) (v keyring.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *KeyringMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetSalt sets the "salt" field.
+func (m *KeyringMutation) SetSalt(b []byte) {
+	m.salt = &b
+}
+
+// Salt returns the value of the "salt" field in the mutation.
+func (m *KeyringMutation) Salt() (r []byte, exists bool) {
+	v := m.salt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSalt returns the old "salt" field's value of the Keyring entity.
+// If the Keyring object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyringMutation) OldSalt(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSalt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSalt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSalt: %w", err)
+	}
+	return oldValue.Salt, nil
+}
+
+// ClearSalt clears the value of the "salt" field.
+func (m *KeyringMutation) ClearSalt() {
+	m.salt = nil
+	m.clearedFields[keyring.FieldSalt] = struct{}{}
+}
+
+// SaltCleared returns if the "salt" field was cleared in this mutation.
+func (m *KeyringMutation) SaltCleared() bool {
+	_, ok := m.clearedFields[keyring.FieldSalt]
+	return ok
+}
+
+// ResetSalt resets all changes to the "salt" field.
+func (m *KeyringMutation) ResetSalt() {
+	m.salt = nil
+	delete(m.clearedFields, keyring.FieldSalt)
+}
+
+// SetKdfTime sets the "kdf_time" field.
+func (m *KeyringMutation) SetKdfTime(u uint32) {
+	m.kdf_time = &u
+	m.addkdf_time = nil
+}
+
+// KdfTime returns the value of the "kdf_time" field in the mutation.
+func (m *KeyringMutation) KdfTime() (r uint32, exists bool) {
+	v := m.kdf_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKdfTime returns the old "kdf_time" field's value of the Keyring entity.
+// If the Keyring object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyringMutation) OldKdfTime(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKdfTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKdfTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKdfTime: %w", err)
+	}
+	return oldValue.KdfTime, nil
+}
+
+// AddKdfTime adds u to the "kdf_time" field.
+func (m *KeyringMutation) AddKdfTime(u int32) {
+	if m.addkdf_time != nil {
+		*m.addkdf_time += u
+	} else {
+		m.addkdf_time = &u
+	}
+}
+
+// AddedKdfTime returns the value that was added to the "kdf_time" field in this mutation.
+func (m *KeyringMutation) AddedKdfTime() (r int32, exists bool) {
+	v := m.addkdf_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearKdfTime clears the value of the "kdf_time" field.
+func (m *KeyringMutation) ClearKdfTime() {
+	m.kdf_time = nil
+	m.addkdf_time = nil
+	m.clearedFields[keyring.FieldKdfTime] = struct{}{}
+}
+
+// KdfTimeCleared returns if the "kdf_time" field was cleared in this mutation.
+func (m *KeyringMutation) KdfTimeCleared() bool {
+	_, ok := m.clearedFields[keyring.FieldKdfTime]
+	return ok
+}
+
+// ResetKdfTime resets all changes to the "kdf_time" field.
+func (m *KeyringMutation) ResetKdfTime() {
+	m.kdf_time = nil
+	m.addkdf_time = nil
+	delete(m.clearedFields, keyring.FieldKdfTime)
+}
+
+// SetKdfMemory sets the "kdf_memory" field.
+func (m *KeyringMutation) SetKdfMemory(u uint32) {
+	m.kdf_memory = &u
+	m.addkdf_memory = nil
+}
+
+// KdfMemory returns the value of the "kdf_memory" field in the mutation.
+func (m *KeyringMutation) KdfMemory() (r uint32, exists bool) {
+	v := m.kdf_memory
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKdfMemory returns the old "kdf_memory" field's value of the Keyring entity.
+// If the Keyring object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyringMutation) OldKdfMemory(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKdfMemory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKdfMemory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKdfMemory: %w", err)
+	}
+	return oldValue.KdfMemory, nil
+}
+
+// AddKdfMemory adds u to the "kdf_memory" field.
+func (m *KeyringMutation) AddKdfMemory(u int32) {
+	if m.addkdf_memory != nil {
+		*m.addkdf_memory += u
+	} else {
+		m.addkdf_memory = &u
+	}
+}
+
+// AddedKdfMemory returns the value that was added to the "kdf_memory" field in this mutation.
+func (m *KeyringMutation) AddedKdfMemory() (r int32, exists bool) {
+	v := m.addkdf_memory
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearKdfMemory clears the value of the "kdf_memory" field.
+func (m *KeyringMutation) ClearKdfMemory() {
+	m.kdf_memory = nil
+	m.addkdf_memory = nil
+	m.clearedFields[keyring.FieldKdfMemory] = struct{}{}
+}
+
+// KdfMemoryCleared returns if the "kdf_memory" field was cleared in this mutation.
+func (m *KeyringMutation) KdfMemoryCleared() bool {
+	_, ok := m.clearedFields[keyring.FieldKdfMemory]
+	return ok
+}
+
+// ResetKdfMemory resets all changes to the "kdf_memory" field.
+func (m *KeyringMutation) ResetKdfMemory() {
+	m.kdf_memory = nil
+	m.addkdf_memory = nil
+	delete(m.clearedFields, keyring.FieldKdfMemory)
+}
+
+// SetKdfThreads sets the "kdf_threads" field.
+func (m *KeyringMutation) SetKdfThreads(u uint8) {
+	m.kdf_threads = &u
+	m.addkdf_threads = nil
+}
+
+// KdfThreads returns the value of the "kdf_threads" field in the mutation.
+func (m *KeyringMutation) KdfThreads() (r uint8, exists bool) {
+	v := m.kdf_threads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKdfThreads returns the old "kdf_threads" field's value of the Keyring entity.
+// If the Keyring object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyringMutation) OldKdfThreads(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKdfThreads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKdfThreads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKdfThreads: %w", err)
+	}
+	return oldValue.KdfThreads, nil
+}
+
+// AddKdfThreads adds u to the "kdf_threads" field.
+func (m *KeyringMutation) AddKdfThreads(u int8) {
+	if m.addkdf_threads != nil {
+		*m.addkdf_threads += u
+	} else {
+		m.addkdf_threads = &u
+	}
+}
+
+// AddedKdfThreads returns the value that was added to the "kdf_threads" field in this mutation.
+func (m *KeyringMutation) AddedKdfThreads() (r int8, exists bool) {
+	v := m.addkdf_threads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearKdfThreads clears the value of the "kdf_threads" field.
+func (m *KeyringMutation) ClearKdfThreads() {
+	m.kdf_threads = nil
+	m.addkdf_threads = nil
+	m.clearedFields[keyring.FieldKdfThreads] = struct{}{}
+}
+
+// KdfThreadsCleared returns if the "kdf_threads" field was cleared in this mutation.
+func (m *KeyringMutation) KdfThreadsCleared() bool {
+	_, ok := m.clearedFields[keyring.FieldKdfThreads]
+	return ok
+}
+
+// ResetKdfThreads resets all changes to the "kdf_threads" field.
+func (m *KeyringMutation) ResetKdfThreads() {
+	m.kdf_threads = nil
+	m.addkdf_threads = nil
+	delete(m.clearedFields, keyring.FieldKdfThreads)
+}
+
+// SetCheck sets the "check" field.
+func (m *KeyringMutation) SetCheck(b []byte) {
+	m.check = &b
+}
+
+// Check returns the value of the "check" field in the mutation.
+func (m *KeyringMutation) Check() (r []byte, exists bool) {
+	v := m.check
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheck returns the old "check" field's value of the Keyring entity.
+// If the Keyring object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyringMutation) OldCheck(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheck is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheck requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheck: %w", err)
+	}
+	return oldValue.Check, nil
+}
+
+// ResetCheck resets all changes to the "check" field.
+func (m *KeyringMutation) ResetCheck() {
+	m.check = nil
+}
+
+// SetKeysBound sets the "keys_bound" field.
+func (m *KeyringMutation) SetKeysBound(b bool) {
+	m.keys_bound = &b
+}
+
+// KeysBound returns the value of the "keys_bound" field in the mutation.
+func (m *KeyringMutation) KeysBound() (r bool, exists bool) {
+	v := m.keys_bound
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeysBound returns the old "keys_bound" field's value of the Keyring entity.
+// If the Keyring object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyringMutation) OldKeysBound(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeysBound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeysBound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeysBound: %w", err)
+	}
+	return oldValue.KeysBound, nil
+}
+
+// ResetKeysBound resets all changes to the "keys_bound" field.
+func (m *KeyringMutation) ResetKeysBound() {
+	m.keys_bound = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *KeyringMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *KeyringMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Keyring entity.
+// If the Keyring object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyringMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *KeyringMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *KeyringMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *KeyringMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Keyring entity.
+// If the Keyring object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyringMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *KeyringMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the KeyringMutation builder.
+func (m *KeyringMutation) Where(ps ...predicate.Keyring) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyringMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyringMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Keyring, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyringMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyringMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Keyring).
+func (m *KeyringMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyringMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.source != nil {
+		fields = append(fields, keyring.FieldSource)
+	}
+	if m.salt != nil {
+		fields = append(fields, keyring.FieldSalt)
+	}
+	if m.kdf_time != nil {
+		fields = append(fields, keyring.FieldKdfTime)
+	}
+	if m.kdf_memory != nil {
+		fields = append(fields, keyring.FieldKdfMemory)
+	}
+	if m.kdf_threads != nil {
+		fields = append(fields, keyring.FieldKdfThreads)
+	}
+	if m.check != nil {
+		fields = append(fields, keyring.FieldCheck)
+	}
+	if m.keys_bound != nil {
+		fields = append(fields, keyring.FieldKeysBound)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, keyring.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, keyring.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyringMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keyring.FieldSource:
+		return m.Source()
+	case keyring.FieldSalt:
+		return m.Salt()
+	case keyring.FieldKdfTime:
+		return m.KdfTime()
+	case keyring.FieldKdfMemory:
+		return m.KdfMemory()
+	case keyring.FieldKdfThreads:
+		return m.KdfThreads()
+	case keyring.FieldCheck:
+		return m.Check()
+	case keyring.FieldKeysBound:
+		return m.KeysBound()
+	case keyring.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case keyring.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyringMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keyring.FieldSource:
+		return m.OldSource(ctx)
+	case keyring.FieldSalt:
+		return m.OldSalt(ctx)
+	case keyring.FieldKdfTime:
+		return m.OldKdfTime(ctx)
+	case keyring.FieldKdfMemory:
+		return m.OldKdfMemory(ctx)
+	case keyring.FieldKdfThreads:
+		return m.OldKdfThreads(ctx)
+	case keyring.FieldCheck:
+		return m.OldCheck(ctx)
+	case keyring.FieldKeysBound:
+		return m.OldKeysBound(ctx)
+	case keyring.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case keyring.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Keyring field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyringMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keyring.FieldSource:
+		v, ok := value.(keyring.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case keyring.FieldSalt:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSalt(v)
+		return nil
+	case keyring.FieldKdfTime:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKdfTime(v)
+		return nil
+	case keyring.FieldKdfMemory:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKdfMemory(v)
+		return nil
+	case keyring.FieldKdfThreads:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKdfThreads(v)
+		return nil
+	case keyring.FieldCheck:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheck(v)
+		return nil
+	case keyring.FieldKeysBound:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeysBound(v)
+		return nil
+	case keyring.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case keyring.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Keyring field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyringMutation) AddedFields() []string {
+	var fields []string
+	if m.addkdf_time != nil {
+		fields = append(fields, keyring.FieldKdfTime)
+	}
+	if m.addkdf_memory != nil {
+		fields = append(fields, keyring.FieldKdfMemory)
+	}
+	if m.addkdf_threads != nil {
+		fields = append(fields, keyring.FieldKdfThreads)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyringMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case keyring.FieldKdfTime:
+		return m.AddedKdfTime()
+	case keyring.FieldKdfMemory:
+		return m.AddedKdfMemory()
+	case keyring.FieldKdfThreads:
+		return m.AddedKdfThreads()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyringMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case keyring.FieldKdfTime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKdfTime(v)
+		return nil
+	case keyring.FieldKdfMemory:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKdfMemory(v)
+		return nil
+	case keyring.FieldKdfThreads:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKdfThreads(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Keyring numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyringMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(keyring.FieldSalt) {
+		fields = append(fields, keyring.FieldSalt)
+	}
+	if m.FieldCleared(keyring.FieldKdfTime) {
+		fields = append(fields, keyring.FieldKdfTime)
+	}
+	if m.FieldCleared(keyring.FieldKdfMemory) {
+		fields = append(fields, keyring.FieldKdfMemory)
+	}
+	if m.FieldCleared(keyring.FieldKdfThreads) {
+		fields = append(fields, keyring.FieldKdfThreads)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyringMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyringMutation) ClearField(name string) error {
+	switch name {
+	case keyring.FieldSalt:
+		m.ClearSalt()
+		return nil
+	case keyring.FieldKdfTime:
+		m.ClearKdfTime()
+		return nil
+	case keyring.FieldKdfMemory:
+		m.ClearKdfMemory()
+		return nil
+	case keyring.FieldKdfThreads:
+		m.ClearKdfThreads()
+		return nil
+	}
+	return fmt.Errorf("unknown Keyring nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyringMutation) ResetField(name string) error {
+	switch name {
+	case keyring.FieldSource:
+		m.ResetSource()
+		return nil
+	case keyring.FieldSalt:
+		m.ResetSalt()
+		return nil
+	case keyring.FieldKdfTime:
+		m.ResetKdfTime()
+		return nil
+	case keyring.FieldKdfMemory:
+		m.ResetKdfMemory()
+		return nil
+	case keyring.FieldKdfThreads:
+		m.ResetKdfThreads()
+		return nil
+	case keyring.FieldCheck:
+		m.ResetCheck()
+		return nil
+	case keyring.FieldKeysBound:
+		m.ResetKeysBound()
+		return nil
+	case keyring.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case keyring.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Keyring field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyringMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyringMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyringMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyringMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyringMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyringMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyringMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Keyring unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyringMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Keyring edge %s", name)
+}
+
+// NamespaceMutation represents an operation that mutates the Namespace nodes in the graph.
+type NamespaceMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	desc                    *string
+	key_reveal_disabled     *bool
+	updated_at              *time.Time
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	certificates            map[int]struct{}
+	removedcertificates     map[int]struct{}
+	clearedcertificates     bool
+	signing_requests        map[int]struct{}
+	removedsigning_requests map[int]struct{}
+	clearedsigning_requests bool
+	done                    bool
+	oldValue                func(context.Context) (*Namespace, error)
+	predicates              []predicate.Namespace
+}
+
+var _ ent.Mutation = (*NamespaceMutation)(nil)
+
+// namespaceOption allows management of the mutation configuration using functional options.
+type namespaceOption func(*NamespaceMutation)
+
+// newNamespaceMutation creates new mutation for the Namespace entity.
+func newNamespaceMutation(c config, op Op, opts ...namespaceOption) *NamespaceMutation {
+	m := &NamespaceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNamespace,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNamespaceID sets the ID field of the mutation.
+func withNamespaceID(id int) namespaceOption {
+	return func(m *NamespaceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Namespace
+		)
+		m.oldValue = func(ctx context.Context) (*Namespace, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Namespace.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNamespace sets the old Namespace of the mutation.
+func withNamespace(node *Namespace) namespaceOption {
+	return func(m *NamespaceMutation) {
+		m.oldValue = func(context.Context) (*Namespace, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NamespaceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NamespaceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Namespace entities.
+func (m *NamespaceMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NamespaceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NamespaceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Namespace.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *NamespaceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NamespaceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NamespaceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDesc sets the "desc" field.
+func (m *NamespaceMutation) SetDesc(s string) {
+	m.desc = &s
+}
+
+// Desc returns the value of the "desc" field in the mutation.
+func (m *NamespaceMutation) Desc() (r string, exists bool) {
+	v := m.desc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDesc returns the old "desc" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceMutation) OldDesc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDesc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDesc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDesc: %w", err)
+	}
+	return oldValue.Desc, nil
+}
+
+// ClearDesc clears the value of the "desc" field.
+func (m *NamespaceMutation) ClearDesc() {
+	m.desc = nil
+	m.clearedFields[namespace.FieldDesc] = struct{}{}
+}
+
+// DescCleared returns if the "desc" field was cleared in this mutation.
+func (m *NamespaceMutation) DescCleared() bool {
+	_, ok := m.clearedFields[namespace.FieldDesc]
+	return ok
+}
+
+// ResetDesc resets all changes to the "desc" field.
+func (m *NamespaceMutation) ResetDesc() {
+	m.desc = nil
+	delete(m.clearedFields, namespace.FieldDesc)
+}
+
+// SetKeyRevealDisabled sets the "key_reveal_disabled" field.
+func (m *NamespaceMutation) SetKeyRevealDisabled(b bool) {
+	m.key_reveal_disabled = &b
+}
+
+// KeyRevealDisabled returns the value of the "key_reveal_disabled" field in the mutation.
+func (m *NamespaceMutation) KeyRevealDisabled() (r bool, exists bool) {
+	v := m.key_reveal_disabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyRevealDisabled returns the old "key_reveal_disabled" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceMutation) OldKeyRevealDisabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyRevealDisabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyRevealDisabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyRevealDisabled: %w", err)
+	}
+	return oldValue.KeyRevealDisabled, nil
+}
+
+// ResetKeyRevealDisabled resets all changes to the "key_reveal_disabled" field.
+func (m *NamespaceMutation) ResetKeyRevealDisabled() {
+	m.key_reveal_disabled = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NamespaceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NamespaceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NamespaceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NamespaceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NamespaceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NamespaceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddCertificateIDs adds the "certificates" edge to the Certificate entity by ids.
+func (m *NamespaceMutation) AddCertificateIDs(ids ...int) {
+	if m.certificates == nil {
+		m.certificates = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.certificates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCertificates clears the "certificates" edge to the Certificate entity.
+func (m *NamespaceMutation) ClearCertificates() {
+	m.clearedcertificates = true
+}
+
+// CertificatesCleared reports if the "certificates" edge to the Certificate entity was cleared.
+func (m *NamespaceMutation) CertificatesCleared() bool {
+	return m.clearedcertificates
+}
+
+// RemoveCertificateIDs removes the "certificates" edge to the Certificate entity by IDs.
+func (m *NamespaceMutation) RemoveCertificateIDs(ids ...int) {
+	if m.removedcertificates == nil {
+		m.removedcertificates = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.certificates, ids[i])
+		m.removedcertificates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCertificates returns the removed IDs of the "certificates" edge to the Certificate entity.
+func (m *NamespaceMutation) RemovedCertificatesIDs() (ids []int) {
+	for id := range m.removedcertificates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CertificatesIDs returns the "certificates" edge IDs in the mutation.
+func (m *NamespaceMutation) CertificatesIDs() (ids []int) {
+	for id := range m.certificates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCertificates resets all changes to the "certificates" edge.
+func (m *NamespaceMutation) ResetCertificates() {
+	m.certificates = nil
+	m.clearedcertificates = false
+	m.removedcertificates = nil
+}
+
+// AddSigningRequestIDs adds the "signing_requests" edge to the SigningRequest entity by ids.
+func (m *NamespaceMutation) AddSigningRequestIDs(ids ...int) {
+	if m.signing_requests == nil {
+		m.signing_requests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.signing_requests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSigningRequests clears the "signing_requests" edge to the SigningRequest entity.
+func (m *NamespaceMutation) ClearSigningRequests() {
+	m.clearedsigning_requests = true
+}
+
+// SigningRequestsCleared reports if the "signing_requests" edge to the SigningRequest entity was cleared.
+func (m *NamespaceMutation) SigningRequestsCleared() bool {
+	return m.clearedsigning_requests
+}
+
+// RemoveSigningRequestIDs removes the "signing_requests" edge to the SigningRequest entity by IDs.
+func (m *NamespaceMutation) RemoveSigningRequestIDs(ids ...int) {
+	if m.removedsigning_requests == nil {
+		m.removedsigning_requests = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.signing_requests, ids[i])
+		m.removedsigning_requests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSigningRequests returns the removed IDs of the "signing_requests" edge to the SigningRequest entity.
+func (m *NamespaceMutation) RemovedSigningRequestsIDs() (ids []int) {
+	for id := range m.removedsigning_requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SigningRequestsIDs returns the "signing_requests" edge IDs in the mutation.
+func (m *NamespaceMutation) SigningRequestsIDs() (ids []int) {
+	for id := range m.signing_requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSigningRequests resets all changes to the "signing_requests" edge.
+func (m *NamespaceMutation) ResetSigningRequests() {
+	m.signing_requests = nil
+	m.clearedsigning_requests = false
+	m.removedsigning_requests = nil
+}
+
+// Where appends a list predicates to the NamespaceMutation builder.
+func (m *NamespaceMutation) Where(ps ...predicate.Namespace) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NamespaceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Namespace, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NamespaceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NamespaceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Namespace).
+func (m *NamespaceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NamespaceMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, namespace.FieldName)
+	}
+	if m.desc != nil {
+		fields = append(fields, namespace.FieldDesc)
+	}
+	if m.key_reveal_disabled != nil {
+		fields = append(fields, namespace.FieldKeyRevealDisabled)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, namespace.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, namespace.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NamespaceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case namespace.FieldName:
+		return m.Name()
+	case namespace.FieldDesc:
+		return m.Desc()
+	case namespace.FieldKeyRevealDisabled:
+		return m.KeyRevealDisabled()
+	case namespace.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case namespace.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case namespace.FieldName:
+		return m.OldName(ctx)
+	case namespace.FieldDesc:
+		return m.OldDesc(ctx)
+	case namespace.FieldKeyRevealDisabled:
+		return m.OldKeyRevealDisabled(ctx)
+	case namespace.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case namespace.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Namespace field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NamespaceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case namespace.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case namespace.FieldDesc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDesc(v)
+		return nil
+	case namespace.FieldKeyRevealDisabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyRevealDisabled(v)
+		return nil
+	case namespace.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case namespace.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Namespace field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NamespaceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NamespaceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NamespaceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Namespace numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NamespaceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(namespace.FieldDesc) {
+		fields = append(fields, namespace.FieldDesc)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NamespaceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NamespaceMutation) ClearField(name string) error {
+	switch name {
+	case namespace.FieldDesc:
+		m.ClearDesc()
+		return nil
+	}
+	return fmt.Errorf("unknown Namespace nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NamespaceMutation) ResetField(name string) error {
+	switch name {
+	case namespace.FieldName:
+		m.ResetName()
+		return nil
+	case namespace.FieldDesc:
+		m.ResetDesc()
+		return nil
+	case namespace.FieldKeyRevealDisabled:
+		m.ResetKeyRevealDisabled()
+		return nil
+	case namespace.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case namespace.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Namespace field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NamespaceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.certificates != nil {
+		edges = append(edges, namespace.EdgeCertificates)
+	}
+	if m.signing_requests != nil {
+		edges = append(edges, namespace.EdgeSigningRequests)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NamespaceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case namespace.EdgeCertificates:
+		ids := make([]ent.Value, 0, len(m.certificates))
+		for id := range m.certificates {
+			ids = append(ids, id)
+		}
+		return ids
+	case namespace.EdgeSigningRequests:
+		ids := make([]ent.Value, 0, len(m.signing_requests))
+		for id := range m.signing_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NamespaceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcertificates != nil {
+		edges = append(edges, namespace.EdgeCertificates)
+	}
+	if m.removedsigning_requests != nil {
+		edges = append(edges, namespace.EdgeSigningRequests)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NamespaceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case namespace.EdgeCertificates:
+		ids := make([]ent.Value, 0, len(m.removedcertificates))
+		for id := range m.removedcertificates {
+			ids = append(ids, id)
+		}
+		return ids
+	case namespace.EdgeSigningRequests:
+		ids := make([]ent.Value, 0, len(m.removedsigning_requests))
+		for id := range m.removedsigning_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NamespaceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcertificates {
+		edges = append(edges, namespace.EdgeCertificates)
+	}
+	if m.clearedsigning_requests {
+		edges = append(edges, namespace.EdgeSigningRequests)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NamespaceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case namespace.EdgeCertificates:
+		return m.clearedcertificates
+	case namespace.EdgeSigningRequests:
+		return m.clearedsigning_requests
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NamespaceMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Namespace unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NamespaceMutation) ResetEdge(name string) error {
+	switch name {
+	case namespace.EdgeCertificates:
+		m.ResetCertificates()
+		return nil
+	case namespace.EdgeSigningRequests:
+		m.ResetSigningRequests()
+		return nil
+	}
+	return fmt.Errorf("unknown Namespace edge %s", name)
+}
+
+// RenewalAttemptMutation represents an operation that mutates the RenewalAttempt nodes in the graph.
+type RenewalAttemptMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	certificate_id        *int
+	addcertificate_id     *int
+	namespace_id          *int
+	addnamespace_id       *int
+	rekey                 *bool
+	status                *renewalattempt.Status
+	error                 *string
+	signing_request_id    *int
+	addsigning_request_id *int
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	policy                *int
+	clearedpolicy         bool
+	done                  bool
+	oldValue              func(context.Context) (*RenewalAttempt, error)
+	predicates            []predicate.RenewalAttempt
+}
+
+var _ ent.Mutation = (*RenewalAttemptMutation)(nil)
+
+// renewalattemptOption allows management of the mutation configuration using functional options.
+type renewalattemptOption func(*RenewalAttemptMutation)
+
+// newRenewalAttemptMutation creates new mutation for the RenewalAttempt entity.
+func newRenewalAttemptMutation(c config, op Op, opts ...renewalattemptOption) *RenewalAttemptMutation {
+	m := &RenewalAttemptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRenewalAttempt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRenewalAttemptID sets the ID field of the mutation.
+func withRenewalAttemptID(id int) renewalattemptOption {
+	return func(m *RenewalAttemptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RenewalAttempt
+		)
+		m.oldValue = func(ctx context.Context) (*RenewalAttempt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RenewalAttempt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRenewalAttempt sets the old RenewalAttempt of the mutation.
+func withRenewalAttempt(node *RenewalAttempt) renewalattemptOption {
+	return func(m *RenewalAttemptMutation) {
+		m.oldValue = func(context.Context) (*RenewalAttempt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RenewalAttemptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RenewalAttemptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RenewalAttempt entities.
+func (m *RenewalAttemptMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RenewalAttemptMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RenewalAttemptMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RenewalAttempt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (m *RenewalAttemptMutation) SetPolicyID(i int) {
+	m.policy = &i
+}
+
+// PolicyID returns the value of the "policy_id" field in the mutation.
+func (m *RenewalAttemptMutation) PolicyID() (r int, exists bool) {
+	v := m.policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyID returns the old "policy_id" field's value of the RenewalAttempt entity.
+// If the RenewalAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalAttemptMutation) OldPolicyID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyID: %w", err)
+	}
+	return oldValue.PolicyID, nil
+}
+
+// ResetPolicyID resets all changes to the "policy_id" field.
+func (m *RenewalAttemptMutation) ResetPolicyID() {
+	m.policy = nil
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (m *RenewalAttemptMutation) SetCertificateID(i int) {
+	m.certificate_id = &i
+	m.addcertificate_id = nil
+}
+
+// CertificateID returns the value of the "certificate_id" field in the mutation.
+func (m *RenewalAttemptMutation) CertificateID() (r int, exists bool) {
+	v := m.certificate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateID returns the old "certificate_id" field's value of the RenewalAttempt entity.
+// If the RenewalAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalAttemptMutation) OldCertificateID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateID: %w", err)
+	}
+	return oldValue.CertificateID, nil
+}
+
+// AddCertificateID adds i to the "certificate_id" field.
+func (m *RenewalAttemptMutation) AddCertificateID(i int) {
+	if m.addcertificate_id != nil {
+		*m.addcertificate_id += i
+	} else {
+		m.addcertificate_id = &i
+	}
+}
+
+// AddedCertificateID returns the value that was added to the "certificate_id" field in this mutation.
+func (m *RenewalAttemptMutation) AddedCertificateID() (r int, exists bool) {
+	v := m.addcertificate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCertificateID resets all changes to the "certificate_id" field.
+func (m *RenewalAttemptMutation) ResetCertificateID() {
+	m.certificate_id = nil
+	m.addcertificate_id = nil
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (m *RenewalAttemptMutation) SetNamespaceID(i int) {
+	m.namespace_id = &i
+	m.addnamespace_id = nil
+}
+
+// NamespaceID returns the value of the "namespace_id" field in the mutation.
+func (m *RenewalAttemptMutation) NamespaceID() (r int, exists bool) {
+	v := m.namespace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespaceID returns the old "namespace_id" field's value of the RenewalAttempt entity.
+// If the RenewalAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalAttemptMutation) OldNamespaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespaceID: %w", err)
+	}
+	return oldValue.NamespaceID, nil
+}
+
+// AddNamespaceID adds i to the "namespace_id" field.
+func (m *RenewalAttemptMutation) AddNamespaceID(i int) {
+	if m.addnamespace_id != nil {
+		*m.addnamespace_id += i
+	} else {
+		m.addnamespace_id = &i
+	}
+}
+
+// AddedNamespaceID returns the value that was added to the "namespace_id" field in this mutation.
+func (m *RenewalAttemptMutation) AddedNamespaceID() (r int, exists bool) {
+	v := m.addnamespace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNamespaceID resets all changes to the "namespace_id" field.
+func (m *RenewalAttemptMutation) ResetNamespaceID() {
+	m.namespace_id = nil
+	m.addnamespace_id = nil
+}
+
+// SetRekey sets the "rekey" field.
+func (m *RenewalAttemptMutation) SetRekey(b bool) {
+	m.rekey = &b
+}
+
+// Rekey returns the value of the "rekey" field in the mutation.
+func (m *RenewalAttemptMutation) Rekey() (r bool, exists bool) {
+	v := m.rekey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRekey returns the old "rekey" field's value of the RenewalAttempt entity.
+// If the RenewalAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalAttemptMutation) OldRekey(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRekey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRekey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRekey: %w", err)
+	}
+	return oldValue.Rekey, nil
+}
+
+// ResetRekey resets all changes to the "rekey" field.
+func (m *RenewalAttemptMutation) ResetRekey() {
+	m.rekey = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *RenewalAttemptMutation) SetStatus(r renewalattempt.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RenewalAttemptMutation) Status() (r renewalattempt.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RenewalAttempt entity.
+// If the RenewalAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalAttemptMutation) OldStatus(ctx context.Context) (v renewalattempt.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RenewalAttemptMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *RenewalAttemptMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *RenewalAttemptMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the RenewalAttempt entity.
+// If the RenewalAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalAttemptMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *RenewalAttemptMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[renewalattempt.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *RenewalAttemptMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[renewalattempt.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *RenewalAttemptMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, renewalattempt.FieldError)
+}
+
+// SetSigningRequestID sets the "signing_request_id" field.
+func (m *RenewalAttemptMutation) SetSigningRequestID(i int) {
+	m.signing_request_id = &i
+	m.addsigning_request_id = nil
+}
+
+// SigningRequestID returns the value of the "signing_request_id" field in the mutation.
+func (m *RenewalAttemptMutation) SigningRequestID() (r int, exists bool) {
+	v := m.signing_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigningRequestID returns the old "signing_request_id" field's value of the RenewalAttempt entity.
+// If the RenewalAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalAttemptMutation) OldSigningRequestID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigningRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigningRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigningRequestID: %w", err)
+	}
+	return oldValue.SigningRequestID, nil
+}
+
+// AddSigningRequestID adds i to the "signing_request_id" field.
+func (m *RenewalAttemptMutation) AddSigningRequestID(i int) {
+	if m.addsigning_request_id != nil {
+		*m.addsigning_request_id += i
+	} else {
+		m.addsigning_request_id = &i
+	}
+}
+
+// AddedSigningRequestID returns the value that was added to the "signing_request_id" field in this mutation.
+func (m *RenewalAttemptMutation) AddedSigningRequestID() (r int, exists bool) {
+	v := m.addsigning_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSigningRequestID clears the value of the "signing_request_id" field.
+func (m *RenewalAttemptMutation) ClearSigningRequestID() {
+	m.signing_request_id = nil
+	m.addsigning_request_id = nil
+	m.clearedFields[renewalattempt.FieldSigningRequestID] = struct{}{}
+}
+
+// SigningRequestIDCleared returns if the "signing_request_id" field was cleared in this mutation.
+func (m *RenewalAttemptMutation) SigningRequestIDCleared() bool {
+	_, ok := m.clearedFields[renewalattempt.FieldSigningRequestID]
+	return ok
+}
+
+// ResetSigningRequestID resets all changes to the "signing_request_id" field.
+func (m *RenewalAttemptMutation) ResetSigningRequestID() {
+	m.signing_request_id = nil
+	m.addsigning_request_id = nil
+	delete(m.clearedFields, renewalattempt.FieldSigningRequestID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RenewalAttemptMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RenewalAttemptMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RenewalAttempt entity.
+// If the RenewalAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalAttemptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RenewalAttemptMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearPolicy clears the "policy" edge to the RenewalPolicy entity.
+func (m *RenewalAttemptMutation) ClearPolicy() {
+	m.clearedpolicy = true
+	m.clearedFields[renewalattempt.FieldPolicyID] = struct{}{}
+}
+
+// PolicyCleared reports if the "policy" edge to the RenewalPolicy entity was cleared.
+func (m *RenewalAttemptMutation) PolicyCleared() bool {
+	return m.clearedpolicy
+}
+
+// PolicyIDs returns the "policy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PolicyID instead. It exists only for internal usage by the builders.
+func (m *RenewalAttemptMutation) PolicyIDs() (ids []int) {
+	if id := m.policy; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPolicy resets all changes to the "policy" edge.
+func (m *RenewalAttemptMutation) ResetPolicy() {
+	m.policy = nil
+	m.clearedpolicy = false
+}
+
+// Where appends a list predicates to the RenewalAttemptMutation builder.
+func (m *RenewalAttemptMutation) Where(ps ...predicate.RenewalAttempt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RenewalAttemptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RenewalAttemptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RenewalAttempt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RenewalAttemptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RenewalAttemptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RenewalAttempt).
+func (m *RenewalAttemptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RenewalAttemptMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.policy != nil {
+		fields = append(fields, renewalattempt.FieldPolicyID)
+	}
+	if m.certificate_id != nil {
+		fields = append(fields, renewalattempt.FieldCertificateID)
+	}
+	if m.namespace_id != nil {
+		fields = append(fields, renewalattempt.FieldNamespaceID)
+	}
+	if m.rekey != nil {
+		fields = append(fields, renewalattempt.FieldRekey)
+	}
+	if m.status != nil {
+		fields = append(fields, renewalattempt.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, renewalattempt.FieldError)
+	}
+	if m.signing_request_id != nil {
+		fields = append(fields, renewalattempt.FieldSigningRequestID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, renewalattempt.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RenewalAttemptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case renewalattempt.FieldPolicyID:
+		return m.PolicyID()
+	case renewalattempt.FieldCertificateID:
+		return m.CertificateID()
+	case renewalattempt.FieldNamespaceID:
+		return m.NamespaceID()
+	case renewalattempt.FieldRekey:
+		return m.Rekey()
+	case renewalattempt.FieldStatus:
+		return m.Status()
+	case renewalattempt.FieldError:
+		return m.Error()
+	case renewalattempt.FieldSigningRequestID:
+		return m.SigningRequestID()
+	case renewalattempt.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RenewalAttemptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case renewalattempt.FieldPolicyID:
+		return m.OldPolicyID(ctx)
+	case renewalattempt.FieldCertificateID:
+		return m.OldCertificateID(ctx)
+	case renewalattempt.FieldNamespaceID:
+		return m.OldNamespaceID(ctx)
+	case renewalattempt.FieldRekey:
+		return m.OldRekey(ctx)
+	case renewalattempt.FieldStatus:
+		return m.OldStatus(ctx)
+	case renewalattempt.FieldError:
+		return m.OldError(ctx)
+	case renewalattempt.FieldSigningRequestID:
+		return m.OldSigningRequestID(ctx)
+	case renewalattempt.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RenewalAttempt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RenewalAttemptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case renewalattempt.FieldPolicyID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyID(v)
+		return nil
+	case renewalattempt.FieldCertificateID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateID(v)
+		return nil
+	case renewalattempt.FieldNamespaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespaceID(v)
+		return nil
+	case renewalattempt.FieldRekey:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRekey(v)
+		return nil
+	case renewalattempt.FieldStatus:
+		v, ok := value.(renewalattempt.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case renewalattempt.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case renewalattempt.FieldSigningRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigningRequestID(v)
+		return nil
+	case renewalattempt.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalAttempt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RenewalAttemptMutation) AddedFields() []string {
+	var fields []string
+	if m.addcertificate_id != nil {
+		fields = append(fields, renewalattempt.FieldCertificateID)
+	}
+	if m.addnamespace_id != nil {
+		fields = append(fields, renewalattempt.FieldNamespaceID)
+	}
+	if m.addsigning_request_id != nil {
+		fields = append(fields, renewalattempt.FieldSigningRequestID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RenewalAttemptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case renewalattempt.FieldCertificateID:
+		return m.AddedCertificateID()
+	case renewalattempt.FieldNamespaceID:
+		return m.AddedNamespaceID()
+	case renewalattempt.FieldSigningRequestID:
+		return m.AddedSigningRequestID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RenewalAttemptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case renewalattempt.FieldCertificateID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCertificateID(v)
+		return nil
+	case renewalattempt.FieldNamespaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNamespaceID(v)
+		return nil
+	case renewalattempt.FieldSigningRequestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSigningRequestID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalAttempt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RenewalAttemptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(renewalattempt.FieldError) {
+		fields = append(fields, renewalattempt.FieldError)
+	}
+	if m.FieldCleared(renewalattempt.FieldSigningRequestID) {
+		fields = append(fields, renewalattempt.FieldSigningRequestID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RenewalAttemptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RenewalAttemptMutation) ClearField(name string) error {
+	switch name {
+	case renewalattempt.FieldError:
+		m.ClearError()
+		return nil
+	case renewalattempt.FieldSigningRequestID:
+		m.ClearSigningRequestID()
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalAttempt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RenewalAttemptMutation) ResetField(name string) error {
+	switch name {
+	case renewalattempt.FieldPolicyID:
+		m.ResetPolicyID()
+		return nil
+	case renewalattempt.FieldCertificateID:
+		m.ResetCertificateID()
+		return nil
+	case renewalattempt.FieldNamespaceID:
+		m.ResetNamespaceID()
+		return nil
+	case renewalattempt.FieldRekey:
+		m.ResetRekey()
+		return nil
+	case renewalattempt.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case renewalattempt.FieldError:
+		m.ResetError()
+		return nil
+	case renewalattempt.FieldSigningRequestID:
+		m.ResetSigningRequestID()
+		return nil
+	case renewalattempt.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalAttempt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RenewalAttemptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.policy != nil {
+		edges = append(edges, renewalattempt.EdgePolicy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RenewalAttemptMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case renewalattempt.EdgePolicy:
+		if id := m.policy; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RenewalAttemptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RenewalAttemptMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RenewalAttemptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpolicy {
+		edges = append(edges, renewalattempt.EdgePolicy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RenewalAttemptMutation) EdgeCleared(name string) bool {
+	switch name {
+	case renewalattempt.EdgePolicy:
+		return m.clearedpolicy
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RenewalAttemptMutation) ClearEdge(name string) error {
+	switch name {
+	case renewalattempt.EdgePolicy:
+		m.ClearPolicy()
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalAttempt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RenewalAttemptMutation) ResetEdge(name string) error {
+	switch name {
+	case renewalattempt.EdgePolicy:
+		m.ResetPolicy()
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalAttempt edge %s", name)
+}
+
+// RenewalPolicyMutation represents an operation that mutates the RenewalPolicy nodes in the graph.
+type RenewalPolicyMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	trigger               *renewalpolicy.Trigger
+	remaining_fraction    *float64
+	addremaining_fraction *float64
+	interval_days         *int
+	addinterval_days      *int
+	rekey                 *bool
+	valid_days            *int
+	addvalid_days         *int
+	enabled               *bool
+	failures              *int
+	addfailures           *int
+	last_error            *string
+	next_attempt_at       *time.Time
+	updated_at            *time.Time
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	certificate           *int
+	clearedcertificate    bool
+	attempts              map[int]struct{}
+	removedattempts       map[int]struct{}
+	clearedattempts       bool
+	done                  bool
+	oldValue              func(context.Context) (*RenewalPolicy, error)
+	predicates            []predicate.RenewalPolicy
+}
+
+var _ ent.Mutation = (*RenewalPolicyMutation)(nil)
+
+// renewalpolicyOption allows management of the mutation configuration using functional options.
+type renewalpolicyOption func(*RenewalPolicyMutation)
+
+// newRenewalPolicyMutation creates new mutation for the RenewalPolicy entity.
+func newRenewalPolicyMutation(c config, op Op, opts ...renewalpolicyOption) *RenewalPolicyMutation {
+	m := &RenewalPolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRenewalPolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRenewalPolicyID sets the ID field of the mutation.
+func withRenewalPolicyID(id int) renewalpolicyOption {
+	return func(m *RenewalPolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RenewalPolicy
+		)
+		m.oldValue = func(ctx context.Context) (*RenewalPolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RenewalPolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRenewalPolicy sets the old RenewalPolicy of the mutation.
+func withRenewalPolicy(node *RenewalPolicy) renewalpolicyOption {
+	return func(m *RenewalPolicyMutation) {
+		m.oldValue = func(context.Context) (*RenewalPolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RenewalPolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RenewalPolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RenewalPolicy entities.
+func (m *RenewalPolicyMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RenewalPolicyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RenewalPolicyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RenewalPolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (m *RenewalPolicyMutation) SetCertificateID(i int) {
+	m.certificate = &i
+}
+
+// CertificateID returns the value of the "certificate_id" field in the mutation.
+func (m *RenewalPolicyMutation) CertificateID() (r int, exists bool) {
+	v := m.certificate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateID returns the old "certificate_id" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldCertificateID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateID: %w", err)
+	}
+	return oldValue.CertificateID, nil
+}
+
+// ResetCertificateID resets all changes to the "certificate_id" field.
+func (m *RenewalPolicyMutation) ResetCertificateID() {
+	m.certificate = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *RenewalPolicyMutation) SetTrigger(r renewalpolicy.Trigger) {
+	m.trigger = &r
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *RenewalPolicyMutation) Trigger() (r renewalpolicy.Trigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldTrigger(ctx context.Context) (v renewalpolicy.Trigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *RenewalPolicyMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetRemainingFraction sets the "remaining_fraction" field.
+func (m *RenewalPolicyMutation) SetRemainingFraction(f float64) {
+	m.remaining_fraction = &f
+	m.addremaining_fraction = nil
+}
+
+// RemainingFraction returns the value of the "remaining_fraction" field in the mutation.
+func (m *RenewalPolicyMutation) RemainingFraction() (r float64, exists bool) {
+	v := m.remaining_fraction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemainingFraction returns the old "remaining_fraction" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldRemainingFraction(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemainingFraction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemainingFraction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemainingFraction: %w", err)
+	}
+	return oldValue.RemainingFraction, nil
+}
+
+// AddRemainingFraction adds f to the "remaining_fraction" field.
+func (m *RenewalPolicyMutation) AddRemainingFraction(f float64) {
+	if m.addremaining_fraction != nil {
+		*m.addremaining_fraction += f
+	} else {
+		m.addremaining_fraction = &f
+	}
+}
+
+// AddedRemainingFraction returns the value that was added to the "remaining_fraction" field in this mutation.
+func (m *RenewalPolicyMutation) AddedRemainingFraction() (r float64, exists bool) {
+	v := m.addremaining_fraction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRemainingFraction clears the value of the "remaining_fraction" field.
+func (m *RenewalPolicyMutation) ClearRemainingFraction() {
+	m.remaining_fraction = nil
+	m.addremaining_fraction = nil
+	m.clearedFields[renewalpolicy.FieldRemainingFraction] = struct{}{}
+}
+
+// RemainingFractionCleared returns if the "remaining_fraction" field was cleared in this mutation.
+func (m *RenewalPolicyMutation) RemainingFractionCleared() bool {
+	_, ok := m.clearedFields[renewalpolicy.FieldRemainingFraction]
+	return ok
+}
+
+// ResetRemainingFraction resets all changes to the "remaining_fraction" field.
+func (m *RenewalPolicyMutation) ResetRemainingFraction() {
+	m.remaining_fraction = nil
+	m.addremaining_fraction = nil
+	delete(m.clearedFields, renewalpolicy.FieldRemainingFraction)
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (m *RenewalPolicyMutation) SetIntervalDays(i int) {
+	m.interval_days = &i
+	m.addinterval_days = nil
+}
+
+// IntervalDays returns the value of the "interval_days" field in the mutation.
+func (m *RenewalPolicyMutation) IntervalDays() (r int, exists bool) {
+	v := m.interval_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntervalDays returns the old "interval_days" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldIntervalDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntervalDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntervalDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntervalDays: %w", err)
+	}
+	return oldValue.IntervalDays, nil
+}
+
+// AddIntervalDays adds i to the "interval_days" field.
+func (m *RenewalPolicyMutation) AddIntervalDays(i int) {
+	if m.addinterval_days != nil {
+		*m.addinterval_days += i
+	} else {
+		m.addinterval_days = &i
+	}
+}
+
+// AddedIntervalDays returns the value that was added to the "interval_days" field in this mutation.
+func (m *RenewalPolicyMutation) AddedIntervalDays() (r int, exists bool) {
+	v := m.addinterval_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIntervalDays clears the value of the "interval_days" field.
+func (m *RenewalPolicyMutation) ClearIntervalDays() {
+	m.interval_days = nil
+	m.addinterval_days = nil
+	m.clearedFields[renewalpolicy.FieldIntervalDays] = struct{}{}
+}
+
+// IntervalDaysCleared returns if the "interval_days" field was cleared in this mutation.
+func (m *RenewalPolicyMutation) IntervalDaysCleared() bool {
+	_, ok := m.clearedFields[renewalpolicy.FieldIntervalDays]
+	return ok
+}
+
+// ResetIntervalDays resets all changes to the "interval_days" field.
+func (m *RenewalPolicyMutation) ResetIntervalDays() {
+	m.interval_days = nil
+	m.addinterval_days = nil
+	delete(m.clearedFields, renewalpolicy.FieldIntervalDays)
+}
+
+// SetRekey sets the "rekey" field.
+func (m *RenewalPolicyMutation) SetRekey(b bool) {
+	m.rekey = &b
+}
+
+// Rekey returns the value of the "rekey" field in the mutation.
+func (m *RenewalPolicyMutation) Rekey() (r bool, exists bool) {
+	v := m.rekey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRekey returns the old "rekey" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldRekey(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRekey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRekey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRekey: %w", err)
+	}
+	return oldValue.Rekey, nil
+}
+
+// ResetRekey resets all changes to the "rekey" field.
+func (m *RenewalPolicyMutation) ResetRekey() {
+	m.rekey = nil
+}
+
+// SetValidDays sets the "valid_days" field.
+func (m *RenewalPolicyMutation) SetValidDays(i int) {
+	m.valid_days = &i
+	m.addvalid_days = nil
+}
+
+// ValidDays returns the value of the "valid_days" field in the mutation.
+func (m *RenewalPolicyMutation) ValidDays() (r int, exists bool) {
+	v := m.valid_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidDays returns the old "valid_days" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldValidDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidDays: %w", err)
+	}
+	return oldValue.ValidDays, nil
+}
+
+// AddValidDays adds i to the "valid_days" field.
+func (m *RenewalPolicyMutation) AddValidDays(i int) {
+	if m.addvalid_days != nil {
+		*m.addvalid_days += i
+	} else {
+		m.addvalid_days = &i
+	}
+}
+
+// AddedValidDays returns the value that was added to the "valid_days" field in this mutation.
+func (m *RenewalPolicyMutation) AddedValidDays() (r int, exists bool) {
+	v := m.addvalid_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearValidDays clears the value of the "valid_days" field.
+func (m *RenewalPolicyMutation) ClearValidDays() {
+	m.valid_days = nil
+	m.addvalid_days = nil
+	m.clearedFields[renewalpolicy.FieldValidDays] = struct{}{}
+}
+
+// ValidDaysCleared returns if the "valid_days" field was cleared in this mutation.
+func (m *RenewalPolicyMutation) ValidDaysCleared() bool {
+	_, ok := m.clearedFields[renewalpolicy.FieldValidDays]
+	return ok
+}
+
+// ResetValidDays resets all changes to the "valid_days" field.
+func (m *RenewalPolicyMutation) ResetValidDays() {
+	m.valid_days = nil
+	m.addvalid_days = nil
+	delete(m.clearedFields, renewalpolicy.FieldValidDays)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *RenewalPolicyMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *RenewalPolicyMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *RenewalPolicyMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetFailures sets the "failures" field.
+func (m *RenewalPolicyMutation) SetFailures(i int) {
+	m.failures = &i
+	m.addfailures = nil
+}
+
+// Failures returns the value of the "failures" field in the mutation.
+func (m *RenewalPolicyMutation) Failures() (r int, exists bool) {
+	v := m.failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailures returns the old "failures" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailures: %w", err)
+	}
+	return oldValue.Failures, nil
+}
+
+// AddFailures adds i to the "failures" field.
+func (m *RenewalPolicyMutation) AddFailures(i int) {
+	if m.addfailures != nil {
+		*m.addfailures += i
+	} else {
+		m.addfailures = &i
+	}
+}
+
+// AddedFailures returns the value that was added to the "failures" field in this mutation.
+func (m *RenewalPolicyMutation) AddedFailures() (r int, exists bool) {
+	v := m.addfailures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailures resets all changes to the "failures" field.
+func (m *RenewalPolicyMutation) ResetFailures() {
+	m.failures = nil
+	m.addfailures = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *RenewalPolicyMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *RenewalPolicyMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *RenewalPolicyMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[renewalpolicy.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *RenewalPolicyMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[renewalpolicy.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *RenewalPolicyMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, renewalpolicy.FieldLastError)
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *RenewalPolicyMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *RenewalPolicyMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *RenewalPolicyMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[renewalpolicy.FieldNextAttemptAt] = struct{}{}
+}
+
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *RenewalPolicyMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[renewalpolicy.FieldNextAttemptAt]
+	return ok
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *RenewalPolicyMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, renewalpolicy.FieldNextAttemptAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RenewalPolicyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RenewalPolicyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RenewalPolicyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RenewalPolicyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RenewalPolicyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RenewalPolicy entity.
+// If the RenewalPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenewalPolicyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RenewalPolicyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (m *RenewalPolicyMutation) ClearCertificate() {
+	m.clearedcertificate = true
+	m.clearedFields[renewalpolicy.FieldCertificateID] = struct{}{}
+}
+
+// CertificateCleared reports if the "certificate" edge to the Certificate entity was cleared.
+func (m *RenewalPolicyMutation) CertificateCleared() bool {
+	return m.clearedcertificate
+}
+
+// CertificateIDs returns the "certificate" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CertificateID instead. It exists only for internal usage by the builders.
+func (m *RenewalPolicyMutation) CertificateIDs() (ids []int) {
+	if id := m.certificate; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCertificate resets all changes to the "certificate" edge.
+func (m *RenewalPolicyMutation) ResetCertificate() {
+	m.certificate = nil
+	m.clearedcertificate = false
+}
+
+// AddAttemptIDs adds the "attempts" edge to the RenewalAttempt entity by ids.
+func (m *RenewalPolicyMutation) AddAttemptIDs(ids ...int) {
+	if m.attempts == nil {
+		m.attempts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.attempts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttempts clears the "attempts" edge to the RenewalAttempt entity.
+func (m *RenewalPolicyMutation) ClearAttempts() {
+	m.clearedattempts = true
+}
+
+// AttemptsCleared reports if the "attempts" edge to the RenewalAttempt entity was cleared.
+func (m *RenewalPolicyMutation) AttemptsCleared() bool {
+	return m.clearedattempts
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to the RenewalAttempt entity by IDs.
+func (m *RenewalPolicyMutation) RemoveAttemptIDs(ids ...int) {
+	if m.removedattempts == nil {
+		m.removedattempts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.attempts, ids[i])
+		m.removedattempts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttempts returns the removed IDs of the "attempts" edge to the RenewalAttempt entity.
+func (m *RenewalPolicyMutation) RemovedAttemptsIDs() (ids []int) {
+	for id := range m.removedattempts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttemptsIDs returns the "attempts" edge IDs in the mutation.
+func (m *RenewalPolicyMutation) AttemptsIDs() (ids []int) {
+	for id := range m.attempts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttempts resets all changes to the "attempts" edge.
+func (m *RenewalPolicyMutation) ResetAttempts() {
+	m.attempts = nil
+	m.clearedattempts = false
+	m.removedattempts = nil
+}
+
+// Where appends a list predicates to the RenewalPolicyMutation builder.
+func (m *RenewalPolicyMutation) Where(ps ...predicate.RenewalPolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RenewalPolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RenewalPolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RenewalPolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RenewalPolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RenewalPolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RenewalPolicy).
+func (m *RenewalPolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RenewalPolicyMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.certificate != nil {
+		fields = append(fields, renewalpolicy.FieldCertificateID)
+	}
+	if m.trigger != nil {
+		fields = append(fields, renewalpolicy.FieldTrigger)
+	}
+	if m.remaining_fraction != nil {
+		fields = append(fields, renewalpolicy.FieldRemainingFraction)
+	}
+	if m.interval_days != nil {
+		fields = append(fields, renewalpolicy.FieldIntervalDays)
+	}
+	if m.rekey != nil {
+		fields = append(fields, renewalpolicy.FieldRekey)
+	}
+	if m.valid_days != nil {
+		fields = append(fields, renewalpolicy.FieldValidDays)
+	}
+	if m.enabled != nil {
+		fields = append(fields, renewalpolicy.FieldEnabled)
+	}
+	if m.failures != nil {
+		fields = append(fields, renewalpolicy.FieldFailures)
+	}
+	if m.last_error != nil {
+		fields = append(fields, renewalpolicy.FieldLastError)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, renewalpolicy.FieldNextAttemptAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, renewalpolicy.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, renewalpolicy.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RenewalPolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case renewalpolicy.FieldCertificateID:
+		return m.CertificateID()
+	case renewalpolicy.FieldTrigger:
+		return m.Trigger()
+	case renewalpolicy.FieldRemainingFraction:
+		return m.RemainingFraction()
+	case renewalpolicy.FieldIntervalDays:
+		return m.IntervalDays()
+	case renewalpolicy.FieldRekey:
+		return m.Rekey()
+	case renewalpolicy.FieldValidDays:
+		return m.ValidDays()
+	case renewalpolicy.FieldEnabled:
+		return m.Enabled()
+	case renewalpolicy.FieldFailures:
+		return m.Failures()
+	case renewalpolicy.FieldLastError:
+		return m.LastError()
+	case renewalpolicy.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case renewalpolicy.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case renewalpolicy.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RenewalPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case renewalpolicy.FieldCertificateID:
+		return m.OldCertificateID(ctx)
+	case renewalpolicy.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case renewalpolicy.FieldRemainingFraction:
+		return m.OldRemainingFraction(ctx)
+	case renewalpolicy.FieldIntervalDays:
+		return m.OldIntervalDays(ctx)
+	case renewalpolicy.FieldRekey:
+		return m.OldRekey(ctx)
+	case renewalpolicy.FieldValidDays:
+		return m.OldValidDays(ctx)
+	case renewalpolicy.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case renewalpolicy.FieldFailures:
+		return m.OldFailures(ctx)
+	case renewalpolicy.FieldLastError:
+		return m.OldLastError(ctx)
+	case renewalpolicy.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case renewalpolicy.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case renewalpolicy.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RenewalPolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RenewalPolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case renewalpolicy.FieldCertificateID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateID(v)
+		return nil
+	case renewalpolicy.FieldTrigger:
+		v, ok := value.(renewalpolicy.Trigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case renewalpolicy.FieldRemainingFraction:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemainingFraction(v)
+		return nil
+	case renewalpolicy.FieldIntervalDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntervalDays(v)
+		return nil
+	case renewalpolicy.FieldRekey:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRekey(v)
+		return nil
+	case renewalpolicy.FieldValidDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidDays(v)
+		return nil
+	case renewalpolicy.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case renewalpolicy.FieldFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailures(v)
+		return nil
+	case renewalpolicy.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case renewalpolicy.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case renewalpolicy.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case renewalpolicy.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalPolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RenewalPolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addremaining_fraction != nil {
+		fields = append(fields, renewalpolicy.FieldRemainingFraction)
+	}
+	if m.addinterval_days != nil {
+		fields = append(fields, renewalpolicy.FieldIntervalDays)
+	}
+	if m.addvalid_days != nil {
+		fields = append(fields, renewalpolicy.FieldValidDays)
+	}
+	if m.addfailures != nil {
+		fields = append(fields, renewalpolicy.FieldFailures)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RenewalPolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case renewalpolicy.FieldRemainingFraction:
+		return m.AddedRemainingFraction()
+	case renewalpolicy.FieldIntervalDays:
+		return m.AddedIntervalDays()
+	case renewalpolicy.FieldValidDays:
+		return m.AddedValidDays()
+	case renewalpolicy.FieldFailures:
+		return m.AddedFailures()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RenewalPolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case renewalpolicy.FieldRemainingFraction:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRemainingFraction(v)
+		return nil
+	case renewalpolicy.FieldIntervalDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntervalDays(v)
+		return nil
+	case renewalpolicy.FieldValidDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValidDays(v)
+		return nil
+	case renewalpolicy.FieldFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailures(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalPolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RenewalPolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(renewalpolicy.FieldRemainingFraction) {
+		fields = append(fields, renewalpolicy.FieldRemainingFraction)
+	}
+	if m.FieldCleared(renewalpolicy.FieldIntervalDays) {
+		fields = append(fields, renewalpolicy.FieldIntervalDays)
+	}
+	if m.FieldCleared(renewalpolicy.FieldValidDays) {
+		fields = append(fields, renewalpolicy.FieldValidDays)
+	}
+	if m.FieldCleared(renewalpolicy.FieldLastError) {
+		fields = append(fields, renewalpolicy.FieldLastError)
+	}
+	if m.FieldCleared(renewalpolicy.FieldNextAttemptAt) {
+		fields = append(fields, renewalpolicy.FieldNextAttemptAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RenewalPolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RenewalPolicyMutation) ClearField(name string) error {
+	switch name {
+	case renewalpolicy.FieldRemainingFraction:
+		m.ClearRemainingFraction()
+		return nil
+	case renewalpolicy.FieldIntervalDays:
+		m.ClearIntervalDays()
+		return nil
+	case renewalpolicy.FieldValidDays:
+		m.ClearValidDays()
+		return nil
+	case renewalpolicy.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case renewalpolicy.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalPolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RenewalPolicyMutation) ResetField(name string) error {
+	switch name {
+	case renewalpolicy.FieldCertificateID:
+		m.ResetCertificateID()
+		return nil
+	case renewalpolicy.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case renewalpolicy.FieldRemainingFraction:
+		m.ResetRemainingFraction()
+		return nil
+	case renewalpolicy.FieldIntervalDays:
+		m.ResetIntervalDays()
+		return nil
+	case renewalpolicy.FieldRekey:
+		m.ResetRekey()
+		return nil
+	case renewalpolicy.FieldValidDays:
+		m.ResetValidDays()
+		return nil
+	case renewalpolicy.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case renewalpolicy.FieldFailures:
+		m.ResetFailures()
+		return nil
+	case renewalpolicy.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case renewalpolicy.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case renewalpolicy.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case renewalpolicy.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalPolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RenewalPolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.certificate != nil {
+		edges = append(edges, renewalpolicy.EdgeCertificate)
+	}
+	if m.attempts != nil {
+		edges = append(edges, renewalpolicy.EdgeAttempts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RenewalPolicyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case renewalpolicy.EdgeCertificate:
+		if id := m.certificate; id != nil {
+			return []ent.Value{*id}
+		}
+	case renewalpolicy.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.attempts))
+		for id := range m.attempts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RenewalPolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedattempts != nil {
+		edges = append(edges, renewalpolicy.EdgeAttempts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RenewalPolicyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case renewalpolicy.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.removedattempts))
+		for id := range m.removedattempts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RenewalPolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcertificate {
+		edges = append(edges, renewalpolicy.EdgeCertificate)
+	}
+	if m.clearedattempts {
+		edges = append(edges, renewalpolicy.EdgeAttempts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RenewalPolicyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case renewalpolicy.EdgeCertificate:
+		return m.clearedcertificate
+	case renewalpolicy.EdgeAttempts:
+		return m.clearedattempts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RenewalPolicyMutation) ClearEdge(name string) error {
+	switch name {
+	case renewalpolicy.EdgeCertificate:
+		m.ClearCertificate()
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalPolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RenewalPolicyMutation) ResetEdge(name string) error {
+	switch name {
+	case renewalpolicy.EdgeCertificate:
+		m.ResetCertificate()
+		return nil
+	case renewalpolicy.EdgeAttempts:
+		m.ResetAttempts()
+		return nil
+	}
+	return fmt.Errorf("unknown RenewalPolicy edge %s", name)
+}
+
+// SigningRequestMutation represents an operation that mutates the SigningRequest nodes in the graph.
+type SigningRequestMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	issuer_id         *int
+	addissuer_id      *int
+	kind              *signingrequest.Kind
+	certificate_id    *int
+	addcertificate_id *int
+	template          *string
+	key_pem           *string
+	key_ref           *string
+	desc              *string
+	usage             *string
+	status            *signingrequest.Status
+	updated_at        *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	namespace         *int
+	clearednamespace  bool
+	done              bool
+	oldValue          func(context.Context) (*SigningRequest, error)
+	predicates        []predicate.SigningRequest
+}
+
+var _ ent.Mutation = (*SigningRequestMutation)(nil)
+
+// signingrequestOption allows management of the mutation configuration using functional options.
+type signingrequestOption func(*SigningRequestMutation)
+
+// newSigningRequestMutation creates new mutation for the SigningRequest entity.
+func newSigningRequestMutation(c config, op Op, opts ...signingrequestOption) *SigningRequestMutation {
+	m := &SigningRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSigningRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSigningRequestID sets the ID field of the mutation.
+func withSigningRequestID(id int) signingrequestOption {
+	return func(m *SigningRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SigningRequest
+		)
+		m.oldValue = func(ctx context.Context) (*SigningRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SigningRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSigningRequest sets the old SigningRequest of the mutation.
+func withSigningRequest(node *SigningRequest) signingrequestOption {
+	return func(m *SigningRequestMutation) {
+		m.oldValue = func(context.Context) (*SigningRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SigningRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SigningRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SigningRequest entities.
+func (m *SigningRequestMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SigningRequestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SigningRequestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SigningRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (m *SigningRequestMutation) SetNamespaceID(i int) {
+	m.namespace = &i
+}
+
+// NamespaceID returns the value of the "namespace_id" field in the mutation.
+func (m *SigningRequestMutation) NamespaceID() (r int, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespaceID returns the old "namespace_id" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldNamespaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespaceID: %w", err)
+	}
+	return oldValue.NamespaceID, nil
+}
+
+// ResetNamespaceID resets all changes to the "namespace_id" field.
+func (m *SigningRequestMutation) ResetNamespaceID() {
+	m.namespace = nil
+}
+
+// SetIssuerID sets the "issuer_id" field.
+func (m *SigningRequestMutation) SetIssuerID(i int) {
+	m.issuer_id = &i
+	m.addissuer_id = nil
+}
+
+// IssuerID returns the value of the "issuer_id" field in the mutation.
+func (m *SigningRequestMutation) IssuerID() (r int, exists bool) {
+	v := m.issuer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuerID returns the old "issuer_id" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldIssuerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuerID: %w", err)
+	}
+	return oldValue.IssuerID, nil
+}
+
+// AddIssuerID adds i to the "issuer_id" field.
+func (m *SigningRequestMutation) AddIssuerID(i int) {
+	if m.addissuer_id != nil {
+		*m.addissuer_id += i
+	} else {
+		m.addissuer_id = &i
+	}
+}
+
+// AddedIssuerID returns the value that was added to the "issuer_id" field in this mutation.
+func (m *SigningRequestMutation) AddedIssuerID() (r int, exists bool) {
+	v := m.addissuer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssuerID resets all changes to the "issuer_id" field.
+func (m *SigningRequestMutation) ResetIssuerID() {
+	m.issuer_id = nil
+	m.addissuer_id = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *SigningRequestMutation) SetKind(s signingrequest.Kind) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *SigningRequestMutation) Kind() (r signingrequest.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldKind(ctx context.Context) (v signingrequest.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *SigningRequestMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (m *SigningRequestMutation) SetCertificateID(i int) {
+	m.certificate_id = &i
+	m.addcertificate_id = nil
+}
+
+// CertificateID returns the value of the "certificate_id" field in the mutation.
+func (m *SigningRequestMutation) CertificateID() (r int, exists bool) {
+	v := m.certificate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateID returns the old "certificate_id" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldCertificateID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateID: %w", err)
+	}
+	return oldValue.CertificateID, nil
+}
+
+// AddCertificateID adds i to the "certificate_id" field.
+func (m *SigningRequestMutation) AddCertificateID(i int) {
+	if m.addcertificate_id != nil {
+		*m.addcertificate_id += i
+	} else {
+		m.addcertificate_id = &i
+	}
+}
+
+// AddedCertificateID returns the value that was added to the "certificate_id" field in this mutation.
+func (m *SigningRequestMutation) AddedCertificateID() (r int, exists bool) {
+	v := m.addcertificate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCertificateID clears the value of the "certificate_id" field.
+func (m *SigningRequestMutation) ClearCertificateID() {
+	m.certificate_id = nil
+	m.addcertificate_id = nil
+	m.clearedFields[signingrequest.FieldCertificateID] = struct{}{}
+}
+
+// CertificateIDCleared returns if the "certificate_id" field was cleared in this mutation.
+func (m *SigningRequestMutation) CertificateIDCleared() bool {
+	_, ok := m.clearedFields[signingrequest.FieldCertificateID]
+	return ok
+}
+
+// ResetCertificateID resets all changes to the "certificate_id" field.
+func (m *SigningRequestMutation) ResetCertificateID() {
+	m.certificate_id = nil
+	m.addcertificate_id = nil
+	delete(m.clearedFields, signingrequest.FieldCertificateID)
+}
+
+// SetTemplate sets the "template" field.
+func (m *SigningRequestMutation) SetTemplate(s string) {
+	m.template = &s
+}
+
+// Template returns the value of the "template" field in the mutation.
+func (m *SigningRequestMutation) Template() (r string, exists bool) {
+	v := m.template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplate returns the old "template" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplate: %w", err)
+	}
+	return oldValue.Template, nil
+}
+
+// ResetTemplate resets all changes to the "template" field.
+func (m *SigningRequestMutation) ResetTemplate() {
+	m.template = nil
+}
+
+// SetKeyPem sets the "key_pem" field.
+func (m *SigningRequestMutation) SetKeyPem(s string) {
+	m.key_pem = &s
+}
+
+// KeyPem returns the value of the "key_pem" field in the mutation.
+func (m *SigningRequestMutation) KeyPem() (r string, exists bool) {
+	v := m.key_pem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyPem returns the old "key_pem" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldKeyPem(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyPem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyPem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyPem: %w", err)
+	}
+	return oldValue.KeyPem, nil
+}
+
+// ClearKeyPem clears the value of the "key_pem" field.
+func (m *SigningRequestMutation) ClearKeyPem() {
+	m.key_pem = nil
+	m.clearedFields[signingrequest.FieldKeyPem] = struct{}{}
+}
+
+// KeyPemCleared returns if the "key_pem" field was cleared in this mutation.
+func (m *SigningRequestMutation) KeyPemCleared() bool {
+	_, ok := m.clearedFields[signingrequest.FieldKeyPem]
+	return ok
+}
+
+// ResetKeyPem resets all changes to the "key_pem" field.
+func (m *SigningRequestMutation) ResetKeyPem() {
+	m.key_pem = nil
+	delete(m.clearedFields, signingrequest.FieldKeyPem)
+}
+
+// SetKeyRef sets the "key_ref" field.
+func (m *SigningRequestMutation) SetKeyRef(s string) {
+	m.key_ref = &s
+}
+
+// KeyRef returns the value of the "key_ref" field in the mutation.
+func (m *SigningRequestMutation) KeyRef() (r string, exists bool) {
+	v := m.key_ref
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyRef returns the old "key_ref" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldKeyRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyRef: %w", err)
+	}
+	return oldValue.KeyRef, nil
+}
+
+// ClearKeyRef clears the value of the "key_ref" field.
+func (m *SigningRequestMutation) ClearKeyRef() {
+	m.key_ref = nil
+	m.clearedFields[signingrequest.FieldKeyRef] = struct{}{}
+}
+
+// KeyRefCleared returns if the "key_ref" field was cleared in this mutation.
+func (m *SigningRequestMutation) KeyRefCleared() bool {
+	_, ok := m.clearedFields[signingrequest.FieldKeyRef]
+	return ok
+}
+
+// ResetKeyRef resets all changes to the "key_ref" field.
+func (m *SigningRequestMutation) ResetKeyRef() {
+	m.key_ref = nil
+	delete(m.clearedFields, signingrequest.FieldKeyRef)
+}
+
+// SetDesc sets the "desc" field.
+func (m *SigningRequestMutation) SetDesc(s string) {
+	m.desc = &s
+}
+
+// Desc returns the value of the "desc" field in the mutation.
+func (m *SigningRequestMutation) Desc() (r string, exists bool) {
+	v := m.desc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDesc returns the old "desc" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldDesc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDesc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDesc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDesc: %w", err)
+	}
+	return oldValue.Desc, nil
+}
+
+// ClearDesc clears the value of the "desc" field.
+func (m *SigningRequestMutation) ClearDesc() {
+	m.desc = nil
+	m.clearedFields[signingrequest.FieldDesc] = struct{}{}
+}
+
+// DescCleared returns if the "desc" field was cleared in this mutation.
+func (m *SigningRequestMutation) DescCleared() bool {
+	_, ok := m.clearedFields[signingrequest.FieldDesc]
+	return ok
+}
+
+// ResetDesc resets all changes to the "desc" field.
+func (m *SigningRequestMutation) ResetDesc() {
+	m.desc = nil
+	delete(m.clearedFields, signingrequest.FieldDesc)
+}
+
+// SetUsage sets the "usage" field.
+func (m *SigningRequestMutation) SetUsage(s string) {
+	m.usage = &s
+}
+
+// Usage returns the value of the "usage" field in the mutation.
+func (m *SigningRequestMutation) Usage() (r string, exists bool) {
+	v := m.usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsage returns the old "usage" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldUsage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsage: %w", err)
+	}
+	return oldValue.Usage, nil
+}
+
+// ClearUsage clears the value of the "usage" field.
+func (m *SigningRequestMutation) ClearUsage() {
+	m.usage = nil
+	m.clearedFields[signingrequest.FieldUsage] = struct{}{}
+}
+
+// UsageCleared returns if the "usage" field was cleared in this mutation.
+func (m *SigningRequestMutation) UsageCleared() bool {
+	_, ok := m.clearedFields[signingrequest.FieldUsage]
+	return ok
+}
+
+// ResetUsage resets all changes to the "usage" field.
+func (m *SigningRequestMutation) ResetUsage() {
+	m.usage = nil
+	delete(m.clearedFields, signingrequest.FieldUsage)
+}
+
+// SetStatus sets the "status" field.
+func (m *SigningRequestMutation) SetStatus(s signingrequest.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SigningRequestMutation) Status() (r signingrequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldStatus(ctx context.Context) (v signingrequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SigningRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SigningRequestMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SigningRequestMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SigningRequestMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SigningRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SigningRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SigningRequest entity.
+// If the SigningRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1185,73 +8511,46 @@ func (m *NamespaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NamespaceMutation) ResetCreatedAt() {
+func (m *SigningRequestMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// AddCertificateIDs adds the "certificates" edge to the Certificate entity by ids.
-func (m *NamespaceMutation) AddCertificateIDs(ids ...int) {
-	if m.certificates == nil {
-		m.certificates = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.certificates[ids[i]] = struct{}{}
-	}
-}
-
-// ClearCertificates clears the "certificates" edge to the Certificate entity.
-func (m *NamespaceMutation) ClearCertificates() {
-	m.clearedcertificates = true
-}
-
-// CertificatesCleared reports if the "certificates" edge to the Certificate entity was cleared.
-func (m *NamespaceMutation) CertificatesCleared() bool {
-	return m.clearedcertificates
-}
-
-// RemoveCertificateIDs removes the "certificates" edge to the Certificate entity by IDs.
-func (m *NamespaceMutation) RemoveCertificateIDs(ids ...int) {
-	if m.removedcertificates == nil {
-		m.removedcertificates = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.certificates, ids[i])
-		m.removedcertificates[ids[i]] = struct{}{}
-	}
+// ClearNamespace clears the "namespace" edge to the Namespace entity.
+func (m *SigningRequestMutation) ClearNamespace() {
+	m.clearednamespace = true
+	m.clearedFields[signingrequest.FieldNamespaceID] = struct{}{}
 }
 
-// RemovedCertificates returns the removed IDs of the "certificates" edge to the Certificate entity.
-func (m *NamespaceMutation) RemovedCertificatesIDs() (ids []int) {
-	for id := range m.removedcertificates {
-		ids = append(ids, id)
-	}
-	return
+// NamespaceCleared reports if the "namespace" edge to the Namespace entity was cleared.
+func (m *SigningRequestMutation) NamespaceCleared() bool {
+	return m.clearednamespace
 }
 
-// CertificatesIDs returns the "certificates" edge IDs in the mutation.
-func (m *NamespaceMutation) CertificatesIDs() (ids []int) {
-	for id := range m.certificates {
-		ids = append(ids, id)
+// NamespaceIDs returns the "namespace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NamespaceID instead. It exists only for internal usage by the builders.
+func (m *SigningRequestMutation) NamespaceIDs() (ids []int) {
+	if id := m.namespace; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCertificates resets all changes to the "certificates" edge.
-func (m *NamespaceMutation) ResetCertificates() {
-	m.certificates = nil
-	m.clearedcertificates = false
-	m.removedcertificates = nil
+// ResetNamespace resets all changes to the "namespace" edge.
+func (m *SigningRequestMutation) ResetNamespace() {
+	m.namespace = nil
+	m.clearednamespace = false
 }
 
-// Where appends a list predicates to the NamespaceMutation builder.
-func (m *NamespaceMutation) Where(ps ...predicate.Namespace) {
+// Where appends a list predicates to the SigningRequestMutation builder.
+func (m *SigningRequestMutation) Where(ps ...predicate.SigningRequest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NamespaceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SigningRequestMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Namespace, len(ps))
+func (m *SigningRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SigningRequest, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1259,36 +8558,60 @@ func (m *NamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NamespaceMutation) Op() Op {
+func (m *SigningRequestMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NamespaceMutation) SetOp(op Op) {
+func (m *SigningRequestMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Namespace).
-func (m *NamespaceMutation) Type() string {
+// Type returns the node type of this mutation (SigningRequest).
+func (m *SigningRequestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NamespaceMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, namespace.FieldName)
+func (m *SigningRequestMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.namespace != nil {
+		fields = append(fields, signingrequest.FieldNamespaceID)
+	}
+	if m.issuer_id != nil {
+		fields = append(fields, signingrequest.FieldIssuerID)
+	}
+	if m.kind != nil {
+		fields = append(fields, signingrequest.FieldKind)
+	}
+	if m.certificate_id != nil {
+		fields = append(fields, signingrequest.FieldCertificateID)
+	}
+	if m.template != nil {
+		fields = append(fields, signingrequest.FieldTemplate)
+	}
+	if m.key_pem != nil {
+		fields = append(fields, signingrequest.FieldKeyPem)
+	}
+	if m.key_ref != nil {
+		fields = append(fields, signingrequest.FieldKeyRef)
 	}
 	if m.desc != nil {
-		fields = append(fields, namespace.FieldDesc)
+		fields = append(fields, signingrequest.FieldDesc)
+	}
+	if m.usage != nil {
+		fields = append(fields, signingrequest.FieldUsage)
+	}
+	if m.status != nil {
+		fields = append(fields, signingrequest.FieldStatus)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, namespace.FieldUpdatedAt)
+		fields = append(fields, signingrequest.FieldUpdatedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, namespace.FieldCreatedAt)
+		fields = append(fields, signingrequest.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1296,15 +8619,31 @@ func (m *NamespaceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NamespaceMutation) Field(name string) (ent.Value, bool) {
+func (m *SigningRequestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case namespace.FieldName:
-		return m.Name()
-	case namespace.FieldDesc:
+	case signingrequest.FieldNamespaceID:
+		return m.NamespaceID()
+	case signingrequest.FieldIssuerID:
+		return m.IssuerID()
+	case signingrequest.FieldKind:
+		return m.Kind()
+	case signingrequest.FieldCertificateID:
+		return m.CertificateID()
+	case signingrequest.FieldTemplate:
+		return m.Template()
+	case signingrequest.FieldKeyPem:
+		return m.KeyPem()
+	case signingrequest.FieldKeyRef:
+		return m.KeyRef()
+	case signingrequest.FieldDesc:
 		return m.Desc()
-	case namespace.FieldUpdatedAt:
+	case signingrequest.FieldUsage:
+		return m.Usage()
+	case signingrequest.FieldStatus:
+		return m.Status()
+	case signingrequest.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case namespace.FieldCreatedAt:
+	case signingrequest.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1313,47 +8652,119 @@ func (m *NamespaceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SigningRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case namespace.FieldName:
-		return m.OldName(ctx)
-	case namespace.FieldDesc:
+	case signingrequest.FieldNamespaceID:
+		return m.OldNamespaceID(ctx)
+	case signingrequest.FieldIssuerID:
+		return m.OldIssuerID(ctx)
+	case signingrequest.FieldKind:
+		return m.OldKind(ctx)
+	case signingrequest.FieldCertificateID:
+		return m.OldCertificateID(ctx)
+	case signingrequest.FieldTemplate:
+		return m.OldTemplate(ctx)
+	case signingrequest.FieldKeyPem:
+		return m.OldKeyPem(ctx)
+	case signingrequest.FieldKeyRef:
+		return m.OldKeyRef(ctx)
+	case signingrequest.FieldDesc:
 		return m.OldDesc(ctx)
-	case namespace.FieldUpdatedAt:
+	case signingrequest.FieldUsage:
+		return m.OldUsage(ctx)
+	case signingrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case signingrequest.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case namespace.FieldCreatedAt:
+	case signingrequest.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Namespace field %s", name)
+	return nil, fmt.Errorf("unknown SigningRequest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NamespaceMutation) SetField(name string, value ent.Value) error {
+func (m *SigningRequestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case namespace.FieldName:
+	case signingrequest.FieldNamespaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespaceID(v)
+		return nil
+	case signingrequest.FieldIssuerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuerID(v)
+		return nil
+	case signingrequest.FieldKind:
+		v, ok := value.(signingrequest.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case signingrequest.FieldCertificateID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateID(v)
+		return nil
+	case signingrequest.FieldTemplate:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTemplate(v)
 		return nil
-	case namespace.FieldDesc:
+	case signingrequest.FieldKeyPem:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyPem(v)
+		return nil
+	case signingrequest.FieldKeyRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyRef(v)
+		return nil
+	case signingrequest.FieldDesc:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDesc(v)
 		return nil
-	case namespace.FieldUpdatedAt:
+	case signingrequest.FieldUsage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsage(v)
+		return nil
+	case signingrequest.FieldStatus:
+		v, ok := value.(signingrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case signingrequest.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case namespace.FieldCreatedAt:
+	case signingrequest.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1361,159 +8772,224 @@ func (m *NamespaceMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Namespace field %s", name)
+	return fmt.Errorf("unknown SigningRequest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NamespaceMutation) AddedFields() []string {
-	return nil
+func (m *SigningRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addissuer_id != nil {
+		fields = append(fields, signingrequest.FieldIssuerID)
+	}
+	if m.addcertificate_id != nil {
+		fields = append(fields, signingrequest.FieldCertificateID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NamespaceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SigningRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case signingrequest.FieldIssuerID:
+		return m.AddedIssuerID()
+	case signingrequest.FieldCertificateID:
+		return m.AddedCertificateID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NamespaceMutation) AddField(name string, value ent.Value) error {
+func (m *SigningRequestMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case signingrequest.FieldIssuerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssuerID(v)
+		return nil
+	case signingrequest.FieldCertificateID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCertificateID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Namespace numeric field %s", name)
+	return fmt.Errorf("unknown SigningRequest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NamespaceMutation) ClearedFields() []string {
+func (m *SigningRequestMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(namespace.FieldDesc) {
-		fields = append(fields, namespace.FieldDesc)
+	if m.FieldCleared(signingrequest.FieldCertificateID) {
+		fields = append(fields, signingrequest.FieldCertificateID)
+	}
+	if m.FieldCleared(signingrequest.FieldKeyPem) {
+		fields = append(fields, signingrequest.FieldKeyPem)
+	}
+	if m.FieldCleared(signingrequest.FieldKeyRef) {
+		fields = append(fields, signingrequest.FieldKeyRef)
+	}
+	if m.FieldCleared(signingrequest.FieldDesc) {
+		fields = append(fields, signingrequest.FieldDesc)
+	}
+	if m.FieldCleared(signingrequest.FieldUsage) {
+		fields = append(fields, signingrequest.FieldUsage)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NamespaceMutation) FieldCleared(name string) bool {
+func (m *SigningRequestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NamespaceMutation) ClearField(name string) error {
+func (m *SigningRequestMutation) ClearField(name string) error {
 	switch name {
-	case namespace.FieldDesc:
+	case signingrequest.FieldCertificateID:
+		m.ClearCertificateID()
+		return nil
+	case signingrequest.FieldKeyPem:
+		m.ClearKeyPem()
+		return nil
+	case signingrequest.FieldKeyRef:
+		m.ClearKeyRef()
+		return nil
+	case signingrequest.FieldDesc:
 		m.ClearDesc()
 		return nil
+	case signingrequest.FieldUsage:
+		m.ClearUsage()
+		return nil
 	}
-	return fmt.Errorf("unknown Namespace nullable field %s", name)
+	return fmt.Errorf("unknown SigningRequest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NamespaceMutation) ResetField(name string) error {
+func (m *SigningRequestMutation) ResetField(name string) error {
 	switch name {
-	case namespace.FieldName:
-		m.ResetName()
+	case signingrequest.FieldNamespaceID:
+		m.ResetNamespaceID()
 		return nil
-	case namespace.FieldDesc:
+	case signingrequest.FieldIssuerID:
+		m.ResetIssuerID()
+		return nil
+	case signingrequest.FieldKind:
+		m.ResetKind()
+		return nil
+	case signingrequest.FieldCertificateID:
+		m.ResetCertificateID()
+		return nil
+	case signingrequest.FieldTemplate:
+		m.ResetTemplate()
+		return nil
+	case signingrequest.FieldKeyPem:
+		m.ResetKeyPem()
+		return nil
+	case signingrequest.FieldKeyRef:
+		m.ResetKeyRef()
+		return nil
+	case signingrequest.FieldDesc:
 		m.ResetDesc()
 		return nil
-	case namespace.FieldUpdatedAt:
+	case signingrequest.FieldUsage:
+		m.ResetUsage()
+		return nil
+	case signingrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case signingrequest.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case namespace.FieldCreatedAt:
+	case signingrequest.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Namespace field %s", name)
+	return fmt.Errorf("unknown SigningRequest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NamespaceMutation) AddedEdges() []string {
+func (m *SigningRequestMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.certificates != nil {
-		edges = append(edges, namespace.EdgeCertificates)
+	if m.namespace != nil {
+		edges = append(edges, signingrequest.EdgeNamespace)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NamespaceMutation) AddedIDs(name string) []ent.Value {
+func (m *SigningRequestMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case namespace.EdgeCertificates:
-		ids := make([]ent.Value, 0, len(m.certificates))
-		for id := range m.certificates {
-			ids = append(ids, id)
+	case signingrequest.EdgeNamespace:
+		if id := m.namespace; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NamespaceMutation) RemovedEdges() []string {
+func (m *SigningRequestMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedcertificates != nil {
-		edges = append(edges, namespace.EdgeCertificates)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NamespaceMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case namespace.EdgeCertificates:
-		ids := make([]ent.Value, 0, len(m.removedcertificates))
-		for id := range m.removedcertificates {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SigningRequestMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NamespaceMutation) ClearedEdges() []string {
+func (m *SigningRequestMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedcertificates {
-		edges = append(edges, namespace.EdgeCertificates)
+	if m.clearednamespace {
+		edges = append(edges, signingrequest.EdgeNamespace)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NamespaceMutation) EdgeCleared(name string) bool {
+func (m *SigningRequestMutation) EdgeCleared(name string) bool {
 	switch name {
-	case namespace.EdgeCertificates:
-		return m.clearedcertificates
+	case signingrequest.EdgeNamespace:
+		return m.clearednamespace
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NamespaceMutation) ClearEdge(name string) error {
+func (m *SigningRequestMutation) ClearEdge(name string) error {
 	switch name {
+	case signingrequest.EdgeNamespace:
+		m.ClearNamespace()
+		return nil
 	}
-	return fmt.Errorf("unknown Namespace unique edge %s", name)
+	return fmt.Errorf("unknown SigningRequest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NamespaceMutation) ResetEdge(name string) error {
+func (m *SigningRequestMutation) ResetEdge(name string) error {
 	switch name {
-	case namespace.EdgeCertificates:
-		m.ResetCertificates()
+	case signingrequest.EdgeNamespace:
+		m.ResetNamespace()
 		return nil
 	}
-	return fmt.Errorf("unknown Namespace edge %s", name)
+	return fmt.Errorf("unknown SigningRequest edge %s", name)
 }
@@ -21,6 +21,8 @@ type Namespace struct {
 	Name string `json:"name,omitempty"`
 	// Desc holds the value of the "desc" field.
 	Desc string `json:"desc,omitempty"`
+	// KeyRevealDisabled holds the value of the "key_reveal_disabled" field.
+	KeyRevealDisabled bool `json:"key_reveal_disabled,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -35,9 +37,11 @@ type Namespace struct {
 type NamespaceEdges struct {
 	// Certificates holds the value of the certificates edge.
 	Certificates []*Certificate `json:"certificates,omitempty"`
+	// SigningRequests holds the value of the signing_requests edge.
+	SigningRequests []*SigningRequest `json:"signing_requests,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // CertificatesOrErr returns the Certificates value or an error if the edge
@@ -49,11 +53,22 @@ func (e NamespaceEdges) CertificatesOrErr() ([]*Certificate, error) {
 	return nil, &NotLoadedError{edge: "certificates"}
 }
 
+// SigningRequestsOrErr returns the SigningRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e NamespaceEdges) SigningRequestsOrErr() ([]*SigningRequest, error) {
+	if e.loadedTypes[1] {
+		return e.SigningRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "signing_requests"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Namespace) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case namespace.FieldKeyRevealDisabled:
+			values[i] = new(sql.NullBool)
 		case namespace.FieldID:
 			values[i] = new(sql.NullInt64)
 		case namespace.FieldName, namespace.FieldDesc:
@@ -93,6 +108,12 @@ func (n *Namespace) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				n.Desc = value.String
 			}
+		case namespace.FieldKeyRevealDisabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field key_reveal_disabled", values[i])
+			} else if value.Valid {
+				n.KeyRevealDisabled = value.Bool
+			}
 		case namespace.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -123,6 +144,11 @@ func (n *Namespace) QueryCertificates() *CertificateQuery {
 	return NewNamespaceClient(n.config).QueryCertificates(n)
 }
 
+// QuerySigningRequests queries the "signing_requests" edge of the Namespace entity.
+func (n *Namespace) QuerySigningRequests() *SigningRequestQuery {
+	return NewNamespaceClient(n.config).QuerySigningRequests(n)
+}
+
 // Update returns a builder for updating this Namespace.
 // Note that you need to call Namespace.Unwrap() before calling this method if this Namespace
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -152,6 +178,9 @@ func (n *Namespace) String() string {
 	builder.WriteString("desc=")
 	builder.WriteString(n.Desc)
 	builder.WriteString(", ")
+	builder.WriteString("key_reveal_disabled=")
+	builder.WriteString(fmt.Sprintf("%v", n.KeyRevealDisabled))
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(n.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
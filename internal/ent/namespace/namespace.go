@@ -18,12 +18,16 @@ const (
 	FieldName = "name"
 	// FieldDesc holds the string denoting the desc field in the database.
 	FieldDesc = "desc"
+	// FieldKeyRevealDisabled holds the string denoting the key_reveal_disabled field in the database.
+	FieldKeyRevealDisabled = "key_reveal_disabled"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeCertificates holds the string denoting the certificates edge name in mutations.
 	EdgeCertificates = "certificates"
+	// EdgeSigningRequests holds the string denoting the signing_requests edge name in mutations.
+	EdgeSigningRequests = "signing_requests"
 	// Table holds the table name of the namespace in the database.
 	Table = "namespaces"
 	// CertificatesTable is the table that holds the certificates relation/edge.
@@ -33,6 +37,13 @@ const (
 	CertificatesInverseTable = "certificates"
 	// CertificatesColumn is the table column denoting the certificates relation/edge.
 	CertificatesColumn = "namespace_id"
+	// SigningRequestsTable is the table that holds the signing_requests relation/edge.
+	SigningRequestsTable = "signing_requests"
+	// SigningRequestsInverseTable is the table name for the SigningRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "signingrequest" package.
+	SigningRequestsInverseTable = "signing_requests"
+	// SigningRequestsColumn is the table column denoting the signing_requests relation/edge.
+	SigningRequestsColumn = "namespace_id"
 )
 
 // Columns holds all SQL columns for namespace fields.
@@ -40,6 +51,7 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldDesc,
+	FieldKeyRevealDisabled,
 	FieldUpdatedAt,
 	FieldCreatedAt,
 }
@@ -57,6 +69,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultDesc holds the default value on creation for the "desc" field.
 	DefaultDesc string
+	// DefaultKeyRevealDisabled holds the default value on creation for the "key_reveal_disabled" field.
+	DefaultKeyRevealDisabled bool
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
@@ -85,6 +99,11 @@ func ByDesc(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDesc, opts...).ToFunc()
 }
 
+// ByKeyRevealDisabled orders the results by the key_reveal_disabled field.
+func ByKeyRevealDisabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyRevealDisabled, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -108,6 +127,20 @@ func ByCertificates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCertificatesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySigningRequestsCount orders the results by signing_requests count.
+func BySigningRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSigningRequestsStep(), opts...)
+	}
+}
+
+// BySigningRequests orders the results by signing_requests terms.
+func BySigningRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSigningRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newCertificatesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -115,3 +148,10 @@ func newCertificatesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CertificatesTable, CertificatesColumn),
 	)
 }
+func newSigningRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SigningRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SigningRequestsTable, SigningRequestsColumn),
+	)
+}
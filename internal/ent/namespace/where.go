@@ -65,6 +65,11 @@ func Desc(v string) predicate.Namespace {
 	return predicate.Namespace(sql.FieldEQ(FieldDesc, v))
 }
 
+// KeyRevealDisabled applies equality check predicate on the "key_reveal_disabled" field. It's identical to KeyRevealDisabledEQ.
+func KeyRevealDisabled(v bool) predicate.Namespace {
+	return predicate.Namespace(sql.FieldEQ(FieldKeyRevealDisabled, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Namespace {
 	return predicate.Namespace(sql.FieldEQ(FieldUpdatedAt, v))
@@ -215,6 +220,16 @@ func DescContainsFold(v string) predicate.Namespace {
 	return predicate.Namespace(sql.FieldContainsFold(FieldDesc, v))
 }
 
+// KeyRevealDisabledEQ applies the EQ predicate on the "key_reveal_disabled" field.
+func KeyRevealDisabledEQ(v bool) predicate.Namespace {
+	return predicate.Namespace(sql.FieldEQ(FieldKeyRevealDisabled, v))
+}
+
+// KeyRevealDisabledNEQ applies the NEQ predicate on the "key_reveal_disabled" field.
+func KeyRevealDisabledNEQ(v bool) predicate.Namespace {
+	return predicate.Namespace(sql.FieldNEQ(FieldKeyRevealDisabled, v))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Namespace {
 	return predicate.Namespace(sql.FieldEQ(FieldUpdatedAt, v))
@@ -318,6 +333,29 @@ func HasCertificatesWith(preds ...predicate.Certificate) predicate.Namespace {
 	})
 }
 
+// HasSigningRequests applies the HasEdge predicate on the "signing_requests" edge.
+func HasSigningRequests() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SigningRequestsTable, SigningRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSigningRequestsWith applies the HasEdge predicate on the "signing_requests" edge with a given conditions (other predicates).
+func HasSigningRequestsWith(preds ...predicate.SigningRequest) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		step := newSigningRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Namespace) predicate.Namespace {
 	return predicate.Namespace(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/logeable/certmgr/internal/ent/certificate"
 	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
 )
 
 // NamespaceCreate is the builder for creating a Namespace entity.
@@ -41,6 +42,20 @@ func (nc *NamespaceCreate) SetNillableDesc(s *string) *NamespaceCreate {
 	return nc
 }
 
+// SetKeyRevealDisabled sets the "key_reveal_disabled" field.
+func (nc *NamespaceCreate) SetKeyRevealDisabled(b bool) *NamespaceCreate {
+	nc.mutation.SetKeyRevealDisabled(b)
+	return nc
+}
+
+// SetNillableKeyRevealDisabled sets the "key_reveal_disabled" field if the given value is not nil.
+func (nc *NamespaceCreate) SetNillableKeyRevealDisabled(b *bool) *NamespaceCreate {
+	if b != nil {
+		nc.SetKeyRevealDisabled(*b)
+	}
+	return nc
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (nc *NamespaceCreate) SetUpdatedAt(t time.Time) *NamespaceCreate {
 	nc.mutation.SetUpdatedAt(t)
@@ -90,6 +105,21 @@ func (nc *NamespaceCreate) AddCertificates(c ...*Certificate) *NamespaceCreate {
 	return nc.AddCertificateIDs(ids...)
 }
 
+// AddSigningRequestIDs adds the "signing_requests" edge to the SigningRequest entity by IDs.
+func (nc *NamespaceCreate) AddSigningRequestIDs(ids ...int) *NamespaceCreate {
+	nc.mutation.AddSigningRequestIDs(ids...)
+	return nc
+}
+
+// AddSigningRequests adds the "signing_requests" edges to the SigningRequest entity.
+func (nc *NamespaceCreate) AddSigningRequests(s ...*SigningRequest) *NamespaceCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nc.AddSigningRequestIDs(ids...)
+}
+
 // Mutation returns the NamespaceMutation object of the builder.
 func (nc *NamespaceCreate) Mutation() *NamespaceMutation {
 	return nc.mutation
@@ -129,6 +159,10 @@ func (nc *NamespaceCreate) defaults() {
 		v := namespace.DefaultDesc
 		nc.mutation.SetDesc(v)
 	}
+	if _, ok := nc.mutation.KeyRevealDisabled(); !ok {
+		v := namespace.DefaultKeyRevealDisabled
+		nc.mutation.SetKeyRevealDisabled(v)
+	}
 	if _, ok := nc.mutation.UpdatedAt(); !ok {
 		v := namespace.DefaultUpdatedAt()
 		nc.mutation.SetUpdatedAt(v)
@@ -144,6 +178,9 @@ func (nc *NamespaceCreate) check() error {
 	if _, ok := nc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Namespace.name"`)}
 	}
+	if _, ok := nc.mutation.KeyRevealDisabled(); !ok {
+		return &ValidationError{Name: "key_reveal_disabled", err: errors.New(`ent: missing required field "Namespace.key_reveal_disabled"`)}
+	}
 	if _, ok := nc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Namespace.updated_at"`)}
 	}
@@ -195,6 +232,10 @@ func (nc *NamespaceCreate) createSpec() (*Namespace, *sqlgraph.CreateSpec) {
 		_spec.SetField(namespace.FieldDesc, field.TypeString, value)
 		_node.Desc = value
 	}
+	if value, ok := nc.mutation.KeyRevealDisabled(); ok {
+		_spec.SetField(namespace.FieldKeyRevealDisabled, field.TypeBool, value)
+		_node.KeyRevealDisabled = value
+	}
 	if value, ok := nc.mutation.UpdatedAt(); ok {
 		_spec.SetField(namespace.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -219,6 +260,22 @@ func (nc *NamespaceCreate) createSpec() (*Namespace, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := nc.mutation.SigningRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.SigningRequestsTable,
+			Columns: []string{namespace.SigningRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,16 +15,18 @@ import (
 	"github.com/logeable/certmgr/internal/ent/certificate"
 	"github.com/logeable/certmgr/internal/ent/namespace"
 	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
 )
 
 // NamespaceQuery is the builder for querying Namespace entities.
 type NamespaceQuery struct {
 	config
-	ctx              *QueryContext
-	order            []namespace.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Namespace
-	withCertificates *CertificateQuery
+	ctx                 *QueryContext
+	order               []namespace.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Namespace
+	withCertificates    *CertificateQuery
+	withSigningRequests *SigningRequestQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +85,28 @@ func (nq *NamespaceQuery) QueryCertificates() *CertificateQuery {
 	return query
 }
 
+// QuerySigningRequests chains the current query on the "signing_requests" edge.
+func (nq *NamespaceQuery) QuerySigningRequests() *SigningRequestQuery {
+	query := (&SigningRequestClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(namespace.Table, namespace.FieldID, selector),
+			sqlgraph.To(signingrequest.Table, signingrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, namespace.SigningRequestsTable, namespace.SigningRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Namespace entity from the query.
 // Returns a *NotFoundError when no Namespace was found.
 func (nq *NamespaceQuery) First(ctx context.Context) (*Namespace, error) {
@@ -270,12 +294,13 @@ func (nq *NamespaceQuery) Clone() *NamespaceQuery {
 		return nil
 	}
 	return &NamespaceQuery{
-		config:           nq.config,
-		ctx:              nq.ctx.Clone(),
-		order:            append([]namespace.OrderOption{}, nq.order...),
-		inters:           append([]Interceptor{}, nq.inters...),
-		predicates:       append([]predicate.Namespace{}, nq.predicates...),
-		withCertificates: nq.withCertificates.Clone(),
+		config:              nq.config,
+		ctx:                 nq.ctx.Clone(),
+		order:               append([]namespace.OrderOption{}, nq.order...),
+		inters:              append([]Interceptor{}, nq.inters...),
+		predicates:          append([]predicate.Namespace{}, nq.predicates...),
+		withCertificates:    nq.withCertificates.Clone(),
+		withSigningRequests: nq.withSigningRequests.Clone(),
 		// clone intermediate query.
 		sql:  nq.sql.Clone(),
 		path: nq.path,
@@ -293,6 +318,17 @@ func (nq *NamespaceQuery) WithCertificates(opts ...func(*CertificateQuery)) *Nam
 	return nq
 }
 
+// WithSigningRequests tells the query-builder to eager-load the nodes that are connected to
+// the "signing_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NamespaceQuery) WithSigningRequests(opts ...func(*SigningRequestQuery)) *NamespaceQuery {
+	query := (&SigningRequestClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withSigningRequests = query
+	return nq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +407,9 @@ func (nq *NamespaceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Na
 	var (
 		nodes       = []*Namespace{}
 		_spec       = nq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			nq.withCertificates != nil,
+			nq.withSigningRequests != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +437,13 @@ func (nq *NamespaceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Na
 			return nil, err
 		}
 	}
+	if query := nq.withSigningRequests; query != nil {
+		if err := nq.loadSigningRequests(ctx, query, nodes,
+			func(n *Namespace) { n.Edges.SigningRequests = []*SigningRequest{} },
+			func(n *Namespace, e *SigningRequest) { n.Edges.SigningRequests = append(n.Edges.SigningRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,6 +477,36 @@ func (nq *NamespaceQuery) loadCertificates(ctx context.Context, query *Certifica
 	}
 	return nil
 }
+func (nq *NamespaceQuery) loadSigningRequests(ctx context.Context, query *SigningRequestQuery, nodes []*Namespace, init func(*Namespace), assign func(*Namespace, *SigningRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Namespace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(signingrequest.FieldNamespaceID)
+	}
+	query.Where(predicate.SigningRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(namespace.SigningRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.NamespaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "namespace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (nq *NamespaceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := nq.querySpec()
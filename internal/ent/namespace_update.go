@@ -14,6 +14,7 @@ import (
 	"github.com/logeable/certmgr/internal/ent/certificate"
 	"github.com/logeable/certmgr/internal/ent/namespace"
 	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
 )
 
 // NamespaceUpdate is the builder for updating Namespace entities.
@@ -63,6 +64,20 @@ func (nu *NamespaceUpdate) ClearDesc() *NamespaceUpdate {
 	return nu
 }
 
+// SetKeyRevealDisabled sets the "key_reveal_disabled" field.
+func (nu *NamespaceUpdate) SetKeyRevealDisabled(b bool) *NamespaceUpdate {
+	nu.mutation.SetKeyRevealDisabled(b)
+	return nu
+}
+
+// SetNillableKeyRevealDisabled sets the "key_reveal_disabled" field if the given value is not nil.
+func (nu *NamespaceUpdate) SetNillableKeyRevealDisabled(b *bool) *NamespaceUpdate {
+	if b != nil {
+		nu.SetKeyRevealDisabled(*b)
+	}
+	return nu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (nu *NamespaceUpdate) SetUpdatedAt(t time.Time) *NamespaceUpdate {
 	nu.mutation.SetUpdatedAt(t)
@@ -84,6 +99,21 @@ func (nu *NamespaceUpdate) AddCertificates(c ...*Certificate) *NamespaceUpdate {
 	return nu.AddCertificateIDs(ids...)
 }
 
+// AddSigningRequestIDs adds the "signing_requests" edge to the SigningRequest entity by IDs.
+func (nu *NamespaceUpdate) AddSigningRequestIDs(ids ...int) *NamespaceUpdate {
+	nu.mutation.AddSigningRequestIDs(ids...)
+	return nu
+}
+
+// AddSigningRequests adds the "signing_requests" edges to the SigningRequest entity.
+func (nu *NamespaceUpdate) AddSigningRequests(s ...*SigningRequest) *NamespaceUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nu.AddSigningRequestIDs(ids...)
+}
+
 // Mutation returns the NamespaceMutation object of the builder.
 func (nu *NamespaceUpdate) Mutation() *NamespaceMutation {
 	return nu.mutation
@@ -110,6 +140,27 @@ func (nu *NamespaceUpdate) RemoveCertificates(c ...*Certificate) *NamespaceUpdat
 	return nu.RemoveCertificateIDs(ids...)
 }
 
+// ClearSigningRequests clears all "signing_requests" edges to the SigningRequest entity.
+func (nu *NamespaceUpdate) ClearSigningRequests() *NamespaceUpdate {
+	nu.mutation.ClearSigningRequests()
+	return nu
+}
+
+// RemoveSigningRequestIDs removes the "signing_requests" edge to SigningRequest entities by IDs.
+func (nu *NamespaceUpdate) RemoveSigningRequestIDs(ids ...int) *NamespaceUpdate {
+	nu.mutation.RemoveSigningRequestIDs(ids...)
+	return nu
+}
+
+// RemoveSigningRequests removes "signing_requests" edges to SigningRequest entities.
+func (nu *NamespaceUpdate) RemoveSigningRequests(s ...*SigningRequest) *NamespaceUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nu.RemoveSigningRequestIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (nu *NamespaceUpdate) Save(ctx context.Context) (int, error) {
 	nu.defaults()
@@ -164,6 +215,9 @@ func (nu *NamespaceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if nu.mutation.DescCleared() {
 		_spec.ClearField(namespace.FieldDesc, field.TypeString)
 	}
+	if value, ok := nu.mutation.KeyRevealDisabled(); ok {
+		_spec.SetField(namespace.FieldKeyRevealDisabled, field.TypeBool, value)
+	}
 	if value, ok := nu.mutation.UpdatedAt(); ok {
 		_spec.SetField(namespace.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -212,6 +266,51 @@ func (nu *NamespaceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nu.mutation.SigningRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.SigningRequestsTable,
+			Columns: []string{namespace.SigningRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedSigningRequestsIDs(); len(nodes) > 0 && !nu.mutation.SigningRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.SigningRequestsTable,
+			Columns: []string{namespace.SigningRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.SigningRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.SigningRequestsTable,
+			Columns: []string{namespace.SigningRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{namespace.Label}
@@ -266,6 +365,20 @@ func (nuo *NamespaceUpdateOne) ClearDesc() *NamespaceUpdateOne {
 	return nuo
 }
 
+// SetKeyRevealDisabled sets the "key_reveal_disabled" field.
+func (nuo *NamespaceUpdateOne) SetKeyRevealDisabled(b bool) *NamespaceUpdateOne {
+	nuo.mutation.SetKeyRevealDisabled(b)
+	return nuo
+}
+
+// SetNillableKeyRevealDisabled sets the "key_reveal_disabled" field if the given value is not nil.
+func (nuo *NamespaceUpdateOne) SetNillableKeyRevealDisabled(b *bool) *NamespaceUpdateOne {
+	if b != nil {
+		nuo.SetKeyRevealDisabled(*b)
+	}
+	return nuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (nuo *NamespaceUpdateOne) SetUpdatedAt(t time.Time) *NamespaceUpdateOne {
 	nuo.mutation.SetUpdatedAt(t)
@@ -287,6 +400,21 @@ func (nuo *NamespaceUpdateOne) AddCertificates(c ...*Certificate) *NamespaceUpda
 	return nuo.AddCertificateIDs(ids...)
 }
 
+// AddSigningRequestIDs adds the "signing_requests" edge to the SigningRequest entity by IDs.
+func (nuo *NamespaceUpdateOne) AddSigningRequestIDs(ids ...int) *NamespaceUpdateOne {
+	nuo.mutation.AddSigningRequestIDs(ids...)
+	return nuo
+}
+
+// AddSigningRequests adds the "signing_requests" edges to the SigningRequest entity.
+func (nuo *NamespaceUpdateOne) AddSigningRequests(s ...*SigningRequest) *NamespaceUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nuo.AddSigningRequestIDs(ids...)
+}
+
 // Mutation returns the NamespaceMutation object of the builder.
 func (nuo *NamespaceUpdateOne) Mutation() *NamespaceMutation {
 	return nuo.mutation
@@ -313,6 +441,27 @@ func (nuo *NamespaceUpdateOne) RemoveCertificates(c ...*Certificate) *NamespaceU
 	return nuo.RemoveCertificateIDs(ids...)
 }
 
+// ClearSigningRequests clears all "signing_requests" edges to the SigningRequest entity.
+func (nuo *NamespaceUpdateOne) ClearSigningRequests() *NamespaceUpdateOne {
+	nuo.mutation.ClearSigningRequests()
+	return nuo
+}
+
+// RemoveSigningRequestIDs removes the "signing_requests" edge to SigningRequest entities by IDs.
+func (nuo *NamespaceUpdateOne) RemoveSigningRequestIDs(ids ...int) *NamespaceUpdateOne {
+	nuo.mutation.RemoveSigningRequestIDs(ids...)
+	return nuo
+}
+
+// RemoveSigningRequests removes "signing_requests" edges to SigningRequest entities.
+func (nuo *NamespaceUpdateOne) RemoveSigningRequests(s ...*SigningRequest) *NamespaceUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nuo.RemoveSigningRequestIDs(ids...)
+}
+
 // Where appends a list predicates to the NamespaceUpdate builder.
 func (nuo *NamespaceUpdateOne) Where(ps ...predicate.Namespace) *NamespaceUpdateOne {
 	nuo.mutation.Where(ps...)
@@ -397,6 +546,9 @@ func (nuo *NamespaceUpdateOne) sqlSave(ctx context.Context) (_node *Namespace, e
 	if nuo.mutation.DescCleared() {
 		_spec.ClearField(namespace.FieldDesc, field.TypeString)
 	}
+	if value, ok := nuo.mutation.KeyRevealDisabled(); ok {
+		_spec.SetField(namespace.FieldKeyRevealDisabled, field.TypeBool, value)
+	}
 	if value, ok := nuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(namespace.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -445,6 +597,51 @@ func (nuo *NamespaceUpdateOne) sqlSave(ctx context.Context) (_node *Namespace, e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nuo.mutation.SigningRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.SigningRequestsTable,
+			Columns: []string{namespace.SigningRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedSigningRequestsIDs(); len(nodes) > 0 && !nuo.mutation.SigningRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.SigningRequestsTable,
+			Columns: []string{namespace.SigningRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.SigningRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.SigningRequestsTable,
+			Columns: []string{namespace.SigningRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Namespace{config: nuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,8 +6,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
 // Certificate is the predicate function for certificate builders.
 type Certificate func(*sql.Selector)
 
+// ExpiryNotification is the predicate function for expirynotification builders.
+type ExpiryNotification func(*sql.Selector)
+
+// Keyring is the predicate function for keyring builders.
+type Keyring func(*sql.Selector)
+
 // Namespace is the predicate function for namespace builders.
 type Namespace func(*sql.Selector)
+
+// RenewalAttempt is the predicate function for renewalattempt builders.
+type RenewalAttempt func(*sql.Selector)
+
+// RenewalPolicy is the predicate function for renewalpolicy builders.
+type RenewalPolicy func(*sql.Selector)
+
+// SigningRequest is the predicate function for signingrequest builders.
+type SigningRequest func(*sql.Selector)
@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+)
+
+// RenewalAttempt is the model entity for the RenewalAttempt schema.
+type RenewalAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PolicyID holds the value of the "policy_id" field.
+	PolicyID int `json:"policy_id,omitempty"`
+	// CertificateID holds the value of the "certificate_id" field.
+	CertificateID int `json:"certificate_id,omitempty"`
+	// NamespaceID holds the value of the "namespace_id" field.
+	NamespaceID int `json:"namespace_id,omitempty"`
+	// Rekey holds the value of the "rekey" field.
+	Rekey bool `json:"rekey,omitempty"`
+	// Status holds the value of the "status" field.
+	Status renewalattempt.Status `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// SigningRequestID holds the value of the "signing_request_id" field.
+	SigningRequestID int `json:"signing_request_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RenewalAttemptQuery when eager-loading is set.
+	Edges        RenewalAttemptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RenewalAttemptEdges holds the relations/edges for other nodes in the graph.
+type RenewalAttemptEdges struct {
+	// Policy holds the value of the policy edge.
+	Policy *RenewalPolicy `json:"policy,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PolicyOrErr returns the Policy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RenewalAttemptEdges) PolicyOrErr() (*RenewalPolicy, error) {
+	if e.Policy != nil {
+		return e.Policy, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: renewalpolicy.Label}
+	}
+	return nil, &NotLoadedError{edge: "policy"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RenewalAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case renewalattempt.FieldRekey:
+			values[i] = new(sql.NullBool)
+		case renewalattempt.FieldID, renewalattempt.FieldPolicyID, renewalattempt.FieldCertificateID, renewalattempt.FieldNamespaceID, renewalattempt.FieldSigningRequestID:
+			values[i] = new(sql.NullInt64)
+		case renewalattempt.FieldStatus, renewalattempt.FieldError:
+			values[i] = new(sql.NullString)
+		case renewalattempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RenewalAttempt fields.
+func (ra *RenewalAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case renewalattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ra.ID = int(value.Int64)
+		case renewalattempt.FieldPolicyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_id", values[i])
+			} else if value.Valid {
+				ra.PolicyID = int(value.Int64)
+			}
+		case renewalattempt.FieldCertificateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_id", values[i])
+			} else if value.Valid {
+				ra.CertificateID = int(value.Int64)
+			}
+		case renewalattempt.FieldNamespaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace_id", values[i])
+			} else if value.Valid {
+				ra.NamespaceID = int(value.Int64)
+			}
+		case renewalattempt.FieldRekey:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field rekey", values[i])
+			} else if value.Valid {
+				ra.Rekey = value.Bool
+			}
+		case renewalattempt.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ra.Status = renewalattempt.Status(value.String)
+			}
+		case renewalattempt.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				ra.Error = value.String
+			}
+		case renewalattempt.FieldSigningRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_request_id", values[i])
+			} else if value.Valid {
+				ra.SigningRequestID = int(value.Int64)
+			}
+		case renewalattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ra.CreatedAt = value.Time
+			}
+		default:
+			ra.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RenewalAttempt.
+// This includes values selected through modifiers, order, etc.
+func (ra *RenewalAttempt) Value(name string) (ent.Value, error) {
+	return ra.selectValues.Get(name)
+}
+
+// QueryPolicy queries the "policy" edge of the RenewalAttempt entity.
+func (ra *RenewalAttempt) QueryPolicy() *RenewalPolicyQuery {
+	return NewRenewalAttemptClient(ra.config).QueryPolicy(ra)
+}
+
+// Update returns a builder for updating this RenewalAttempt.
+// Note that you need to call RenewalAttempt.Unwrap() before calling this method if this RenewalAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ra *RenewalAttempt) Update() *RenewalAttemptUpdateOne {
+	return NewRenewalAttemptClient(ra.config).UpdateOne(ra)
+}
+
+// Unwrap unwraps the RenewalAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ra *RenewalAttempt) Unwrap() *RenewalAttempt {
+	_tx, ok := ra.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RenewalAttempt is not a transactional entity")
+	}
+	ra.config.driver = _tx.drv
+	return ra
+}
+
+// String implements the fmt.Stringer.
+func (ra *RenewalAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("RenewalAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ra.ID))
+	builder.WriteString("policy_id=")
+	builder.WriteString(fmt.Sprintf("%v", ra.PolicyID))
+	builder.WriteString(", ")
+	builder.WriteString("certificate_id=")
+	builder.WriteString(fmt.Sprintf("%v", ra.CertificateID))
+	builder.WriteString(", ")
+	builder.WriteString("namespace_id=")
+	builder.WriteString(fmt.Sprintf("%v", ra.NamespaceID))
+	builder.WriteString(", ")
+	builder.WriteString("rekey=")
+	builder.WriteString(fmt.Sprintf("%v", ra.Rekey))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ra.Status))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(ra.Error)
+	builder.WriteString(", ")
+	builder.WriteString("signing_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", ra.SigningRequestID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ra.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RenewalAttempts is a parsable slice of RenewalAttempt.
+type RenewalAttempts []*RenewalAttempt
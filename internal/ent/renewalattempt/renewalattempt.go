@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package renewalattempt
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the renewalattempt type in the database.
+	Label = "renewal_attempt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPolicyID holds the string denoting the policy_id field in the database.
+	FieldPolicyID = "policy_id"
+	// FieldCertificateID holds the string denoting the certificate_id field in the database.
+	FieldCertificateID = "certificate_id"
+	// FieldNamespaceID holds the string denoting the namespace_id field in the database.
+	FieldNamespaceID = "namespace_id"
+	// FieldRekey holds the string denoting the rekey field in the database.
+	FieldRekey = "rekey"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldSigningRequestID holds the string denoting the signing_request_id field in the database.
+	FieldSigningRequestID = "signing_request_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePolicy holds the string denoting the policy edge name in mutations.
+	EdgePolicy = "policy"
+	// Table holds the table name of the renewalattempt in the database.
+	Table = "renewal_attempts"
+	// PolicyTable is the table that holds the policy relation/edge.
+	PolicyTable = "renewal_attempts"
+	// PolicyInverseTable is the table name for the RenewalPolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "renewalpolicy" package.
+	PolicyInverseTable = "renewal_policies"
+	// PolicyColumn is the table column denoting the policy relation/edge.
+	PolicyColumn = "policy_id"
+)
+
+// Columns holds all SQL columns for renewalattempt fields.
+var Columns = []string{
+	FieldID,
+	FieldPolicyID,
+	FieldCertificateID,
+	FieldNamespaceID,
+	FieldRekey,
+	FieldStatus,
+	FieldError,
+	FieldSigningRequestID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusSucceeded        Status = "succeeded"
+	StatusFailed           Status = "failed"
+	StatusPendingSignature Status = "pending_signature"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusSucceeded, StatusFailed, StatusPendingSignature:
+		return nil
+	default:
+		return fmt.Errorf("renewalattempt: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the RenewalAttempt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPolicyID orders the results by the policy_id field.
+func ByPolicyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyID, opts...).ToFunc()
+}
+
+// ByCertificateID orders the results by the certificate_id field.
+func ByCertificateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateID, opts...).ToFunc()
+}
+
+// ByNamespaceID orders the results by the namespace_id field.
+func ByNamespaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespaceID, opts...).ToFunc()
+}
+
+// ByRekey orders the results by the rekey field.
+func ByRekey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRekey, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// BySigningRequestID orders the results by the signing_request_id field.
+func BySigningRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigningRequestID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPolicyField orders the results by policy field.
+func ByPolicyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPolicyStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPolicyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PolicyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PolicyTable, PolicyColumn),
+	)
+}
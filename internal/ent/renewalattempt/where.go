@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package renewalattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// PolicyID applies equality check predicate on the "policy_id" field. It's identical to PolicyIDEQ.
+func PolicyID(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldPolicyID, v))
+}
+
+// CertificateID applies equality check predicate on the "certificate_id" field. It's identical to CertificateIDEQ.
+func CertificateID(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// NamespaceID applies equality check predicate on the "namespace_id" field. It's identical to NamespaceIDEQ.
+func NamespaceID(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldNamespaceID, v))
+}
+
+// Rekey applies equality check predicate on the "rekey" field. It's identical to RekeyEQ.
+func Rekey(v bool) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldRekey, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldError, v))
+}
+
+// SigningRequestID applies equality check predicate on the "signing_request_id" field. It's identical to SigningRequestIDEQ.
+func SigningRequestID(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldSigningRequestID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PolicyIDEQ applies the EQ predicate on the "policy_id" field.
+func PolicyIDEQ(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldPolicyID, v))
+}
+
+// PolicyIDNEQ applies the NEQ predicate on the "policy_id" field.
+func PolicyIDNEQ(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNEQ(FieldPolicyID, v))
+}
+
+// PolicyIDIn applies the In predicate on the "policy_id" field.
+func PolicyIDIn(vs ...int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldIn(FieldPolicyID, vs...))
+}
+
+// PolicyIDNotIn applies the NotIn predicate on the "policy_id" field.
+func PolicyIDNotIn(vs ...int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNotIn(FieldPolicyID, vs...))
+}
+
+// CertificateIDEQ applies the EQ predicate on the "certificate_id" field.
+func CertificateIDEQ(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// CertificateIDNEQ applies the NEQ predicate on the "certificate_id" field.
+func CertificateIDNEQ(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNEQ(FieldCertificateID, v))
+}
+
+// CertificateIDIn applies the In predicate on the "certificate_id" field.
+func CertificateIDIn(vs ...int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDNotIn applies the NotIn predicate on the "certificate_id" field.
+func CertificateIDNotIn(vs ...int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNotIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDGT applies the GT predicate on the "certificate_id" field.
+func CertificateIDGT(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGT(FieldCertificateID, v))
+}
+
+// CertificateIDGTE applies the GTE predicate on the "certificate_id" field.
+func CertificateIDGTE(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGTE(FieldCertificateID, v))
+}
+
+// CertificateIDLT applies the LT predicate on the "certificate_id" field.
+func CertificateIDLT(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLT(FieldCertificateID, v))
+}
+
+// CertificateIDLTE applies the LTE predicate on the "certificate_id" field.
+func CertificateIDLTE(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLTE(FieldCertificateID, v))
+}
+
+// NamespaceIDEQ applies the EQ predicate on the "namespace_id" field.
+func NamespaceIDEQ(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldNamespaceID, v))
+}
+
+// NamespaceIDNEQ applies the NEQ predicate on the "namespace_id" field.
+func NamespaceIDNEQ(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNEQ(FieldNamespaceID, v))
+}
+
+// NamespaceIDIn applies the In predicate on the "namespace_id" field.
+func NamespaceIDIn(vs ...int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldIn(FieldNamespaceID, vs...))
+}
+
+// NamespaceIDNotIn applies the NotIn predicate on the "namespace_id" field.
+func NamespaceIDNotIn(vs ...int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNotIn(FieldNamespaceID, vs...))
+}
+
+// NamespaceIDGT applies the GT predicate on the "namespace_id" field.
+func NamespaceIDGT(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGT(FieldNamespaceID, v))
+}
+
+// NamespaceIDGTE applies the GTE predicate on the "namespace_id" field.
+func NamespaceIDGTE(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGTE(FieldNamespaceID, v))
+}
+
+// NamespaceIDLT applies the LT predicate on the "namespace_id" field.
+func NamespaceIDLT(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLT(FieldNamespaceID, v))
+}
+
+// NamespaceIDLTE applies the LTE predicate on the "namespace_id" field.
+func NamespaceIDLTE(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLTE(FieldNamespaceID, v))
+}
+
+// RekeyEQ applies the EQ predicate on the "rekey" field.
+func RekeyEQ(v bool) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldRekey, v))
+}
+
+// RekeyNEQ applies the NEQ predicate on the "rekey" field.
+func RekeyNEQ(v bool) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNEQ(FieldRekey, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldContainsFold(FieldError, v))
+}
+
+// SigningRequestIDEQ applies the EQ predicate on the "signing_request_id" field.
+func SigningRequestIDEQ(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDNEQ applies the NEQ predicate on the "signing_request_id" field.
+func SigningRequestIDNEQ(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNEQ(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDIn applies the In predicate on the "signing_request_id" field.
+func SigningRequestIDIn(vs ...int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldIn(FieldSigningRequestID, vs...))
+}
+
+// SigningRequestIDNotIn applies the NotIn predicate on the "signing_request_id" field.
+func SigningRequestIDNotIn(vs ...int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNotIn(FieldSigningRequestID, vs...))
+}
+
+// SigningRequestIDGT applies the GT predicate on the "signing_request_id" field.
+func SigningRequestIDGT(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGT(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDGTE applies the GTE predicate on the "signing_request_id" field.
+func SigningRequestIDGTE(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGTE(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDLT applies the LT predicate on the "signing_request_id" field.
+func SigningRequestIDLT(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLT(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDLTE applies the LTE predicate on the "signing_request_id" field.
+func SigningRequestIDLTE(v int) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLTE(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDIsNil applies the IsNil predicate on the "signing_request_id" field.
+func SigningRequestIDIsNil() predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldIsNull(FieldSigningRequestID))
+}
+
+// SigningRequestIDNotNil applies the NotNil predicate on the "signing_request_id" field.
+func SigningRequestIDNotNil() predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNotNull(FieldSigningRequestID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPolicy applies the HasEdge predicate on the "policy" edge.
+func HasPolicy() predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PolicyTable, PolicyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPolicyWith applies the HasEdge predicate on the "policy" edge with a given conditions (other predicates).
+func HasPolicyWith(preds ...predicate.RenewalPolicy) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(func(s *sql.Selector) {
+		step := newPolicyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RenewalAttempt) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RenewalAttempt) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RenewalAttempt) predicate.RenewalAttempt {
+	return predicate.RenewalAttempt(sql.NotPredicates(p))
+}
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+)
+
+// RenewalAttemptCreate is the builder for creating a RenewalAttempt entity.
+type RenewalAttemptCreate struct {
+	config
+	mutation *RenewalAttemptMutation
+	hooks    []Hook
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (rac *RenewalAttemptCreate) SetPolicyID(i int) *RenewalAttemptCreate {
+	rac.mutation.SetPolicyID(i)
+	return rac
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (rac *RenewalAttemptCreate) SetCertificateID(i int) *RenewalAttemptCreate {
+	rac.mutation.SetCertificateID(i)
+	return rac
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (rac *RenewalAttemptCreate) SetNamespaceID(i int) *RenewalAttemptCreate {
+	rac.mutation.SetNamespaceID(i)
+	return rac
+}
+
+// SetRekey sets the "rekey" field.
+func (rac *RenewalAttemptCreate) SetRekey(b bool) *RenewalAttemptCreate {
+	rac.mutation.SetRekey(b)
+	return rac
+}
+
+// SetStatus sets the "status" field.
+func (rac *RenewalAttemptCreate) SetStatus(r renewalattempt.Status) *RenewalAttemptCreate {
+	rac.mutation.SetStatus(r)
+	return rac
+}
+
+// SetError sets the "error" field.
+func (rac *RenewalAttemptCreate) SetError(s string) *RenewalAttemptCreate {
+	rac.mutation.SetError(s)
+	return rac
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rac *RenewalAttemptCreate) SetNillableError(s *string) *RenewalAttemptCreate {
+	if s != nil {
+		rac.SetError(*s)
+	}
+	return rac
+}
+
+// SetSigningRequestID sets the "signing_request_id" field.
+func (rac *RenewalAttemptCreate) SetSigningRequestID(i int) *RenewalAttemptCreate {
+	rac.mutation.SetSigningRequestID(i)
+	return rac
+}
+
+// SetNillableSigningRequestID sets the "signing_request_id" field if the given value is not nil.
+func (rac *RenewalAttemptCreate) SetNillableSigningRequestID(i *int) *RenewalAttemptCreate {
+	if i != nil {
+		rac.SetSigningRequestID(*i)
+	}
+	return rac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rac *RenewalAttemptCreate) SetCreatedAt(t time.Time) *RenewalAttemptCreate {
+	rac.mutation.SetCreatedAt(t)
+	return rac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rac *RenewalAttemptCreate) SetNillableCreatedAt(t *time.Time) *RenewalAttemptCreate {
+	if t != nil {
+		rac.SetCreatedAt(*t)
+	}
+	return rac
+}
+
+// SetID sets the "id" field.
+func (rac *RenewalAttemptCreate) SetID(i int) *RenewalAttemptCreate {
+	rac.mutation.SetID(i)
+	return rac
+}
+
+// SetPolicy sets the "policy" edge to the RenewalPolicy entity.
+func (rac *RenewalAttemptCreate) SetPolicy(r *RenewalPolicy) *RenewalAttemptCreate {
+	return rac.SetPolicyID(r.ID)
+}
+
+// Mutation returns the RenewalAttemptMutation object of the builder.
+func (rac *RenewalAttemptCreate) Mutation() *RenewalAttemptMutation {
+	return rac.mutation
+}
+
+// Save creates the RenewalAttempt in the database.
+func (rac *RenewalAttemptCreate) Save(ctx context.Context) (*RenewalAttempt, error) {
+	rac.defaults()
+	return withHooks(ctx, rac.sqlSave, rac.mutation, rac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rac *RenewalAttemptCreate) SaveX(ctx context.Context) *RenewalAttempt {
+	v, err := rac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rac *RenewalAttemptCreate) Exec(ctx context.Context) error {
+	_, err := rac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rac *RenewalAttemptCreate) ExecX(ctx context.Context) {
+	if err := rac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rac *RenewalAttemptCreate) defaults() {
+	if _, ok := rac.mutation.Error(); !ok {
+		v := renewalattempt.DefaultError
+		rac.mutation.SetError(v)
+	}
+	if _, ok := rac.mutation.CreatedAt(); !ok {
+		v := renewalattempt.DefaultCreatedAt()
+		rac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rac *RenewalAttemptCreate) check() error {
+	if _, ok := rac.mutation.PolicyID(); !ok {
+		return &ValidationError{Name: "policy_id", err: errors.New(`ent: missing required field "RenewalAttempt.policy_id"`)}
+	}
+	if _, ok := rac.mutation.CertificateID(); !ok {
+		return &ValidationError{Name: "certificate_id", err: errors.New(`ent: missing required field "RenewalAttempt.certificate_id"`)}
+	}
+	if _, ok := rac.mutation.NamespaceID(); !ok {
+		return &ValidationError{Name: "namespace_id", err: errors.New(`ent: missing required field "RenewalAttempt.namespace_id"`)}
+	}
+	if _, ok := rac.mutation.Rekey(); !ok {
+		return &ValidationError{Name: "rekey", err: errors.New(`ent: missing required field "RenewalAttempt.rekey"`)}
+	}
+	if _, ok := rac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RenewalAttempt.status"`)}
+	}
+	if v, ok := rac.mutation.Status(); ok {
+		if err := renewalattempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RenewalAttempt.status": %w`, err)}
+		}
+	}
+	if _, ok := rac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RenewalAttempt.created_at"`)}
+	}
+	if v, ok := rac.mutation.ID(); ok {
+		if err := renewalattempt.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RenewalAttempt.id": %w`, err)}
+		}
+	}
+	if len(rac.mutation.PolicyIDs()) == 0 {
+		return &ValidationError{Name: "policy", err: errors.New(`ent: missing required edge "RenewalAttempt.policy"`)}
+	}
+	return nil
+}
+
+func (rac *RenewalAttemptCreate) sqlSave(ctx context.Context) (*RenewalAttempt, error) {
+	if err := rac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rac.mutation.id = &_node.ID
+	rac.mutation.done = true
+	return _node, nil
+}
+
+func (rac *RenewalAttemptCreate) createSpec() (*RenewalAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RenewalAttempt{config: rac.config}
+		_spec = sqlgraph.NewCreateSpec(renewalattempt.Table, sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt))
+	)
+	if id, ok := rac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rac.mutation.CertificateID(); ok {
+		_spec.SetField(renewalattempt.FieldCertificateID, field.TypeInt, value)
+		_node.CertificateID = value
+	}
+	if value, ok := rac.mutation.NamespaceID(); ok {
+		_spec.SetField(renewalattempt.FieldNamespaceID, field.TypeInt, value)
+		_node.NamespaceID = value
+	}
+	if value, ok := rac.mutation.Rekey(); ok {
+		_spec.SetField(renewalattempt.FieldRekey, field.TypeBool, value)
+		_node.Rekey = value
+	}
+	if value, ok := rac.mutation.Status(); ok {
+		_spec.SetField(renewalattempt.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rac.mutation.Error(); ok {
+		_spec.SetField(renewalattempt.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := rac.mutation.SigningRequestID(); ok {
+		_spec.SetField(renewalattempt.FieldSigningRequestID, field.TypeInt, value)
+		_node.SigningRequestID = value
+	}
+	if value, ok := rac.mutation.CreatedAt(); ok {
+		_spec.SetField(renewalattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rac.mutation.PolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   renewalattempt.PolicyTable,
+			Columns: []string{renewalattempt.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PolicyID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RenewalAttemptCreateBulk is the builder for creating many RenewalAttempt entities in bulk.
+type RenewalAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*RenewalAttemptCreate
+}
+
+// Save creates the RenewalAttempt entities in the database.
+func (racb *RenewalAttemptCreateBulk) Save(ctx context.Context) ([]*RenewalAttempt, error) {
+	if racb.err != nil {
+		return nil, racb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(racb.builders))
+	nodes := make([]*RenewalAttempt, len(racb.builders))
+	mutators := make([]Mutator, len(racb.builders))
+	for i := range racb.builders {
+		func(i int, root context.Context) {
+			builder := racb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RenewalAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, racb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, racb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, racb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (racb *RenewalAttemptCreateBulk) SaveX(ctx context.Context) []*RenewalAttempt {
+	v, err := racb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (racb *RenewalAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := racb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (racb *RenewalAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := racb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
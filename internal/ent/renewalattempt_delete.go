@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+)
+
+// RenewalAttemptDelete is the builder for deleting a RenewalAttempt entity.
+type RenewalAttemptDelete struct {
+	config
+	hooks    []Hook
+	mutation *RenewalAttemptMutation
+}
+
+// Where appends a list predicates to the RenewalAttemptDelete builder.
+func (rad *RenewalAttemptDelete) Where(ps ...predicate.RenewalAttempt) *RenewalAttemptDelete {
+	rad.mutation.Where(ps...)
+	return rad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rad *RenewalAttemptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rad.sqlExec, rad.mutation, rad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rad *RenewalAttemptDelete) ExecX(ctx context.Context) int {
+	n, err := rad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rad *RenewalAttemptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(renewalattempt.Table, sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt))
+	if ps := rad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rad.mutation.done = true
+	return affected, err
+}
+
+// RenewalAttemptDeleteOne is the builder for deleting a single RenewalAttempt entity.
+type RenewalAttemptDeleteOne struct {
+	rad *RenewalAttemptDelete
+}
+
+// Where appends a list predicates to the RenewalAttemptDelete builder.
+func (rado *RenewalAttemptDeleteOne) Where(ps ...predicate.RenewalAttempt) *RenewalAttemptDeleteOne {
+	rado.rad.mutation.Where(ps...)
+	return rado
+}
+
+// Exec executes the deletion query.
+func (rado *RenewalAttemptDeleteOne) Exec(ctx context.Context) error {
+	n, err := rado.rad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{renewalattempt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rado *RenewalAttemptDeleteOne) ExecX(ctx context.Context) {
+	if err := rado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+)
+
+// RenewalAttemptQuery is the builder for querying RenewalAttempt entities.
+type RenewalAttemptQuery struct {
+	config
+	ctx        *QueryContext
+	order      []renewalattempt.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RenewalAttempt
+	withPolicy *RenewalPolicyQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RenewalAttemptQuery builder.
+func (raq *RenewalAttemptQuery) Where(ps ...predicate.RenewalAttempt) *RenewalAttemptQuery {
+	raq.predicates = append(raq.predicates, ps...)
+	return raq
+}
+
+// Limit the number of records to be returned by this query.
+func (raq *RenewalAttemptQuery) Limit(limit int) *RenewalAttemptQuery {
+	raq.ctx.Limit = &limit
+	return raq
+}
+
+// Offset to start from.
+func (raq *RenewalAttemptQuery) Offset(offset int) *RenewalAttemptQuery {
+	raq.ctx.Offset = &offset
+	return raq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (raq *RenewalAttemptQuery) Unique(unique bool) *RenewalAttemptQuery {
+	raq.ctx.Unique = &unique
+	return raq
+}
+
+// Order specifies how the records should be ordered.
+func (raq *RenewalAttemptQuery) Order(o ...renewalattempt.OrderOption) *RenewalAttemptQuery {
+	raq.order = append(raq.order, o...)
+	return raq
+}
+
+// QueryPolicy chains the current query on the "policy" edge.
+func (raq *RenewalAttemptQuery) QueryPolicy() *RenewalPolicyQuery {
+	query := (&RenewalPolicyClient{config: raq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := raq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := raq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(renewalattempt.Table, renewalattempt.FieldID, selector),
+			sqlgraph.To(renewalpolicy.Table, renewalpolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, renewalattempt.PolicyTable, renewalattempt.PolicyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(raq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RenewalAttempt entity from the query.
+// Returns a *NotFoundError when no RenewalAttempt was found.
+func (raq *RenewalAttemptQuery) First(ctx context.Context) (*RenewalAttempt, error) {
+	nodes, err := raq.Limit(1).All(setContextOp(ctx, raq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{renewalattempt.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (raq *RenewalAttemptQuery) FirstX(ctx context.Context) *RenewalAttempt {
+	node, err := raq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RenewalAttempt ID from the query.
+// Returns a *NotFoundError when no RenewalAttempt ID was found.
+func (raq *RenewalAttemptQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = raq.Limit(1).IDs(setContextOp(ctx, raq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{renewalattempt.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (raq *RenewalAttemptQuery) FirstIDX(ctx context.Context) int {
+	id, err := raq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RenewalAttempt entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RenewalAttempt entity is found.
+// Returns a *NotFoundError when no RenewalAttempt entities are found.
+func (raq *RenewalAttemptQuery) Only(ctx context.Context) (*RenewalAttempt, error) {
+	nodes, err := raq.Limit(2).All(setContextOp(ctx, raq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{renewalattempt.Label}
+	default:
+		return nil, &NotSingularError{renewalattempt.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (raq *RenewalAttemptQuery) OnlyX(ctx context.Context) *RenewalAttempt {
+	node, err := raq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RenewalAttempt ID in the query.
+// Returns a *NotSingularError when more than one RenewalAttempt ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (raq *RenewalAttemptQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = raq.Limit(2).IDs(setContextOp(ctx, raq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{renewalattempt.Label}
+	default:
+		err = &NotSingularError{renewalattempt.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (raq *RenewalAttemptQuery) OnlyIDX(ctx context.Context) int {
+	id, err := raq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RenewalAttempts.
+func (raq *RenewalAttemptQuery) All(ctx context.Context) ([]*RenewalAttempt, error) {
+	ctx = setContextOp(ctx, raq.ctx, ent.OpQueryAll)
+	if err := raq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RenewalAttempt, *RenewalAttemptQuery]()
+	return withInterceptors[[]*RenewalAttempt](ctx, raq, qr, raq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (raq *RenewalAttemptQuery) AllX(ctx context.Context) []*RenewalAttempt {
+	nodes, err := raq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RenewalAttempt IDs.
+func (raq *RenewalAttemptQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if raq.ctx.Unique == nil && raq.path != nil {
+		raq.Unique(true)
+	}
+	ctx = setContextOp(ctx, raq.ctx, ent.OpQueryIDs)
+	if err = raq.Select(renewalattempt.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (raq *RenewalAttemptQuery) IDsX(ctx context.Context) []int {
+	ids, err := raq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (raq *RenewalAttemptQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, raq.ctx, ent.OpQueryCount)
+	if err := raq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, raq, querierCount[*RenewalAttemptQuery](), raq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (raq *RenewalAttemptQuery) CountX(ctx context.Context) int {
+	count, err := raq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (raq *RenewalAttemptQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, raq.ctx, ent.OpQueryExist)
+	switch _, err := raq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (raq *RenewalAttemptQuery) ExistX(ctx context.Context) bool {
+	exist, err := raq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RenewalAttemptQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (raq *RenewalAttemptQuery) Clone() *RenewalAttemptQuery {
+	if raq == nil {
+		return nil
+	}
+	return &RenewalAttemptQuery{
+		config:     raq.config,
+		ctx:        raq.ctx.Clone(),
+		order:      append([]renewalattempt.OrderOption{}, raq.order...),
+		inters:     append([]Interceptor{}, raq.inters...),
+		predicates: append([]predicate.RenewalAttempt{}, raq.predicates...),
+		withPolicy: raq.withPolicy.Clone(),
+		// clone intermediate query.
+		sql:  raq.sql.Clone(),
+		path: raq.path,
+	}
+}
+
+// WithPolicy tells the query-builder to eager-load the nodes that are connected to
+// the "policy" edge. The optional arguments are used to configure the query builder of the edge.
+func (raq *RenewalAttemptQuery) WithPolicy(opts ...func(*RenewalPolicyQuery)) *RenewalAttemptQuery {
+	query := (&RenewalPolicyClient{config: raq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	raq.withPolicy = query
+	return raq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PolicyID int `json:"policy_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RenewalAttempt.Query().
+//		GroupBy(renewalattempt.FieldPolicyID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (raq *RenewalAttemptQuery) GroupBy(field string, fields ...string) *RenewalAttemptGroupBy {
+	raq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RenewalAttemptGroupBy{build: raq}
+	grbuild.flds = &raq.ctx.Fields
+	grbuild.label = renewalattempt.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PolicyID int `json:"policy_id,omitempty"`
+//	}
+//
+//	client.RenewalAttempt.Query().
+//		Select(renewalattempt.FieldPolicyID).
+//		Scan(ctx, &v)
+func (raq *RenewalAttemptQuery) Select(fields ...string) *RenewalAttemptSelect {
+	raq.ctx.Fields = append(raq.ctx.Fields, fields...)
+	sbuild := &RenewalAttemptSelect{RenewalAttemptQuery: raq}
+	sbuild.label = renewalattempt.Label
+	sbuild.flds, sbuild.scan = &raq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RenewalAttemptSelect configured with the given aggregations.
+func (raq *RenewalAttemptQuery) Aggregate(fns ...AggregateFunc) *RenewalAttemptSelect {
+	return raq.Select().Aggregate(fns...)
+}
+
+func (raq *RenewalAttemptQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range raq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, raq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range raq.ctx.Fields {
+		if !renewalattempt.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if raq.path != nil {
+		prev, err := raq.path(ctx)
+		if err != nil {
+			return err
+		}
+		raq.sql = prev
+	}
+	return nil
+}
+
+func (raq *RenewalAttemptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RenewalAttempt, error) {
+	var (
+		nodes       = []*RenewalAttempt{}
+		_spec       = raq.querySpec()
+		loadedTypes = [1]bool{
+			raq.withPolicy != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RenewalAttempt).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RenewalAttempt{config: raq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, raq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := raq.withPolicy; query != nil {
+		if err := raq.loadPolicy(ctx, query, nodes, nil,
+			func(n *RenewalAttempt, e *RenewalPolicy) { n.Edges.Policy = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (raq *RenewalAttemptQuery) loadPolicy(ctx context.Context, query *RenewalPolicyQuery, nodes []*RenewalAttempt, init func(*RenewalAttempt), assign func(*RenewalAttempt, *RenewalPolicy)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RenewalAttempt)
+	for i := range nodes {
+		fk := nodes[i].PolicyID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(renewalpolicy.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "policy_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (raq *RenewalAttemptQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := raq.querySpec()
+	_spec.Node.Columns = raq.ctx.Fields
+	if len(raq.ctx.Fields) > 0 {
+		_spec.Unique = raq.ctx.Unique != nil && *raq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, raq.driver, _spec)
+}
+
+func (raq *RenewalAttemptQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(renewalattempt.Table, renewalattempt.Columns, sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt))
+	_spec.From = raq.sql
+	if unique := raq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if raq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := raq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, renewalattempt.FieldID)
+		for i := range fields {
+			if fields[i] != renewalattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if raq.withPolicy != nil {
+			_spec.Node.AddColumnOnce(renewalattempt.FieldPolicyID)
+		}
+	}
+	if ps := raq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := raq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := raq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := raq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (raq *RenewalAttemptQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(raq.driver.Dialect())
+	t1 := builder.Table(renewalattempt.Table)
+	columns := raq.ctx.Fields
+	if len(columns) == 0 {
+		columns = renewalattempt.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if raq.sql != nil {
+		selector = raq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if raq.ctx.Unique != nil && *raq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range raq.predicates {
+		p(selector)
+	}
+	for _, p := range raq.order {
+		p(selector)
+	}
+	if offset := raq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := raq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RenewalAttemptGroupBy is the group-by builder for RenewalAttempt entities.
+type RenewalAttemptGroupBy struct {
+	selector
+	build *RenewalAttemptQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ragb *RenewalAttemptGroupBy) Aggregate(fns ...AggregateFunc) *RenewalAttemptGroupBy {
+	ragb.fns = append(ragb.fns, fns...)
+	return ragb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ragb *RenewalAttemptGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ragb.build.ctx, ent.OpQueryGroupBy)
+	if err := ragb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RenewalAttemptQuery, *RenewalAttemptGroupBy](ctx, ragb.build, ragb, ragb.build.inters, v)
+}
+
+func (ragb *RenewalAttemptGroupBy) sqlScan(ctx context.Context, root *RenewalAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ragb.fns))
+	for _, fn := range ragb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ragb.flds)+len(ragb.fns))
+		for _, f := range *ragb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ragb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ragb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RenewalAttemptSelect is the builder for selecting fields of RenewalAttempt entities.
+type RenewalAttemptSelect struct {
+	*RenewalAttemptQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ras *RenewalAttemptSelect) Aggregate(fns ...AggregateFunc) *RenewalAttemptSelect {
+	ras.fns = append(ras.fns, fns...)
+	return ras
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ras *RenewalAttemptSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ras.ctx, ent.OpQuerySelect)
+	if err := ras.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RenewalAttemptQuery, *RenewalAttemptSelect](ctx, ras.RenewalAttemptQuery, ras, ras.inters, v)
+}
+
+func (ras *RenewalAttemptSelect) sqlScan(ctx context.Context, root *RenewalAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ras.fns))
+	for _, fn := range ras.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ras.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ras.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
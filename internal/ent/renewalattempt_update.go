@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+)
+
+// RenewalAttemptUpdate is the builder for updating RenewalAttempt entities.
+type RenewalAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RenewalAttemptMutation
+}
+
+// Where appends a list predicates to the RenewalAttemptUpdate builder.
+func (rau *RenewalAttemptUpdate) Where(ps ...predicate.RenewalAttempt) *RenewalAttemptUpdate {
+	rau.mutation.Where(ps...)
+	return rau
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (rau *RenewalAttemptUpdate) SetPolicyID(i int) *RenewalAttemptUpdate {
+	rau.mutation.SetPolicyID(i)
+	return rau
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (rau *RenewalAttemptUpdate) SetNillablePolicyID(i *int) *RenewalAttemptUpdate {
+	if i != nil {
+		rau.SetPolicyID(*i)
+	}
+	return rau
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (rau *RenewalAttemptUpdate) SetCertificateID(i int) *RenewalAttemptUpdate {
+	rau.mutation.ResetCertificateID()
+	rau.mutation.SetCertificateID(i)
+	return rau
+}
+
+// SetNillableCertificateID sets the "certificate_id" field if the given value is not nil.
+func (rau *RenewalAttemptUpdate) SetNillableCertificateID(i *int) *RenewalAttemptUpdate {
+	if i != nil {
+		rau.SetCertificateID(*i)
+	}
+	return rau
+}
+
+// AddCertificateID adds i to the "certificate_id" field.
+func (rau *RenewalAttemptUpdate) AddCertificateID(i int) *RenewalAttemptUpdate {
+	rau.mutation.AddCertificateID(i)
+	return rau
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (rau *RenewalAttemptUpdate) SetNamespaceID(i int) *RenewalAttemptUpdate {
+	rau.mutation.ResetNamespaceID()
+	rau.mutation.SetNamespaceID(i)
+	return rau
+}
+
+// SetNillableNamespaceID sets the "namespace_id" field if the given value is not nil.
+func (rau *RenewalAttemptUpdate) SetNillableNamespaceID(i *int) *RenewalAttemptUpdate {
+	if i != nil {
+		rau.SetNamespaceID(*i)
+	}
+	return rau
+}
+
+// AddNamespaceID adds i to the "namespace_id" field.
+func (rau *RenewalAttemptUpdate) AddNamespaceID(i int) *RenewalAttemptUpdate {
+	rau.mutation.AddNamespaceID(i)
+	return rau
+}
+
+// SetRekey sets the "rekey" field.
+func (rau *RenewalAttemptUpdate) SetRekey(b bool) *RenewalAttemptUpdate {
+	rau.mutation.SetRekey(b)
+	return rau
+}
+
+// SetNillableRekey sets the "rekey" field if the given value is not nil.
+func (rau *RenewalAttemptUpdate) SetNillableRekey(b *bool) *RenewalAttemptUpdate {
+	if b != nil {
+		rau.SetRekey(*b)
+	}
+	return rau
+}
+
+// SetStatus sets the "status" field.
+func (rau *RenewalAttemptUpdate) SetStatus(r renewalattempt.Status) *RenewalAttemptUpdate {
+	rau.mutation.SetStatus(r)
+	return rau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rau *RenewalAttemptUpdate) SetNillableStatus(r *renewalattempt.Status) *RenewalAttemptUpdate {
+	if r != nil {
+		rau.SetStatus(*r)
+	}
+	return rau
+}
+
+// SetError sets the "error" field.
+func (rau *RenewalAttemptUpdate) SetError(s string) *RenewalAttemptUpdate {
+	rau.mutation.SetError(s)
+	return rau
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rau *RenewalAttemptUpdate) SetNillableError(s *string) *RenewalAttemptUpdate {
+	if s != nil {
+		rau.SetError(*s)
+	}
+	return rau
+}
+
+// ClearError clears the value of the "error" field.
+func (rau *RenewalAttemptUpdate) ClearError() *RenewalAttemptUpdate {
+	rau.mutation.ClearError()
+	return rau
+}
+
+// SetSigningRequestID sets the "signing_request_id" field.
+func (rau *RenewalAttemptUpdate) SetSigningRequestID(i int) *RenewalAttemptUpdate {
+	rau.mutation.ResetSigningRequestID()
+	rau.mutation.SetSigningRequestID(i)
+	return rau
+}
+
+// SetNillableSigningRequestID sets the "signing_request_id" field if the given value is not nil.
+func (rau *RenewalAttemptUpdate) SetNillableSigningRequestID(i *int) *RenewalAttemptUpdate {
+	if i != nil {
+		rau.SetSigningRequestID(*i)
+	}
+	return rau
+}
+
+// AddSigningRequestID adds i to the "signing_request_id" field.
+func (rau *RenewalAttemptUpdate) AddSigningRequestID(i int) *RenewalAttemptUpdate {
+	rau.mutation.AddSigningRequestID(i)
+	return rau
+}
+
+// ClearSigningRequestID clears the value of the "signing_request_id" field.
+func (rau *RenewalAttemptUpdate) ClearSigningRequestID() *RenewalAttemptUpdate {
+	rau.mutation.ClearSigningRequestID()
+	return rau
+}
+
+// SetPolicy sets the "policy" edge to the RenewalPolicy entity.
+func (rau *RenewalAttemptUpdate) SetPolicy(r *RenewalPolicy) *RenewalAttemptUpdate {
+	return rau.SetPolicyID(r.ID)
+}
+
+// Mutation returns the RenewalAttemptMutation object of the builder.
+func (rau *RenewalAttemptUpdate) Mutation() *RenewalAttemptMutation {
+	return rau.mutation
+}
+
+// ClearPolicy clears the "policy" edge to the RenewalPolicy entity.
+func (rau *RenewalAttemptUpdate) ClearPolicy() *RenewalAttemptUpdate {
+	rau.mutation.ClearPolicy()
+	return rau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rau *RenewalAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rau.sqlSave, rau.mutation, rau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rau *RenewalAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := rau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rau *RenewalAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := rau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rau *RenewalAttemptUpdate) ExecX(ctx context.Context) {
+	if err := rau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rau *RenewalAttemptUpdate) check() error {
+	if v, ok := rau.mutation.Status(); ok {
+		if err := renewalattempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RenewalAttempt.status": %w`, err)}
+		}
+	}
+	if rau.mutation.PolicyCleared() && len(rau.mutation.PolicyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RenewalAttempt.policy"`)
+	}
+	return nil
+}
+
+func (rau *RenewalAttemptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(renewalattempt.Table, renewalattempt.Columns, sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt))
+	if ps := rau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rau.mutation.CertificateID(); ok {
+		_spec.SetField(renewalattempt.FieldCertificateID, field.TypeInt, value)
+	}
+	if value, ok := rau.mutation.AddedCertificateID(); ok {
+		_spec.AddField(renewalattempt.FieldCertificateID, field.TypeInt, value)
+	}
+	if value, ok := rau.mutation.NamespaceID(); ok {
+		_spec.SetField(renewalattempt.FieldNamespaceID, field.TypeInt, value)
+	}
+	if value, ok := rau.mutation.AddedNamespaceID(); ok {
+		_spec.AddField(renewalattempt.FieldNamespaceID, field.TypeInt, value)
+	}
+	if value, ok := rau.mutation.Rekey(); ok {
+		_spec.SetField(renewalattempt.FieldRekey, field.TypeBool, value)
+	}
+	if value, ok := rau.mutation.Status(); ok {
+		_spec.SetField(renewalattempt.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rau.mutation.Error(); ok {
+		_spec.SetField(renewalattempt.FieldError, field.TypeString, value)
+	}
+	if rau.mutation.ErrorCleared() {
+		_spec.ClearField(renewalattempt.FieldError, field.TypeString)
+	}
+	if value, ok := rau.mutation.SigningRequestID(); ok {
+		_spec.SetField(renewalattempt.FieldSigningRequestID, field.TypeInt, value)
+	}
+	if value, ok := rau.mutation.AddedSigningRequestID(); ok {
+		_spec.AddField(renewalattempt.FieldSigningRequestID, field.TypeInt, value)
+	}
+	if rau.mutation.SigningRequestIDCleared() {
+		_spec.ClearField(renewalattempt.FieldSigningRequestID, field.TypeInt)
+	}
+	if rau.mutation.PolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   renewalattempt.PolicyTable,
+			Columns: []string{renewalattempt.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rau.mutation.PolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   renewalattempt.PolicyTable,
+			Columns: []string{renewalattempt.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{renewalattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rau.mutation.done = true
+	return n, nil
+}
+
+// RenewalAttemptUpdateOne is the builder for updating a single RenewalAttempt entity.
+type RenewalAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RenewalAttemptMutation
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (rauo *RenewalAttemptUpdateOne) SetPolicyID(i int) *RenewalAttemptUpdateOne {
+	rauo.mutation.SetPolicyID(i)
+	return rauo
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (rauo *RenewalAttemptUpdateOne) SetNillablePolicyID(i *int) *RenewalAttemptUpdateOne {
+	if i != nil {
+		rauo.SetPolicyID(*i)
+	}
+	return rauo
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (rauo *RenewalAttemptUpdateOne) SetCertificateID(i int) *RenewalAttemptUpdateOne {
+	rauo.mutation.ResetCertificateID()
+	rauo.mutation.SetCertificateID(i)
+	return rauo
+}
+
+// SetNillableCertificateID sets the "certificate_id" field if the given value is not nil.
+func (rauo *RenewalAttemptUpdateOne) SetNillableCertificateID(i *int) *RenewalAttemptUpdateOne {
+	if i != nil {
+		rauo.SetCertificateID(*i)
+	}
+	return rauo
+}
+
+// AddCertificateID adds i to the "certificate_id" field.
+func (rauo *RenewalAttemptUpdateOne) AddCertificateID(i int) *RenewalAttemptUpdateOne {
+	rauo.mutation.AddCertificateID(i)
+	return rauo
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (rauo *RenewalAttemptUpdateOne) SetNamespaceID(i int) *RenewalAttemptUpdateOne {
+	rauo.mutation.ResetNamespaceID()
+	rauo.mutation.SetNamespaceID(i)
+	return rauo
+}
+
+// SetNillableNamespaceID sets the "namespace_id" field if the given value is not nil.
+func (rauo *RenewalAttemptUpdateOne) SetNillableNamespaceID(i *int) *RenewalAttemptUpdateOne {
+	if i != nil {
+		rauo.SetNamespaceID(*i)
+	}
+	return rauo
+}
+
+// AddNamespaceID adds i to the "namespace_id" field.
+func (rauo *RenewalAttemptUpdateOne) AddNamespaceID(i int) *RenewalAttemptUpdateOne {
+	rauo.mutation.AddNamespaceID(i)
+	return rauo
+}
+
+// SetRekey sets the "rekey" field.
+func (rauo *RenewalAttemptUpdateOne) SetRekey(b bool) *RenewalAttemptUpdateOne {
+	rauo.mutation.SetRekey(b)
+	return rauo
+}
+
+// SetNillableRekey sets the "rekey" field if the given value is not nil.
+func (rauo *RenewalAttemptUpdateOne) SetNillableRekey(b *bool) *RenewalAttemptUpdateOne {
+	if b != nil {
+		rauo.SetRekey(*b)
+	}
+	return rauo
+}
+
+// SetStatus sets the "status" field.
+func (rauo *RenewalAttemptUpdateOne) SetStatus(r renewalattempt.Status) *RenewalAttemptUpdateOne {
+	rauo.mutation.SetStatus(r)
+	return rauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rauo *RenewalAttemptUpdateOne) SetNillableStatus(r *renewalattempt.Status) *RenewalAttemptUpdateOne {
+	if r != nil {
+		rauo.SetStatus(*r)
+	}
+	return rauo
+}
+
+// SetError sets the "error" field.
+func (rauo *RenewalAttemptUpdateOne) SetError(s string) *RenewalAttemptUpdateOne {
+	rauo.mutation.SetError(s)
+	return rauo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rauo *RenewalAttemptUpdateOne) SetNillableError(s *string) *RenewalAttemptUpdateOne {
+	if s != nil {
+		rauo.SetError(*s)
+	}
+	return rauo
+}
+
+// ClearError clears the value of the "error" field.
+func (rauo *RenewalAttemptUpdateOne) ClearError() *RenewalAttemptUpdateOne {
+	rauo.mutation.ClearError()
+	return rauo
+}
+
+// SetSigningRequestID sets the "signing_request_id" field.
+func (rauo *RenewalAttemptUpdateOne) SetSigningRequestID(i int) *RenewalAttemptUpdateOne {
+	rauo.mutation.ResetSigningRequestID()
+	rauo.mutation.SetSigningRequestID(i)
+	return rauo
+}
+
+// SetNillableSigningRequestID sets the "signing_request_id" field if the given value is not nil.
+func (rauo *RenewalAttemptUpdateOne) SetNillableSigningRequestID(i *int) *RenewalAttemptUpdateOne {
+	if i != nil {
+		rauo.SetSigningRequestID(*i)
+	}
+	return rauo
+}
+
+// AddSigningRequestID adds i to the "signing_request_id" field.
+func (rauo *RenewalAttemptUpdateOne) AddSigningRequestID(i int) *RenewalAttemptUpdateOne {
+	rauo.mutation.AddSigningRequestID(i)
+	return rauo
+}
+
+// ClearSigningRequestID clears the value of the "signing_request_id" field.
+func (rauo *RenewalAttemptUpdateOne) ClearSigningRequestID() *RenewalAttemptUpdateOne {
+	rauo.mutation.ClearSigningRequestID()
+	return rauo
+}
+
+// SetPolicy sets the "policy" edge to the RenewalPolicy entity.
+func (rauo *RenewalAttemptUpdateOne) SetPolicy(r *RenewalPolicy) *RenewalAttemptUpdateOne {
+	return rauo.SetPolicyID(r.ID)
+}
+
+// Mutation returns the RenewalAttemptMutation object of the builder.
+func (rauo *RenewalAttemptUpdateOne) Mutation() *RenewalAttemptMutation {
+	return rauo.mutation
+}
+
+// ClearPolicy clears the "policy" edge to the RenewalPolicy entity.
+func (rauo *RenewalAttemptUpdateOne) ClearPolicy() *RenewalAttemptUpdateOne {
+	rauo.mutation.ClearPolicy()
+	return rauo
+}
+
+// Where appends a list predicates to the RenewalAttemptUpdate builder.
+func (rauo *RenewalAttemptUpdateOne) Where(ps ...predicate.RenewalAttempt) *RenewalAttemptUpdateOne {
+	rauo.mutation.Where(ps...)
+	return rauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rauo *RenewalAttemptUpdateOne) Select(field string, fields ...string) *RenewalAttemptUpdateOne {
+	rauo.fields = append([]string{field}, fields...)
+	return rauo
+}
+
+// Save executes the query and returns the updated RenewalAttempt entity.
+func (rauo *RenewalAttemptUpdateOne) Save(ctx context.Context) (*RenewalAttempt, error) {
+	return withHooks(ctx, rauo.sqlSave, rauo.mutation, rauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rauo *RenewalAttemptUpdateOne) SaveX(ctx context.Context) *RenewalAttempt {
+	node, err := rauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rauo *RenewalAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := rauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rauo *RenewalAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := rauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rauo *RenewalAttemptUpdateOne) check() error {
+	if v, ok := rauo.mutation.Status(); ok {
+		if err := renewalattempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RenewalAttempt.status": %w`, err)}
+		}
+	}
+	if rauo.mutation.PolicyCleared() && len(rauo.mutation.PolicyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RenewalAttempt.policy"`)
+	}
+	return nil
+}
+
+func (rauo *RenewalAttemptUpdateOne) sqlSave(ctx context.Context) (_node *RenewalAttempt, err error) {
+	if err := rauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(renewalattempt.Table, renewalattempt.Columns, sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt))
+	id, ok := rauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RenewalAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, renewalattempt.FieldID)
+		for _, f := range fields {
+			if !renewalattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != renewalattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rauo.mutation.CertificateID(); ok {
+		_spec.SetField(renewalattempt.FieldCertificateID, field.TypeInt, value)
+	}
+	if value, ok := rauo.mutation.AddedCertificateID(); ok {
+		_spec.AddField(renewalattempt.FieldCertificateID, field.TypeInt, value)
+	}
+	if value, ok := rauo.mutation.NamespaceID(); ok {
+		_spec.SetField(renewalattempt.FieldNamespaceID, field.TypeInt, value)
+	}
+	if value, ok := rauo.mutation.AddedNamespaceID(); ok {
+		_spec.AddField(renewalattempt.FieldNamespaceID, field.TypeInt, value)
+	}
+	if value, ok := rauo.mutation.Rekey(); ok {
+		_spec.SetField(renewalattempt.FieldRekey, field.TypeBool, value)
+	}
+	if value, ok := rauo.mutation.Status(); ok {
+		_spec.SetField(renewalattempt.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rauo.mutation.Error(); ok {
+		_spec.SetField(renewalattempt.FieldError, field.TypeString, value)
+	}
+	if rauo.mutation.ErrorCleared() {
+		_spec.ClearField(renewalattempt.FieldError, field.TypeString)
+	}
+	if value, ok := rauo.mutation.SigningRequestID(); ok {
+		_spec.SetField(renewalattempt.FieldSigningRequestID, field.TypeInt, value)
+	}
+	if value, ok := rauo.mutation.AddedSigningRequestID(); ok {
+		_spec.AddField(renewalattempt.FieldSigningRequestID, field.TypeInt, value)
+	}
+	if rauo.mutation.SigningRequestIDCleared() {
+		_spec.ClearField(renewalattempt.FieldSigningRequestID, field.TypeInt)
+	}
+	if rauo.mutation.PolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   renewalattempt.PolicyTable,
+			Columns: []string{renewalattempt.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rauo.mutation.PolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   renewalattempt.PolicyTable,
+			Columns: []string{renewalattempt.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RenewalAttempt{config: rauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{renewalattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rauo.mutation.done = true
+	return _node, nil
+}
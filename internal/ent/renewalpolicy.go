@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+)
+
+// RenewalPolicy is the model entity for the RenewalPolicy schema.
+type RenewalPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CertificateID holds the value of the "certificate_id" field.
+	CertificateID int `json:"certificate_id,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger renewalpolicy.Trigger `json:"trigger,omitempty"`
+	// RemainingFraction holds the value of the "remaining_fraction" field.
+	RemainingFraction float64 `json:"remaining_fraction,omitempty"`
+	// IntervalDays holds the value of the "interval_days" field.
+	IntervalDays int `json:"interval_days,omitempty"`
+	// Rekey holds the value of the "rekey" field.
+	Rekey bool `json:"rekey,omitempty"`
+	// ValidDays holds the value of the "valid_days" field.
+	ValidDays int `json:"valid_days,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Failures holds the value of the "failures" field.
+	Failures int `json:"failures,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RenewalPolicyQuery when eager-loading is set.
+	Edges        RenewalPolicyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RenewalPolicyEdges holds the relations/edges for other nodes in the graph.
+type RenewalPolicyEdges struct {
+	// Certificate holds the value of the certificate edge.
+	Certificate *Certificate `json:"certificate,omitempty"`
+	// Attempts holds the value of the attempts edge.
+	Attempts []*RenewalAttempt `json:"attempts,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CertificateOrErr returns the Certificate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RenewalPolicyEdges) CertificateOrErr() (*Certificate, error) {
+	if e.Certificate != nil {
+		return e.Certificate, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: certificate.Label}
+	}
+	return nil, &NotLoadedError{edge: "certificate"}
+}
+
+// AttemptsOrErr returns the Attempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e RenewalPolicyEdges) AttemptsOrErr() ([]*RenewalAttempt, error) {
+	if e.loadedTypes[1] {
+		return e.Attempts, nil
+	}
+	return nil, &NotLoadedError{edge: "attempts"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RenewalPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case renewalpolicy.FieldRekey, renewalpolicy.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case renewalpolicy.FieldRemainingFraction:
+			values[i] = new(sql.NullFloat64)
+		case renewalpolicy.FieldID, renewalpolicy.FieldCertificateID, renewalpolicy.FieldIntervalDays, renewalpolicy.FieldValidDays, renewalpolicy.FieldFailures:
+			values[i] = new(sql.NullInt64)
+		case renewalpolicy.FieldTrigger, renewalpolicy.FieldLastError:
+			values[i] = new(sql.NullString)
+		case renewalpolicy.FieldNextAttemptAt, renewalpolicy.FieldUpdatedAt, renewalpolicy.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RenewalPolicy fields.
+func (rp *RenewalPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case renewalpolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rp.ID = int(value.Int64)
+		case renewalpolicy.FieldCertificateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_id", values[i])
+			} else if value.Valid {
+				rp.CertificateID = int(value.Int64)
+			}
+		case renewalpolicy.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				rp.Trigger = renewalpolicy.Trigger(value.String)
+			}
+		case renewalpolicy.FieldRemainingFraction:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field remaining_fraction", values[i])
+			} else if value.Valid {
+				rp.RemainingFraction = value.Float64
+			}
+		case renewalpolicy.FieldIntervalDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval_days", values[i])
+			} else if value.Valid {
+				rp.IntervalDays = int(value.Int64)
+			}
+		case renewalpolicy.FieldRekey:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field rekey", values[i])
+			} else if value.Valid {
+				rp.Rekey = value.Bool
+			}
+		case renewalpolicy.FieldValidDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_days", values[i])
+			} else if value.Valid {
+				rp.ValidDays = int(value.Int64)
+			}
+		case renewalpolicy.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				rp.Enabled = value.Bool
+			}
+		case renewalpolicy.FieldFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failures", values[i])
+			} else if value.Valid {
+				rp.Failures = int(value.Int64)
+			}
+		case renewalpolicy.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				rp.LastError = value.String
+			}
+		case renewalpolicy.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				rp.NextAttemptAt = new(time.Time)
+				*rp.NextAttemptAt = value.Time
+			}
+		case renewalpolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rp.UpdatedAt = value.Time
+			}
+		case renewalpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rp.CreatedAt = value.Time
+			}
+		default:
+			rp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RenewalPolicy.
+// This includes values selected through modifiers, order, etc.
+func (rp *RenewalPolicy) Value(name string) (ent.Value, error) {
+	return rp.selectValues.Get(name)
+}
+
+// QueryCertificate queries the "certificate" edge of the RenewalPolicy entity.
+func (rp *RenewalPolicy) QueryCertificate() *CertificateQuery {
+	return NewRenewalPolicyClient(rp.config).QueryCertificate(rp)
+}
+
+// QueryAttempts queries the "attempts" edge of the RenewalPolicy entity.
+func (rp *RenewalPolicy) QueryAttempts() *RenewalAttemptQuery {
+	return NewRenewalPolicyClient(rp.config).QueryAttempts(rp)
+}
+
+// Update returns a builder for updating this RenewalPolicy.
+// Note that you need to call RenewalPolicy.Unwrap() before calling this method if this RenewalPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rp *RenewalPolicy) Update() *RenewalPolicyUpdateOne {
+	return NewRenewalPolicyClient(rp.config).UpdateOne(rp)
+}
+
+// Unwrap unwraps the RenewalPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rp *RenewalPolicy) Unwrap() *RenewalPolicy {
+	_tx, ok := rp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RenewalPolicy is not a transactional entity")
+	}
+	rp.config.driver = _tx.drv
+	return rp
+}
+
+// String implements the fmt.Stringer.
+func (rp *RenewalPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("RenewalPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rp.ID))
+	builder.WriteString("certificate_id=")
+	builder.WriteString(fmt.Sprintf("%v", rp.CertificateID))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("remaining_fraction=")
+	builder.WriteString(fmt.Sprintf("%v", rp.RemainingFraction))
+	builder.WriteString(", ")
+	builder.WriteString("interval_days=")
+	builder.WriteString(fmt.Sprintf("%v", rp.IntervalDays))
+	builder.WriteString(", ")
+	builder.WriteString("rekey=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Rekey))
+	builder.WriteString(", ")
+	builder.WriteString("valid_days=")
+	builder.WriteString(fmt.Sprintf("%v", rp.ValidDays))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("failures=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Failures))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(rp.LastError)
+	builder.WriteString(", ")
+	if v := rp.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RenewalPolicies is a parsable slice of RenewalPolicy.
+type RenewalPolicies []*RenewalPolicy
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package renewalpolicy
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the renewalpolicy type in the database.
+	Label = "renewal_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCertificateID holds the string denoting the certificate_id field in the database.
+	FieldCertificateID = "certificate_id"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldRemainingFraction holds the string denoting the remaining_fraction field in the database.
+	FieldRemainingFraction = "remaining_fraction"
+	// FieldIntervalDays holds the string denoting the interval_days field in the database.
+	FieldIntervalDays = "interval_days"
+	// FieldRekey holds the string denoting the rekey field in the database.
+	FieldRekey = "rekey"
+	// FieldValidDays holds the string denoting the valid_days field in the database.
+	FieldValidDays = "valid_days"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldFailures holds the string denoting the failures field in the database.
+	FieldFailures = "failures"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCertificate holds the string denoting the certificate edge name in mutations.
+	EdgeCertificate = "certificate"
+	// EdgeAttempts holds the string denoting the attempts edge name in mutations.
+	EdgeAttempts = "attempts"
+	// Table holds the table name of the renewalpolicy in the database.
+	Table = "renewal_policies"
+	// CertificateTable is the table that holds the certificate relation/edge.
+	CertificateTable = "renewal_policies"
+	// CertificateInverseTable is the table name for the Certificate entity.
+	// It exists in this package in order to avoid circular dependency with the "certificate" package.
+	CertificateInverseTable = "certificates"
+	// CertificateColumn is the table column denoting the certificate relation/edge.
+	CertificateColumn = "certificate_id"
+	// AttemptsTable is the table that holds the attempts relation/edge.
+	AttemptsTable = "renewal_attempts"
+	// AttemptsInverseTable is the table name for the RenewalAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "renewalattempt" package.
+	AttemptsInverseTable = "renewal_attempts"
+	// AttemptsColumn is the table column denoting the attempts relation/edge.
+	AttemptsColumn = "policy_id"
+)
+
+// Columns holds all SQL columns for renewalpolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldCertificateID,
+	FieldTrigger,
+	FieldRemainingFraction,
+	FieldIntervalDays,
+	FieldRekey,
+	FieldValidDays,
+	FieldEnabled,
+	FieldFailures,
+	FieldLastError,
+	FieldNextAttemptAt,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRekey holds the default value on creation for the "rekey" field.
+	DefaultRekey bool
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultFailures holds the default value on creation for the "failures" field.
+	DefaultFailures int
+	// DefaultLastError holds the default value on creation for the "last_error" field.
+	DefaultLastError string
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// Trigger defines the type for the "trigger" enum field.
+type Trigger string
+
+// Trigger values.
+const (
+	TriggerRemainingFraction Trigger = "remaining_fraction"
+	TriggerInterval          Trigger = "interval"
+)
+
+func (t Trigger) String() string {
+	return string(t)
+}
+
+// TriggerValidator is a validator for the "trigger" field enum values. It is called by the builders before save.
+func TriggerValidator(t Trigger) error {
+	switch t {
+	case TriggerRemainingFraction, TriggerInterval:
+		return nil
+	default:
+		return fmt.Errorf("renewalpolicy: invalid enum value for trigger field: %q", t)
+	}
+}
+
+// OrderOption defines the ordering options for the RenewalPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCertificateID orders the results by the certificate_id field.
+func ByCertificateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateID, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByRemainingFraction orders the results by the remaining_fraction field.
+func ByRemainingFraction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemainingFraction, opts...).ToFunc()
+}
+
+// ByIntervalDays orders the results by the interval_days field.
+func ByIntervalDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntervalDays, opts...).ToFunc()
+}
+
+// ByRekey orders the results by the rekey field.
+func ByRekey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRekey, opts...).ToFunc()
+}
+
+// ByValidDays orders the results by the valid_days field.
+func ByValidDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidDays, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByFailures orders the results by the failures field.
+func ByFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailures, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByNextAttemptAt orders the results by the next_attempt_at field.
+func ByNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCertificateField orders the results by certificate field.
+func ByCertificateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCertificateStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAttemptsCount orders the results by attempts count.
+func ByAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttemptsStep(), opts...)
+	}
+}
+
+// ByAttempts orders the results by attempts terms.
+func ByAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newCertificateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CertificateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, CertificateTable, CertificateColumn),
+	)
+}
+func newAttemptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttemptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttemptsTable, AttemptsColumn),
+	)
+}
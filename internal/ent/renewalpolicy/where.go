@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package renewalpolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// CertificateID applies equality check predicate on the "certificate_id" field. It's identical to CertificateIDEQ.
+func CertificateID(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// RemainingFraction applies equality check predicate on the "remaining_fraction" field. It's identical to RemainingFractionEQ.
+func RemainingFraction(v float64) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldRemainingFraction, v))
+}
+
+// IntervalDays applies equality check predicate on the "interval_days" field. It's identical to IntervalDaysEQ.
+func IntervalDays(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldIntervalDays, v))
+}
+
+// Rekey applies equality check predicate on the "rekey" field. It's identical to RekeyEQ.
+func Rekey(v bool) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldRekey, v))
+}
+
+// ValidDays applies equality check predicate on the "valid_days" field. It's identical to ValidDaysEQ.
+func ValidDays(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldValidDays, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Failures applies equality check predicate on the "failures" field. It's identical to FailuresEQ.
+func Failures(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldFailures, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldLastError, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CertificateIDEQ applies the EQ predicate on the "certificate_id" field.
+func CertificateIDEQ(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// CertificateIDNEQ applies the NEQ predicate on the "certificate_id" field.
+func CertificateIDNEQ(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldCertificateID, v))
+}
+
+// CertificateIDIn applies the In predicate on the "certificate_id" field.
+func CertificateIDIn(vs ...int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDNotIn applies the NotIn predicate on the "certificate_id" field.
+func CertificateIDNotIn(vs ...int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldCertificateID, vs...))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// RemainingFractionEQ applies the EQ predicate on the "remaining_fraction" field.
+func RemainingFractionEQ(v float64) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldRemainingFraction, v))
+}
+
+// RemainingFractionNEQ applies the NEQ predicate on the "remaining_fraction" field.
+func RemainingFractionNEQ(v float64) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldRemainingFraction, v))
+}
+
+// RemainingFractionIn applies the In predicate on the "remaining_fraction" field.
+func RemainingFractionIn(vs ...float64) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldRemainingFraction, vs...))
+}
+
+// RemainingFractionNotIn applies the NotIn predicate on the "remaining_fraction" field.
+func RemainingFractionNotIn(vs ...float64) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldRemainingFraction, vs...))
+}
+
+// RemainingFractionGT applies the GT predicate on the "remaining_fraction" field.
+func RemainingFractionGT(v float64) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGT(FieldRemainingFraction, v))
+}
+
+// RemainingFractionGTE applies the GTE predicate on the "remaining_fraction" field.
+func RemainingFractionGTE(v float64) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGTE(FieldRemainingFraction, v))
+}
+
+// RemainingFractionLT applies the LT predicate on the "remaining_fraction" field.
+func RemainingFractionLT(v float64) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLT(FieldRemainingFraction, v))
+}
+
+// RemainingFractionLTE applies the LTE predicate on the "remaining_fraction" field.
+func RemainingFractionLTE(v float64) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLTE(FieldRemainingFraction, v))
+}
+
+// RemainingFractionIsNil applies the IsNil predicate on the "remaining_fraction" field.
+func RemainingFractionIsNil() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIsNull(FieldRemainingFraction))
+}
+
+// RemainingFractionNotNil applies the NotNil predicate on the "remaining_fraction" field.
+func RemainingFractionNotNil() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotNull(FieldRemainingFraction))
+}
+
+// IntervalDaysEQ applies the EQ predicate on the "interval_days" field.
+func IntervalDaysEQ(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldIntervalDays, v))
+}
+
+// IntervalDaysNEQ applies the NEQ predicate on the "interval_days" field.
+func IntervalDaysNEQ(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldIntervalDays, v))
+}
+
+// IntervalDaysIn applies the In predicate on the "interval_days" field.
+func IntervalDaysIn(vs ...int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldIntervalDays, vs...))
+}
+
+// IntervalDaysNotIn applies the NotIn predicate on the "interval_days" field.
+func IntervalDaysNotIn(vs ...int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldIntervalDays, vs...))
+}
+
+// IntervalDaysGT applies the GT predicate on the "interval_days" field.
+func IntervalDaysGT(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGT(FieldIntervalDays, v))
+}
+
+// IntervalDaysGTE applies the GTE predicate on the "interval_days" field.
+func IntervalDaysGTE(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGTE(FieldIntervalDays, v))
+}
+
+// IntervalDaysLT applies the LT predicate on the "interval_days" field.
+func IntervalDaysLT(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLT(FieldIntervalDays, v))
+}
+
+// IntervalDaysLTE applies the LTE predicate on the "interval_days" field.
+func IntervalDaysLTE(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLTE(FieldIntervalDays, v))
+}
+
+// IntervalDaysIsNil applies the IsNil predicate on the "interval_days" field.
+func IntervalDaysIsNil() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIsNull(FieldIntervalDays))
+}
+
+// IntervalDaysNotNil applies the NotNil predicate on the "interval_days" field.
+func IntervalDaysNotNil() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotNull(FieldIntervalDays))
+}
+
+// RekeyEQ applies the EQ predicate on the "rekey" field.
+func RekeyEQ(v bool) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldRekey, v))
+}
+
+// RekeyNEQ applies the NEQ predicate on the "rekey" field.
+func RekeyNEQ(v bool) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldRekey, v))
+}
+
+// ValidDaysEQ applies the EQ predicate on the "valid_days" field.
+func ValidDaysEQ(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldValidDays, v))
+}
+
+// ValidDaysNEQ applies the NEQ predicate on the "valid_days" field.
+func ValidDaysNEQ(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldValidDays, v))
+}
+
+// ValidDaysIn applies the In predicate on the "valid_days" field.
+func ValidDaysIn(vs ...int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldValidDays, vs...))
+}
+
+// ValidDaysNotIn applies the NotIn predicate on the "valid_days" field.
+func ValidDaysNotIn(vs ...int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldValidDays, vs...))
+}
+
+// ValidDaysGT applies the GT predicate on the "valid_days" field.
+func ValidDaysGT(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGT(FieldValidDays, v))
+}
+
+// ValidDaysGTE applies the GTE predicate on the "valid_days" field.
+func ValidDaysGTE(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGTE(FieldValidDays, v))
+}
+
+// ValidDaysLT applies the LT predicate on the "valid_days" field.
+func ValidDaysLT(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLT(FieldValidDays, v))
+}
+
+// ValidDaysLTE applies the LTE predicate on the "valid_days" field.
+func ValidDaysLTE(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLTE(FieldValidDays, v))
+}
+
+// ValidDaysIsNil applies the IsNil predicate on the "valid_days" field.
+func ValidDaysIsNil() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIsNull(FieldValidDays))
+}
+
+// ValidDaysNotNil applies the NotNil predicate on the "valid_days" field.
+func ValidDaysNotNil() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotNull(FieldValidDays))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// FailuresEQ applies the EQ predicate on the "failures" field.
+func FailuresEQ(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldFailures, v))
+}
+
+// FailuresNEQ applies the NEQ predicate on the "failures" field.
+func FailuresNEQ(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldFailures, v))
+}
+
+// FailuresIn applies the In predicate on the "failures" field.
+func FailuresIn(vs ...int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldFailures, vs...))
+}
+
+// FailuresNotIn applies the NotIn predicate on the "failures" field.
+func FailuresNotIn(vs ...int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldFailures, vs...))
+}
+
+// FailuresGT applies the GT predicate on the "failures" field.
+func FailuresGT(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGT(FieldFailures, v))
+}
+
+// FailuresGTE applies the GTE predicate on the "failures" field.
+func FailuresGTE(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGTE(FieldFailures, v))
+}
+
+// FailuresLT applies the LT predicate on the "failures" field.
+func FailuresLT(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLT(FieldFailures, v))
+}
+
+// FailuresLTE applies the LTE predicate on the "failures" field.
+func FailuresLTE(v int) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLTE(FieldFailures, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCertificate applies the HasEdge predicate on the "certificate" edge.
+func HasCertificate() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, CertificateTable, CertificateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCertificateWith applies the HasEdge predicate on the "certificate" edge with a given conditions (other predicates).
+func HasCertificateWith(preds ...predicate.Certificate) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(func(s *sql.Selector) {
+		step := newCertificateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttempts applies the HasEdge predicate on the "attempts" edge.
+func HasAttempts() predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttemptsTable, AttemptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptsWith applies the HasEdge predicate on the "attempts" edge with a given conditions (other predicates).
+func HasAttemptsWith(preds ...predicate.RenewalAttempt) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(func(s *sql.Selector) {
+		step := newAttemptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RenewalPolicy) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RenewalPolicy) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RenewalPolicy) predicate.RenewalPolicy {
+	return predicate.RenewalPolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,498 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+)
+
+// RenewalPolicyCreate is the builder for creating a RenewalPolicy entity.
+type RenewalPolicyCreate struct {
+	config
+	mutation *RenewalPolicyMutation
+	hooks    []Hook
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (rpc *RenewalPolicyCreate) SetCertificateID(i int) *RenewalPolicyCreate {
+	rpc.mutation.SetCertificateID(i)
+	return rpc
+}
+
+// SetTrigger sets the "trigger" field.
+func (rpc *RenewalPolicyCreate) SetTrigger(r renewalpolicy.Trigger) *RenewalPolicyCreate {
+	rpc.mutation.SetTrigger(r)
+	return rpc
+}
+
+// SetRemainingFraction sets the "remaining_fraction" field.
+func (rpc *RenewalPolicyCreate) SetRemainingFraction(f float64) *RenewalPolicyCreate {
+	rpc.mutation.SetRemainingFraction(f)
+	return rpc
+}
+
+// SetNillableRemainingFraction sets the "remaining_fraction" field if the given value is not nil.
+func (rpc *RenewalPolicyCreate) SetNillableRemainingFraction(f *float64) *RenewalPolicyCreate {
+	if f != nil {
+		rpc.SetRemainingFraction(*f)
+	}
+	return rpc
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (rpc *RenewalPolicyCreate) SetIntervalDays(i int) *RenewalPolicyCreate {
+	rpc.mutation.SetIntervalDays(i)
+	return rpc
+}
+
+// SetNillableIntervalDays sets the "interval_days" field if the given value is not nil.
+func (rpc *RenewalPolicyCreate) SetNillableIntervalDays(i *int) *RenewalPolicyCreate {
+	if i != nil {
+		rpc.SetIntervalDays(*i)
+	}
+	return rpc
+}
+
+// SetRekey sets the "rekey" field.
+func (rpc *RenewalPolicyCreate) SetRekey(b bool) *RenewalPolicyCreate {
+	rpc.mutation.SetRekey(b)
+	return rpc
+}
+
+// SetNillableRekey sets the "rekey" field if the given value is not nil.
+func (rpc *RenewalPolicyCreate) SetNillableRekey(b *bool) *RenewalPolicyCreate {
+	if b != nil {
+		rpc.SetRekey(*b)
+	}
+	return rpc
+}
+
+// SetValidDays sets the "valid_days" field.
+func (rpc *RenewalPolicyCreate) SetValidDays(i int) *RenewalPolicyCreate {
+	rpc.mutation.SetValidDays(i)
+	return rpc
+}
+
+// SetNillableValidDays sets the "valid_days" field if the given value is not nil.
+func (rpc *RenewalPolicyCreate) SetNillableValidDays(i *int) *RenewalPolicyCreate {
+	if i != nil {
+		rpc.SetValidDays(*i)
+	}
+	return rpc
+}
+
+// SetEnabled sets the "enabled" field.
+func (rpc *RenewalPolicyCreate) SetEnabled(b bool) *RenewalPolicyCreate {
+	rpc.mutation.SetEnabled(b)
+	return rpc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rpc *RenewalPolicyCreate) SetNillableEnabled(b *bool) *RenewalPolicyCreate {
+	if b != nil {
+		rpc.SetEnabled(*b)
+	}
+	return rpc
+}
+
+// SetFailures sets the "failures" field.
+func (rpc *RenewalPolicyCreate) SetFailures(i int) *RenewalPolicyCreate {
+	rpc.mutation.SetFailures(i)
+	return rpc
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (rpc *RenewalPolicyCreate) SetNillableFailures(i *int) *RenewalPolicyCreate {
+	if i != nil {
+		rpc.SetFailures(*i)
+	}
+	return rpc
+}
+
+// SetLastError sets the "last_error" field.
+func (rpc *RenewalPolicyCreate) SetLastError(s string) *RenewalPolicyCreate {
+	rpc.mutation.SetLastError(s)
+	return rpc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (rpc *RenewalPolicyCreate) SetNillableLastError(s *string) *RenewalPolicyCreate {
+	if s != nil {
+		rpc.SetLastError(*s)
+	}
+	return rpc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (rpc *RenewalPolicyCreate) SetNextAttemptAt(t time.Time) *RenewalPolicyCreate {
+	rpc.mutation.SetNextAttemptAt(t)
+	return rpc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (rpc *RenewalPolicyCreate) SetNillableNextAttemptAt(t *time.Time) *RenewalPolicyCreate {
+	if t != nil {
+		rpc.SetNextAttemptAt(*t)
+	}
+	return rpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpc *RenewalPolicyCreate) SetUpdatedAt(t time.Time) *RenewalPolicyCreate {
+	rpc.mutation.SetUpdatedAt(t)
+	return rpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rpc *RenewalPolicyCreate) SetNillableUpdatedAt(t *time.Time) *RenewalPolicyCreate {
+	if t != nil {
+		rpc.SetUpdatedAt(*t)
+	}
+	return rpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpc *RenewalPolicyCreate) SetCreatedAt(t time.Time) *RenewalPolicyCreate {
+	rpc.mutation.SetCreatedAt(t)
+	return rpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpc *RenewalPolicyCreate) SetNillableCreatedAt(t *time.Time) *RenewalPolicyCreate {
+	if t != nil {
+		rpc.SetCreatedAt(*t)
+	}
+	return rpc
+}
+
+// SetID sets the "id" field.
+func (rpc *RenewalPolicyCreate) SetID(i int) *RenewalPolicyCreate {
+	rpc.mutation.SetID(i)
+	return rpc
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (rpc *RenewalPolicyCreate) SetCertificate(c *Certificate) *RenewalPolicyCreate {
+	return rpc.SetCertificateID(c.ID)
+}
+
+// AddAttemptIDs adds the "attempts" edge to the RenewalAttempt entity by IDs.
+func (rpc *RenewalPolicyCreate) AddAttemptIDs(ids ...int) *RenewalPolicyCreate {
+	rpc.mutation.AddAttemptIDs(ids...)
+	return rpc
+}
+
+// AddAttempts adds the "attempts" edges to the RenewalAttempt entity.
+func (rpc *RenewalPolicyCreate) AddAttempts(r ...*RenewalAttempt) *RenewalPolicyCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rpc.AddAttemptIDs(ids...)
+}
+
+// Mutation returns the RenewalPolicyMutation object of the builder.
+func (rpc *RenewalPolicyCreate) Mutation() *RenewalPolicyMutation {
+	return rpc.mutation
+}
+
+// Save creates the RenewalPolicy in the database.
+func (rpc *RenewalPolicyCreate) Save(ctx context.Context) (*RenewalPolicy, error) {
+	rpc.defaults()
+	return withHooks(ctx, rpc.sqlSave, rpc.mutation, rpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rpc *RenewalPolicyCreate) SaveX(ctx context.Context) *RenewalPolicy {
+	v, err := rpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpc *RenewalPolicyCreate) Exec(ctx context.Context) error {
+	_, err := rpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpc *RenewalPolicyCreate) ExecX(ctx context.Context) {
+	if err := rpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpc *RenewalPolicyCreate) defaults() {
+	if _, ok := rpc.mutation.Rekey(); !ok {
+		v := renewalpolicy.DefaultRekey
+		rpc.mutation.SetRekey(v)
+	}
+	if _, ok := rpc.mutation.Enabled(); !ok {
+		v := renewalpolicy.DefaultEnabled
+		rpc.mutation.SetEnabled(v)
+	}
+	if _, ok := rpc.mutation.Failures(); !ok {
+		v := renewalpolicy.DefaultFailures
+		rpc.mutation.SetFailures(v)
+	}
+	if _, ok := rpc.mutation.LastError(); !ok {
+		v := renewalpolicy.DefaultLastError
+		rpc.mutation.SetLastError(v)
+	}
+	if _, ok := rpc.mutation.UpdatedAt(); !ok {
+		v := renewalpolicy.DefaultUpdatedAt()
+		rpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		v := renewalpolicy.DefaultCreatedAt()
+		rpc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpc *RenewalPolicyCreate) check() error {
+	if _, ok := rpc.mutation.CertificateID(); !ok {
+		return &ValidationError{Name: "certificate_id", err: errors.New(`ent: missing required field "RenewalPolicy.certificate_id"`)}
+	}
+	if _, ok := rpc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "RenewalPolicy.trigger"`)}
+	}
+	if v, ok := rpc.mutation.Trigger(); ok {
+		if err := renewalpolicy.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "RenewalPolicy.trigger": %w`, err)}
+		}
+	}
+	if _, ok := rpc.mutation.Rekey(); !ok {
+		return &ValidationError{Name: "rekey", err: errors.New(`ent: missing required field "RenewalPolicy.rekey"`)}
+	}
+	if _, ok := rpc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "RenewalPolicy.enabled"`)}
+	}
+	if _, ok := rpc.mutation.Failures(); !ok {
+		return &ValidationError{Name: "failures", err: errors.New(`ent: missing required field "RenewalPolicy.failures"`)}
+	}
+	if _, ok := rpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RenewalPolicy.updated_at"`)}
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RenewalPolicy.created_at"`)}
+	}
+	if v, ok := rpc.mutation.ID(); ok {
+		if err := renewalpolicy.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RenewalPolicy.id": %w`, err)}
+		}
+	}
+	if len(rpc.mutation.CertificateIDs()) == 0 {
+		return &ValidationError{Name: "certificate", err: errors.New(`ent: missing required edge "RenewalPolicy.certificate"`)}
+	}
+	return nil
+}
+
+func (rpc *RenewalPolicyCreate) sqlSave(ctx context.Context) (*RenewalPolicy, error) {
+	if err := rpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rpc.mutation.id = &_node.ID
+	rpc.mutation.done = true
+	return _node, nil
+}
+
+func (rpc *RenewalPolicyCreate) createSpec() (*RenewalPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RenewalPolicy{config: rpc.config}
+		_spec = sqlgraph.NewCreateSpec(renewalpolicy.Table, sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt))
+	)
+	if id, ok := rpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rpc.mutation.Trigger(); ok {
+		_spec.SetField(renewalpolicy.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := rpc.mutation.RemainingFraction(); ok {
+		_spec.SetField(renewalpolicy.FieldRemainingFraction, field.TypeFloat64, value)
+		_node.RemainingFraction = value
+	}
+	if value, ok := rpc.mutation.IntervalDays(); ok {
+		_spec.SetField(renewalpolicy.FieldIntervalDays, field.TypeInt, value)
+		_node.IntervalDays = value
+	}
+	if value, ok := rpc.mutation.Rekey(); ok {
+		_spec.SetField(renewalpolicy.FieldRekey, field.TypeBool, value)
+		_node.Rekey = value
+	}
+	if value, ok := rpc.mutation.ValidDays(); ok {
+		_spec.SetField(renewalpolicy.FieldValidDays, field.TypeInt, value)
+		_node.ValidDays = value
+	}
+	if value, ok := rpc.mutation.Enabled(); ok {
+		_spec.SetField(renewalpolicy.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := rpc.mutation.Failures(); ok {
+		_spec.SetField(renewalpolicy.FieldFailures, field.TypeInt, value)
+		_node.Failures = value
+	}
+	if value, ok := rpc.mutation.LastError(); ok {
+		_spec.SetField(renewalpolicy.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := rpc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(renewalpolicy.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := rpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(renewalpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rpc.mutation.CreatedAt(); ok {
+		_spec.SetField(renewalpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rpc.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   renewalpolicy.CertificateTable,
+			Columns: []string{renewalpolicy.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CertificateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rpc.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   renewalpolicy.AttemptsTable,
+			Columns: []string{renewalpolicy.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RenewalPolicyCreateBulk is the builder for creating many RenewalPolicy entities in bulk.
+type RenewalPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*RenewalPolicyCreate
+}
+
+// Save creates the RenewalPolicy entities in the database.
+func (rpcb *RenewalPolicyCreateBulk) Save(ctx context.Context) ([]*RenewalPolicy, error) {
+	if rpcb.err != nil {
+		return nil, rpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rpcb.builders))
+	nodes := make([]*RenewalPolicy, len(rpcb.builders))
+	mutators := make([]Mutator, len(rpcb.builders))
+	for i := range rpcb.builders {
+		func(i int, root context.Context) {
+			builder := rpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RenewalPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpcb *RenewalPolicyCreateBulk) SaveX(ctx context.Context) []*RenewalPolicy {
+	v, err := rpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpcb *RenewalPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := rpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpcb *RenewalPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := rpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
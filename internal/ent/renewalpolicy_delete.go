@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+)
+
+// RenewalPolicyDelete is the builder for deleting a RenewalPolicy entity.
+type RenewalPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *RenewalPolicyMutation
+}
+
+// Where appends a list predicates to the RenewalPolicyDelete builder.
+func (rpd *RenewalPolicyDelete) Where(ps ...predicate.RenewalPolicy) *RenewalPolicyDelete {
+	rpd.mutation.Where(ps...)
+	return rpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rpd *RenewalPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rpd.sqlExec, rpd.mutation, rpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpd *RenewalPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := rpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rpd *RenewalPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(renewalpolicy.Table, sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt))
+	if ps := rpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rpd.mutation.done = true
+	return affected, err
+}
+
+// RenewalPolicyDeleteOne is the builder for deleting a single RenewalPolicy entity.
+type RenewalPolicyDeleteOne struct {
+	rpd *RenewalPolicyDelete
+}
+
+// Where appends a list predicates to the RenewalPolicyDelete builder.
+func (rpdo *RenewalPolicyDeleteOne) Where(ps ...predicate.RenewalPolicy) *RenewalPolicyDeleteOne {
+	rpdo.rpd.mutation.Where(ps...)
+	return rpdo
+}
+
+// Exec executes the deletion query.
+func (rpdo *RenewalPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := rpdo.rpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{renewalpolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpdo *RenewalPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := rpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+)
+
+// RenewalPolicyQuery is the builder for querying RenewalPolicy entities.
+type RenewalPolicyQuery struct {
+	config
+	ctx             *QueryContext
+	order           []renewalpolicy.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.RenewalPolicy
+	withCertificate *CertificateQuery
+	withAttempts    *RenewalAttemptQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RenewalPolicyQuery builder.
+func (rpq *RenewalPolicyQuery) Where(ps ...predicate.RenewalPolicy) *RenewalPolicyQuery {
+	rpq.predicates = append(rpq.predicates, ps...)
+	return rpq
+}
+
+// Limit the number of records to be returned by this query.
+func (rpq *RenewalPolicyQuery) Limit(limit int) *RenewalPolicyQuery {
+	rpq.ctx.Limit = &limit
+	return rpq
+}
+
+// Offset to start from.
+func (rpq *RenewalPolicyQuery) Offset(offset int) *RenewalPolicyQuery {
+	rpq.ctx.Offset = &offset
+	return rpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rpq *RenewalPolicyQuery) Unique(unique bool) *RenewalPolicyQuery {
+	rpq.ctx.Unique = &unique
+	return rpq
+}
+
+// Order specifies how the records should be ordered.
+func (rpq *RenewalPolicyQuery) Order(o ...renewalpolicy.OrderOption) *RenewalPolicyQuery {
+	rpq.order = append(rpq.order, o...)
+	return rpq
+}
+
+// QueryCertificate chains the current query on the "certificate" edge.
+func (rpq *RenewalPolicyQuery) QueryCertificate() *CertificateQuery {
+	query := (&CertificateClient{config: rpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(renewalpolicy.Table, renewalpolicy.FieldID, selector),
+			sqlgraph.To(certificate.Table, certificate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, renewalpolicy.CertificateTable, renewalpolicy.CertificateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttempts chains the current query on the "attempts" edge.
+func (rpq *RenewalPolicyQuery) QueryAttempts() *RenewalAttemptQuery {
+	query := (&RenewalAttemptClient{config: rpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(renewalpolicy.Table, renewalpolicy.FieldID, selector),
+			sqlgraph.To(renewalattempt.Table, renewalattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, renewalpolicy.AttemptsTable, renewalpolicy.AttemptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RenewalPolicy entity from the query.
+// Returns a *NotFoundError when no RenewalPolicy was found.
+func (rpq *RenewalPolicyQuery) First(ctx context.Context) (*RenewalPolicy, error) {
+	nodes, err := rpq.Limit(1).All(setContextOp(ctx, rpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{renewalpolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rpq *RenewalPolicyQuery) FirstX(ctx context.Context) *RenewalPolicy {
+	node, err := rpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RenewalPolicy ID from the query.
+// Returns a *NotFoundError when no RenewalPolicy ID was found.
+func (rpq *RenewalPolicyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rpq.Limit(1).IDs(setContextOp(ctx, rpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{renewalpolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rpq *RenewalPolicyQuery) FirstIDX(ctx context.Context) int {
+	id, err := rpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RenewalPolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RenewalPolicy entity is found.
+// Returns a *NotFoundError when no RenewalPolicy entities are found.
+func (rpq *RenewalPolicyQuery) Only(ctx context.Context) (*RenewalPolicy, error) {
+	nodes, err := rpq.Limit(2).All(setContextOp(ctx, rpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{renewalpolicy.Label}
+	default:
+		return nil, &NotSingularError{renewalpolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rpq *RenewalPolicyQuery) OnlyX(ctx context.Context) *RenewalPolicy {
+	node, err := rpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RenewalPolicy ID in the query.
+// Returns a *NotSingularError when more than one RenewalPolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rpq *RenewalPolicyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rpq.Limit(2).IDs(setContextOp(ctx, rpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{renewalpolicy.Label}
+	default:
+		err = &NotSingularError{renewalpolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rpq *RenewalPolicyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RenewalPolicies.
+func (rpq *RenewalPolicyQuery) All(ctx context.Context) ([]*RenewalPolicy, error) {
+	ctx = setContextOp(ctx, rpq.ctx, ent.OpQueryAll)
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RenewalPolicy, *RenewalPolicyQuery]()
+	return withInterceptors[[]*RenewalPolicy](ctx, rpq, qr, rpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rpq *RenewalPolicyQuery) AllX(ctx context.Context) []*RenewalPolicy {
+	nodes, err := rpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RenewalPolicy IDs.
+func (rpq *RenewalPolicyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rpq.ctx.Unique == nil && rpq.path != nil {
+		rpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rpq.ctx, ent.OpQueryIDs)
+	if err = rpq.Select(renewalpolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rpq *RenewalPolicyQuery) IDsX(ctx context.Context) []int {
+	ids, err := rpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rpq *RenewalPolicyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rpq.ctx, ent.OpQueryCount)
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rpq, querierCount[*RenewalPolicyQuery](), rpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rpq *RenewalPolicyQuery) CountX(ctx context.Context) int {
+	count, err := rpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rpq *RenewalPolicyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rpq.ctx, ent.OpQueryExist)
+	switch _, err := rpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rpq *RenewalPolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := rpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RenewalPolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rpq *RenewalPolicyQuery) Clone() *RenewalPolicyQuery {
+	if rpq == nil {
+		return nil
+	}
+	return &RenewalPolicyQuery{
+		config:          rpq.config,
+		ctx:             rpq.ctx.Clone(),
+		order:           append([]renewalpolicy.OrderOption{}, rpq.order...),
+		inters:          append([]Interceptor{}, rpq.inters...),
+		predicates:      append([]predicate.RenewalPolicy{}, rpq.predicates...),
+		withCertificate: rpq.withCertificate.Clone(),
+		withAttempts:    rpq.withAttempts.Clone(),
+		// clone intermediate query.
+		sql:  rpq.sql.Clone(),
+		path: rpq.path,
+	}
+}
+
+// WithCertificate tells the query-builder to eager-load the nodes that are connected to
+// the "certificate" edge. The optional arguments are used to configure the query builder of the edge.
+func (rpq *RenewalPolicyQuery) WithCertificate(opts ...func(*CertificateQuery)) *RenewalPolicyQuery {
+	query := (&CertificateClient{config: rpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rpq.withCertificate = query
+	return rpq
+}
+
+// WithAttempts tells the query-builder to eager-load the nodes that are connected to
+// the "attempts" edge. The optional arguments are used to configure the query builder of the edge.
+func (rpq *RenewalPolicyQuery) WithAttempts(opts ...func(*RenewalAttemptQuery)) *RenewalPolicyQuery {
+	query := (&RenewalAttemptClient{config: rpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rpq.withAttempts = query
+	return rpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CertificateID int `json:"certificate_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RenewalPolicy.Query().
+//		GroupBy(renewalpolicy.FieldCertificateID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rpq *RenewalPolicyQuery) GroupBy(field string, fields ...string) *RenewalPolicyGroupBy {
+	rpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RenewalPolicyGroupBy{build: rpq}
+	grbuild.flds = &rpq.ctx.Fields
+	grbuild.label = renewalpolicy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CertificateID int `json:"certificate_id,omitempty"`
+//	}
+//
+//	client.RenewalPolicy.Query().
+//		Select(renewalpolicy.FieldCertificateID).
+//		Scan(ctx, &v)
+func (rpq *RenewalPolicyQuery) Select(fields ...string) *RenewalPolicySelect {
+	rpq.ctx.Fields = append(rpq.ctx.Fields, fields...)
+	sbuild := &RenewalPolicySelect{RenewalPolicyQuery: rpq}
+	sbuild.label = renewalpolicy.Label
+	sbuild.flds, sbuild.scan = &rpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RenewalPolicySelect configured with the given aggregations.
+func (rpq *RenewalPolicyQuery) Aggregate(fns ...AggregateFunc) *RenewalPolicySelect {
+	return rpq.Select().Aggregate(fns...)
+}
+
+func (rpq *RenewalPolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rpq.ctx.Fields {
+		if !renewalpolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rpq.path != nil {
+		prev, err := rpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rpq.sql = prev
+	}
+	return nil
+}
+
+func (rpq *RenewalPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RenewalPolicy, error) {
+	var (
+		nodes       = []*RenewalPolicy{}
+		_spec       = rpq.querySpec()
+		loadedTypes = [2]bool{
+			rpq.withCertificate != nil,
+			rpq.withAttempts != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RenewalPolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RenewalPolicy{config: rpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rpq.withCertificate; query != nil {
+		if err := rpq.loadCertificate(ctx, query, nodes, nil,
+			func(n *RenewalPolicy, e *Certificate) { n.Edges.Certificate = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rpq.withAttempts; query != nil {
+		if err := rpq.loadAttempts(ctx, query, nodes,
+			func(n *RenewalPolicy) { n.Edges.Attempts = []*RenewalAttempt{} },
+			func(n *RenewalPolicy, e *RenewalAttempt) { n.Edges.Attempts = append(n.Edges.Attempts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rpq *RenewalPolicyQuery) loadCertificate(ctx context.Context, query *CertificateQuery, nodes []*RenewalPolicy, init func(*RenewalPolicy), assign func(*RenewalPolicy, *Certificate)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RenewalPolicy)
+	for i := range nodes {
+		fk := nodes[i].CertificateID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(certificate.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "certificate_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rpq *RenewalPolicyQuery) loadAttempts(ctx context.Context, query *RenewalAttemptQuery, nodes []*RenewalPolicy, init func(*RenewalPolicy), assign func(*RenewalPolicy, *RenewalAttempt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*RenewalPolicy)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(renewalattempt.FieldPolicyID)
+	}
+	query.Where(predicate.RenewalAttempt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(renewalpolicy.AttemptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PolicyID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "policy_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (rpq *RenewalPolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rpq.querySpec()
+	_spec.Node.Columns = rpq.ctx.Fields
+	if len(rpq.ctx.Fields) > 0 {
+		_spec.Unique = rpq.ctx.Unique != nil && *rpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rpq.driver, _spec)
+}
+
+func (rpq *RenewalPolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(renewalpolicy.Table, renewalpolicy.Columns, sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt))
+	_spec.From = rpq.sql
+	if unique := rpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, renewalpolicy.FieldID)
+		for i := range fields {
+			if fields[i] != renewalpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rpq.withCertificate != nil {
+			_spec.Node.AddColumnOnce(renewalpolicy.FieldCertificateID)
+		}
+	}
+	if ps := rpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rpq *RenewalPolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rpq.driver.Dialect())
+	t1 := builder.Table(renewalpolicy.Table)
+	columns := rpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = renewalpolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rpq.sql != nil {
+		selector = rpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rpq.ctx.Unique != nil && *rpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rpq.predicates {
+		p(selector)
+	}
+	for _, p := range rpq.order {
+		p(selector)
+	}
+	if offset := rpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RenewalPolicyGroupBy is the group-by builder for RenewalPolicy entities.
+type RenewalPolicyGroupBy struct {
+	selector
+	build *RenewalPolicyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rpgb *RenewalPolicyGroupBy) Aggregate(fns ...AggregateFunc) *RenewalPolicyGroupBy {
+	rpgb.fns = append(rpgb.fns, fns...)
+	return rpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rpgb *RenewalPolicyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RenewalPolicyQuery, *RenewalPolicyGroupBy](ctx, rpgb.build, rpgb, rpgb.build.inters, v)
+}
+
+func (rpgb *RenewalPolicyGroupBy) sqlScan(ctx context.Context, root *RenewalPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rpgb.fns))
+	for _, fn := range rpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rpgb.flds)+len(rpgb.fns))
+		for _, f := range *rpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RenewalPolicySelect is the builder for selecting fields of RenewalPolicy entities.
+type RenewalPolicySelect struct {
+	*RenewalPolicyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rps *RenewalPolicySelect) Aggregate(fns ...AggregateFunc) *RenewalPolicySelect {
+	rps.fns = append(rps.fns, fns...)
+	return rps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rps *RenewalPolicySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rps.ctx, ent.OpQuerySelect)
+	if err := rps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RenewalPolicyQuery, *RenewalPolicySelect](ctx, rps.RenewalPolicyQuery, rps, rps.inters, v)
+}
+
+func (rps *RenewalPolicySelect) sqlScan(ctx context.Context, root *RenewalPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rps.fns))
+	for _, fn := range rps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
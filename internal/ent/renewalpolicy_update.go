@@ -0,0 +1,992 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+)
+
+// RenewalPolicyUpdate is the builder for updating RenewalPolicy entities.
+type RenewalPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RenewalPolicyMutation
+}
+
+// Where appends a list predicates to the RenewalPolicyUpdate builder.
+func (rpu *RenewalPolicyUpdate) Where(ps ...predicate.RenewalPolicy) *RenewalPolicyUpdate {
+	rpu.mutation.Where(ps...)
+	return rpu
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (rpu *RenewalPolicyUpdate) SetCertificateID(i int) *RenewalPolicyUpdate {
+	rpu.mutation.SetCertificateID(i)
+	return rpu
+}
+
+// SetNillableCertificateID sets the "certificate_id" field if the given value is not nil.
+func (rpu *RenewalPolicyUpdate) SetNillableCertificateID(i *int) *RenewalPolicyUpdate {
+	if i != nil {
+		rpu.SetCertificateID(*i)
+	}
+	return rpu
+}
+
+// SetTrigger sets the "trigger" field.
+func (rpu *RenewalPolicyUpdate) SetTrigger(r renewalpolicy.Trigger) *RenewalPolicyUpdate {
+	rpu.mutation.SetTrigger(r)
+	return rpu
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (rpu *RenewalPolicyUpdate) SetNillableTrigger(r *renewalpolicy.Trigger) *RenewalPolicyUpdate {
+	if r != nil {
+		rpu.SetTrigger(*r)
+	}
+	return rpu
+}
+
+// SetRemainingFraction sets the "remaining_fraction" field.
+func (rpu *RenewalPolicyUpdate) SetRemainingFraction(f float64) *RenewalPolicyUpdate {
+	rpu.mutation.ResetRemainingFraction()
+	rpu.mutation.SetRemainingFraction(f)
+	return rpu
+}
+
+// SetNillableRemainingFraction sets the "remaining_fraction" field if the given value is not nil.
+func (rpu *RenewalPolicyUpdate) SetNillableRemainingFraction(f *float64) *RenewalPolicyUpdate {
+	if f != nil {
+		rpu.SetRemainingFraction(*f)
+	}
+	return rpu
+}
+
+// AddRemainingFraction adds f to the "remaining_fraction" field.
+func (rpu *RenewalPolicyUpdate) AddRemainingFraction(f float64) *RenewalPolicyUpdate {
+	rpu.mutation.AddRemainingFraction(f)
+	return rpu
+}
+
+// ClearRemainingFraction clears the value of the "remaining_fraction" field.
+func (rpu *RenewalPolicyUpdate) ClearRemainingFraction() *RenewalPolicyUpdate {
+	rpu.mutation.ClearRemainingFraction()
+	return rpu
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (rpu *RenewalPolicyUpdate) SetIntervalDays(i int) *RenewalPolicyUpdate {
+	rpu.mutation.ResetIntervalDays()
+	rpu.mutation.SetIntervalDays(i)
+	return rpu
+}
+
+// SetNillableIntervalDays sets the "interval_days" field if the given value is not nil.
+func (rpu *RenewalPolicyUpdate) SetNillableIntervalDays(i *int) *RenewalPolicyUpdate {
+	if i != nil {
+		rpu.SetIntervalDays(*i)
+	}
+	return rpu
+}
+
+// AddIntervalDays adds i to the "interval_days" field.
+func (rpu *RenewalPolicyUpdate) AddIntervalDays(i int) *RenewalPolicyUpdate {
+	rpu.mutation.AddIntervalDays(i)
+	return rpu
+}
+
+// ClearIntervalDays clears the value of the "interval_days" field.
+func (rpu *RenewalPolicyUpdate) ClearIntervalDays() *RenewalPolicyUpdate {
+	rpu.mutation.ClearIntervalDays()
+	return rpu
+}
+
+// SetRekey sets the "rekey" field.
+func (rpu *RenewalPolicyUpdate) SetRekey(b bool) *RenewalPolicyUpdate {
+	rpu.mutation.SetRekey(b)
+	return rpu
+}
+
+// SetNillableRekey sets the "rekey" field if the given value is not nil.
+func (rpu *RenewalPolicyUpdate) SetNillableRekey(b *bool) *RenewalPolicyUpdate {
+	if b != nil {
+		rpu.SetRekey(*b)
+	}
+	return rpu
+}
+
+// SetValidDays sets the "valid_days" field.
+func (rpu *RenewalPolicyUpdate) SetValidDays(i int) *RenewalPolicyUpdate {
+	rpu.mutation.ResetValidDays()
+	rpu.mutation.SetValidDays(i)
+	return rpu
+}
+
+// SetNillableValidDays sets the "valid_days" field if the given value is not nil.
+func (rpu *RenewalPolicyUpdate) SetNillableValidDays(i *int) *RenewalPolicyUpdate {
+	if i != nil {
+		rpu.SetValidDays(*i)
+	}
+	return rpu
+}
+
+// AddValidDays adds i to the "valid_days" field.
+func (rpu *RenewalPolicyUpdate) AddValidDays(i int) *RenewalPolicyUpdate {
+	rpu.mutation.AddValidDays(i)
+	return rpu
+}
+
+// ClearValidDays clears the value of the "valid_days" field.
+func (rpu *RenewalPolicyUpdate) ClearValidDays() *RenewalPolicyUpdate {
+	rpu.mutation.ClearValidDays()
+	return rpu
+}
+
+// SetEnabled sets the "enabled" field.
+func (rpu *RenewalPolicyUpdate) SetEnabled(b bool) *RenewalPolicyUpdate {
+	rpu.mutation.SetEnabled(b)
+	return rpu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rpu *RenewalPolicyUpdate) SetNillableEnabled(b *bool) *RenewalPolicyUpdate {
+	if b != nil {
+		rpu.SetEnabled(*b)
+	}
+	return rpu
+}
+
+// SetFailures sets the "failures" field.
+func (rpu *RenewalPolicyUpdate) SetFailures(i int) *RenewalPolicyUpdate {
+	rpu.mutation.ResetFailures()
+	rpu.mutation.SetFailures(i)
+	return rpu
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (rpu *RenewalPolicyUpdate) SetNillableFailures(i *int) *RenewalPolicyUpdate {
+	if i != nil {
+		rpu.SetFailures(*i)
+	}
+	return rpu
+}
+
+// AddFailures adds i to the "failures" field.
+func (rpu *RenewalPolicyUpdate) AddFailures(i int) *RenewalPolicyUpdate {
+	rpu.mutation.AddFailures(i)
+	return rpu
+}
+
+// SetLastError sets the "last_error" field.
+func (rpu *RenewalPolicyUpdate) SetLastError(s string) *RenewalPolicyUpdate {
+	rpu.mutation.SetLastError(s)
+	return rpu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (rpu *RenewalPolicyUpdate) SetNillableLastError(s *string) *RenewalPolicyUpdate {
+	if s != nil {
+		rpu.SetLastError(*s)
+	}
+	return rpu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (rpu *RenewalPolicyUpdate) ClearLastError() *RenewalPolicyUpdate {
+	rpu.mutation.ClearLastError()
+	return rpu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (rpu *RenewalPolicyUpdate) SetNextAttemptAt(t time.Time) *RenewalPolicyUpdate {
+	rpu.mutation.SetNextAttemptAt(t)
+	return rpu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (rpu *RenewalPolicyUpdate) SetNillableNextAttemptAt(t *time.Time) *RenewalPolicyUpdate {
+	if t != nil {
+		rpu.SetNextAttemptAt(*t)
+	}
+	return rpu
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (rpu *RenewalPolicyUpdate) ClearNextAttemptAt() *RenewalPolicyUpdate {
+	rpu.mutation.ClearNextAttemptAt()
+	return rpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpu *RenewalPolicyUpdate) SetUpdatedAt(t time.Time) *RenewalPolicyUpdate {
+	rpu.mutation.SetUpdatedAt(t)
+	return rpu
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (rpu *RenewalPolicyUpdate) SetCertificate(c *Certificate) *RenewalPolicyUpdate {
+	return rpu.SetCertificateID(c.ID)
+}
+
+// AddAttemptIDs adds the "attempts" edge to the RenewalAttempt entity by IDs.
+func (rpu *RenewalPolicyUpdate) AddAttemptIDs(ids ...int) *RenewalPolicyUpdate {
+	rpu.mutation.AddAttemptIDs(ids...)
+	return rpu
+}
+
+// AddAttempts adds the "attempts" edges to the RenewalAttempt entity.
+func (rpu *RenewalPolicyUpdate) AddAttempts(r ...*RenewalAttempt) *RenewalPolicyUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rpu.AddAttemptIDs(ids...)
+}
+
+// Mutation returns the RenewalPolicyMutation object of the builder.
+func (rpu *RenewalPolicyUpdate) Mutation() *RenewalPolicyMutation {
+	return rpu.mutation
+}
+
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (rpu *RenewalPolicyUpdate) ClearCertificate() *RenewalPolicyUpdate {
+	rpu.mutation.ClearCertificate()
+	return rpu
+}
+
+// ClearAttempts clears all "attempts" edges to the RenewalAttempt entity.
+func (rpu *RenewalPolicyUpdate) ClearAttempts() *RenewalPolicyUpdate {
+	rpu.mutation.ClearAttempts()
+	return rpu
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to RenewalAttempt entities by IDs.
+func (rpu *RenewalPolicyUpdate) RemoveAttemptIDs(ids ...int) *RenewalPolicyUpdate {
+	rpu.mutation.RemoveAttemptIDs(ids...)
+	return rpu
+}
+
+// RemoveAttempts removes "attempts" edges to RenewalAttempt entities.
+func (rpu *RenewalPolicyUpdate) RemoveAttempts(r ...*RenewalAttempt) *RenewalPolicyUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rpu.RemoveAttemptIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rpu *RenewalPolicyUpdate) Save(ctx context.Context) (int, error) {
+	rpu.defaults()
+	return withHooks(ctx, rpu.sqlSave, rpu.mutation, rpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpu *RenewalPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := rpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rpu *RenewalPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := rpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpu *RenewalPolicyUpdate) ExecX(ctx context.Context) {
+	if err := rpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpu *RenewalPolicyUpdate) defaults() {
+	if _, ok := rpu.mutation.UpdatedAt(); !ok {
+		v := renewalpolicy.UpdateDefaultUpdatedAt()
+		rpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpu *RenewalPolicyUpdate) check() error {
+	if v, ok := rpu.mutation.Trigger(); ok {
+		if err := renewalpolicy.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "RenewalPolicy.trigger": %w`, err)}
+		}
+	}
+	if rpu.mutation.CertificateCleared() && len(rpu.mutation.CertificateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RenewalPolicy.certificate"`)
+	}
+	return nil
+}
+
+func (rpu *RenewalPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(renewalpolicy.Table, renewalpolicy.Columns, sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt))
+	if ps := rpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpu.mutation.Trigger(); ok {
+		_spec.SetField(renewalpolicy.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := rpu.mutation.RemainingFraction(); ok {
+		_spec.SetField(renewalpolicy.FieldRemainingFraction, field.TypeFloat64, value)
+	}
+	if value, ok := rpu.mutation.AddedRemainingFraction(); ok {
+		_spec.AddField(renewalpolicy.FieldRemainingFraction, field.TypeFloat64, value)
+	}
+	if rpu.mutation.RemainingFractionCleared() {
+		_spec.ClearField(renewalpolicy.FieldRemainingFraction, field.TypeFloat64)
+	}
+	if value, ok := rpu.mutation.IntervalDays(); ok {
+		_spec.SetField(renewalpolicy.FieldIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := rpu.mutation.AddedIntervalDays(); ok {
+		_spec.AddField(renewalpolicy.FieldIntervalDays, field.TypeInt, value)
+	}
+	if rpu.mutation.IntervalDaysCleared() {
+		_spec.ClearField(renewalpolicy.FieldIntervalDays, field.TypeInt)
+	}
+	if value, ok := rpu.mutation.Rekey(); ok {
+		_spec.SetField(renewalpolicy.FieldRekey, field.TypeBool, value)
+	}
+	if value, ok := rpu.mutation.ValidDays(); ok {
+		_spec.SetField(renewalpolicy.FieldValidDays, field.TypeInt, value)
+	}
+	if value, ok := rpu.mutation.AddedValidDays(); ok {
+		_spec.AddField(renewalpolicy.FieldValidDays, field.TypeInt, value)
+	}
+	if rpu.mutation.ValidDaysCleared() {
+		_spec.ClearField(renewalpolicy.FieldValidDays, field.TypeInt)
+	}
+	if value, ok := rpu.mutation.Enabled(); ok {
+		_spec.SetField(renewalpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := rpu.mutation.Failures(); ok {
+		_spec.SetField(renewalpolicy.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := rpu.mutation.AddedFailures(); ok {
+		_spec.AddField(renewalpolicy.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := rpu.mutation.LastError(); ok {
+		_spec.SetField(renewalpolicy.FieldLastError, field.TypeString, value)
+	}
+	if rpu.mutation.LastErrorCleared() {
+		_spec.ClearField(renewalpolicy.FieldLastError, field.TypeString)
+	}
+	if value, ok := rpu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(renewalpolicy.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if rpu.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(renewalpolicy.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := rpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(renewalpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rpu.mutation.CertificateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   renewalpolicy.CertificateTable,
+			Columns: []string{renewalpolicy.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpu.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   renewalpolicy.CertificateTable,
+			Columns: []string{renewalpolicy.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rpu.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   renewalpolicy.AttemptsTable,
+			Columns: []string{renewalpolicy.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpu.mutation.RemovedAttemptsIDs(); len(nodes) > 0 && !rpu.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   renewalpolicy.AttemptsTable,
+			Columns: []string{renewalpolicy.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpu.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   renewalpolicy.AttemptsTable,
+			Columns: []string{renewalpolicy.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{renewalpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rpu.mutation.done = true
+	return n, nil
+}
+
+// RenewalPolicyUpdateOne is the builder for updating a single RenewalPolicy entity.
+type RenewalPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RenewalPolicyMutation
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (rpuo *RenewalPolicyUpdateOne) SetCertificateID(i int) *RenewalPolicyUpdateOne {
+	rpuo.mutation.SetCertificateID(i)
+	return rpuo
+}
+
+// SetNillableCertificateID sets the "certificate_id" field if the given value is not nil.
+func (rpuo *RenewalPolicyUpdateOne) SetNillableCertificateID(i *int) *RenewalPolicyUpdateOne {
+	if i != nil {
+		rpuo.SetCertificateID(*i)
+	}
+	return rpuo
+}
+
+// SetTrigger sets the "trigger" field.
+func (rpuo *RenewalPolicyUpdateOne) SetTrigger(r renewalpolicy.Trigger) *RenewalPolicyUpdateOne {
+	rpuo.mutation.SetTrigger(r)
+	return rpuo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (rpuo *RenewalPolicyUpdateOne) SetNillableTrigger(r *renewalpolicy.Trigger) *RenewalPolicyUpdateOne {
+	if r != nil {
+		rpuo.SetTrigger(*r)
+	}
+	return rpuo
+}
+
+// SetRemainingFraction sets the "remaining_fraction" field.
+func (rpuo *RenewalPolicyUpdateOne) SetRemainingFraction(f float64) *RenewalPolicyUpdateOne {
+	rpuo.mutation.ResetRemainingFraction()
+	rpuo.mutation.SetRemainingFraction(f)
+	return rpuo
+}
+
+// SetNillableRemainingFraction sets the "remaining_fraction" field if the given value is not nil.
+func (rpuo *RenewalPolicyUpdateOne) SetNillableRemainingFraction(f *float64) *RenewalPolicyUpdateOne {
+	if f != nil {
+		rpuo.SetRemainingFraction(*f)
+	}
+	return rpuo
+}
+
+// AddRemainingFraction adds f to the "remaining_fraction" field.
+func (rpuo *RenewalPolicyUpdateOne) AddRemainingFraction(f float64) *RenewalPolicyUpdateOne {
+	rpuo.mutation.AddRemainingFraction(f)
+	return rpuo
+}
+
+// ClearRemainingFraction clears the value of the "remaining_fraction" field.
+func (rpuo *RenewalPolicyUpdateOne) ClearRemainingFraction() *RenewalPolicyUpdateOne {
+	rpuo.mutation.ClearRemainingFraction()
+	return rpuo
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (rpuo *RenewalPolicyUpdateOne) SetIntervalDays(i int) *RenewalPolicyUpdateOne {
+	rpuo.mutation.ResetIntervalDays()
+	rpuo.mutation.SetIntervalDays(i)
+	return rpuo
+}
+
+// SetNillableIntervalDays sets the "interval_days" field if the given value is not nil.
+func (rpuo *RenewalPolicyUpdateOne) SetNillableIntervalDays(i *int) *RenewalPolicyUpdateOne {
+	if i != nil {
+		rpuo.SetIntervalDays(*i)
+	}
+	return rpuo
+}
+
+// AddIntervalDays adds i to the "interval_days" field.
+func (rpuo *RenewalPolicyUpdateOne) AddIntervalDays(i int) *RenewalPolicyUpdateOne {
+	rpuo.mutation.AddIntervalDays(i)
+	return rpuo
+}
+
+// ClearIntervalDays clears the value of the "interval_days" field.
+func (rpuo *RenewalPolicyUpdateOne) ClearIntervalDays() *RenewalPolicyUpdateOne {
+	rpuo.mutation.ClearIntervalDays()
+	return rpuo
+}
+
+// SetRekey sets the "rekey" field.
+func (rpuo *RenewalPolicyUpdateOne) SetRekey(b bool) *RenewalPolicyUpdateOne {
+	rpuo.mutation.SetRekey(b)
+	return rpuo
+}
+
+// SetNillableRekey sets the "rekey" field if the given value is not nil.
+func (rpuo *RenewalPolicyUpdateOne) SetNillableRekey(b *bool) *RenewalPolicyUpdateOne {
+	if b != nil {
+		rpuo.SetRekey(*b)
+	}
+	return rpuo
+}
+
+// SetValidDays sets the "valid_days" field.
+func (rpuo *RenewalPolicyUpdateOne) SetValidDays(i int) *RenewalPolicyUpdateOne {
+	rpuo.mutation.ResetValidDays()
+	rpuo.mutation.SetValidDays(i)
+	return rpuo
+}
+
+// SetNillableValidDays sets the "valid_days" field if the given value is not nil.
+func (rpuo *RenewalPolicyUpdateOne) SetNillableValidDays(i *int) *RenewalPolicyUpdateOne {
+	if i != nil {
+		rpuo.SetValidDays(*i)
+	}
+	return rpuo
+}
+
+// AddValidDays adds i to the "valid_days" field.
+func (rpuo *RenewalPolicyUpdateOne) AddValidDays(i int) *RenewalPolicyUpdateOne {
+	rpuo.mutation.AddValidDays(i)
+	return rpuo
+}
+
+// ClearValidDays clears the value of the "valid_days" field.
+func (rpuo *RenewalPolicyUpdateOne) ClearValidDays() *RenewalPolicyUpdateOne {
+	rpuo.mutation.ClearValidDays()
+	return rpuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (rpuo *RenewalPolicyUpdateOne) SetEnabled(b bool) *RenewalPolicyUpdateOne {
+	rpuo.mutation.SetEnabled(b)
+	return rpuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rpuo *RenewalPolicyUpdateOne) SetNillableEnabled(b *bool) *RenewalPolicyUpdateOne {
+	if b != nil {
+		rpuo.SetEnabled(*b)
+	}
+	return rpuo
+}
+
+// SetFailures sets the "failures" field.
+func (rpuo *RenewalPolicyUpdateOne) SetFailures(i int) *RenewalPolicyUpdateOne {
+	rpuo.mutation.ResetFailures()
+	rpuo.mutation.SetFailures(i)
+	return rpuo
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (rpuo *RenewalPolicyUpdateOne) SetNillableFailures(i *int) *RenewalPolicyUpdateOne {
+	if i != nil {
+		rpuo.SetFailures(*i)
+	}
+	return rpuo
+}
+
+// AddFailures adds i to the "failures" field.
+func (rpuo *RenewalPolicyUpdateOne) AddFailures(i int) *RenewalPolicyUpdateOne {
+	rpuo.mutation.AddFailures(i)
+	return rpuo
+}
+
+// SetLastError sets the "last_error" field.
+func (rpuo *RenewalPolicyUpdateOne) SetLastError(s string) *RenewalPolicyUpdateOne {
+	rpuo.mutation.SetLastError(s)
+	return rpuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (rpuo *RenewalPolicyUpdateOne) SetNillableLastError(s *string) *RenewalPolicyUpdateOne {
+	if s != nil {
+		rpuo.SetLastError(*s)
+	}
+	return rpuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (rpuo *RenewalPolicyUpdateOne) ClearLastError() *RenewalPolicyUpdateOne {
+	rpuo.mutation.ClearLastError()
+	return rpuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (rpuo *RenewalPolicyUpdateOne) SetNextAttemptAt(t time.Time) *RenewalPolicyUpdateOne {
+	rpuo.mutation.SetNextAttemptAt(t)
+	return rpuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (rpuo *RenewalPolicyUpdateOne) SetNillableNextAttemptAt(t *time.Time) *RenewalPolicyUpdateOne {
+	if t != nil {
+		rpuo.SetNextAttemptAt(*t)
+	}
+	return rpuo
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (rpuo *RenewalPolicyUpdateOne) ClearNextAttemptAt() *RenewalPolicyUpdateOne {
+	rpuo.mutation.ClearNextAttemptAt()
+	return rpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpuo *RenewalPolicyUpdateOne) SetUpdatedAt(t time.Time) *RenewalPolicyUpdateOne {
+	rpuo.mutation.SetUpdatedAt(t)
+	return rpuo
+}
+
+// SetCertificate sets the "certificate" edge to the Certificate entity.
+func (rpuo *RenewalPolicyUpdateOne) SetCertificate(c *Certificate) *RenewalPolicyUpdateOne {
+	return rpuo.SetCertificateID(c.ID)
+}
+
+// AddAttemptIDs adds the "attempts" edge to the RenewalAttempt entity by IDs.
+func (rpuo *RenewalPolicyUpdateOne) AddAttemptIDs(ids ...int) *RenewalPolicyUpdateOne {
+	rpuo.mutation.AddAttemptIDs(ids...)
+	return rpuo
+}
+
+// AddAttempts adds the "attempts" edges to the RenewalAttempt entity.
+func (rpuo *RenewalPolicyUpdateOne) AddAttempts(r ...*RenewalAttempt) *RenewalPolicyUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rpuo.AddAttemptIDs(ids...)
+}
+
+// Mutation returns the RenewalPolicyMutation object of the builder.
+func (rpuo *RenewalPolicyUpdateOne) Mutation() *RenewalPolicyMutation {
+	return rpuo.mutation
+}
+
+// ClearCertificate clears the "certificate" edge to the Certificate entity.
+func (rpuo *RenewalPolicyUpdateOne) ClearCertificate() *RenewalPolicyUpdateOne {
+	rpuo.mutation.ClearCertificate()
+	return rpuo
+}
+
+// ClearAttempts clears all "attempts" edges to the RenewalAttempt entity.
+func (rpuo *RenewalPolicyUpdateOne) ClearAttempts() *RenewalPolicyUpdateOne {
+	rpuo.mutation.ClearAttempts()
+	return rpuo
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to RenewalAttempt entities by IDs.
+func (rpuo *RenewalPolicyUpdateOne) RemoveAttemptIDs(ids ...int) *RenewalPolicyUpdateOne {
+	rpuo.mutation.RemoveAttemptIDs(ids...)
+	return rpuo
+}
+
+// RemoveAttempts removes "attempts" edges to RenewalAttempt entities.
+func (rpuo *RenewalPolicyUpdateOne) RemoveAttempts(r ...*RenewalAttempt) *RenewalPolicyUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rpuo.RemoveAttemptIDs(ids...)
+}
+
+// Where appends a list predicates to the RenewalPolicyUpdate builder.
+func (rpuo *RenewalPolicyUpdateOne) Where(ps ...predicate.RenewalPolicy) *RenewalPolicyUpdateOne {
+	rpuo.mutation.Where(ps...)
+	return rpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rpuo *RenewalPolicyUpdateOne) Select(field string, fields ...string) *RenewalPolicyUpdateOne {
+	rpuo.fields = append([]string{field}, fields...)
+	return rpuo
+}
+
+// Save executes the query and returns the updated RenewalPolicy entity.
+func (rpuo *RenewalPolicyUpdateOne) Save(ctx context.Context) (*RenewalPolicy, error) {
+	rpuo.defaults()
+	return withHooks(ctx, rpuo.sqlSave, rpuo.mutation, rpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpuo *RenewalPolicyUpdateOne) SaveX(ctx context.Context) *RenewalPolicy {
+	node, err := rpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rpuo *RenewalPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := rpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpuo *RenewalPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := rpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpuo *RenewalPolicyUpdateOne) defaults() {
+	if _, ok := rpuo.mutation.UpdatedAt(); !ok {
+		v := renewalpolicy.UpdateDefaultUpdatedAt()
+		rpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpuo *RenewalPolicyUpdateOne) check() error {
+	if v, ok := rpuo.mutation.Trigger(); ok {
+		if err := renewalpolicy.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "RenewalPolicy.trigger": %w`, err)}
+		}
+	}
+	if rpuo.mutation.CertificateCleared() && len(rpuo.mutation.CertificateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RenewalPolicy.certificate"`)
+	}
+	return nil
+}
+
+func (rpuo *RenewalPolicyUpdateOne) sqlSave(ctx context.Context) (_node *RenewalPolicy, err error) {
+	if err := rpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(renewalpolicy.Table, renewalpolicy.Columns, sqlgraph.NewFieldSpec(renewalpolicy.FieldID, field.TypeInt))
+	id, ok := rpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RenewalPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, renewalpolicy.FieldID)
+		for _, f := range fields {
+			if !renewalpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != renewalpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpuo.mutation.Trigger(); ok {
+		_spec.SetField(renewalpolicy.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := rpuo.mutation.RemainingFraction(); ok {
+		_spec.SetField(renewalpolicy.FieldRemainingFraction, field.TypeFloat64, value)
+	}
+	if value, ok := rpuo.mutation.AddedRemainingFraction(); ok {
+		_spec.AddField(renewalpolicy.FieldRemainingFraction, field.TypeFloat64, value)
+	}
+	if rpuo.mutation.RemainingFractionCleared() {
+		_spec.ClearField(renewalpolicy.FieldRemainingFraction, field.TypeFloat64)
+	}
+	if value, ok := rpuo.mutation.IntervalDays(); ok {
+		_spec.SetField(renewalpolicy.FieldIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := rpuo.mutation.AddedIntervalDays(); ok {
+		_spec.AddField(renewalpolicy.FieldIntervalDays, field.TypeInt, value)
+	}
+	if rpuo.mutation.IntervalDaysCleared() {
+		_spec.ClearField(renewalpolicy.FieldIntervalDays, field.TypeInt)
+	}
+	if value, ok := rpuo.mutation.Rekey(); ok {
+		_spec.SetField(renewalpolicy.FieldRekey, field.TypeBool, value)
+	}
+	if value, ok := rpuo.mutation.ValidDays(); ok {
+		_spec.SetField(renewalpolicy.FieldValidDays, field.TypeInt, value)
+	}
+	if value, ok := rpuo.mutation.AddedValidDays(); ok {
+		_spec.AddField(renewalpolicy.FieldValidDays, field.TypeInt, value)
+	}
+	if rpuo.mutation.ValidDaysCleared() {
+		_spec.ClearField(renewalpolicy.FieldValidDays, field.TypeInt)
+	}
+	if value, ok := rpuo.mutation.Enabled(); ok {
+		_spec.SetField(renewalpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := rpuo.mutation.Failures(); ok {
+		_spec.SetField(renewalpolicy.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := rpuo.mutation.AddedFailures(); ok {
+		_spec.AddField(renewalpolicy.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := rpuo.mutation.LastError(); ok {
+		_spec.SetField(renewalpolicy.FieldLastError, field.TypeString, value)
+	}
+	if rpuo.mutation.LastErrorCleared() {
+		_spec.ClearField(renewalpolicy.FieldLastError, field.TypeString)
+	}
+	if value, ok := rpuo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(renewalpolicy.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if rpuo.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(renewalpolicy.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := rpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(renewalpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rpuo.mutation.CertificateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   renewalpolicy.CertificateTable,
+			Columns: []string{renewalpolicy.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpuo.mutation.CertificateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   renewalpolicy.CertificateTable,
+			Columns: []string{renewalpolicy.CertificateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certificate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rpuo.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   renewalpolicy.AttemptsTable,
+			Columns: []string{renewalpolicy.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpuo.mutation.RemovedAttemptsIDs(); len(nodes) > 0 && !rpuo.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   renewalpolicy.AttemptsTable,
+			Columns: []string{renewalpolicy.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpuo.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   renewalpolicy.AttemptsTable,
+			Columns: []string{renewalpolicy.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(renewalattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RenewalPolicy{config: rpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{renewalpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rpuo.mutation.done = true
+	return _node, nil
+}
@@ -5,57 +5,241 @@ package ent
 import (
 	"time"
 
+	"github.com/logeable/certmgr/internal/ent/auditevent"
 	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+	"github.com/logeable/certmgr/internal/ent/keyring"
 	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
 	"github.com/logeable/certmgr/internal/ent/schema"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescActor is the schema descriptor for actor field.
+	auditeventDescActor := auditeventFields[1].Descriptor()
+	// auditevent.DefaultActor holds the default value on creation for the actor field.
+	auditevent.DefaultActor = auditeventDescActor.Default.(string)
+	// auditeventDescParams is the schema descriptor for params field.
+	auditeventDescParams := auditeventFields[7].Descriptor()
+	// auditevent.DefaultParams holds the default value on creation for the params field.
+	auditevent.DefaultParams = auditeventDescParams.Default.(string)
+	// auditeventDescError is the schema descriptor for error field.
+	auditeventDescError := auditeventFields[9].Descriptor()
+	// auditevent.DefaultError holds the default value on creation for the error field.
+	auditevent.DefaultError = auditeventDescError.Default.(string)
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[12].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditevent.IDValidator = auditeventDescID.Validators[0].(func(int) error)
 	certificateFields := schema.Certificate{}.Fields()
 	_ = certificateFields
+	// certificateDescKeyRef is the schema descriptor for key_ref field.
+	certificateDescKeyRef := certificateFields[4].Descriptor()
+	// certificate.DefaultKeyRef holds the default value on creation for the key_ref field.
+	certificate.DefaultKeyRef = certificateDescKeyRef.Default.(string)
+	// certificateDescKeyExportable is the schema descriptor for key_exportable field.
+	certificateDescKeyExportable := certificateFields[5].Descriptor()
+	// certificate.DefaultKeyExportable holds the default value on creation for the key_exportable field.
+	certificate.DefaultKeyExportable = certificateDescKeyExportable.Default.(bool)
+	// certificateDescOffline is the schema descriptor for offline field.
+	certificateDescOffline := certificateFields[6].Descriptor()
+	// certificate.DefaultOffline holds the default value on creation for the offline field.
+	certificate.DefaultOffline = certificateDescOffline.Default.(bool)
 	// certificateDescDesc is the schema descriptor for desc field.
-	certificateDescDesc := certificateFields[4].Descriptor()
+	certificateDescDesc := certificateFields[7].Descriptor()
 	// certificate.DefaultDesc holds the default value on creation for the desc field.
 	certificate.DefaultDesc = certificateDescDesc.Default.(string)
 	// certificateDescUsage is the schema descriptor for usage field.
-	certificateDescUsage := certificateFields[6].Descriptor()
+	certificateDescUsage := certificateFields[9].Descriptor()
 	// certificate.DefaultUsage holds the default value on creation for the usage field.
 	certificate.DefaultUsage = certificateDescUsage.Default.(string)
+	// certificateDescSubject is the schema descriptor for subject field.
+	certificateDescSubject := certificateFields[10].Descriptor()
+	// certificate.DefaultSubject holds the default value on creation for the subject field.
+	certificate.DefaultSubject = certificateDescSubject.Default.(string)
+	// certificateDescCommonName is the schema descriptor for common_name field.
+	certificateDescCommonName := certificateFields[11].Descriptor()
+	// certificate.DefaultCommonName holds the default value on creation for the common_name field.
+	certificate.DefaultCommonName = certificateDescCommonName.Default.(string)
+	// certificateDescSerialNumber is the schema descriptor for serial_number field.
+	certificateDescSerialNumber := certificateFields[12].Descriptor()
+	// certificate.DefaultSerialNumber holds the default value on creation for the serial_number field.
+	certificate.DefaultSerialNumber = certificateDescSerialNumber.Default.(string)
+	// certificateDescFingerprint is the schema descriptor for fingerprint field.
+	certificateDescFingerprint := certificateFields[13].Descriptor()
+	// certificate.DefaultFingerprint holds the default value on creation for the fingerprint field.
+	certificate.DefaultFingerprint = certificateDescFingerprint.Default.(string)
+	// certificateDescSubjectKeyID is the schema descriptor for subject_key_id field.
+	certificateDescSubjectKeyID := certificateFields[14].Descriptor()
+	// certificate.DefaultSubjectKeyID holds the default value on creation for the subject_key_id field.
+	certificate.DefaultSubjectKeyID = certificateDescSubjectKeyID.Default.(string)
+	// certificateDescAuthorityKeyID is the schema descriptor for authority_key_id field.
+	certificateDescAuthorityKeyID := certificateFields[15].Descriptor()
+	// certificate.DefaultAuthorityKeyID holds the default value on creation for the authority_key_id field.
+	certificate.DefaultAuthorityKeyID = certificateDescAuthorityKeyID.Default.(string)
+	// certificateDescKeyType is the schema descriptor for key_type field.
+	certificateDescKeyType := certificateFields[16].Descriptor()
+	// certificate.DefaultKeyType holds the default value on creation for the key_type field.
+	certificate.DefaultKeyType = certificateDescKeyType.Default.(string)
+	// certificateDescSignatureAlgorithm is the schema descriptor for signature_algorithm field.
+	certificateDescSignatureAlgorithm := certificateFields[17].Descriptor()
+	// certificate.DefaultSignatureAlgorithm holds the default value on creation for the signature_algorithm field.
+	certificate.DefaultSignatureAlgorithm = certificateDescSignatureAlgorithm.Default.(string)
+	// certificateDescIsCa is the schema descriptor for is_ca field.
+	certificateDescIsCa := certificateFields[18].Descriptor()
+	// certificate.DefaultIsCa holds the default value on creation for the is_ca field.
+	certificate.DefaultIsCa = certificateDescIsCa.Default.(bool)
 	// certificateDescUpdatedAt is the schema descriptor for updated_at field.
-	certificateDescUpdatedAt := certificateFields[7].Descriptor()
+	certificateDescUpdatedAt := certificateFields[23].Descriptor()
 	// certificate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	certificate.DefaultUpdatedAt = certificateDescUpdatedAt.Default.(func() time.Time)
 	// certificate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	certificate.UpdateDefaultUpdatedAt = certificateDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// certificateDescCreatedAt is the schema descriptor for created_at field.
-	certificateDescCreatedAt := certificateFields[8].Descriptor()
+	certificateDescCreatedAt := certificateFields[24].Descriptor()
 	// certificate.DefaultCreatedAt holds the default value on creation for the created_at field.
 	certificate.DefaultCreatedAt = certificateDescCreatedAt.Default.(func() time.Time)
 	// certificateDescID is the schema descriptor for id field.
 	certificateDescID := certificateFields[0].Descriptor()
 	// certificate.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	certificate.IDValidator = certificateDescID.Validators[0].(func(int) error)
+	expirynotificationFields := schema.ExpiryNotification{}.Fields()
+	_ = expirynotificationFields
+	// expirynotificationDescCreatedAt is the schema descriptor for created_at field.
+	expirynotificationDescCreatedAt := expirynotificationFields[5].Descriptor()
+	// expirynotification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	expirynotification.DefaultCreatedAt = expirynotificationDescCreatedAt.Default.(func() time.Time)
+	// expirynotificationDescID is the schema descriptor for id field.
+	expirynotificationDescID := expirynotificationFields[0].Descriptor()
+	// expirynotification.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	expirynotification.IDValidator = expirynotificationDescID.Validators[0].(func(int) error)
+	keyringFields := schema.Keyring{}.Fields()
+	_ = keyringFields
+	// keyringDescKeysBound is the schema descriptor for keys_bound field.
+	keyringDescKeysBound := keyringFields[7].Descriptor()
+	// keyring.DefaultKeysBound holds the default value on creation for the keys_bound field.
+	keyring.DefaultKeysBound = keyringDescKeysBound.Default.(bool)
+	// keyringDescUpdatedAt is the schema descriptor for updated_at field.
+	keyringDescUpdatedAt := keyringFields[8].Descriptor()
+	// keyring.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	keyring.DefaultUpdatedAt = keyringDescUpdatedAt.Default.(func() time.Time)
+	// keyring.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	keyring.UpdateDefaultUpdatedAt = keyringDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// keyringDescCreatedAt is the schema descriptor for created_at field.
+	keyringDescCreatedAt := keyringFields[9].Descriptor()
+	// keyring.DefaultCreatedAt holds the default value on creation for the created_at field.
+	keyring.DefaultCreatedAt = keyringDescCreatedAt.Default.(func() time.Time)
+	// keyringDescID is the schema descriptor for id field.
+	keyringDescID := keyringFields[0].Descriptor()
+	// keyring.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	keyring.IDValidator = keyringDescID.Validators[0].(func(int) error)
 	namespaceFields := schema.Namespace{}.Fields()
 	_ = namespaceFields
 	// namespaceDescDesc is the schema descriptor for desc field.
 	namespaceDescDesc := namespaceFields[2].Descriptor()
 	// namespace.DefaultDesc holds the default value on creation for the desc field.
 	namespace.DefaultDesc = namespaceDescDesc.Default.(string)
+	// namespaceDescKeyRevealDisabled is the schema descriptor for key_reveal_disabled field.
+	namespaceDescKeyRevealDisabled := namespaceFields[3].Descriptor()
+	// namespace.DefaultKeyRevealDisabled holds the default value on creation for the key_reveal_disabled field.
+	namespace.DefaultKeyRevealDisabled = namespaceDescKeyRevealDisabled.Default.(bool)
 	// namespaceDescUpdatedAt is the schema descriptor for updated_at field.
-	namespaceDescUpdatedAt := namespaceFields[3].Descriptor()
+	namespaceDescUpdatedAt := namespaceFields[4].Descriptor()
 	// namespace.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	namespace.DefaultUpdatedAt = namespaceDescUpdatedAt.Default.(func() time.Time)
 	// namespace.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	namespace.UpdateDefaultUpdatedAt = namespaceDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// namespaceDescCreatedAt is the schema descriptor for created_at field.
-	namespaceDescCreatedAt := namespaceFields[4].Descriptor()
+	namespaceDescCreatedAt := namespaceFields[5].Descriptor()
 	// namespace.DefaultCreatedAt holds the default value on creation for the created_at field.
 	namespace.DefaultCreatedAt = namespaceDescCreatedAt.Default.(func() time.Time)
 	// namespaceDescID is the schema descriptor for id field.
 	namespaceDescID := namespaceFields[0].Descriptor()
 	// namespace.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	namespace.IDValidator = namespaceDescID.Validators[0].(func(int) error)
+	renewalattemptFields := schema.RenewalAttempt{}.Fields()
+	_ = renewalattemptFields
+	// renewalattemptDescError is the schema descriptor for error field.
+	renewalattemptDescError := renewalattemptFields[6].Descriptor()
+	// renewalattempt.DefaultError holds the default value on creation for the error field.
+	renewalattempt.DefaultError = renewalattemptDescError.Default.(string)
+	// renewalattemptDescCreatedAt is the schema descriptor for created_at field.
+	renewalattemptDescCreatedAt := renewalattemptFields[8].Descriptor()
+	// renewalattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	renewalattempt.DefaultCreatedAt = renewalattemptDescCreatedAt.Default.(func() time.Time)
+	// renewalattemptDescID is the schema descriptor for id field.
+	renewalattemptDescID := renewalattemptFields[0].Descriptor()
+	// renewalattempt.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	renewalattempt.IDValidator = renewalattemptDescID.Validators[0].(func(int) error)
+	renewalpolicyFields := schema.RenewalPolicy{}.Fields()
+	_ = renewalpolicyFields
+	// renewalpolicyDescRekey is the schema descriptor for rekey field.
+	renewalpolicyDescRekey := renewalpolicyFields[5].Descriptor()
+	// renewalpolicy.DefaultRekey holds the default value on creation for the rekey field.
+	renewalpolicy.DefaultRekey = renewalpolicyDescRekey.Default.(bool)
+	// renewalpolicyDescEnabled is the schema descriptor for enabled field.
+	renewalpolicyDescEnabled := renewalpolicyFields[7].Descriptor()
+	// renewalpolicy.DefaultEnabled holds the default value on creation for the enabled field.
+	renewalpolicy.DefaultEnabled = renewalpolicyDescEnabled.Default.(bool)
+	// renewalpolicyDescFailures is the schema descriptor for failures field.
+	renewalpolicyDescFailures := renewalpolicyFields[8].Descriptor()
+	// renewalpolicy.DefaultFailures holds the default value on creation for the failures field.
+	renewalpolicy.DefaultFailures = renewalpolicyDescFailures.Default.(int)
+	// renewalpolicyDescLastError is the schema descriptor for last_error field.
+	renewalpolicyDescLastError := renewalpolicyFields[9].Descriptor()
+	// renewalpolicy.DefaultLastError holds the default value on creation for the last_error field.
+	renewalpolicy.DefaultLastError = renewalpolicyDescLastError.Default.(string)
+	// renewalpolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	renewalpolicyDescUpdatedAt := renewalpolicyFields[11].Descriptor()
+	// renewalpolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	renewalpolicy.DefaultUpdatedAt = renewalpolicyDescUpdatedAt.Default.(func() time.Time)
+	// renewalpolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	renewalpolicy.UpdateDefaultUpdatedAt = renewalpolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// renewalpolicyDescCreatedAt is the schema descriptor for created_at field.
+	renewalpolicyDescCreatedAt := renewalpolicyFields[12].Descriptor()
+	// renewalpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	renewalpolicy.DefaultCreatedAt = renewalpolicyDescCreatedAt.Default.(func() time.Time)
+	// renewalpolicyDescID is the schema descriptor for id field.
+	renewalpolicyDescID := renewalpolicyFields[0].Descriptor()
+	// renewalpolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	renewalpolicy.IDValidator = renewalpolicyDescID.Validators[0].(func(int) error)
+	signingrequestFields := schema.SigningRequest{}.Fields()
+	_ = signingrequestFields
+	// signingrequestDescKeyRef is the schema descriptor for key_ref field.
+	signingrequestDescKeyRef := signingrequestFields[7].Descriptor()
+	// signingrequest.DefaultKeyRef holds the default value on creation for the key_ref field.
+	signingrequest.DefaultKeyRef = signingrequestDescKeyRef.Default.(string)
+	// signingrequestDescDesc is the schema descriptor for desc field.
+	signingrequestDescDesc := signingrequestFields[8].Descriptor()
+	// signingrequest.DefaultDesc holds the default value on creation for the desc field.
+	signingrequest.DefaultDesc = signingrequestDescDesc.Default.(string)
+	// signingrequestDescUsage is the schema descriptor for usage field.
+	signingrequestDescUsage := signingrequestFields[9].Descriptor()
+	// signingrequest.DefaultUsage holds the default value on creation for the usage field.
+	signingrequest.DefaultUsage = signingrequestDescUsage.Default.(string)
+	// signingrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	signingrequestDescUpdatedAt := signingrequestFields[11].Descriptor()
+	// signingrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	signingrequest.DefaultUpdatedAt = signingrequestDescUpdatedAt.Default.(func() time.Time)
+	// signingrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	signingrequest.UpdateDefaultUpdatedAt = signingrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// signingrequestDescCreatedAt is the schema descriptor for created_at field.
+	signingrequestDescCreatedAt := signingrequestFields[12].Descriptor()
+	// signingrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signingrequest.DefaultCreatedAt = signingrequestDescCreatedAt.Default.(func() time.Time)
+	// signingrequestDescID is the schema descriptor for id field.
+	signingrequestDescID := signingrequestFields[0].Descriptor()
+	// signingrequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	signingrequest.IDValidator = signingrequestDescID.Validators[0].(func(int) error)
 }
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity, one row
+// per mutation or sensitive read. Rows are chained by hash and never updated;
+// target IDs are plain columns so events outlive what they describe.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique().
+			Immutable(),
+		field.String("actor").Default(""),
+		field.String("source"),
+		field.String("operation"),
+		field.String("target_type"),
+		field.Int("target_id").Optional(),
+		field.Int("namespace_id").Optional(),
+		field.Text("params").Optional().Default(""),
+		field.Enum("outcome").Values("success", "failure"),
+		field.Text("error").Optional().Default(""),
+		field.String("prev_hash").Unique(),
+		field.String("hash").Unique(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("target_type", "target_id"),
+		index.Fields("namespace_id"),
+		index.Fields("operation"),
+		index.Fields("actor"),
+	}
+}
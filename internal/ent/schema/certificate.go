@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -24,9 +25,27 @@ func (Certificate) Fields() []ent.Field {
 		field.Int("namespace_id"),
 		field.Text("cert_pem"),
 		field.Text("key_pem").Optional(),
+		field.Text("key_ref").Optional().Default(""),
+		field.Bool("key_exportable").Default(true),
+		field.Bool("offline").Default(false),
 		field.Text("desc").Optional().Default(""),
 		field.Int("issuer_id").Optional(),
 		field.Text("usage").Optional().Default(""),
+		// Parsed from cert_pem whenever it is set, so lists and searches
+		// can run in SQL.
+		field.Text("subject").Optional().Default(""),
+		field.String("common_name").Optional().Default(""),
+		field.String("serial_number").Optional().Default(""),
+		field.String("fingerprint").Optional().Default(""),
+		field.String("subject_key_id").Optional().Default(""),
+		field.String("authority_key_id").Optional().Default(""),
+		field.String("key_type").Optional().Default(""),
+		field.String("signature_algorithm").Optional().Default(""),
+		field.Bool("is_ca").Optional().Default(false),
+		field.Time("not_before").Optional(),
+		field.Time("not_after").Optional(),
+		field.Strings("dns_names").Optional(),
+		field.Strings("ip_addresses").Optional(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
@@ -40,6 +59,8 @@ func (Certificate) Fields() []ent.Field {
 func (Certificate) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("namespace", Namespace.Type).Ref("certificates").Field("namespace_id").Unique().Required(),
+		edge.To("expiry_notifications", ExpiryNotification.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("renewal_policy", RenewalPolicy.Type).Unique().Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -47,5 +68,12 @@ func (Certificate) Edges() []ent.Edge {
 func (Certificate) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("namespace_id"),
+		index.Fields("namespace_id", "not_after"),
+		index.Fields("not_after"),
+		index.Fields("common_name"),
+		index.Fields("serial_number"),
+		index.Fields("fingerprint"),
+		index.Fields("subject_key_id"),
+		index.Fields("authority_key_id"),
 	}
 }
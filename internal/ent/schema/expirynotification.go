@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExpiryNotification holds the schema definition for the ExpiryNotification
+// entity. A row records that the notification for a threshold was sent for a
+// certificate, while the chain member expiring first had the given fingerprint.
+type ExpiryNotification struct {
+	ent.Schema
+}
+
+// Fields of the ExpiryNotification.
+func (ExpiryNotification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique().
+			Immutable(),
+		field.Int("certificate_id"),
+		field.String("fingerprint"),
+		field.Int("threshold_days"),
+		field.Time("not_after"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ExpiryNotification.
+func (ExpiryNotification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("certificate", Certificate.Type).Ref("expiry_notifications").Field("certificate_id").Unique().Required(),
+	}
+}
+
+// Indexes of the ExpiryNotification.
+func (ExpiryNotification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("certificate_id", "fingerprint", "threshold_days").Unique(),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Keyring holds the schema definition for the Keyring entity. It stores the
+// parameters needed to re-derive the master key that wraps private key data
+// keys, plus a check value used to verify the master key on unlock.
+type Keyring struct {
+	ent.Schema
+}
+
+// Fields of the Keyring.
+func (Keyring) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique().
+			Immutable(),
+		field.Enum("source").Values("passphrase", "keyfile"),
+		field.Bytes("salt").Optional(),
+		field.Uint32("kdf_time").Optional(),
+		field.Uint32("kdf_memory").Optional(),
+		field.Uint8("kdf_threads").Optional(),
+		field.Bytes("check"),
+		// Set once every sealed key is bound to the row holding it.
+		field.Bool("keys_bound").Default(false),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Keyring.
+func (Keyring) Edges() []ent.Edge {
+	return nil
+}
@@ -24,6 +24,10 @@ func (Namespace) Fields() []ent.Field {
 			Immutable(),
 		field.Text("name").Unique(),
 		field.Text("desc").Optional().Default(""),
+		// Turns off revealing and exporting the private keys of the
+		// namespace's certificates, and leaves them out of namespace
+		// bundles. Full backups still hold them.
+		field.Bool("key_reveal_disabled").Default(false),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
@@ -37,6 +41,7 @@ func (Namespace) Fields() []ent.Field {
 func (Namespace) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("certificates", Certificate.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("signing_requests", SigningRequest.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RenewalAttempt holds the schema definition for the RenewalAttempt entity,
+// one row per automatic renewal run of a policy.
+type RenewalAttempt struct {
+	ent.Schema
+}
+
+// Fields of the RenewalAttempt.
+func (RenewalAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique().
+			Immutable(),
+		field.Int("policy_id"),
+		field.Int("certificate_id"),
+		field.Int("namespace_id"),
+		field.Bool("rekey"),
+		field.Enum("status").Values("succeeded", "failed", "pending_signature"),
+		field.Text("error").Optional().Default(""),
+		field.Int("signing_request_id").Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RenewalAttempt.
+func (RenewalAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("policy", RenewalPolicy.Type).Ref("attempts").Field("policy_id").Unique().Required(),
+	}
+}
+
+// Indexes of the RenewalAttempt.
+func (RenewalAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace_id", "status"),
+		index.Fields("certificate_id"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// RenewalPolicy holds the schema definition for the RenewalPolicy entity. It
+// tells the scheduler when to renew a certificate and whether to rekey it.
+type RenewalPolicy struct {
+	ent.Schema
+}
+
+// Fields of the RenewalPolicy.
+func (RenewalPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique().
+			Immutable(),
+		field.Int("certificate_id").Unique(),
+		field.Enum("trigger").Values("remaining_fraction", "interval"),
+		field.Float("remaining_fraction").Optional(),
+		field.Int("interval_days").Optional(),
+		field.Bool("rekey").Default(false),
+		field.Int("valid_days").Optional(),
+		field.Bool("enabled").Default(true),
+		field.Int("failures").Default(0),
+		field.Text("last_error").Optional().Default(""),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RenewalPolicy.
+func (RenewalPolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("certificate", Certificate.Type).Ref("renewal_policy").Field("certificate_id").Unique().Required(),
+		edge.To("attempts", RenewalAttempt.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
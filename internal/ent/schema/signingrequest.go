@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SigningRequest holds the schema definition for the SigningRequest entity.
+// It is a signing operation waiting for an offline CA key.
+type SigningRequest struct {
+	ent.Schema
+}
+
+// Fields of the SigningRequest.
+func (SigningRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique().
+			Immutable(),
+		field.Int("namespace_id"),
+		field.Int("issuer_id"),
+		field.Enum("kind").Values("create", "renew"),
+		field.Int("certificate_id").Optional(),
+		field.Text("template"),
+		field.Text("key_pem").Optional(),
+		field.Text("key_ref").Optional().Default(""),
+		field.Text("desc").Optional().Default(""),
+		field.Text("usage").Optional().Default(""),
+		field.Enum("status").Values("pending", "completed").Default("pending"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SigningRequest.
+func (SigningRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("namespace", Namespace.Type).Ref("signing_requests").Field("namespace_id").Unique().Required(),
+	}
+}
+
+// Indexes of the SigningRequest.
+func (SigningRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace_id"),
+	}
+}
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+)
+
+// SigningRequest is the model entity for the SigningRequest schema.
+type SigningRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// NamespaceID holds the value of the "namespace_id" field.
+	NamespaceID int `json:"namespace_id,omitempty"`
+	// IssuerID holds the value of the "issuer_id" field.
+	IssuerID int `json:"issuer_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind signingrequest.Kind `json:"kind,omitempty"`
+	// CertificateID holds the value of the "certificate_id" field.
+	CertificateID int `json:"certificate_id,omitempty"`
+	// Template holds the value of the "template" field.
+	Template string `json:"template,omitempty"`
+	// KeyPem holds the value of the "key_pem" field.
+	KeyPem string `json:"key_pem,omitempty"`
+	// KeyRef holds the value of the "key_ref" field.
+	KeyRef string `json:"key_ref,omitempty"`
+	// Desc holds the value of the "desc" field.
+	Desc string `json:"desc,omitempty"`
+	// Usage holds the value of the "usage" field.
+	Usage string `json:"usage,omitempty"`
+	// Status holds the value of the "status" field.
+	Status signingrequest.Status `json:"status,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SigningRequestQuery when eager-loading is set.
+	Edges        SigningRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SigningRequestEdges holds the relations/edges for other nodes in the graph.
+type SigningRequestEdges struct {
+	// Namespace holds the value of the namespace edge.
+	Namespace *Namespace `json:"namespace,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// NamespaceOrErr returns the Namespace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SigningRequestEdges) NamespaceOrErr() (*Namespace, error) {
+	if e.Namespace != nil {
+		return e.Namespace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: namespace.Label}
+	}
+	return nil, &NotLoadedError{edge: "namespace"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SigningRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signingrequest.FieldID, signingrequest.FieldNamespaceID, signingrequest.FieldIssuerID, signingrequest.FieldCertificateID:
+			values[i] = new(sql.NullInt64)
+		case signingrequest.FieldKind, signingrequest.FieldTemplate, signingrequest.FieldKeyPem, signingrequest.FieldKeyRef, signingrequest.FieldDesc, signingrequest.FieldUsage, signingrequest.FieldStatus:
+			values[i] = new(sql.NullString)
+		case signingrequest.FieldUpdatedAt, signingrequest.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SigningRequest fields.
+func (sr *SigningRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signingrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sr.ID = int(value.Int64)
+		case signingrequest.FieldNamespaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace_id", values[i])
+			} else if value.Valid {
+				sr.NamespaceID = int(value.Int64)
+			}
+		case signingrequest.FieldIssuerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer_id", values[i])
+			} else if value.Valid {
+				sr.IssuerID = int(value.Int64)
+			}
+		case signingrequest.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				sr.Kind = signingrequest.Kind(value.String)
+			}
+		case signingrequest.FieldCertificateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_id", values[i])
+			} else if value.Valid {
+				sr.CertificateID = int(value.Int64)
+			}
+		case signingrequest.FieldTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template", values[i])
+			} else if value.Valid {
+				sr.Template = value.String
+			}
+		case signingrequest.FieldKeyPem:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_pem", values[i])
+			} else if value.Valid {
+				sr.KeyPem = value.String
+			}
+		case signingrequest.FieldKeyRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_ref", values[i])
+			} else if value.Valid {
+				sr.KeyRef = value.String
+			}
+		case signingrequest.FieldDesc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field desc", values[i])
+			} else if value.Valid {
+				sr.Desc = value.String
+			}
+		case signingrequest.FieldUsage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field usage", values[i])
+			} else if value.Valid {
+				sr.Usage = value.String
+			}
+		case signingrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sr.Status = signingrequest.Status(value.String)
+			}
+		case signingrequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sr.UpdatedAt = value.Time
+			}
+		case signingrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sr.CreatedAt = value.Time
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SigningRequest.
+// This includes values selected through modifiers, order, etc.
+func (sr *SigningRequest) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// QueryNamespace queries the "namespace" edge of the SigningRequest entity.
+func (sr *SigningRequest) QueryNamespace() *NamespaceQuery {
+	return NewSigningRequestClient(sr.config).QueryNamespace(sr)
+}
+
+// Update returns a builder for updating this SigningRequest.
+// Note that you need to call SigningRequest.Unwrap() before calling this method if this SigningRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *SigningRequest) Update() *SigningRequestUpdateOne {
+	return NewSigningRequestClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the SigningRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *SigningRequest) Unwrap() *SigningRequest {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SigningRequest is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *SigningRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("SigningRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("namespace_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.NamespaceID))
+	builder.WriteString(", ")
+	builder.WriteString("issuer_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.IssuerID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("certificate_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.CertificateID))
+	builder.WriteString(", ")
+	builder.WriteString("template=")
+	builder.WriteString(sr.Template)
+	builder.WriteString(", ")
+	builder.WriteString("key_pem=")
+	builder.WriteString(sr.KeyPem)
+	builder.WriteString(", ")
+	builder.WriteString("key_ref=")
+	builder.WriteString(sr.KeyRef)
+	builder.WriteString(", ")
+	builder.WriteString("desc=")
+	builder.WriteString(sr.Desc)
+	builder.WriteString(", ")
+	builder.WriteString("usage=")
+	builder.WriteString(sr.Usage)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SigningRequests is a parsable slice of SigningRequest.
+type SigningRequests []*SigningRequest
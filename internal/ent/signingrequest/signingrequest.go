@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package signingrequest
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the signingrequest type in the database.
+	Label = "signing_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNamespaceID holds the string denoting the namespace_id field in the database.
+	FieldNamespaceID = "namespace_id"
+	// FieldIssuerID holds the string denoting the issuer_id field in the database.
+	FieldIssuerID = "issuer_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldCertificateID holds the string denoting the certificate_id field in the database.
+	FieldCertificateID = "certificate_id"
+	// FieldTemplate holds the string denoting the template field in the database.
+	FieldTemplate = "template"
+	// FieldKeyPem holds the string denoting the key_pem field in the database.
+	FieldKeyPem = "key_pem"
+	// FieldKeyRef holds the string denoting the key_ref field in the database.
+	FieldKeyRef = "key_ref"
+	// FieldDesc holds the string denoting the desc field in the database.
+	FieldDesc = "desc"
+	// FieldUsage holds the string denoting the usage field in the database.
+	FieldUsage = "usage"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeNamespace holds the string denoting the namespace edge name in mutations.
+	EdgeNamespace = "namespace"
+	// Table holds the table name of the signingrequest in the database.
+	Table = "signing_requests"
+	// NamespaceTable is the table that holds the namespace relation/edge.
+	NamespaceTable = "signing_requests"
+	// NamespaceInverseTable is the table name for the Namespace entity.
+	// It exists in this package in order to avoid circular dependency with the "namespace" package.
+	NamespaceInverseTable = "namespaces"
+	// NamespaceColumn is the table column denoting the namespace relation/edge.
+	NamespaceColumn = "namespace_id"
+)
+
+// Columns holds all SQL columns for signingrequest fields.
+var Columns = []string{
+	FieldID,
+	FieldNamespaceID,
+	FieldIssuerID,
+	FieldKind,
+	FieldCertificateID,
+	FieldTemplate,
+	FieldKeyPem,
+	FieldKeyRef,
+	FieldDesc,
+	FieldUsage,
+	FieldStatus,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultKeyRef holds the default value on creation for the "key_ref" field.
+	DefaultKeyRef string
+	// DefaultDesc holds the default value on creation for the "desc" field.
+	DefaultDesc string
+	// DefaultUsage holds the default value on creation for the "usage" field.
+	DefaultUsage string
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindCreate Kind = "create"
+	KindRenew  Kind = "renew"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindCreate, KindRenew:
+		return nil
+	default:
+		return fmt.Errorf("signingrequest: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusCompleted Status = "completed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusCompleted:
+		return nil
+	default:
+		return fmt.Errorf("signingrequest: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the SigningRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNamespaceID orders the results by the namespace_id field.
+func ByNamespaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespaceID, opts...).ToFunc()
+}
+
+// ByIssuerID orders the results by the issuer_id field.
+func ByIssuerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuerID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByCertificateID orders the results by the certificate_id field.
+func ByCertificateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateID, opts...).ToFunc()
+}
+
+// ByTemplate orders the results by the template field.
+func ByTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplate, opts...).ToFunc()
+}
+
+// ByKeyPem orders the results by the key_pem field.
+func ByKeyPem(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyPem, opts...).ToFunc()
+}
+
+// ByKeyRef orders the results by the key_ref field.
+func ByKeyRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyRef, opts...).ToFunc()
+}
+
+// ByDesc orders the results by the desc field.
+func ByDesc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDesc, opts...).ToFunc()
+}
+
+// ByUsage orders the results by the usage field.
+func ByUsage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsage, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByNamespaceField orders the results by namespace field.
+func ByNamespaceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNamespaceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newNamespaceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NamespaceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, NamespaceTable, NamespaceColumn),
+	)
+}
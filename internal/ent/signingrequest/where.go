@@ -0,0 +1,739 @@
+// Code generated by ent, DO NOT EDIT.
+
+package signingrequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLTE(FieldID, id))
+}
+
+// NamespaceID applies equality check predicate on the "namespace_id" field. It's identical to NamespaceIDEQ.
+func NamespaceID(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldNamespaceID, v))
+}
+
+// IssuerID applies equality check predicate on the "issuer_id" field. It's identical to IssuerIDEQ.
+func IssuerID(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldIssuerID, v))
+}
+
+// CertificateID applies equality check predicate on the "certificate_id" field. It's identical to CertificateIDEQ.
+func CertificateID(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// Template applies equality check predicate on the "template" field. It's identical to TemplateEQ.
+func Template(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldTemplate, v))
+}
+
+// KeyPem applies equality check predicate on the "key_pem" field. It's identical to KeyPemEQ.
+func KeyPem(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldKeyPem, v))
+}
+
+// KeyRef applies equality check predicate on the "key_ref" field. It's identical to KeyRefEQ.
+func KeyRef(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldKeyRef, v))
+}
+
+// Desc applies equality check predicate on the "desc" field. It's identical to DescEQ.
+func Desc(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldDesc, v))
+}
+
+// Usage applies equality check predicate on the "usage" field. It's identical to UsageEQ.
+func Usage(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldUsage, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NamespaceIDEQ applies the EQ predicate on the "namespace_id" field.
+func NamespaceIDEQ(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldNamespaceID, v))
+}
+
+// NamespaceIDNEQ applies the NEQ predicate on the "namespace_id" field.
+func NamespaceIDNEQ(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldNamespaceID, v))
+}
+
+// NamespaceIDIn applies the In predicate on the "namespace_id" field.
+func NamespaceIDIn(vs ...int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldNamespaceID, vs...))
+}
+
+// NamespaceIDNotIn applies the NotIn predicate on the "namespace_id" field.
+func NamespaceIDNotIn(vs ...int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldNamespaceID, vs...))
+}
+
+// IssuerIDEQ applies the EQ predicate on the "issuer_id" field.
+func IssuerIDEQ(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldIssuerID, v))
+}
+
+// IssuerIDNEQ applies the NEQ predicate on the "issuer_id" field.
+func IssuerIDNEQ(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldIssuerID, v))
+}
+
+// IssuerIDIn applies the In predicate on the "issuer_id" field.
+func IssuerIDIn(vs ...int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldIssuerID, vs...))
+}
+
+// IssuerIDNotIn applies the NotIn predicate on the "issuer_id" field.
+func IssuerIDNotIn(vs ...int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldIssuerID, vs...))
+}
+
+// IssuerIDGT applies the GT predicate on the "issuer_id" field.
+func IssuerIDGT(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGT(FieldIssuerID, v))
+}
+
+// IssuerIDGTE applies the GTE predicate on the "issuer_id" field.
+func IssuerIDGTE(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGTE(FieldIssuerID, v))
+}
+
+// IssuerIDLT applies the LT predicate on the "issuer_id" field.
+func IssuerIDLT(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLT(FieldIssuerID, v))
+}
+
+// IssuerIDLTE applies the LTE predicate on the "issuer_id" field.
+func IssuerIDLTE(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLTE(FieldIssuerID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// CertificateIDEQ applies the EQ predicate on the "certificate_id" field.
+func CertificateIDEQ(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldCertificateID, v))
+}
+
+// CertificateIDNEQ applies the NEQ predicate on the "certificate_id" field.
+func CertificateIDNEQ(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldCertificateID, v))
+}
+
+// CertificateIDIn applies the In predicate on the "certificate_id" field.
+func CertificateIDIn(vs ...int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDNotIn applies the NotIn predicate on the "certificate_id" field.
+func CertificateIDNotIn(vs ...int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldCertificateID, vs...))
+}
+
+// CertificateIDGT applies the GT predicate on the "certificate_id" field.
+func CertificateIDGT(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGT(FieldCertificateID, v))
+}
+
+// CertificateIDGTE applies the GTE predicate on the "certificate_id" field.
+func CertificateIDGTE(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGTE(FieldCertificateID, v))
+}
+
+// CertificateIDLT applies the LT predicate on the "certificate_id" field.
+func CertificateIDLT(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLT(FieldCertificateID, v))
+}
+
+// CertificateIDLTE applies the LTE predicate on the "certificate_id" field.
+func CertificateIDLTE(v int) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLTE(FieldCertificateID, v))
+}
+
+// CertificateIDIsNil applies the IsNil predicate on the "certificate_id" field.
+func CertificateIDIsNil() predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIsNull(FieldCertificateID))
+}
+
+// CertificateIDNotNil applies the NotNil predicate on the "certificate_id" field.
+func CertificateIDNotNil() predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotNull(FieldCertificateID))
+}
+
+// TemplateEQ applies the EQ predicate on the "template" field.
+func TemplateEQ(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldTemplate, v))
+}
+
+// TemplateNEQ applies the NEQ predicate on the "template" field.
+func TemplateNEQ(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldTemplate, v))
+}
+
+// TemplateIn applies the In predicate on the "template" field.
+func TemplateIn(vs ...string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldTemplate, vs...))
+}
+
+// TemplateNotIn applies the NotIn predicate on the "template" field.
+func TemplateNotIn(vs ...string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldTemplate, vs...))
+}
+
+// TemplateGT applies the GT predicate on the "template" field.
+func TemplateGT(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGT(FieldTemplate, v))
+}
+
+// TemplateGTE applies the GTE predicate on the "template" field.
+func TemplateGTE(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGTE(FieldTemplate, v))
+}
+
+// TemplateLT applies the LT predicate on the "template" field.
+func TemplateLT(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLT(FieldTemplate, v))
+}
+
+// TemplateLTE applies the LTE predicate on the "template" field.
+func TemplateLTE(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLTE(FieldTemplate, v))
+}
+
+// TemplateContains applies the Contains predicate on the "template" field.
+func TemplateContains(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldContains(FieldTemplate, v))
+}
+
+// TemplateHasPrefix applies the HasPrefix predicate on the "template" field.
+func TemplateHasPrefix(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldHasPrefix(FieldTemplate, v))
+}
+
+// TemplateHasSuffix applies the HasSuffix predicate on the "template" field.
+func TemplateHasSuffix(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldHasSuffix(FieldTemplate, v))
+}
+
+// TemplateEqualFold applies the EqualFold predicate on the "template" field.
+func TemplateEqualFold(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEqualFold(FieldTemplate, v))
+}
+
+// TemplateContainsFold applies the ContainsFold predicate on the "template" field.
+func TemplateContainsFold(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldContainsFold(FieldTemplate, v))
+}
+
+// KeyPemEQ applies the EQ predicate on the "key_pem" field.
+func KeyPemEQ(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldKeyPem, v))
+}
+
+// KeyPemNEQ applies the NEQ predicate on the "key_pem" field.
+func KeyPemNEQ(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldKeyPem, v))
+}
+
+// KeyPemIn applies the In predicate on the "key_pem" field.
+func KeyPemIn(vs ...string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldKeyPem, vs...))
+}
+
+// KeyPemNotIn applies the NotIn predicate on the "key_pem" field.
+func KeyPemNotIn(vs ...string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldKeyPem, vs...))
+}
+
+// KeyPemGT applies the GT predicate on the "key_pem" field.
+func KeyPemGT(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGT(FieldKeyPem, v))
+}
+
+// KeyPemGTE applies the GTE predicate on the "key_pem" field.
+func KeyPemGTE(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGTE(FieldKeyPem, v))
+}
+
+// KeyPemLT applies the LT predicate on the "key_pem" field.
+func KeyPemLT(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLT(FieldKeyPem, v))
+}
+
+// KeyPemLTE applies the LTE predicate on the "key_pem" field.
+func KeyPemLTE(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLTE(FieldKeyPem, v))
+}
+
+// KeyPemContains applies the Contains predicate on the "key_pem" field.
+func KeyPemContains(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldContains(FieldKeyPem, v))
+}
+
+// KeyPemHasPrefix applies the HasPrefix predicate on the "key_pem" field.
+func KeyPemHasPrefix(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldHasPrefix(FieldKeyPem, v))
+}
+
+// KeyPemHasSuffix applies the HasSuffix predicate on the "key_pem" field.
+func KeyPemHasSuffix(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldHasSuffix(FieldKeyPem, v))
+}
+
+// KeyPemIsNil applies the IsNil predicate on the "key_pem" field.
+func KeyPemIsNil() predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIsNull(FieldKeyPem))
+}
+
+// KeyPemNotNil applies the NotNil predicate on the "key_pem" field.
+func KeyPemNotNil() predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotNull(FieldKeyPem))
+}
+
+// KeyPemEqualFold applies the EqualFold predicate on the "key_pem" field.
+func KeyPemEqualFold(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEqualFold(FieldKeyPem, v))
+}
+
+// KeyPemContainsFold applies the ContainsFold predicate on the "key_pem" field.
+func KeyPemContainsFold(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldContainsFold(FieldKeyPem, v))
+}
+
+// KeyRefEQ applies the EQ predicate on the "key_ref" field.
+func KeyRefEQ(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldKeyRef, v))
+}
+
+// KeyRefNEQ applies the NEQ predicate on the "key_ref" field.
+func KeyRefNEQ(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldKeyRef, v))
+}
+
+// KeyRefIn applies the In predicate on the "key_ref" field.
+func KeyRefIn(vs ...string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldKeyRef, vs...))
+}
+
+// KeyRefNotIn applies the NotIn predicate on the "key_ref" field.
+func KeyRefNotIn(vs ...string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldKeyRef, vs...))
+}
+
+// KeyRefGT applies the GT predicate on the "key_ref" field.
+func KeyRefGT(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGT(FieldKeyRef, v))
+}
+
+// KeyRefGTE applies the GTE predicate on the "key_ref" field.
+func KeyRefGTE(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGTE(FieldKeyRef, v))
+}
+
+// KeyRefLT applies the LT predicate on the "key_ref" field.
+func KeyRefLT(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLT(FieldKeyRef, v))
+}
+
+// KeyRefLTE applies the LTE predicate on the "key_ref" field.
+func KeyRefLTE(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLTE(FieldKeyRef, v))
+}
+
+// KeyRefContains applies the Contains predicate on the "key_ref" field.
+func KeyRefContains(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldContains(FieldKeyRef, v))
+}
+
+// KeyRefHasPrefix applies the HasPrefix predicate on the "key_ref" field.
+func KeyRefHasPrefix(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldHasPrefix(FieldKeyRef, v))
+}
+
+// KeyRefHasSuffix applies the HasSuffix predicate on the "key_ref" field.
+func KeyRefHasSuffix(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldHasSuffix(FieldKeyRef, v))
+}
+
+// KeyRefIsNil applies the IsNil predicate on the "key_ref" field.
+func KeyRefIsNil() predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIsNull(FieldKeyRef))
+}
+
+// KeyRefNotNil applies the NotNil predicate on the "key_ref" field.
+func KeyRefNotNil() predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotNull(FieldKeyRef))
+}
+
+// KeyRefEqualFold applies the EqualFold predicate on the "key_ref" field.
+func KeyRefEqualFold(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEqualFold(FieldKeyRef, v))
+}
+
+// KeyRefContainsFold applies the ContainsFold predicate on the "key_ref" field.
+func KeyRefContainsFold(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldContainsFold(FieldKeyRef, v))
+}
+
+// DescEQ applies the EQ predicate on the "desc" field.
+func DescEQ(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldDesc, v))
+}
+
+// DescNEQ applies the NEQ predicate on the "desc" field.
+func DescNEQ(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldDesc, v))
+}
+
+// DescIn applies the In predicate on the "desc" field.
+func DescIn(vs ...string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldDesc, vs...))
+}
+
+// DescNotIn applies the NotIn predicate on the "desc" field.
+func DescNotIn(vs ...string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldDesc, vs...))
+}
+
+// DescGT applies the GT predicate on the "desc" field.
+func DescGT(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGT(FieldDesc, v))
+}
+
+// DescGTE applies the GTE predicate on the "desc" field.
+func DescGTE(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGTE(FieldDesc, v))
+}
+
+// DescLT applies the LT predicate on the "desc" field.
+func DescLT(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLT(FieldDesc, v))
+}
+
+// DescLTE applies the LTE predicate on the "desc" field.
+func DescLTE(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLTE(FieldDesc, v))
+}
+
+// DescContains applies the Contains predicate on the "desc" field.
+func DescContains(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldContains(FieldDesc, v))
+}
+
+// DescHasPrefix applies the HasPrefix predicate on the "desc" field.
+func DescHasPrefix(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldHasPrefix(FieldDesc, v))
+}
+
+// DescHasSuffix applies the HasSuffix predicate on the "desc" field.
+func DescHasSuffix(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldHasSuffix(FieldDesc, v))
+}
+
+// DescIsNil applies the IsNil predicate on the "desc" field.
+func DescIsNil() predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIsNull(FieldDesc))
+}
+
+// DescNotNil applies the NotNil predicate on the "desc" field.
+func DescNotNil() predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotNull(FieldDesc))
+}
+
+// DescEqualFold applies the EqualFold predicate on the "desc" field.
+func DescEqualFold(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEqualFold(FieldDesc, v))
+}
+
+// DescContainsFold applies the ContainsFold predicate on the "desc" field.
+func DescContainsFold(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldContainsFold(FieldDesc, v))
+}
+
+// UsageEQ applies the EQ predicate on the "usage" field.
+func UsageEQ(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldUsage, v))
+}
+
+// UsageNEQ applies the NEQ predicate on the "usage" field.
+func UsageNEQ(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldUsage, v))
+}
+
+// UsageIn applies the In predicate on the "usage" field.
+func UsageIn(vs ...string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldUsage, vs...))
+}
+
+// UsageNotIn applies the NotIn predicate on the "usage" field.
+func UsageNotIn(vs ...string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldUsage, vs...))
+}
+
+// UsageGT applies the GT predicate on the "usage" field.
+func UsageGT(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGT(FieldUsage, v))
+}
+
+// UsageGTE applies the GTE predicate on the "usage" field.
+func UsageGTE(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGTE(FieldUsage, v))
+}
+
+// UsageLT applies the LT predicate on the "usage" field.
+func UsageLT(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLT(FieldUsage, v))
+}
+
+// UsageLTE applies the LTE predicate on the "usage" field.
+func UsageLTE(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLTE(FieldUsage, v))
+}
+
+// UsageContains applies the Contains predicate on the "usage" field.
+func UsageContains(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldContains(FieldUsage, v))
+}
+
+// UsageHasPrefix applies the HasPrefix predicate on the "usage" field.
+func UsageHasPrefix(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldHasPrefix(FieldUsage, v))
+}
+
+// UsageHasSuffix applies the HasSuffix predicate on the "usage" field.
+func UsageHasSuffix(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldHasSuffix(FieldUsage, v))
+}
+
+// UsageIsNil applies the IsNil predicate on the "usage" field.
+func UsageIsNil() predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIsNull(FieldUsage))
+}
+
+// UsageNotNil applies the NotNil predicate on the "usage" field.
+func UsageNotNil() predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotNull(FieldUsage))
+}
+
+// UsageEqualFold applies the EqualFold predicate on the "usage" field.
+func UsageEqualFold(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEqualFold(FieldUsage, v))
+}
+
+// UsageContainsFold applies the ContainsFold predicate on the "usage" field.
+func UsageContainsFold(v string) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldContainsFold(FieldUsage, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasNamespace applies the HasEdge predicate on the "namespace" edge.
+func HasNamespace() predicate.SigningRequest {
+	return predicate.SigningRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NamespaceTable, NamespaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNamespaceWith applies the HasEdge predicate on the "namespace" edge with a given conditions (other predicates).
+func HasNamespaceWith(preds ...predicate.Namespace) predicate.SigningRequest {
+	return predicate.SigningRequest(func(s *sql.Selector) {
+		step := newNamespaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SigningRequest) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SigningRequest) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SigningRequest) predicate.SigningRequest {
+	return predicate.SigningRequest(sql.NotPredicates(p))
+}
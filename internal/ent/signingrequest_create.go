@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+)
+
+// SigningRequestCreate is the builder for creating a SigningRequest entity.
+type SigningRequestCreate struct {
+	config
+	mutation *SigningRequestMutation
+	hooks    []Hook
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (src *SigningRequestCreate) SetNamespaceID(i int) *SigningRequestCreate {
+	src.mutation.SetNamespaceID(i)
+	return src
+}
+
+// SetIssuerID sets the "issuer_id" field.
+func (src *SigningRequestCreate) SetIssuerID(i int) *SigningRequestCreate {
+	src.mutation.SetIssuerID(i)
+	return src
+}
+
+// SetKind sets the "kind" field.
+func (src *SigningRequestCreate) SetKind(s signingrequest.Kind) *SigningRequestCreate {
+	src.mutation.SetKind(s)
+	return src
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (src *SigningRequestCreate) SetCertificateID(i int) *SigningRequestCreate {
+	src.mutation.SetCertificateID(i)
+	return src
+}
+
+// SetNillableCertificateID sets the "certificate_id" field if the given value is not nil.
+func (src *SigningRequestCreate) SetNillableCertificateID(i *int) *SigningRequestCreate {
+	if i != nil {
+		src.SetCertificateID(*i)
+	}
+	return src
+}
+
+// SetTemplate sets the "template" field.
+func (src *SigningRequestCreate) SetTemplate(s string) *SigningRequestCreate {
+	src.mutation.SetTemplate(s)
+	return src
+}
+
+// SetKeyPem sets the "key_pem" field.
+func (src *SigningRequestCreate) SetKeyPem(s string) *SigningRequestCreate {
+	src.mutation.SetKeyPem(s)
+	return src
+}
+
+// SetNillableKeyPem sets the "key_pem" field if the given value is not nil.
+func (src *SigningRequestCreate) SetNillableKeyPem(s *string) *SigningRequestCreate {
+	if s != nil {
+		src.SetKeyPem(*s)
+	}
+	return src
+}
+
+// SetKeyRef sets the "key_ref" field.
+func (src *SigningRequestCreate) SetKeyRef(s string) *SigningRequestCreate {
+	src.mutation.SetKeyRef(s)
+	return src
+}
+
+// SetNillableKeyRef sets the "key_ref" field if the given value is not nil.
+func (src *SigningRequestCreate) SetNillableKeyRef(s *string) *SigningRequestCreate {
+	if s != nil {
+		src.SetKeyRef(*s)
+	}
+	return src
+}
+
+// SetDesc sets the "desc" field.
+func (src *SigningRequestCreate) SetDesc(s string) *SigningRequestCreate {
+	src.mutation.SetDesc(s)
+	return src
+}
+
+// SetNillableDesc sets the "desc" field if the given value is not nil.
+func (src *SigningRequestCreate) SetNillableDesc(s *string) *SigningRequestCreate {
+	if s != nil {
+		src.SetDesc(*s)
+	}
+	return src
+}
+
+// SetUsage sets the "usage" field.
+func (src *SigningRequestCreate) SetUsage(s string) *SigningRequestCreate {
+	src.mutation.SetUsage(s)
+	return src
+}
+
+// SetNillableUsage sets the "usage" field if the given value is not nil.
+func (src *SigningRequestCreate) SetNillableUsage(s *string) *SigningRequestCreate {
+	if s != nil {
+		src.SetUsage(*s)
+	}
+	return src
+}
+
+// SetStatus sets the "status" field.
+func (src *SigningRequestCreate) SetStatus(s signingrequest.Status) *SigningRequestCreate {
+	src.mutation.SetStatus(s)
+	return src
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (src *SigningRequestCreate) SetNillableStatus(s *signingrequest.Status) *SigningRequestCreate {
+	if s != nil {
+		src.SetStatus(*s)
+	}
+	return src
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (src *SigningRequestCreate) SetUpdatedAt(t time.Time) *SigningRequestCreate {
+	src.mutation.SetUpdatedAt(t)
+	return src
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (src *SigningRequestCreate) SetNillableUpdatedAt(t *time.Time) *SigningRequestCreate {
+	if t != nil {
+		src.SetUpdatedAt(*t)
+	}
+	return src
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (src *SigningRequestCreate) SetCreatedAt(t time.Time) *SigningRequestCreate {
+	src.mutation.SetCreatedAt(t)
+	return src
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (src *SigningRequestCreate) SetNillableCreatedAt(t *time.Time) *SigningRequestCreate {
+	if t != nil {
+		src.SetCreatedAt(*t)
+	}
+	return src
+}
+
+// SetID sets the "id" field.
+func (src *SigningRequestCreate) SetID(i int) *SigningRequestCreate {
+	src.mutation.SetID(i)
+	return src
+}
+
+// SetNamespace sets the "namespace" edge to the Namespace entity.
+func (src *SigningRequestCreate) SetNamespace(n *Namespace) *SigningRequestCreate {
+	return src.SetNamespaceID(n.ID)
+}
+
+// Mutation returns the SigningRequestMutation object of the builder.
+func (src *SigningRequestCreate) Mutation() *SigningRequestMutation {
+	return src.mutation
+}
+
+// Save creates the SigningRequest in the database.
+func (src *SigningRequestCreate) Save(ctx context.Context) (*SigningRequest, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *SigningRequestCreate) SaveX(ctx context.Context) *SigningRequest {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *SigningRequestCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *SigningRequestCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *SigningRequestCreate) defaults() {
+	if _, ok := src.mutation.KeyRef(); !ok {
+		v := signingrequest.DefaultKeyRef
+		src.mutation.SetKeyRef(v)
+	}
+	if _, ok := src.mutation.Desc(); !ok {
+		v := signingrequest.DefaultDesc
+		src.mutation.SetDesc(v)
+	}
+	if _, ok := src.mutation.Usage(); !ok {
+		v := signingrequest.DefaultUsage
+		src.mutation.SetUsage(v)
+	}
+	if _, ok := src.mutation.Status(); !ok {
+		v := signingrequest.DefaultStatus
+		src.mutation.SetStatus(v)
+	}
+	if _, ok := src.mutation.UpdatedAt(); !ok {
+		v := signingrequest.DefaultUpdatedAt()
+		src.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		v := signingrequest.DefaultCreatedAt()
+		src.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *SigningRequestCreate) check() error {
+	if _, ok := src.mutation.NamespaceID(); !ok {
+		return &ValidationError{Name: "namespace_id", err: errors.New(`ent: missing required field "SigningRequest.namespace_id"`)}
+	}
+	if _, ok := src.mutation.IssuerID(); !ok {
+		return &ValidationError{Name: "issuer_id", err: errors.New(`ent: missing required field "SigningRequest.issuer_id"`)}
+	}
+	if _, ok := src.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "SigningRequest.kind"`)}
+	}
+	if v, ok := src.mutation.Kind(); ok {
+		if err := signingrequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SigningRequest.kind": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.Template(); !ok {
+		return &ValidationError{Name: "template", err: errors.New(`ent: missing required field "SigningRequest.template"`)}
+	}
+	if _, ok := src.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SigningRequest.status"`)}
+	}
+	if v, ok := src.mutation.Status(); ok {
+		if err := signingrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SigningRequest.updated_at"`)}
+	}
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SigningRequest.created_at"`)}
+	}
+	if v, ok := src.mutation.ID(); ok {
+		if err := signingrequest.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SigningRequest.id": %w`, err)}
+		}
+	}
+	if len(src.mutation.NamespaceIDs()) == 0 {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required edge "SigningRequest.namespace"`)}
+	}
+	return nil
+}
+
+func (src *SigningRequestCreate) sqlSave(ctx context.Context) (*SigningRequest, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *SigningRequestCreate) createSpec() (*SigningRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SigningRequest{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(signingrequest.Table, sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt))
+	)
+	if id, ok := src.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := src.mutation.IssuerID(); ok {
+		_spec.SetField(signingrequest.FieldIssuerID, field.TypeInt, value)
+		_node.IssuerID = value
+	}
+	if value, ok := src.mutation.Kind(); ok {
+		_spec.SetField(signingrequest.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := src.mutation.CertificateID(); ok {
+		_spec.SetField(signingrequest.FieldCertificateID, field.TypeInt, value)
+		_node.CertificateID = value
+	}
+	if value, ok := src.mutation.Template(); ok {
+		_spec.SetField(signingrequest.FieldTemplate, field.TypeString, value)
+		_node.Template = value
+	}
+	if value, ok := src.mutation.KeyPem(); ok {
+		_spec.SetField(signingrequest.FieldKeyPem, field.TypeString, value)
+		_node.KeyPem = value
+	}
+	if value, ok := src.mutation.KeyRef(); ok {
+		_spec.SetField(signingrequest.FieldKeyRef, field.TypeString, value)
+		_node.KeyRef = value
+	}
+	if value, ok := src.mutation.Desc(); ok {
+		_spec.SetField(signingrequest.FieldDesc, field.TypeString, value)
+		_node.Desc = value
+	}
+	if value, ok := src.mutation.Usage(); ok {
+		_spec.SetField(signingrequest.FieldUsage, field.TypeString, value)
+		_node.Usage = value
+	}
+	if value, ok := src.mutation.Status(); ok {
+		_spec.SetField(signingrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := src.mutation.UpdatedAt(); ok {
+		_spec.SetField(signingrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := src.mutation.CreatedAt(); ok {
+		_spec.SetField(signingrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := src.mutation.NamespaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   signingrequest.NamespaceTable,
+			Columns: []string{signingrequest.NamespaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(namespace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.NamespaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SigningRequestCreateBulk is the builder for creating many SigningRequest entities in bulk.
+type SigningRequestCreateBulk struct {
+	config
+	err      error
+	builders []*SigningRequestCreate
+}
+
+// Save creates the SigningRequest entities in the database.
+func (srcb *SigningRequestCreateBulk) Save(ctx context.Context) ([]*SigningRequest, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*SigningRequest, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SigningRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *SigningRequestCreateBulk) SaveX(ctx context.Context) []*SigningRequest {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *SigningRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *SigningRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
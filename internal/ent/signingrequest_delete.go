@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+)
+
+// SigningRequestDelete is the builder for deleting a SigningRequest entity.
+type SigningRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *SigningRequestMutation
+}
+
+// Where appends a list predicates to the SigningRequestDelete builder.
+func (srd *SigningRequestDelete) Where(ps ...predicate.SigningRequest) *SigningRequestDelete {
+	srd.mutation.Where(ps...)
+	return srd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (srd *SigningRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, srd.sqlExec, srd.mutation, srd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srd *SigningRequestDelete) ExecX(ctx context.Context) int {
+	n, err := srd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (srd *SigningRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(signingrequest.Table, sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt))
+	if ps := srd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, srd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	srd.mutation.done = true
+	return affected, err
+}
+
+// SigningRequestDeleteOne is the builder for deleting a single SigningRequest entity.
+type SigningRequestDeleteOne struct {
+	srd *SigningRequestDelete
+}
+
+// Where appends a list predicates to the SigningRequestDelete builder.
+func (srdo *SigningRequestDeleteOne) Where(ps ...predicate.SigningRequest) *SigningRequestDeleteOne {
+	srdo.srd.mutation.Where(ps...)
+	return srdo
+}
+
+// Exec executes the deletion query.
+func (srdo *SigningRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := srdo.srd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{signingrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srdo *SigningRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := srdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+)
+
+// SigningRequestQuery is the builder for querying SigningRequest entities.
+type SigningRequestQuery struct {
+	config
+	ctx           *QueryContext
+	order         []signingrequest.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.SigningRequest
+	withNamespace *NamespaceQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SigningRequestQuery builder.
+func (srq *SigningRequestQuery) Where(ps ...predicate.SigningRequest) *SigningRequestQuery {
+	srq.predicates = append(srq.predicates, ps...)
+	return srq
+}
+
+// Limit the number of records to be returned by this query.
+func (srq *SigningRequestQuery) Limit(limit int) *SigningRequestQuery {
+	srq.ctx.Limit = &limit
+	return srq
+}
+
+// Offset to start from.
+func (srq *SigningRequestQuery) Offset(offset int) *SigningRequestQuery {
+	srq.ctx.Offset = &offset
+	return srq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (srq *SigningRequestQuery) Unique(unique bool) *SigningRequestQuery {
+	srq.ctx.Unique = &unique
+	return srq
+}
+
+// Order specifies how the records should be ordered.
+func (srq *SigningRequestQuery) Order(o ...signingrequest.OrderOption) *SigningRequestQuery {
+	srq.order = append(srq.order, o...)
+	return srq
+}
+
+// QueryNamespace chains the current query on the "namespace" edge.
+func (srq *SigningRequestQuery) QueryNamespace() *NamespaceQuery {
+	query := (&NamespaceClient{config: srq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := srq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := srq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(signingrequest.Table, signingrequest.FieldID, selector),
+			sqlgraph.To(namespace.Table, namespace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, signingrequest.NamespaceTable, signingrequest.NamespaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(srq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SigningRequest entity from the query.
+// Returns a *NotFoundError when no SigningRequest was found.
+func (srq *SigningRequestQuery) First(ctx context.Context) (*SigningRequest, error) {
+	nodes, err := srq.Limit(1).All(setContextOp(ctx, srq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{signingrequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (srq *SigningRequestQuery) FirstX(ctx context.Context) *SigningRequest {
+	node, err := srq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SigningRequest ID from the query.
+// Returns a *NotFoundError when no SigningRequest ID was found.
+func (srq *SigningRequestQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = srq.Limit(1).IDs(setContextOp(ctx, srq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{signingrequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (srq *SigningRequestQuery) FirstIDX(ctx context.Context) int {
+	id, err := srq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SigningRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SigningRequest entity is found.
+// Returns a *NotFoundError when no SigningRequest entities are found.
+func (srq *SigningRequestQuery) Only(ctx context.Context) (*SigningRequest, error) {
+	nodes, err := srq.Limit(2).All(setContextOp(ctx, srq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{signingrequest.Label}
+	default:
+		return nil, &NotSingularError{signingrequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (srq *SigningRequestQuery) OnlyX(ctx context.Context) *SigningRequest {
+	node, err := srq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SigningRequest ID in the query.
+// Returns a *NotSingularError when more than one SigningRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (srq *SigningRequestQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = srq.Limit(2).IDs(setContextOp(ctx, srq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{signingrequest.Label}
+	default:
+		err = &NotSingularError{signingrequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (srq *SigningRequestQuery) OnlyIDX(ctx context.Context) int {
+	id, err := srq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SigningRequests.
+func (srq *SigningRequestQuery) All(ctx context.Context) ([]*SigningRequest, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryAll)
+	if err := srq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SigningRequest, *SigningRequestQuery]()
+	return withInterceptors[[]*SigningRequest](ctx, srq, qr, srq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (srq *SigningRequestQuery) AllX(ctx context.Context) []*SigningRequest {
+	nodes, err := srq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SigningRequest IDs.
+func (srq *SigningRequestQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if srq.ctx.Unique == nil && srq.path != nil {
+		srq.Unique(true)
+	}
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryIDs)
+	if err = srq.Select(signingrequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (srq *SigningRequestQuery) IDsX(ctx context.Context) []int {
+	ids, err := srq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (srq *SigningRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryCount)
+	if err := srq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, srq, querierCount[*SigningRequestQuery](), srq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (srq *SigningRequestQuery) CountX(ctx context.Context) int {
+	count, err := srq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (srq *SigningRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryExist)
+	switch _, err := srq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (srq *SigningRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := srq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SigningRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (srq *SigningRequestQuery) Clone() *SigningRequestQuery {
+	if srq == nil {
+		return nil
+	}
+	return &SigningRequestQuery{
+		config:        srq.config,
+		ctx:           srq.ctx.Clone(),
+		order:         append([]signingrequest.OrderOption{}, srq.order...),
+		inters:        append([]Interceptor{}, srq.inters...),
+		predicates:    append([]predicate.SigningRequest{}, srq.predicates...),
+		withNamespace: srq.withNamespace.Clone(),
+		// clone intermediate query.
+		sql:  srq.sql.Clone(),
+		path: srq.path,
+	}
+}
+
+// WithNamespace tells the query-builder to eager-load the nodes that are connected to
+// the "namespace" edge. The optional arguments are used to configure the query builder of the edge.
+func (srq *SigningRequestQuery) WithNamespace(opts ...func(*NamespaceQuery)) *SigningRequestQuery {
+	query := (&NamespaceClient{config: srq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	srq.withNamespace = query
+	return srq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		NamespaceID int `json:"namespace_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SigningRequest.Query().
+//		GroupBy(signingrequest.FieldNamespaceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (srq *SigningRequestQuery) GroupBy(field string, fields ...string) *SigningRequestGroupBy {
+	srq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SigningRequestGroupBy{build: srq}
+	grbuild.flds = &srq.ctx.Fields
+	grbuild.label = signingrequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		NamespaceID int `json:"namespace_id,omitempty"`
+//	}
+//
+//	client.SigningRequest.Query().
+//		Select(signingrequest.FieldNamespaceID).
+//		Scan(ctx, &v)
+func (srq *SigningRequestQuery) Select(fields ...string) *SigningRequestSelect {
+	srq.ctx.Fields = append(srq.ctx.Fields, fields...)
+	sbuild := &SigningRequestSelect{SigningRequestQuery: srq}
+	sbuild.label = signingrequest.Label
+	sbuild.flds, sbuild.scan = &srq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SigningRequestSelect configured with the given aggregations.
+func (srq *SigningRequestQuery) Aggregate(fns ...AggregateFunc) *SigningRequestSelect {
+	return srq.Select().Aggregate(fns...)
+}
+
+func (srq *SigningRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range srq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, srq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range srq.ctx.Fields {
+		if !signingrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if srq.path != nil {
+		prev, err := srq.path(ctx)
+		if err != nil {
+			return err
+		}
+		srq.sql = prev
+	}
+	return nil
+}
+
+func (srq *SigningRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SigningRequest, error) {
+	var (
+		nodes       = []*SigningRequest{}
+		_spec       = srq.querySpec()
+		loadedTypes = [1]bool{
+			srq.withNamespace != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SigningRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SigningRequest{config: srq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, srq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := srq.withNamespace; query != nil {
+		if err := srq.loadNamespace(ctx, query, nodes, nil,
+			func(n *SigningRequest, e *Namespace) { n.Edges.Namespace = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (srq *SigningRequestQuery) loadNamespace(ctx context.Context, query *NamespaceQuery, nodes []*SigningRequest, init func(*SigningRequest), assign func(*SigningRequest, *Namespace)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SigningRequest)
+	for i := range nodes {
+		fk := nodes[i].NamespaceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(namespace.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "namespace_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (srq *SigningRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := srq.querySpec()
+	_spec.Node.Columns = srq.ctx.Fields
+	if len(srq.ctx.Fields) > 0 {
+		_spec.Unique = srq.ctx.Unique != nil && *srq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, srq.driver, _spec)
+}
+
+func (srq *SigningRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(signingrequest.Table, signingrequest.Columns, sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt))
+	_spec.From = srq.sql
+	if unique := srq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if srq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := srq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingrequest.FieldID)
+		for i := range fields {
+			if fields[i] != signingrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if srq.withNamespace != nil {
+			_spec.Node.AddColumnOnce(signingrequest.FieldNamespaceID)
+		}
+	}
+	if ps := srq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := srq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (srq *SigningRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(srq.driver.Dialect())
+	t1 := builder.Table(signingrequest.Table)
+	columns := srq.ctx.Fields
+	if len(columns) == 0 {
+		columns = signingrequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if srq.sql != nil {
+		selector = srq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if srq.ctx.Unique != nil && *srq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range srq.predicates {
+		p(selector)
+	}
+	for _, p := range srq.order {
+		p(selector)
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SigningRequestGroupBy is the group-by builder for SigningRequest entities.
+type SigningRequestGroupBy struct {
+	selector
+	build *SigningRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (srgb *SigningRequestGroupBy) Aggregate(fns ...AggregateFunc) *SigningRequestGroupBy {
+	srgb.fns = append(srgb.fns, fns...)
+	return srgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srgb *SigningRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srgb.build.ctx, ent.OpQueryGroupBy)
+	if err := srgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SigningRequestQuery, *SigningRequestGroupBy](ctx, srgb.build, srgb, srgb.build.inters, v)
+}
+
+func (srgb *SigningRequestGroupBy) sqlScan(ctx context.Context, root *SigningRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(srgb.fns))
+	for _, fn := range srgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*srgb.flds)+len(srgb.fns))
+		for _, f := range *srgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*srgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SigningRequestSelect is the builder for selecting fields of SigningRequest entities.
+type SigningRequestSelect struct {
+	*SigningRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (srs *SigningRequestSelect) Aggregate(fns ...AggregateFunc) *SigningRequestSelect {
+	srs.fns = append(srs.fns, fns...)
+	return srs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srs *SigningRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srs.ctx, ent.OpQuerySelect)
+	if err := srs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SigningRequestQuery, *SigningRequestSelect](ctx, srs.SigningRequestQuery, srs, srs.inters, v)
+}
+
+func (srs *SigningRequestSelect) sqlScan(ctx context.Context, root *SigningRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(srs.fns))
+	for _, fn := range srs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*srs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,799 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+)
+
+// SigningRequestUpdate is the builder for updating SigningRequest entities.
+type SigningRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SigningRequestMutation
+}
+
+// Where appends a list predicates to the SigningRequestUpdate builder.
+func (sru *SigningRequestUpdate) Where(ps ...predicate.SigningRequest) *SigningRequestUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (sru *SigningRequestUpdate) SetNamespaceID(i int) *SigningRequestUpdate {
+	sru.mutation.SetNamespaceID(i)
+	return sru
+}
+
+// SetNillableNamespaceID sets the "namespace_id" field if the given value is not nil.
+func (sru *SigningRequestUpdate) SetNillableNamespaceID(i *int) *SigningRequestUpdate {
+	if i != nil {
+		sru.SetNamespaceID(*i)
+	}
+	return sru
+}
+
+// SetIssuerID sets the "issuer_id" field.
+func (sru *SigningRequestUpdate) SetIssuerID(i int) *SigningRequestUpdate {
+	sru.mutation.ResetIssuerID()
+	sru.mutation.SetIssuerID(i)
+	return sru
+}
+
+// SetNillableIssuerID sets the "issuer_id" field if the given value is not nil.
+func (sru *SigningRequestUpdate) SetNillableIssuerID(i *int) *SigningRequestUpdate {
+	if i != nil {
+		sru.SetIssuerID(*i)
+	}
+	return sru
+}
+
+// AddIssuerID adds i to the "issuer_id" field.
+func (sru *SigningRequestUpdate) AddIssuerID(i int) *SigningRequestUpdate {
+	sru.mutation.AddIssuerID(i)
+	return sru
+}
+
+// SetKind sets the "kind" field.
+func (sru *SigningRequestUpdate) SetKind(s signingrequest.Kind) *SigningRequestUpdate {
+	sru.mutation.SetKind(s)
+	return sru
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (sru *SigningRequestUpdate) SetNillableKind(s *signingrequest.Kind) *SigningRequestUpdate {
+	if s != nil {
+		sru.SetKind(*s)
+	}
+	return sru
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (sru *SigningRequestUpdate) SetCertificateID(i int) *SigningRequestUpdate {
+	sru.mutation.ResetCertificateID()
+	sru.mutation.SetCertificateID(i)
+	return sru
+}
+
+// SetNillableCertificateID sets the "certificate_id" field if the given value is not nil.
+func (sru *SigningRequestUpdate) SetNillableCertificateID(i *int) *SigningRequestUpdate {
+	if i != nil {
+		sru.SetCertificateID(*i)
+	}
+	return sru
+}
+
+// AddCertificateID adds i to the "certificate_id" field.
+func (sru *SigningRequestUpdate) AddCertificateID(i int) *SigningRequestUpdate {
+	sru.mutation.AddCertificateID(i)
+	return sru
+}
+
+// ClearCertificateID clears the value of the "certificate_id" field.
+func (sru *SigningRequestUpdate) ClearCertificateID() *SigningRequestUpdate {
+	sru.mutation.ClearCertificateID()
+	return sru
+}
+
+// SetTemplate sets the "template" field.
+func (sru *SigningRequestUpdate) SetTemplate(s string) *SigningRequestUpdate {
+	sru.mutation.SetTemplate(s)
+	return sru
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (sru *SigningRequestUpdate) SetNillableTemplate(s *string) *SigningRequestUpdate {
+	if s != nil {
+		sru.SetTemplate(*s)
+	}
+	return sru
+}
+
+// SetKeyPem sets the "key_pem" field.
+func (sru *SigningRequestUpdate) SetKeyPem(s string) *SigningRequestUpdate {
+	sru.mutation.SetKeyPem(s)
+	return sru
+}
+
+// SetNillableKeyPem sets the "key_pem" field if the given value is not nil.
+func (sru *SigningRequestUpdate) SetNillableKeyPem(s *string) *SigningRequestUpdate {
+	if s != nil {
+		sru.SetKeyPem(*s)
+	}
+	return sru
+}
+
+// ClearKeyPem clears the value of the "key_pem" field.
+func (sru *SigningRequestUpdate) ClearKeyPem() *SigningRequestUpdate {
+	sru.mutation.ClearKeyPem()
+	return sru
+}
+
+// SetKeyRef sets the "key_ref" field.
+func (sru *SigningRequestUpdate) SetKeyRef(s string) *SigningRequestUpdate {
+	sru.mutation.SetKeyRef(s)
+	return sru
+}
+
+// SetNillableKeyRef sets the "key_ref" field if the given value is not nil.
+func (sru *SigningRequestUpdate) SetNillableKeyRef(s *string) *SigningRequestUpdate {
+	if s != nil {
+		sru.SetKeyRef(*s)
+	}
+	return sru
+}
+
+// ClearKeyRef clears the value of the "key_ref" field.
+func (sru *SigningRequestUpdate) ClearKeyRef() *SigningRequestUpdate {
+	sru.mutation.ClearKeyRef()
+	return sru
+}
+
+// SetDesc sets the "desc" field.
+func (sru *SigningRequestUpdate) SetDesc(s string) *SigningRequestUpdate {
+	sru.mutation.SetDesc(s)
+	return sru
+}
+
+// SetNillableDesc sets the "desc" field if the given value is not nil.
+func (sru *SigningRequestUpdate) SetNillableDesc(s *string) *SigningRequestUpdate {
+	if s != nil {
+		sru.SetDesc(*s)
+	}
+	return sru
+}
+
+// ClearDesc clears the value of the "desc" field.
+func (sru *SigningRequestUpdate) ClearDesc() *SigningRequestUpdate {
+	sru.mutation.ClearDesc()
+	return sru
+}
+
+// SetUsage sets the "usage" field.
+func (sru *SigningRequestUpdate) SetUsage(s string) *SigningRequestUpdate {
+	sru.mutation.SetUsage(s)
+	return sru
+}
+
+// SetNillableUsage sets the "usage" field if the given value is not nil.
+func (sru *SigningRequestUpdate) SetNillableUsage(s *string) *SigningRequestUpdate {
+	if s != nil {
+		sru.SetUsage(*s)
+	}
+	return sru
+}
+
+// ClearUsage clears the value of the "usage" field.
+func (sru *SigningRequestUpdate) ClearUsage() *SigningRequestUpdate {
+	sru.mutation.ClearUsage()
+	return sru
+}
+
+// SetStatus sets the "status" field.
+func (sru *SigningRequestUpdate) SetStatus(s signingrequest.Status) *SigningRequestUpdate {
+	sru.mutation.SetStatus(s)
+	return sru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sru *SigningRequestUpdate) SetNillableStatus(s *signingrequest.Status) *SigningRequestUpdate {
+	if s != nil {
+		sru.SetStatus(*s)
+	}
+	return sru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sru *SigningRequestUpdate) SetUpdatedAt(t time.Time) *SigningRequestUpdate {
+	sru.mutation.SetUpdatedAt(t)
+	return sru
+}
+
+// SetNamespace sets the "namespace" edge to the Namespace entity.
+func (sru *SigningRequestUpdate) SetNamespace(n *Namespace) *SigningRequestUpdate {
+	return sru.SetNamespaceID(n.ID)
+}
+
+// Mutation returns the SigningRequestMutation object of the builder.
+func (sru *SigningRequestUpdate) Mutation() *SigningRequestMutation {
+	return sru.mutation
+}
+
+// ClearNamespace clears the "namespace" edge to the Namespace entity.
+func (sru *SigningRequestUpdate) ClearNamespace() *SigningRequestUpdate {
+	sru.mutation.ClearNamespace()
+	return sru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *SigningRequestUpdate) Save(ctx context.Context) (int, error) {
+	sru.defaults()
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *SigningRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *SigningRequestUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *SigningRequestUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sru *SigningRequestUpdate) defaults() {
+	if _, ok := sru.mutation.UpdatedAt(); !ok {
+		v := signingrequest.UpdateDefaultUpdatedAt()
+		sru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sru *SigningRequestUpdate) check() error {
+	if v, ok := sru.mutation.Kind(); ok {
+		if err := signingrequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SigningRequest.kind": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.Status(); ok {
+		if err := signingrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningRequest.status": %w`, err)}
+		}
+	}
+	if sru.mutation.NamespaceCleared() && len(sru.mutation.NamespaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SigningRequest.namespace"`)
+	}
+	return nil
+}
+
+func (sru *SigningRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signingrequest.Table, signingrequest.Columns, sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sru.mutation.IssuerID(); ok {
+		_spec.SetField(signingrequest.FieldIssuerID, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedIssuerID(); ok {
+		_spec.AddField(signingrequest.FieldIssuerID, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.Kind(); ok {
+		_spec.SetField(signingrequest.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := sru.mutation.CertificateID(); ok {
+		_spec.SetField(signingrequest.FieldCertificateID, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedCertificateID(); ok {
+		_spec.AddField(signingrequest.FieldCertificateID, field.TypeInt, value)
+	}
+	if sru.mutation.CertificateIDCleared() {
+		_spec.ClearField(signingrequest.FieldCertificateID, field.TypeInt)
+	}
+	if value, ok := sru.mutation.Template(); ok {
+		_spec.SetField(signingrequest.FieldTemplate, field.TypeString, value)
+	}
+	if value, ok := sru.mutation.KeyPem(); ok {
+		_spec.SetField(signingrequest.FieldKeyPem, field.TypeString, value)
+	}
+	if sru.mutation.KeyPemCleared() {
+		_spec.ClearField(signingrequest.FieldKeyPem, field.TypeString)
+	}
+	if value, ok := sru.mutation.KeyRef(); ok {
+		_spec.SetField(signingrequest.FieldKeyRef, field.TypeString, value)
+	}
+	if sru.mutation.KeyRefCleared() {
+		_spec.ClearField(signingrequest.FieldKeyRef, field.TypeString)
+	}
+	if value, ok := sru.mutation.Desc(); ok {
+		_spec.SetField(signingrequest.FieldDesc, field.TypeString, value)
+	}
+	if sru.mutation.DescCleared() {
+		_spec.ClearField(signingrequest.FieldDesc, field.TypeString)
+	}
+	if value, ok := sru.mutation.Usage(); ok {
+		_spec.SetField(signingrequest.FieldUsage, field.TypeString, value)
+	}
+	if sru.mutation.UsageCleared() {
+		_spec.ClearField(signingrequest.FieldUsage, field.TypeString)
+	}
+	if value, ok := sru.mutation.Status(); ok {
+		_spec.SetField(signingrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := sru.mutation.UpdatedAt(); ok {
+		_spec.SetField(signingrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sru.mutation.NamespaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   signingrequest.NamespaceTable,
+			Columns: []string{signingrequest.NamespaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(namespace.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sru.mutation.NamespaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   signingrequest.NamespaceTable,
+			Columns: []string{signingrequest.NamespaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(namespace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// SigningRequestUpdateOne is the builder for updating a single SigningRequest entity.
+type SigningRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SigningRequestMutation
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (sruo *SigningRequestUpdateOne) SetNamespaceID(i int) *SigningRequestUpdateOne {
+	sruo.mutation.SetNamespaceID(i)
+	return sruo
+}
+
+// SetNillableNamespaceID sets the "namespace_id" field if the given value is not nil.
+func (sruo *SigningRequestUpdateOne) SetNillableNamespaceID(i *int) *SigningRequestUpdateOne {
+	if i != nil {
+		sruo.SetNamespaceID(*i)
+	}
+	return sruo
+}
+
+// SetIssuerID sets the "issuer_id" field.
+func (sruo *SigningRequestUpdateOne) SetIssuerID(i int) *SigningRequestUpdateOne {
+	sruo.mutation.ResetIssuerID()
+	sruo.mutation.SetIssuerID(i)
+	return sruo
+}
+
+// SetNillableIssuerID sets the "issuer_id" field if the given value is not nil.
+func (sruo *SigningRequestUpdateOne) SetNillableIssuerID(i *int) *SigningRequestUpdateOne {
+	if i != nil {
+		sruo.SetIssuerID(*i)
+	}
+	return sruo
+}
+
+// AddIssuerID adds i to the "issuer_id" field.
+func (sruo *SigningRequestUpdateOne) AddIssuerID(i int) *SigningRequestUpdateOne {
+	sruo.mutation.AddIssuerID(i)
+	return sruo
+}
+
+// SetKind sets the "kind" field.
+func (sruo *SigningRequestUpdateOne) SetKind(s signingrequest.Kind) *SigningRequestUpdateOne {
+	sruo.mutation.SetKind(s)
+	return sruo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (sruo *SigningRequestUpdateOne) SetNillableKind(s *signingrequest.Kind) *SigningRequestUpdateOne {
+	if s != nil {
+		sruo.SetKind(*s)
+	}
+	return sruo
+}
+
+// SetCertificateID sets the "certificate_id" field.
+func (sruo *SigningRequestUpdateOne) SetCertificateID(i int) *SigningRequestUpdateOne {
+	sruo.mutation.ResetCertificateID()
+	sruo.mutation.SetCertificateID(i)
+	return sruo
+}
+
+// SetNillableCertificateID sets the "certificate_id" field if the given value is not nil.
+func (sruo *SigningRequestUpdateOne) SetNillableCertificateID(i *int) *SigningRequestUpdateOne {
+	if i != nil {
+		sruo.SetCertificateID(*i)
+	}
+	return sruo
+}
+
+// AddCertificateID adds i to the "certificate_id" field.
+func (sruo *SigningRequestUpdateOne) AddCertificateID(i int) *SigningRequestUpdateOne {
+	sruo.mutation.AddCertificateID(i)
+	return sruo
+}
+
+// ClearCertificateID clears the value of the "certificate_id" field.
+func (sruo *SigningRequestUpdateOne) ClearCertificateID() *SigningRequestUpdateOne {
+	sruo.mutation.ClearCertificateID()
+	return sruo
+}
+
+// SetTemplate sets the "template" field.
+func (sruo *SigningRequestUpdateOne) SetTemplate(s string) *SigningRequestUpdateOne {
+	sruo.mutation.SetTemplate(s)
+	return sruo
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (sruo *SigningRequestUpdateOne) SetNillableTemplate(s *string) *SigningRequestUpdateOne {
+	if s != nil {
+		sruo.SetTemplate(*s)
+	}
+	return sruo
+}
+
+// SetKeyPem sets the "key_pem" field.
+func (sruo *SigningRequestUpdateOne) SetKeyPem(s string) *SigningRequestUpdateOne {
+	sruo.mutation.SetKeyPem(s)
+	return sruo
+}
+
+// SetNillableKeyPem sets the "key_pem" field if the given value is not nil.
+func (sruo *SigningRequestUpdateOne) SetNillableKeyPem(s *string) *SigningRequestUpdateOne {
+	if s != nil {
+		sruo.SetKeyPem(*s)
+	}
+	return sruo
+}
+
+// ClearKeyPem clears the value of the "key_pem" field.
+func (sruo *SigningRequestUpdateOne) ClearKeyPem() *SigningRequestUpdateOne {
+	sruo.mutation.ClearKeyPem()
+	return sruo
+}
+
+// SetKeyRef sets the "key_ref" field.
+func (sruo *SigningRequestUpdateOne) SetKeyRef(s string) *SigningRequestUpdateOne {
+	sruo.mutation.SetKeyRef(s)
+	return sruo
+}
+
+// SetNillableKeyRef sets the "key_ref" field if the given value is not nil.
+func (sruo *SigningRequestUpdateOne) SetNillableKeyRef(s *string) *SigningRequestUpdateOne {
+	if s != nil {
+		sruo.SetKeyRef(*s)
+	}
+	return sruo
+}
+
+// ClearKeyRef clears the value of the "key_ref" field.
+func (sruo *SigningRequestUpdateOne) ClearKeyRef() *SigningRequestUpdateOne {
+	sruo.mutation.ClearKeyRef()
+	return sruo
+}
+
+// SetDesc sets the "desc" field.
+func (sruo *SigningRequestUpdateOne) SetDesc(s string) *SigningRequestUpdateOne {
+	sruo.mutation.SetDesc(s)
+	return sruo
+}
+
+// SetNillableDesc sets the "desc" field if the given value is not nil.
+func (sruo *SigningRequestUpdateOne) SetNillableDesc(s *string) *SigningRequestUpdateOne {
+	if s != nil {
+		sruo.SetDesc(*s)
+	}
+	return sruo
+}
+
+// ClearDesc clears the value of the "desc" field.
+func (sruo *SigningRequestUpdateOne) ClearDesc() *SigningRequestUpdateOne {
+	sruo.mutation.ClearDesc()
+	return sruo
+}
+
+// SetUsage sets the "usage" field.
+func (sruo *SigningRequestUpdateOne) SetUsage(s string) *SigningRequestUpdateOne {
+	sruo.mutation.SetUsage(s)
+	return sruo
+}
+
+// SetNillableUsage sets the "usage" field if the given value is not nil.
+func (sruo *SigningRequestUpdateOne) SetNillableUsage(s *string) *SigningRequestUpdateOne {
+	if s != nil {
+		sruo.SetUsage(*s)
+	}
+	return sruo
+}
+
+// ClearUsage clears the value of the "usage" field.
+func (sruo *SigningRequestUpdateOne) ClearUsage() *SigningRequestUpdateOne {
+	sruo.mutation.ClearUsage()
+	return sruo
+}
+
+// SetStatus sets the "status" field.
+func (sruo *SigningRequestUpdateOne) SetStatus(s signingrequest.Status) *SigningRequestUpdateOne {
+	sruo.mutation.SetStatus(s)
+	return sruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sruo *SigningRequestUpdateOne) SetNillableStatus(s *signingrequest.Status) *SigningRequestUpdateOne {
+	if s != nil {
+		sruo.SetStatus(*s)
+	}
+	return sruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sruo *SigningRequestUpdateOne) SetUpdatedAt(t time.Time) *SigningRequestUpdateOne {
+	sruo.mutation.SetUpdatedAt(t)
+	return sruo
+}
+
+// SetNamespace sets the "namespace" edge to the Namespace entity.
+func (sruo *SigningRequestUpdateOne) SetNamespace(n *Namespace) *SigningRequestUpdateOne {
+	return sruo.SetNamespaceID(n.ID)
+}
+
+// Mutation returns the SigningRequestMutation object of the builder.
+func (sruo *SigningRequestUpdateOne) Mutation() *SigningRequestMutation {
+	return sruo.mutation
+}
+
+// ClearNamespace clears the "namespace" edge to the Namespace entity.
+func (sruo *SigningRequestUpdateOne) ClearNamespace() *SigningRequestUpdateOne {
+	sruo.mutation.ClearNamespace()
+	return sruo
+}
+
+// Where appends a list predicates to the SigningRequestUpdate builder.
+func (sruo *SigningRequestUpdateOne) Where(ps ...predicate.SigningRequest) *SigningRequestUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *SigningRequestUpdateOne) Select(field string, fields ...string) *SigningRequestUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated SigningRequest entity.
+func (sruo *SigningRequestUpdateOne) Save(ctx context.Context) (*SigningRequest, error) {
+	sruo.defaults()
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *SigningRequestUpdateOne) SaveX(ctx context.Context) *SigningRequest {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *SigningRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *SigningRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sruo *SigningRequestUpdateOne) defaults() {
+	if _, ok := sruo.mutation.UpdatedAt(); !ok {
+		v := signingrequest.UpdateDefaultUpdatedAt()
+		sruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sruo *SigningRequestUpdateOne) check() error {
+	if v, ok := sruo.mutation.Kind(); ok {
+		if err := signingrequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SigningRequest.kind": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.Status(); ok {
+		if err := signingrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningRequest.status": %w`, err)}
+		}
+	}
+	if sruo.mutation.NamespaceCleared() && len(sruo.mutation.NamespaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SigningRequest.namespace"`)
+	}
+	return nil
+}
+
+func (sruo *SigningRequestUpdateOne) sqlSave(ctx context.Context) (_node *SigningRequest, err error) {
+	if err := sruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signingrequest.Table, signingrequest.Columns, sqlgraph.NewFieldSpec(signingrequest.FieldID, field.TypeInt))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SigningRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingrequest.FieldID)
+		for _, f := range fields {
+			if !signingrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signingrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sruo.mutation.IssuerID(); ok {
+		_spec.SetField(signingrequest.FieldIssuerID, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedIssuerID(); ok {
+		_spec.AddField(signingrequest.FieldIssuerID, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.Kind(); ok {
+		_spec.SetField(signingrequest.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := sruo.mutation.CertificateID(); ok {
+		_spec.SetField(signingrequest.FieldCertificateID, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedCertificateID(); ok {
+		_spec.AddField(signingrequest.FieldCertificateID, field.TypeInt, value)
+	}
+	if sruo.mutation.CertificateIDCleared() {
+		_spec.ClearField(signingrequest.FieldCertificateID, field.TypeInt)
+	}
+	if value, ok := sruo.mutation.Template(); ok {
+		_spec.SetField(signingrequest.FieldTemplate, field.TypeString, value)
+	}
+	if value, ok := sruo.mutation.KeyPem(); ok {
+		_spec.SetField(signingrequest.FieldKeyPem, field.TypeString, value)
+	}
+	if sruo.mutation.KeyPemCleared() {
+		_spec.ClearField(signingrequest.FieldKeyPem, field.TypeString)
+	}
+	if value, ok := sruo.mutation.KeyRef(); ok {
+		_spec.SetField(signingrequest.FieldKeyRef, field.TypeString, value)
+	}
+	if sruo.mutation.KeyRefCleared() {
+		_spec.ClearField(signingrequest.FieldKeyRef, field.TypeString)
+	}
+	if value, ok := sruo.mutation.Desc(); ok {
+		_spec.SetField(signingrequest.FieldDesc, field.TypeString, value)
+	}
+	if sruo.mutation.DescCleared() {
+		_spec.ClearField(signingrequest.FieldDesc, field.TypeString)
+	}
+	if value, ok := sruo.mutation.Usage(); ok {
+		_spec.SetField(signingrequest.FieldUsage, field.TypeString, value)
+	}
+	if sruo.mutation.UsageCleared() {
+		_spec.ClearField(signingrequest.FieldUsage, field.TypeString)
+	}
+	if value, ok := sruo.mutation.Status(); ok {
+		_spec.SetField(signingrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := sruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(signingrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sruo.mutation.NamespaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   signingrequest.NamespaceTable,
+			Columns: []string{signingrequest.NamespaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(namespace.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sruo.mutation.NamespaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   signingrequest.NamespaceTable,
+			Columns: []string{signingrequest.NamespaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(namespace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SigningRequest{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
@@ -12,10 +12,22 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
 	// Certificate is the client for interacting with the Certificate builders.
 	Certificate *CertificateClient
+	// ExpiryNotification is the client for interacting with the ExpiryNotification builders.
+	ExpiryNotification *ExpiryNotificationClient
+	// Keyring is the client for interacting with the Keyring builders.
+	Keyring *KeyringClient
 	// Namespace is the client for interacting with the Namespace builders.
 	Namespace *NamespaceClient
+	// RenewalAttempt is the client for interacting with the RenewalAttempt builders.
+	RenewalAttempt *RenewalAttemptClient
+	// RenewalPolicy is the client for interacting with the RenewalPolicy builders.
+	RenewalPolicy *RenewalPolicyClient
+	// SigningRequest is the client for interacting with the SigningRequest builders.
+	SigningRequest *SigningRequestClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +159,14 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditEvent = NewAuditEventClient(tx.config)
 	tx.Certificate = NewCertificateClient(tx.config)
+	tx.ExpiryNotification = NewExpiryNotificationClient(tx.config)
+	tx.Keyring = NewKeyringClient(tx.config)
 	tx.Namespace = NewNamespaceClient(tx.config)
+	tx.RenewalAttempt = NewRenewalAttemptClient(tx.config)
+	tx.RenewalPolicy = NewRenewalPolicyClient(tx.config)
+	tx.SigningRequest = NewSigningRequestClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Certificate.QueryXXX(), the query will be executed
+// applies a query, for example: AuditEvent.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
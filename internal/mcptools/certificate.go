@@ -3,6 +3,7 @@ package mcptools
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/logeable/certmgr/internal/service"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -12,21 +13,64 @@ import (
 func InitCertificateTools(certificateService *service.CertificateService) []server.ServerTool {
 	return []server.ServerTool{
 		{
-			Tool: mcp.NewTool("list_certificates", mcp.WithDescription("列出指定空间下的所有证书"),
+			Tool: mcp.NewTool("list_certificates", withListOptions("created, updated, expiry, subject",
+				mcp.WithDescription("分页列出指定空间下的证书, 结果中 meta.total 为总数"),
 				mcp.WithNumber("namespace_id",
 					mcp.Required(),
 					mcp.Description("空间ID")),
-			),
+			)...),
 			Handler: listCertificatesHandler(certificateService),
 		},
 		{
-			Tool: mcp.NewTool("get_certificate", mcp.WithDescription("获取指定证书详情"),
+			Tool: mcp.NewTool("get_certificate", mcp.WithDescription("获取指定证书详情, 不包含私钥, keyRevealable 表示能否通过 reveal_private_key 获取私钥"),
 				mcp.WithNumber("id",
 					mcp.Required(),
 					mcp.Description("证书ID")),
 			),
 			Handler: getCertificateHandler(certificateService),
 		},
+		{
+			Tool: mcp.NewTool("reveal_private_key", mcp.WithDescription("获取证书的明文私钥, 每次调用都会记录到审计日志, 空间禁止查看私钥时失败"),
+				mcp.WithNumber("id",
+					mcp.Required(),
+					mcp.Description("证书ID")),
+				mcp.WithString("reason",
+					mcp.Description("查看私钥的原因, 记录在审计日志中")),
+			),
+			Handler: revealPrivateKeyHandler(certificateService),
+		},
+		{
+			Tool: mcp.NewTool("lint_certificate", mcp.WithDescription("按 RFC 5280 和 CA/B 规则检查证书, 返回错误和警告"),
+				mcp.WithNumber("id",
+					mcp.Required(),
+					mcp.Description("证书ID")),
+			),
+			Handler: lintCertificateHandler(certificateService),
+		},
+		{
+			Tool: mcp.NewTool("chain_doctor", mcp.WithDescription("整理 PEM 证书包: 按 叶子 → 根 排序, 从所有空间补全缺失的中间证书, 并报告重复, 无关, 过期, 密钥用途错误和私钥不匹配等问题"),
+				mcp.WithString("bundle",
+					mcp.Required(),
+					mcp.Description("PEM 格式的证书包, 顺序任意")),
+				mcp.WithString("key_pem",
+					mcp.Description("叶子证书的私钥, PEM 格式, 指定时检查是否与证书匹配")),
+			),
+			Handler: chainDoctorHandler(certificateService),
+		},
+		{
+			Tool: mcp.NewTool("probe_tls", mcp.WithDescription("连接 TLS 服务, 获取其返回的证书链, 并与受管理的证书比较指纹, SAN, 过期时间和证书链完整性"),
+				mcp.WithString("address",
+					mcp.Required(),
+					mcp.Description("服务地址, 格式为 host:port")),
+				mcp.WithString("server_name",
+					mcp.Description("SNI 名称, 默认为 address 中的主机")),
+				mcp.WithNumber("certificate_id",
+					mcp.Description("期望服务使用的证书ID, 指定时返回比较结果")),
+				mcp.WithNumber("client_certificate_id",
+					mcp.Description("服务要求客户端证书时使用的证书ID")),
+			),
+			Handler: probeTLSHandler(certificateService),
+		},
 		{
 			Tool: mcp.NewTool("create_certificate", mcp.WithDescription("创建证书"),
 				mcp.WithNumber("namespace_id",
@@ -42,6 +86,8 @@ func InitCertificateTools(certificateService *service.CertificateService) []serv
 					mcp.Description("密钥长度, 支持 2048, 3072, 4096, 只有 key_type 是 RSA 时需要指定")),
 				mcp.WithString("ecc_curve",
 					mcp.Description("椭圆曲线, 支持 P224, P256, P384, P521, 只有 key_type 是 ECDSA 时需要指定")),
+				mcp.WithString("key_backend",
+					mcp.Description("密钥存储后端, 默认 database, 配置了外部签名进程时可以指定 socket, 配置了 PKCS#11 时可以指定 pkcs11")),
 				mcp.WithNumber("valid_days",
 					mcp.Required(),
 					mcp.Description("证书有效期, 单位: 天")),
@@ -108,6 +154,69 @@ func InitCertificateTools(certificateService *service.CertificateService) []serv
 			),
 			Handler: renewCertificateHandler(certificateService),
 		},
+		{
+			Tool: mcp.NewTool("search_certificates", mcp.WithDescription("搜索证书, 所有条件均可选, 可用于查询哪个证书覆盖某个域名"),
+				mcp.WithNumber("namespace_id",
+					mcp.Description("空间ID, 不指定时搜索所有空间")),
+				mcp.WithString("common_name",
+					mcp.Description("通用名称, 包含该字符串即匹配, 不区分大小写")),
+				mcp.WithString("dns_name",
+					mcp.Description("DNS 名称, 匹配相同或覆盖该名称的通配符 SAN, 如 api.internal 匹配 *.internal")),
+				mcp.WithString("ip_address",
+					mcp.Description("IP 地址 SAN")),
+				mcp.WithString("serial_number",
+					mcp.Description("序列号, 十六进制, 可以包含冒号")),
+				mcp.WithString("fingerprint",
+					mcp.Description("证书 SHA-256 指纹, 十六进制, 可以包含冒号")),
+				mcp.WithString("key_type",
+					mcp.Description("密钥类型, 支持 RSA, ECDSA, ED25519")),
+				mcp.WithBoolean("is_ca",
+					mcp.Description("true 只返回 CA 证书, false 只返回终端证书")),
+				mcp.WithString("usage",
+					mcp.Description("证书用途, 支持 CA, server, client, code")),
+				mcp.WithString("expires_within",
+					mcp.Description("在指定时间内过期, 如 30d, 12h")),
+				mcp.WithString("status",
+					mcp.Description("证书状态, 支持 valid, expired, not_yet_valid, 暂不支持吊销状态")),
+			),
+			Handler: searchCertificatesHandler(certificateService),
+		},
+	}
+}
+
+func searchCertificatesHandler(certificateService *service.CertificateService) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		searchReq := service.SearchCertificatesReq{
+			NamespaceID:  req.GetInt("namespace_id", 0),
+			CommonName:   req.GetString("common_name", ""),
+			DNSName:      req.GetString("dns_name", ""),
+			IPAddress:    req.GetString("ip_address", ""),
+			SerialNumber: req.GetString("serial_number", ""),
+			Fingerprint:  req.GetString("fingerprint", ""),
+			KeyType:      req.GetString("key_type", ""),
+			Usage:        req.GetString("usage", ""),
+			Status:       req.GetString("status", ""),
+		}
+		if _, ok := req.GetArguments()["is_ca"]; ok {
+			isCA := req.GetBool("is_ca", false)
+			searchReq.IsCA = &isCA
+		}
+		if v := req.GetString("expires_within", ""); v != "" {
+			within, err := service.ParseWithin(v)
+			if err != nil {
+				return mcp.NewToolResultErrorFromErr("invalid expires_within", err), nil
+			}
+			searchReq.ExpiresWithin = within
+		}
+		certs, err := certificateService.SearchCertificates(ctx, searchReq)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to search certificates", err), nil
+		}
+		jsonBytes, err := json.Marshal(certs)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to marshal certificates", err), nil
+		}
+		return mcp.NewToolResultText(string(jsonBytes)), nil
 	}
 }
 
@@ -128,11 +237,18 @@ func listCertificatesHandler(certificateService *service.CertificateService) ser
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("invalid namespace_id", err), nil
 		}
-		certificates, err := certificateService.ListCertificates(ctx, namespaceId)
+		opts, err := listOptionsFromRequest(req)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid list options", err), nil
+		}
+		if err := opts.CheckFields(CertificateResponse{}); err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid fields", err), nil
+		}
+		certificates, total, err := certificateService.ListCertificates(ctx, namespaceId, opts)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("failed to list certificates", err), nil
 		}
-		var result []CertificateResponse
+		result := make([]CertificateResponse, 0, len(certificates))
 		for _, cert := range certificates {
 			result = append(result, CertificateResponse{
 				ID:        cert.ID,
@@ -145,7 +261,11 @@ func listCertificatesHandler(certificateService *service.CertificateService) ser
 				Usage:     cert.Usage,
 			})
 		}
-		jsonBytes, err := json.Marshal(result)
+		page, err := service.NewListPage(result, total, opts)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid fields", err), nil
+		}
+		jsonBytes, err := json.Marshal(page)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("failed to marshal certificates", err), nil
 		}
@@ -171,6 +291,84 @@ func getCertificateHandler(certificateService *service.CertificateService) serve
 	}
 }
 
+func revealPrivateKeyHandler(certificateService *service.CertificateService) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		id, err := req.RequireInt("id")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid id", err), nil
+		}
+		keyPem, err := certificateService.RevealPrivateKey(ctx, id, service.RevealKeyReq{
+			Reason: req.GetString("reason", ""),
+		})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to reveal private key", err), nil
+		}
+		return mcp.NewToolResultText(keyPem), nil
+	}
+}
+
+func lintCertificateHandler(certificateService *service.CertificateService) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		id, err := req.RequireInt("id")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid id", err), nil
+		}
+		result, err := certificateService.LintCertificate(ctx, id)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to lint certificate", err), nil
+		}
+		jsonBytes, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to marshal lint result", err), nil
+		}
+		return mcp.NewToolResultText(string(jsonBytes)), nil
+	}
+}
+
+func chainDoctorHandler(certificateService *service.CertificateService) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		bundle, err := req.RequireString("bundle")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid bundle", err), nil
+		}
+		result, err := certificateService.DiagnoseChain(ctx, service.ChainDoctorReq{
+			Bundle: bundle,
+			KeyPem: req.GetString("key_pem", ""),
+		})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to diagnose chain", err), nil
+		}
+		jsonBytes, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to marshal chain doctor result", err), nil
+		}
+		return mcp.NewToolResultText(string(jsonBytes)), nil
+	}
+}
+
+func probeTLSHandler(certificateService *service.CertificateService) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		address, err := req.RequireString("address")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid address", err), nil
+		}
+		result, err := certificateService.ProbeEndpoint(ctx, service.ProbeReq{
+			Address:             address,
+			ServerName:          req.GetString("server_name", ""),
+			CertificateID:       req.GetInt("certificate_id", 0),
+			ClientCertificateID: req.GetInt("client_certificate_id", 0),
+		})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to probe endpoint", err), nil
+		}
+		jsonBytes, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to marshal probe result", err), nil
+		}
+		return mcp.NewToolResultText(string(jsonBytes)), nil
+	}
+}
+
 func createCertificateHandler(certificateService *service.CertificateService) server.ToolHandlerFunc {
 	type Subject struct {
 		Country    string `json:"country"`
@@ -187,6 +385,7 @@ func createCertificateHandler(certificateService *service.CertificateService) se
 		KeyType     string   `json:"key_type"`
 		KeyLen      int      `json:"key_len"`
 		ECCCurve    string   `json:"ecc_curve"`
+		KeyBackend  string   `json:"key_backend"`
 		ValidDays   int      `json:"valid_days"`
 		Desc        string   `json:"desc"`
 		Subject     Subject  `json:"subject"`
@@ -206,6 +405,7 @@ func createCertificateHandler(certificateService *service.CertificateService) se
 			KeyType:     args.KeyType,
 			KeyLen:      args.KeyLen,
 			ECCCurve:    args.ECCCurve,
+			KeyBackend:  args.KeyBackend,
 			ValidDays:   args.ValidDays,
 			Desc:        args.Desc,
 			Subject: service.Subject{
@@ -257,10 +457,13 @@ func renewCertificateHandler(certificateService *service.CertificateService) ser
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("invalid valid_days", err), nil
 		}
-		err = certificateService.RenewCertificate(ctx, id, validDays)
+		signingRequest, err := certificateService.RenewCertificate(ctx, id, validDays)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("failed to renew certificate", err), nil
 		}
+		if signingRequest != nil {
+			return mcp.NewToolResultText(fmt.Sprintf("issuer is offline, signing request %d is waiting for an offline signature", signingRequest.ID)), nil
+		}
 		return mcp.NewToolResultText("certificate renewed successfully"), nil
 	}
 }
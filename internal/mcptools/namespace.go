@@ -12,18 +12,28 @@ import (
 )
 
 type Namespace struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Desc      string    `json:"desc"`
-	UpdatedAt time.Time `json:"updated_at"`
-	CreatedAt time.Time `json:"created_at"`
-	CertCount int       `json:"cert_count"`
+	ID            int        `json:"id"`
+	Name          string     `json:"name"`
+	Desc          string     `json:"desc"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	CertCount     int        `json:"cert_count"`
+	CACount       int        `json:"ca_count"`
+	LeafCount     int        `json:"leaf_count"`
+	ExpiredCount  int        `json:"expired_count"`
+	ExpiringCount int        `json:"expiring_count"`
+	RevokedCount  *int       `json:"revoked_count"`
+	NextExpiry    *time.Time `json:"next_expiry"`
+
+	KeyRevealDisabled bool `json:"key_reveal_disabled"`
 }
 
 func InitNamespaceTools(namespaceService *service.NamespaceService) []server.ServerTool {
 	return []server.ServerTool{
 		{
-			Tool:    mcp.NewTool("list_spaces", mcp.WithDescription("列出所有空间")),
+			Tool: mcp.NewTool("list_spaces", withListOptions("created, updated, name",
+				mcp.WithDescription("分页列出空间, 结果中 meta.total 为总数, 每个空间附带 CA/叶子证书数、已过期数、30 天内过期数和最近的过期时间"),
+			)...),
 			Handler: listSpacesHandler(namespaceService),
 		},
 		{
@@ -33,6 +43,8 @@ func InitNamespaceTools(namespaceService *service.NamespaceService) []server.Ser
 					mcp.Description("空间名称")),
 				mcp.WithString("desc",
 					mcp.Description("空间描述")),
+				mcp.WithBoolean("key_reveal_disabled",
+					mcp.Description("禁止查看和导出该空间证书的私钥")),
 			),
 			Handler: createSpaceHandler(namespaceService),
 		},
@@ -45,6 +57,8 @@ func InitNamespaceTools(namespaceService *service.NamespaceService) []server.Ser
 					mcp.Description("空间名称")),
 				mcp.WithString("desc",
 					mcp.Description("空间描述")),
+				mcp.WithBoolean("key_reveal_disabled",
+					mcp.Description("禁止查看和导出该空间证书的私钥, 只能开启, 关闭需要通过界面或 HTTP API")),
 			),
 			Handler: updateSpaceHandler(namespaceService),
 		},
@@ -56,27 +70,63 @@ func InitNamespaceTools(namespaceService *service.NamespaceService) []server.Ser
 			),
 			Handler: deleteSpaceHandler(namespaceService),
 		},
+		{
+			Tool: mcp.NewTool("clone_space", mcp.WithDescription("克隆空间，按原空间的证书树重新签发所有证书并生成新的私钥"),
+				mcp.WithNumber("id",
+					mcp.Required(),
+					mcp.Description("源空间ID")),
+				mcp.WithString("name",
+					mcp.Required(),
+					mcp.Description("新空间名称")),
+				mcp.WithString("desc",
+					mcp.Description("新空间描述")),
+				mcp.WithString("rewrite_from",
+					mcp.Description("需要替换的域名后缀，例如 dev.example")),
+				mcp.WithString("rewrite_to",
+					mcp.Description("替换后的域名后缀，例如 qa.example")),
+			),
+			Handler: cloneSpaceHandler(namespaceService),
+		},
 	}
 }
 
 func listSpacesHandler(namespaceService *service.NamespaceService) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		namespaces, err := namespaceService.ListNamespaces(ctx)
+		opts, err := listOptionsFromRequest(req)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid list options", err), nil
+		}
+		if err := opts.CheckFields(Namespace{}); err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid fields", err), nil
+		}
+		namespaces, total, err := namespaceService.ListNamespaces(ctx, opts)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("failed to list spaces", err), nil
 		}
-		var result []Namespace
+		result := make([]Namespace, 0, len(namespaces))
 		for _, ns := range namespaces {
 			result = append(result, Namespace{
-				ID:        ns.ID,
-				Name:      ns.Name,
-				Desc:      ns.Desc,
-				UpdatedAt: ns.UpdatedAt,
-				CreatedAt: ns.CreatedAt,
-				CertCount: ns.CertCount,
+				ID:            ns.ID,
+				Name:          ns.Name,
+				Desc:          ns.Desc,
+				UpdatedAt:     ns.UpdatedAt,
+				CreatedAt:     ns.CreatedAt,
+				CertCount:     ns.Certificates.Total,
+				CACount:       ns.Certificates.CA,
+				LeafCount:     ns.Certificates.Leaf,
+				ExpiredCount:  ns.Certificates.Expired,
+				ExpiringCount: ns.Certificates.Expiring,
+				RevokedCount:  ns.Certificates.Revoked,
+				NextExpiry:    ns.Certificates.NextExpiry,
+
+				KeyRevealDisabled: ns.KeyRevealDisabled,
 			})
 		}
-		jsonBytes, err := json.Marshal(result)
+		page, err := service.NewListPage(result, total, opts)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid fields", err), nil
+		}
+		jsonBytes, err := json.Marshal(page)
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("failed to marshal spaces", err), nil
 		}
@@ -92,8 +142,9 @@ func createSpaceHandler(namespaceService *service.NamespaceService) server.ToolH
 		}
 		desc := req.GetString("desc", "")
 		ns, err := namespaceService.CreateNamespace(ctx, ent.Namespace{
-			Name: name,
-			Desc: desc,
+			Name:              name,
+			Desc:              desc,
+			KeyRevealDisabled: req.GetBool("key_reveal_disabled", false),
 		})
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("failed to create space", err), nil
@@ -118,9 +169,14 @@ func updateSpaceHandler(namespaceService *service.NamespaceService) server.ToolH
 		}
 		name := req.GetString("name", ns.Name)
 		desc := req.GetString("desc", ns.Desc)
+		keyRevealDisabled := req.GetBool("key_reveal_disabled", ns.KeyRevealDisabled)
+		if ns.KeyRevealDisabled && !keyRevealDisabled {
+			return mcp.NewToolResultError("key reveal can only be re-enabled through the UI or the HTTP API"), nil
+		}
 		ns, err = namespaceService.UpdateNamespace(ctx, id, ent.Namespace{
-			Name: name,
-			Desc: desc,
+			Name:              name,
+			Desc:              desc,
+			KeyRevealDisabled: keyRevealDisabled,
 		})
 		if err != nil {
 			return mcp.NewToolResultErrorFromErr("failed to update space", err), nil
@@ -145,3 +201,33 @@ func deleteSpaceHandler(namespaceService *service.NamespaceService) server.ToolH
 		return mcp.NewToolResultText("success"), nil
 	}
 }
+
+func cloneSpaceHandler(namespaceService *service.NamespaceService) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		id, err := req.RequireInt("id")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid id", err), nil
+		}
+		name, err := req.RequireString("name")
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid name", err), nil
+		}
+		cloneReq := service.CloneNamespaceReq{
+			Name: name,
+			Desc: req.GetString("desc", ""),
+		}
+		from, to := req.GetString("rewrite_from", ""), req.GetString("rewrite_to", "")
+		if from != "" || to != "" {
+			cloneReq.DomainRewrites = []service.DomainRewrite{{From: from, To: to}}
+		}
+		ns, err := namespaceService.CloneNamespace(ctx, id, cloneReq)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to clone space", err), nil
+		}
+		jsonBytes, err := json.Marshal(ns)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to marshal space", err), nil
+		}
+		return mcp.NewToolResultText(string(jsonBytes)), nil
+	}
+}
@@ -0,0 +1,54 @@
+package mcptools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/logeable/certmgr/internal/service"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func InitReportTools(reportService *service.ReportService) []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Tool: mcp.NewTool("expiring_report", mcp.WithDescription("列出所有空间中即将过期（含已过期）的证书，按证书链最早过期时间排序"),
+				mcp.WithString("within",
+					mcp.Description("时间范围, 如 30d, 12h, 默认 30d")),
+				mcp.WithString("format",
+					mcp.Description("输出格式, 支持 json, csv, 默认 json")),
+			),
+			Handler: expiringReportHandler(reportService),
+		},
+	}
+}
+
+func expiringReportHandler(reportService *service.ReportService) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		within, err := service.ParseWithin(req.GetString("within", ""))
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid within", err), nil
+		}
+		rows, err := reportService.ExpiringReport(ctx, within)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to build report", err), nil
+		}
+		switch format := req.GetString("format", "json"); format {
+		case "csv":
+			var sb strings.Builder
+			if err := service.WriteExpiringCSV(&sb, rows); err != nil {
+				return mcp.NewToolResultErrorFromErr("failed to write csv", err), nil
+			}
+			return mcp.NewToolResultText(sb.String()), nil
+		case "json":
+			jsonBytes, err := json.Marshal(rows)
+			if err != nil {
+				return mcp.NewToolResultErrorFromErr("failed to marshal report", err), nil
+			}
+			return mcp.NewToolResultText(string(jsonBytes)), nil
+		default:
+			return mcp.NewToolResultError("unsupported format: " + format), nil
+		}
+	}
+}
@@ -1,20 +1,54 @@
 package mcptools
 
 import (
-	"github.com/logeable/certmgr/internal/ent"
+	"context"
+
 	"github.com/logeable/certmgr/internal/service"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
-func InitTools(dbClient *ent.Client) ([]server.ServerTool, error) {
-	svcCtx := service.NewServiceContext(dbClient)
+func InitTools(svcCtx *service.ServiceContext) ([]server.ServerTool, error) {
 	namespaceService := service.NewNamespaceService(svcCtx)
 	certificateService := service.NewCertificateService(svcCtx)
+	reportService := service.NewReportService(svcCtx)
 
 	var tools []server.ServerTool
 
 	tools = append(tools, InitNamespaceTools(namespaceService)...)
 	tools = append(tools, InitCertificateTools(certificateService)...)
+	tools = append(tools, InitReportTools(reportService)...)
 
+	// Tools run for whoever started the server over stdio.
+	actor := service.LocalUser()
+	for i := range tools {
+		handler := tools[i].Handler
+		tools[i].Handler = func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return handler(service.WithAuditActor(ctx, service.AuditSourceMCP, actor), req)
+		}
+	}
 	return tools, nil
 }
+
+// withListOptions adds the pagination parameters shared by list tools.
+func withListOptions(sortKeys string, opts ...mcp.ToolOption) []mcp.ToolOption {
+	return append(opts,
+		mcp.WithNumber("offset",
+			mcp.Description("跳过的条数, 默认 0")),
+		mcp.WithNumber("limit",
+			mcp.Description("返回的最大条数, 默认 100, 最大 1000")),
+		mcp.WithString("sort",
+			mcp.Description("排序字段, 支持 "+sortKeys+", 前面加 - 表示倒序, 如 -created")),
+		mcp.WithString("fields",
+			mcp.Description("只返回指定字段, 逗号分隔, 如 id,subject")),
+	)
+}
+
+func listOptionsFromRequest(req mcp.CallToolRequest) (service.ListOptions, error) {
+	return service.ParseListOptions(
+		req.GetInt("offset", 0),
+		req.GetInt("limit", 0),
+		req.GetString("sort", ""),
+		req.GetString("fields", ""),
+	)
+}
@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/logeable/certmgr/internal/service"
+	"go.uber.org/zap"
+)
+
+// Job is a task run every Interval. A failed run is logged and the job keeps
+// its schedule.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Start runs every job once right away and then on its interval until ctx is
+// done. Jobs with a non-positive interval are skipped.
+func Start(ctx context.Context, jobs ...Job) {
+	for _, job := range jobs {
+		if job.Interval <= 0 {
+			continue
+		}
+		go run(ctx, job)
+	}
+}
+
+func run(ctx context.Context, job Job) {
+	logger := zap.L().With(zap.String("job", job.Name))
+	ctx = service.WithAuditActor(ctx, service.AuditSourceScheduler, job.Name)
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+	for {
+		start := time.Now()
+		if err := job.Run(ctx); err != nil {
+			logger.Error("job failed", zap.Error(err))
+		} else {
+			logger.Debug("job done", zap.Duration("elapsed", time.Since(start)))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
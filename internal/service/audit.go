@@ -0,0 +1,397 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/user"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/auditevent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"go.uber.org/zap"
+)
+
+const (
+	AuditSourceHTTP      = "http"
+	AuditSourceMCP       = "mcp"
+	AuditSourceScheduler = "scheduler"
+	AuditSourceCLI       = "cli"
+	AuditSourceSystem    = "system"
+
+	auditRedacted = "[redacted]"
+)
+
+// auditSecretKeys are parameter names, lower case without separators, whose
+// values never reach the audit log.
+var auditSecretKeys = map[string]bool{
+	"password":   true,
+	"passphrase": true,
+	"pin":        true,
+	"secret":     true,
+	"keypem":     true,
+	"privatekey": true,
+	"salt":       true,
+	"check":      true,
+}
+
+// auditOmittedFields are mutation fields left out of the params because they
+// are large and derived columns already describe them.
+var auditOmittedFields = map[string]bool{
+	certificate.FieldCertPem: true,
+	"template":               true,
+}
+
+type auditActorKey struct{}
+
+type auditOperationKey struct{}
+
+type auditActor struct {
+	source string
+	name   string
+}
+
+type auditOperation struct {
+	name   string
+	params any
+}
+
+// WithAuditActor tags ctx with who is acting and through which entry point,
+// for every audit event recorded under it.
+func WithAuditActor(ctx context.Context, source, name string) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, auditActor{source: source, name: name})
+}
+
+// LocalUser names the operating system user, the actor of the CLI and of the
+// MCP server which have no caller identity of their own.
+func LocalUser() string {
+	u, err := user.Current()
+	if err != nil {
+		return ""
+	}
+	return u.Username
+}
+
+// withAuditOperation names the service operation the mutations under ctx
+// belong to, with the request parameters to record.
+func withAuditOperation(ctx context.Context, name string, params any) context.Context {
+	return context.WithValue(ctx, auditOperationKey{}, auditOperation{name: name, params: params})
+}
+
+func actorFromContext(ctx context.Context) auditActor {
+	if actor, ok := ctx.Value(auditActorKey{}).(auditActor); ok {
+		return actor
+	}
+	return auditActor{source: AuditSourceSystem}
+}
+
+func operationFromContext(ctx context.Context) (auditOperation, bool) {
+	op, ok := ctx.Value(auditOperationKey{}).(auditOperation)
+	return op, ok
+}
+
+func operationEntry(ctx context.Context) (string, map[string]any) {
+	op, ok := operationFromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	if op.params == nil {
+		return op.name, nil
+	}
+	return op.name, map[string]any{"request": redactAuditParams(op.params)}
+}
+
+type auditEntry struct {
+	operation   string
+	targetType  string
+	targetID    int
+	namespaceID int
+	params      map[string]any
+	err         error
+}
+
+type auditMutation interface {
+	ent.Mutation
+	Client() *ent.Client
+	ID() (int, bool)
+	IDs(ctx context.Context) ([]int, error)
+}
+
+// auditHook records every successful mutation with the same client. Services
+// run their mutations in withTx, so an event is committed exactly when its
+// change is; a mutation outside a transaction commits before its event is
+// written. Audit events themselves can only be created.
+func (sctx *ServiceContext) auditHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if m.Type() == ent.TypeAuditEvent {
+			if !m.Op().Is(ent.OpCreate) {
+				return nil, errors.New("audit events are append-only")
+			}
+			return next.Mutate(ctx, m)
+		}
+		am, ok := m.(auditMutation)
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+
+		var ids []int
+		namespaces := make(map[int]int)
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			ids, err = am.IDs(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("query audit targets failed: %w", err)
+			}
+			if cm, ok := m.(*ent.CertificateMutation); ok && len(ids) > 0 {
+				certs, err := cm.Client().Certificate.Query().
+					Where(certificate.IDIn(ids...)).
+					Select(certificate.FieldNamespaceID).
+					All(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("query namespaces of audit targets failed: %w", err)
+				}
+				for _, cert := range certs {
+					namespaces[cert.ID] = cert.NamespaceID
+				}
+			}
+		}
+
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return value, err
+		}
+		if m.Op().Is(ent.OpCreate) {
+			id, _ := am.ID()
+			ids = []int{id}
+		}
+
+		targetType := snakeCase(m.Type())
+		action := "update"
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			action = "create"
+		case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+			action = "delete"
+		}
+		operation, params := operationEntry(ctx)
+		if operation == "" {
+			operation = targetType + "." + action
+		}
+		if fields := mutationAuditFields(m); len(fields) > 0 {
+			if params == nil {
+				params = make(map[string]any)
+			}
+			params["fields"] = fields
+		}
+		fieldNamespace := 0
+		if v, ok := m.Field("namespace_id"); ok {
+			fieldNamespace, _ = v.(int)
+		}
+		for _, id := range ids {
+			entry := auditEntry{
+				operation:  operation,
+				targetType: targetType,
+				targetID:   id,
+				params:     params,
+			}
+			switch {
+			case m.Type() == ent.TypeNamespace:
+				entry.namespaceID = id
+			case fieldNamespace != 0:
+				entry.namespaceID = fieldNamespace
+			default:
+				entry.namespaceID = namespaces[id]
+			}
+			if err := sctx.appendAudit(ctx, am.Client(), entry); err != nil {
+				return nil, err
+			}
+		}
+		return value, nil
+	})
+}
+
+// auditRead records the operation of ctx as a successful read of sensitive
+// data. It fails when the event cannot be written, so nothing is revealed
+// without a trace.
+func (sctx *ServiceContext) auditRead(ctx context.Context, targetType string, targetID, namespaceID int) error {
+	entry := auditEntry{targetType: targetType, targetID: targetID, namespaceID: namespaceID}
+	entry.operation, entry.params = operationEntry(ctx)
+	return sctx.appendAudit(ctx, sctx.client, entry)
+}
+
+// auditFailure records the operation of ctx as failed when *err is set. It
+// runs after the transaction is rolled back, taking the events of its
+// mutations with it, so it writes outside of it.
+func (sctx *ServiceContext) auditFailure(ctx context.Context, targetType string, targetID int, err *error) {
+	if *err == nil {
+		return
+	}
+	entry := auditEntry{targetType: targetType, targetID: targetID, err: *err}
+	entry.operation, entry.params = operationEntry(ctx)
+	if appendErr := sctx.appendAudit(ctx, sctx.client, entry); appendErr != nil {
+		zap.L().Error("record audit failure failed", zap.String("operation", entry.operation), zap.Error(appendErr))
+	}
+}
+
+// appendAudit adds an event at the head of the chain. prev_hash is unique, so
+// two writers racing for the same head cannot fork the chain.
+func (sctx *ServiceContext) appendAudit(ctx context.Context, client *ent.Client, entry auditEntry) error {
+	sctx.auditMu.Lock()
+	defer sctx.auditMu.Unlock()
+
+	prevHash := ""
+	last, err := client.AuditEvent.Query().
+		Order(ent.Desc(auditevent.FieldID)).
+		Select(auditevent.FieldHash).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("query audit head failed: %w", err)
+	}
+	if last != nil {
+		prevHash = last.Hash
+	}
+
+	actor := actorFromContext(ctx)
+	event := &ent.AuditEvent{
+		Actor:       actor.name,
+		Source:      actor.source,
+		Operation:   entry.operation,
+		TargetType:  entry.targetType,
+		TargetID:    entry.targetID,
+		NamespaceID: entry.namespaceID,
+		Outcome:     auditevent.OutcomeSuccess,
+		PrevHash:    prevHash,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if len(entry.params) > 0 {
+		params, err := json.Marshal(entry.params)
+		if err != nil {
+			return fmt.Errorf("marshal audit params failed: %w", err)
+		}
+		event.Params = string(params)
+	}
+	if entry.err != nil {
+		event.Outcome = auditevent.OutcomeFailure
+		event.Error = entry.err.Error()
+	}
+	event.Hash = auditEventHash(event)
+
+	err = client.AuditEvent.Create().
+		SetActor(event.Actor).
+		SetSource(event.Source).
+		SetOperation(event.Operation).
+		SetTargetType(event.TargetType).
+		SetTargetID(event.TargetID).
+		SetNamespaceID(event.NamespaceID).
+		SetParams(event.Params).
+		SetOutcome(event.Outcome).
+		SetError(event.Error).
+		SetPrevHash(event.PrevHash).
+		SetHash(event.Hash).
+		SetCreatedAt(event.CreatedAt).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("create audit event failed: %w", err)
+	}
+	return nil
+}
+
+// auditEventHash covers every column but the ID, each prefixed by its length
+// so that no two events hash the same input.
+func auditEventHash(event *ent.AuditEvent) string {
+	h := sha256.New()
+	for _, part := range []string{
+		event.PrevHash,
+		event.CreatedAt.UTC().Format(time.RFC3339Nano),
+		event.Actor,
+		event.Source,
+		event.Operation,
+		event.TargetType,
+		strconv.Itoa(event.TargetID),
+		strconv.Itoa(event.NamespaceID),
+		event.Params,
+		string(event.Outcome),
+		event.Error,
+	} {
+		fmt.Fprintf(h, "%d:%s;", len(part), part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func mutationAuditFields(m ent.Mutation) map[string]any {
+	fields := make(map[string]any)
+	for _, name := range m.Fields() {
+		if auditOmittedFields[name] {
+			continue
+		}
+		if auditSecretKeys[normalizeAuditKey(name)] {
+			fields[name] = auditRedacted
+			continue
+		}
+		value, _ := m.Field(name)
+		fields[name] = value
+	}
+	for _, name := range m.ClearedFields() {
+		fields[name] = nil
+	}
+	return fields
+}
+
+// redactAuditParams turns params into plain JSON values with every secret
+// replaced, however deeply it is nested.
+func redactAuditParams(params any) any {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Sprintf("unserializable params: %v", err)
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Sprintf("unserializable params: %v", err)
+	}
+	return redactAuditValue(value)
+}
+
+func redactAuditValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, inner := range v {
+			if auditSecretKeys[normalizeAuditKey(key)] {
+				if inner != "" && inner != nil {
+					v[key] = auditRedacted
+				}
+				continue
+			}
+			v[key] = redactAuditValue(inner)
+		}
+	case []any:
+		for i, inner := range v {
+			v[i] = redactAuditValue(inner)
+		}
+	}
+	return value
+}
+
+func normalizeAuditKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+}
+
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/auditevent"
+)
+
+const auditVerifyBatch = 1000
+
+type AuditService struct {
+	ctx *ServiceContext
+}
+
+func NewAuditService(ctx *ServiceContext) *AuditService {
+	return &AuditService{
+		ctx: ctx,
+	}
+}
+
+// AuditFilter selects audit events, zero values match everything.
+type AuditFilter struct {
+	Actor       string
+	Source      string
+	Operation   string
+	TargetType  string
+	TargetID    int
+	NamespaceID int
+	Outcome     string
+	Since       *time.Time
+	Until       *time.Time
+}
+
+type AuditEvent struct {
+	ID          int             `json:"id"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	Actor       string          `json:"actor"`
+	Source      string          `json:"source"`
+	Operation   string          `json:"operation"`
+	TargetType  string          `json:"targetType"`
+	TargetID    int             `json:"targetId"`
+	NamespaceID int             `json:"namespaceId"`
+	Params      json.RawMessage `json:"params,omitempty"`
+	Outcome     string          `json:"outcome"`
+	Error       string          `json:"error,omitempty"`
+	PrevHash    string          `json:"prevHash"`
+	Hash        string          `json:"hash"`
+}
+
+// AuditVerifyResult reports whether the chain is intact. Head is the hash of
+// the last event; keeping a copy elsewhere also reveals a truncated log.
+type AuditVerifyResult struct {
+	Valid    bool   `json:"valid"`
+	Events   int    `json:"events"`
+	Head     string `json:"head"`
+	BrokenAt int    `json:"brokenAt,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// ListAuditEvents returns one page of matching events, newest first unless
+// opts asks for another order.
+func (s *AuditService) ListAuditEvents(ctx context.Context, filter AuditFilter, opts ListOptions) ([]AuditEvent, int, error) {
+	_, desc, err := opts.sort(SortByCreated, SortByCreated)
+	if err != nil {
+		return nil, 0, err
+	}
+	if opts.SortBy == "" {
+		desc = true
+	}
+	order := ent.Asc(auditevent.FieldID)
+	if desc {
+		order = ent.Desc(auditevent.FieldID)
+	}
+
+	query := s.ctx.client.AuditEvent.Query()
+	if filter.Actor != "" {
+		query = query.Where(auditevent.Actor(filter.Actor))
+	}
+	if filter.Source != "" {
+		query = query.Where(auditevent.Source(filter.Source))
+	}
+	if filter.Operation != "" {
+		query = query.Where(auditevent.Operation(filter.Operation))
+	}
+	if filter.TargetType != "" {
+		query = query.Where(auditevent.TargetType(filter.TargetType))
+	}
+	if filter.TargetID != 0 {
+		query = query.Where(auditevent.TargetID(filter.TargetID))
+	}
+	if filter.NamespaceID != 0 {
+		query = query.Where(auditevent.NamespaceID(filter.NamespaceID))
+	}
+	if filter.Outcome != "" {
+		if err := auditevent.OutcomeValidator(auditevent.Outcome(filter.Outcome)); err != nil {
+			return nil, 0, fmt.Errorf("invalid outcome: %w", err)
+		}
+		query = query.Where(auditevent.OutcomeEQ(auditevent.Outcome(filter.Outcome)))
+	}
+	if filter.Since != nil {
+		query = query.Where(auditevent.CreatedAtGTE(*filter.Since))
+	}
+	if filter.Until != nil {
+		query = query.Where(auditevent.CreatedAtLT(*filter.Until))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count audit events failed: %w", err)
+	}
+	events, err := query.Order(order).Offset(opts.Offset).Limit(opts.limit()).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query audit events failed: %w", err)
+	}
+	result := make([]AuditEvent, 0, len(events))
+	for _, e := range events {
+		result = append(result, toAuditEvent(e))
+	}
+	return result, total, nil
+}
+
+// VerifyAuditChain recomputes every hash in order and reports the first event
+// that does not match its content or its predecessor.
+func (s *AuditService) VerifyAuditChain(ctx context.Context) (*AuditVerifyResult, error) {
+	result := &AuditVerifyResult{Valid: true}
+	lastID := 0
+	for {
+		events, err := s.ctx.client.AuditEvent.Query().
+			Where(auditevent.IDGT(lastID)).
+			Order(ent.Asc(auditevent.FieldID)).
+			Limit(auditVerifyBatch).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query audit events failed: %w", err)
+		}
+		for _, e := range events {
+			switch {
+			case e.PrevHash != result.Head:
+				result.Valid, result.BrokenAt, result.Reason = false, e.ID, "previous hash does not match the preceding event"
+			case auditEventHash(e) != e.Hash:
+				result.Valid, result.BrokenAt, result.Reason = false, e.ID, "hash does not match the event content"
+			}
+			if !result.Valid {
+				return result, nil
+			}
+			result.Head = e.Hash
+			result.Events++
+			lastID = e.ID
+		}
+		if len(events) < auditVerifyBatch {
+			return result, nil
+		}
+	}
+}
+
+func toAuditEvent(e *ent.AuditEvent) AuditEvent {
+	event := AuditEvent{
+		ID:          e.ID,
+		CreatedAt:   e.CreatedAt,
+		Actor:       e.Actor,
+		Source:      e.Source,
+		Operation:   e.Operation,
+		TargetType:  e.TargetType,
+		TargetID:    e.TargetID,
+		NamespaceID: e.NamespaceID,
+		Outcome:     string(e.Outcome),
+		Error:       e.Error,
+		PrevHash:    e.PrevHash,
+		Hash:        e.Hash,
+	}
+	if e.Params != "" {
+		event.Params = json.RawMessage(e.Params)
+	}
+	return event
+}
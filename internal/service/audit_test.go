@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/auditevent"
+	"github.com/logeable/certmgr/internal/ent/enttest"
+)
+
+// newAuditTestContext returns a service context and a second client on the
+// same database that bypasses the audit hook, for tampering with events.
+func newAuditTestContext(t *testing.T) (*ServiceContext, *ent.Client) {
+	t.Helper()
+	dsn := newTestDSN()
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { _ = client.Close() })
+	raw := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { _ = raw.Close() })
+
+	sctx := NewServiceContext(client)
+	if err := sctx.Unlock(context.Background(), MasterKeySource{KeyFile: writeTestKeyFile(t)}); err != nil {
+		t.Fatalf("unlock keyring: %v", err)
+	}
+	return sctx, raw
+}
+
+func TestAuditChainAppendAndVerify(t *testing.T) {
+	sctx, _ := newAuditTestContext(t)
+	ctx := WithAuditActor(context.Background(), AuditSourceHTTP, "alice")
+	ns := createTestNamespace(t, sctx, "audited")
+	if _, err := NewCertificateService(sctx).CreateCertificate(ctx, testCertReq(ns.ID, 0, "Root CA", true)); err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	audit := NewAuditService(sctx)
+	result, err := audit.VerifyAuditChain(ctx)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	events, total, err := audit.ListAuditEvents(ctx, AuditFilter{}, ListOptions{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if !result.Valid || result.Events != total || total < 3 {
+		t.Fatalf("verify %+v over %d events", result, total)
+	}
+	if result.Head != events[0].Hash {
+		t.Fatalf("head %s is not the newest event %s", result.Head, events[0].Hash)
+	}
+	for i := 0; i+1 < len(events); i++ {
+		if events[i].PrevHash != events[i+1].Hash {
+			t.Fatalf("event %d does not chain to event %d", events[i].ID, events[i+1].ID)
+		}
+	}
+
+	created, _, err := audit.ListAuditEvents(ctx, AuditFilter{Operation: "certificate.create", Actor: "alice"}, ListOptions{})
+	if err != nil {
+		t.Fatalf("list certificate events: %v", err)
+	}
+	if len(created) != 2 || created[0].Source != AuditSourceHTTP || created[0].NamespaceID != ns.ID {
+		t.Fatalf("certificate.create events %+v, want the insert and the key update by alice", created)
+	}
+}
+
+func TestAuditChainDetectsTampering(t *testing.T) {
+	sctx, raw := newAuditTestContext(t)
+	ctx := context.Background()
+	createTestChain(t, sctx)
+	audit := NewAuditService(sctx)
+
+	events := raw.AuditEvent.Query().Order(ent.Asc(auditevent.FieldID)).AllX(ctx)
+	if len(events) < 4 {
+		t.Fatalf("only %d events recorded", len(events))
+	}
+	if err := sctx.client.AuditEvent.UpdateOneID(events[1].ID).SetActor("mallory").Exec(ctx); err == nil || !strings.Contains(err.Error(), "append-only") {
+		t.Fatalf("update through the service client returned %v", err)
+	}
+
+	raw.AuditEvent.UpdateOneID(events[1].ID).SetActor("mallory").ExecX(ctx)
+	result, err := audit.VerifyAuditChain(ctx)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if result.Valid || result.BrokenAt != events[1].ID || !strings.Contains(result.Reason, "content") {
+		t.Fatalf("edited event gave %+v", result)
+	}
+	raw.AuditEvent.UpdateOneID(events[1].ID).SetActor(events[1].Actor).ExecX(ctx)
+
+	raw.AuditEvent.DeleteOneID(events[2].ID).ExecX(ctx)
+	result, err = audit.VerifyAuditChain(ctx)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if result.Valid || result.BrokenAt != events[3].ID || !strings.Contains(result.Reason, "previous hash") {
+		t.Fatalf("deleted event gave %+v", result)
+	}
+}
+
+func TestAuditRedactsSecrets(t *testing.T) {
+	sctx, _ := newAuditTestContext(t)
+	ctx := context.Background()
+	_, _, _, leaf := createTestChain(t, sctx)
+	if _, err := NewCertificateService(sctx).ExportCertificate(ctx, leaf.ID, ExportCertReq{Format: ExportFormatJKSKeystore, Password: "changeit"}); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	events, _, err := NewAuditService(sctx).ListAuditEvents(ctx, AuditFilter{}, ListOptions{Limit: 100})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	var keyUpdates, exports int
+	for _, e := range events {
+		params := string(e.Params)
+		if strings.Contains(params, "PRIVATE KEY") || strings.Contains(params, "changeit") {
+			t.Fatalf("event %d %s leaks a secret: %s", e.ID, e.Operation, params)
+		}
+		if strings.Contains(params, `"key_pem":"[redacted]"`) {
+			keyUpdates++
+		}
+		if e.Operation == "certificate.export" && strings.Contains(params, `"password":"[redacted]"`) {
+			exports++
+		}
+	}
+	if keyUpdates != 3 || exports != 1 {
+		t.Fatalf("found %d redacted key updates and %d redacted exports", keyUpdates, exports)
+	}
+
+	redacted := redactAuditParams(map[string]any{
+		"name":   "kept",
+		"nested": map[string]any{"keyPem": "secret", "list": []any{map[string]any{"PIN": "1234"}}},
+		"empty":  map[string]any{"passphrase": ""},
+	})
+	want := map[string]any{
+		"name":   "kept",
+		"nested": map[string]any{"keyPem": auditRedacted, "list": []any{map[string]any{"PIN": auditRedacted}}},
+		"empty":  map[string]any{"passphrase": ""},
+	}
+	if !reflect.DeepEqual(redacted, want) {
+		t.Fatalf("redacted %v, want %v", redacted, want)
+	}
+}
+
+func TestAuditedChangesRollBackWithoutEvent(t *testing.T) {
+	dsn := newTestDSN()
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { _ = client.Close() })
+	sctx := NewServiceContext(client)
+	if err := sctx.Unlock(context.Background(), MasterKeySource{KeyFile: writeTestKeyFile(t)}); err != nil {
+		t.Fatalf("unlock keyring: %v", err)
+	}
+	ctx := context.Background()
+	ns, root, _, leaf := createTestChain(t, sctx)
+	certs := NewCertificateService(sctx)
+	offlineKey, err := certs.ExportOfflineKey(ctx, root.ID, "offline-passphrase")
+	if err != nil {
+		t.Fatalf("export offline key: %v", err)
+	}
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec("CREATE TRIGGER audit_down BEFORE INSERT ON " + auditevent.Table + " BEGIN SELECT RAISE(ABORT, 'audit down'); END")
+	if err != nil {
+		t.Fatalf("create trigger: %v", err)
+	}
+
+	if _, err := certs.RenewCertificate(ctx, leaf.ID, 30); err == nil {
+		t.Fatal("renew succeeded without its audit event")
+	}
+	if got := client.Certificate.GetX(ctx, leaf.ID); got.CertPem != leaf.CertPem {
+		t.Fatal("renewed certificate was committed without its audit event")
+	}
+	if err := certs.TakeCertificateOffline(ctx, root.ID, []byte(offlineKey), "offline-passphrase"); err == nil {
+		t.Fatal("take offline succeeded without its audit event")
+	}
+	if got := client.Certificate.GetX(ctx, root.ID); got.Offline || got.KeyPem == "" {
+		t.Fatal("offline root was committed without its audit event")
+	}
+	if _, err := NewNamespaceService(sctx).UpdateNamespace(ctx, ns.ID, ent.Namespace{Name: "renamed"}); err == nil {
+		t.Fatal("namespace update succeeded without its audit event")
+	}
+	if got := client.Namespace.GetX(ctx, ns.ID); got.Name != ns.Name {
+		t.Fatal("namespace update was committed without its audit event")
+	}
+}
@@ -0,0 +1,851 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+	"github.com/logeable/certmgr/internal/ent/namespace"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+)
+
+const (
+	backupArchiveVersion = 1
+	encryptedBackupType  = "CERTMGR ENCRYPTED BACKUP"
+	// maxArchiveJSONSize bounds the decompressed archive, a small gzip stream
+	// can otherwise expand to gigabytes.
+	maxArchiveJSONSize = 256 << 20
+
+	RestoreModeReplace = "replace"
+	RestoreModeMerge   = "merge"
+)
+
+// ErrBackupPassphraseRequired is returned when an archive holding private keys
+// is written without a passphrase.
+var ErrBackupPassphraseRequired = errors.New("archive contains private keys, a passphrase is required")
+
+// backupArchive is the gzip compressed JSON document written by Backup. Keys
+// are stored decrypted so that an archive can be restored under a different
+// master key, which is why archives with keys are always encrypted. Signing
+// requests, renewal policies and notification records are only part of full
+// backups.
+type backupArchive struct {
+	Version      int                 `json:"version"`
+	CreatedAt    time.Time           `json:"createdAt"`
+	Namespaces   []backupNamespace   `json:"namespaces"`
+	Certificates []backupCertificate `json:"certificates"`
+
+	SigningRequests     []backupSigningRequest     `json:"signingRequests,omitempty"`
+	RenewalPolicies     []backupRenewalPolicy      `json:"renewalPolicies,omitempty"`
+	RenewalAttempts     []backupRenewalAttempt     `json:"renewalAttempts,omitempty"`
+	ExpiryNotifications []backupExpiryNotification `json:"expiryNotifications,omitempty"`
+}
+
+type backupNamespace struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Desc      string    `json:"desc"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	KeyRevealDisabled bool `json:"keyRevealDisabled,omitempty"`
+}
+
+type backupCertificate struct {
+	ID            int       `json:"id"`
+	NamespaceID   int       `json:"namespaceId"`
+	IssuerID      int       `json:"issuerId"`
+	CertPem       string    `json:"certPem"`
+	KeyPem        string    `json:"keyPem,omitempty"`
+	KeyRef        string    `json:"keyRef,omitempty"`
+	KeyExportable bool      `json:"keyExportable"`
+	Offline       bool      `json:"offline"`
+	Desc          string    `json:"desc"`
+	Usage         string    `json:"usage"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type backupSigningRequest struct {
+	ID            int       `json:"id"`
+	NamespaceID   int       `json:"namespaceId"`
+	IssuerID      int       `json:"issuerId"`
+	Kind          string    `json:"kind"`
+	CertificateID int       `json:"certificateId,omitempty"`
+	Template      string    `json:"template"`
+	KeyPem        string    `json:"keyPem,omitempty"`
+	KeyRef        string    `json:"keyRef,omitempty"`
+	Desc          string    `json:"desc"`
+	Usage         string    `json:"usage"`
+	Status        string    `json:"status"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type backupRenewalPolicy struct {
+	ID                int        `json:"id"`
+	CertificateID     int        `json:"certificateId"`
+	Trigger           string     `json:"trigger"`
+	RemainingFraction float64    `json:"remainingFraction,omitempty"`
+	IntervalDays      int        `json:"intervalDays,omitempty"`
+	Rekey             bool       `json:"rekey"`
+	ValidDays         int        `json:"validDays,omitempty"`
+	Enabled           bool       `json:"enabled"`
+	Failures          int        `json:"failures"`
+	LastError         string     `json:"lastError,omitempty"`
+	NextAttemptAt     *time.Time `json:"nextAttemptAt,omitempty"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+	CreatedAt         time.Time  `json:"createdAt"`
+}
+
+type backupRenewalAttempt struct {
+	ID               int       `json:"id"`
+	PolicyID         int       `json:"policyId"`
+	CertificateID    int       `json:"certificateId"`
+	NamespaceID      int       `json:"namespaceId"`
+	Rekey            bool      `json:"rekey"`
+	Status           string    `json:"status"`
+	Error            string    `json:"error,omitempty"`
+	SigningRequestID int       `json:"signingRequestId,omitempty"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+type backupExpiryNotification struct {
+	ID            int       `json:"id"`
+	CertificateID int       `json:"certificateId"`
+	Fingerprint   string    `json:"fingerprint"`
+	ThresholdDays int       `json:"thresholdDays"`
+	NotAfter      time.Time `json:"notAfter"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type RestoreResult struct {
+	Namespaces      int `json:"namespaces"`
+	Certificates    int `json:"certificates"`
+	SigningRequests int `json:"signingRequests"`
+	RenewalPolicies int `json:"renewalPolicies"`
+	Skipped         int `json:"skipped"`
+}
+
+type BackupService struct {
+	ctx *ServiceContext
+}
+
+func NewBackupService(ctx *ServiceContext) *BackupService {
+	return &BackupService{
+		ctx: ctx,
+	}
+}
+
+// Backup returns an archive of every namespace and certificate, encrypted
+// when passphrase is not empty. A passphrase is required once the archive
+// holds any key. Every key stored in the database is saved, so a restore does
+// not lose keys that cannot be revealed or exported.
+func (s *BackupService) Backup(ctx context.Context, passphrase string) (_ []byte, err error) {
+	ctx = withAuditOperation(ctx, "backup.create", map[string]any{"encrypted": passphrase != ""})
+	defer s.ctx.auditFailure(ctx, "backup", 0, &err)
+	archive, err := s.ctx.dumpArchive(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("dump archive failed: %w", err)
+	}
+	data, err := encodeArchive(archive, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("encode archive failed: %w", err)
+	}
+	if err := s.ctx.auditRead(ctx, "backup", 0, 0); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Restore validates data and loads it. In replace mode all existing namespaces,
+// certificates, signing requests, renewal policies and notification records
+// are deleted first; in merge mode namespaces are matched by name and rows
+// already present in them are skipped.
+func (s *BackupService) Restore(ctx context.Context, data []byte, mode string, passphrase string) (_ *RestoreResult, err error) {
+	ctx = withAuditOperation(ctx, "backup.restore", map[string]any{"mode": mode, "encrypted": passphrase != ""})
+	defer s.ctx.auditFailure(ctx, "backup", 0, &err)
+	if mode != RestoreModeReplace && mode != RestoreModeMerge {
+		return nil, fmt.Errorf("unsupported restore mode: %s", mode)
+	}
+	archive, err := decodeArchive(data, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("decode archive failed: %w", err)
+	}
+	if err := archive.validate(); err != nil {
+		return nil, fmt.Errorf("validate archive failed: %w", err)
+	}
+
+	var result *RestoreResult
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		if mode == RestoreModeReplace {
+			if _, err := tx.SigningRequest.Delete().Exec(ctx); err != nil {
+				return fmt.Errorf("delete signing requests failed: %w", err)
+			}
+			if _, err := tx.Certificate.Delete().Exec(ctx); err != nil {
+				return fmt.Errorf("delete certificates failed: %w", err)
+			}
+			if _, err := tx.Namespace.Delete().Exec(ctx); err != nil {
+				return fmt.Errorf("delete namespaces failed: %w", err)
+			}
+		}
+		var err error
+		result, err = s.ctx.loadArchive(ctx, tx, archive, mode == RestoreModeReplace)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restore with tx failed: %w", err)
+	}
+	return result, nil
+}
+
+// dumpArchive collects the matching namespaces with their certificates. A
+// bundle leaves out keys marked not exportable and the keys of namespaces with
+// key reveal disabled, and holds no signing requests, renewal policies or
+// notification records.
+func (sctx *ServiceContext) dumpArchive(ctx context.Context, bundle bool, nsPredicates ...predicate.Namespace) (*backupArchive, error) {
+	archive := &backupArchive{
+		Version:   backupArchiveVersion,
+		CreatedAt: time.Now(),
+	}
+	err := sctx.withTx(ctx, func(tx *ent.Tx) error {
+		nss, err := tx.Namespace.Query().
+			Where(nsPredicates...).
+			Order(ent.Asc(namespace.FieldID)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query namespaces failed: %w", err)
+		}
+		nsIDs := make([]int, 0, len(nss))
+		keysWithheld := make(map[int]bool, len(nss))
+		for _, ns := range nss {
+			nsIDs = append(nsIDs, ns.ID)
+			keysWithheld[ns.ID] = ns.KeyRevealDisabled
+			archive.Namespaces = append(archive.Namespaces, backupNamespace{
+				ID:        ns.ID,
+				Name:      ns.Name,
+				Desc:      ns.Desc,
+				UpdatedAt: ns.UpdatedAt,
+				CreatedAt: ns.CreatedAt,
+
+				KeyRevealDisabled: ns.KeyRevealDisabled,
+			})
+		}
+		certs, err := tx.Certificate.Query().
+			Where(certificate.NamespaceIDIn(nsIDs...)).
+			Order(ent.Asc(certificate.FieldID)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query certificates failed: %w", err)
+		}
+		certIDs := make([]int, 0, len(certs))
+		for _, cert := range certs {
+			certIDs = append(certIDs, cert.ID)
+			keyPem := ""
+			if !bundle || (cert.KeyExportable && !keysWithheld[cert.NamespaceID]) {
+				keyPem, err = sctx.keyring.Open(cert.KeyPem, certKeyAAD(cert.ID))
+				if err != nil {
+					return fmt.Errorf("open key of cert %d failed: %w", cert.ID, err)
+				}
+			}
+			archive.Certificates = append(archive.Certificates, backupCertificate{
+				ID:            cert.ID,
+				NamespaceID:   cert.NamespaceID,
+				IssuerID:      cert.IssuerID,
+				CertPem:       cert.CertPem,
+				KeyPem:        keyPem,
+				KeyRef:        cert.KeyRef,
+				KeyExportable: cert.KeyExportable,
+				Offline:       cert.Offline,
+				Desc:          cert.Desc,
+				Usage:         cert.Usage,
+				UpdatedAt:     cert.UpdatedAt,
+				CreatedAt:     cert.CreatedAt,
+			})
+		}
+		if bundle {
+			return nil
+		}
+		return sctx.dumpCertificateState(ctx, tx, archive, nsIDs, certIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// dumpCertificateState adds the signing requests, renewal policies and
+// notification records of the dumped namespaces and certificates.
+func (sctx *ServiceContext) dumpCertificateState(ctx context.Context, tx *ent.Tx, archive *backupArchive, nsIDs, certIDs []int) error {
+	srs, err := tx.SigningRequest.Query().
+		Where(signingrequest.NamespaceIDIn(nsIDs...)).
+		Order(ent.Asc(signingrequest.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query signing requests failed: %w", err)
+	}
+	for _, sr := range srs {
+		keyPem := ""
+		if sr.KeyPem != "" {
+			keyPem, err = sctx.keyring.Open(sr.KeyPem, signingRequestKeyAAD(sr.ID))
+			if err != nil {
+				return fmt.Errorf("open key of signing request %d failed: %w", sr.ID, err)
+			}
+		}
+		archive.SigningRequests = append(archive.SigningRequests, backupSigningRequest{
+			ID:            sr.ID,
+			NamespaceID:   sr.NamespaceID,
+			IssuerID:      sr.IssuerID,
+			Kind:          sr.Kind.String(),
+			CertificateID: sr.CertificateID,
+			Template:      sr.Template,
+			KeyPem:        keyPem,
+			KeyRef:        sr.KeyRef,
+			Desc:          sr.Desc,
+			Usage:         sr.Usage,
+			Status:        sr.Status.String(),
+			UpdatedAt:     sr.UpdatedAt,
+			CreatedAt:     sr.CreatedAt,
+		})
+	}
+
+	policies, err := tx.RenewalPolicy.Query().
+		Where(renewalpolicy.CertificateIDIn(certIDs...)).
+		Order(ent.Asc(renewalpolicy.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query renewal policies failed: %w", err)
+	}
+	policyIDs := make([]int, 0, len(policies))
+	for _, policy := range policies {
+		policyIDs = append(policyIDs, policy.ID)
+		archive.RenewalPolicies = append(archive.RenewalPolicies, backupRenewalPolicy{
+			ID:                policy.ID,
+			CertificateID:     policy.CertificateID,
+			Trigger:           policy.Trigger.String(),
+			RemainingFraction: policy.RemainingFraction,
+			IntervalDays:      policy.IntervalDays,
+			Rekey:             policy.Rekey,
+			ValidDays:         policy.ValidDays,
+			Enabled:           policy.Enabled,
+			Failures:          policy.Failures,
+			LastError:         policy.LastError,
+			NextAttemptAt:     policy.NextAttemptAt,
+			UpdatedAt:         policy.UpdatedAt,
+			CreatedAt:         policy.CreatedAt,
+		})
+	}
+
+	attempts, err := tx.RenewalAttempt.Query().
+		Where(renewalattempt.PolicyIDIn(policyIDs...)).
+		Order(ent.Asc(renewalattempt.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query renewal attempts failed: %w", err)
+	}
+	for _, attempt := range attempts {
+		archive.RenewalAttempts = append(archive.RenewalAttempts, backupRenewalAttempt{
+			ID:               attempt.ID,
+			PolicyID:         attempt.PolicyID,
+			CertificateID:    attempt.CertificateID,
+			NamespaceID:      attempt.NamespaceID,
+			Rekey:            attempt.Rekey,
+			Status:           attempt.Status.String(),
+			Error:            attempt.Error,
+			SigningRequestID: attempt.SigningRequestID,
+			CreatedAt:        attempt.CreatedAt,
+		})
+	}
+
+	notifications, err := tx.ExpiryNotification.Query().
+		Where(expirynotification.CertificateIDIn(certIDs...)).
+		Order(ent.Asc(expirynotification.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query expiry notifications failed: %w", err)
+	}
+	for _, n := range notifications {
+		archive.ExpiryNotifications = append(archive.ExpiryNotifications, backupExpiryNotification{
+			ID:            n.ID,
+			CertificateID: n.CertificateID,
+			Fingerprint:   n.Fingerprint,
+			ThresholdDays: n.ThresholdDays,
+			NotAfter:      n.NotAfter,
+			CreatedAt:     n.CreatedAt,
+		})
+	}
+	return nil
+}
+
+// loadArchive inserts archive, keeping the archived ids when keepIDs is set
+// and remapping them otherwise. Namespaces whose name already exists are
+// reused, and a certificate whose PEM is already present in its namespace is
+// mapped onto the existing row instead of being inserted.
+func (sctx *ServiceContext) loadArchive(ctx context.Context, tx *ent.Tx, archive *backupArchive, keepIDs bool) (*RestoreResult, error) {
+	result := &RestoreResult{}
+	nsIDs := make(map[int]int, len(archive.Namespaces))
+	for _, ns := range archive.Namespaces {
+		existing, err := tx.Namespace.Query().Where(namespace.Name(ns.Name)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("query namespace %s failed: %w", ns.Name, err)
+		}
+		if existing != nil {
+			nsIDs[ns.ID] = existing.ID
+			continue
+		}
+		create := tx.Namespace.Create()
+		if keepIDs {
+			create.SetID(ns.ID)
+		}
+		created, err := create.
+			SetName(ns.Name).
+			SetDesc(ns.Desc).
+			SetKeyRevealDisabled(ns.KeyRevealDisabled).
+			SetUpdatedAt(ns.UpdatedAt).
+			SetCreatedAt(ns.CreatedAt).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("create namespace %s failed: %w", ns.Name, err)
+		}
+		nsIDs[ns.ID] = created.ID
+		result.Namespaces++
+	}
+
+	certIDs := make(map[int]int, len(archive.Certificates))
+	for _, cert := range archive.orderedCertificates() {
+		nsID := nsIDs[cert.NamespaceID]
+		existing, err := tx.Certificate.Query().
+			Where(certificate.NamespaceID(nsID), certificate.CertPem(cert.CertPem)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("query cert %d failed: %w", cert.ID, err)
+		}
+		if existing != nil {
+			certIDs[cert.ID] = existing.ID
+			result.Skipped++
+			continue
+		}
+		issuerID := 0
+		if cert.IssuerID != 0 {
+			issuerID = certIDs[cert.IssuerID]
+		} else {
+			rootCount, err := tx.Certificate.Query().
+				Where(certificate.NamespaceID(nsID), certificate.IssuerID(0)).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("count root certificates failed: %w", err)
+			}
+			if rootCount > 0 {
+				return nil, fmt.Errorf("namespace %d already has a different root certificate", nsID)
+			}
+		}
+		create := tx.Certificate.Create()
+		if keepIDs {
+			create.SetID(cert.ID)
+		}
+		created, err := create.
+			SetNamespaceID(nsID).
+			SetIssuerID(issuerID).
+			SetCertPem(cert.CertPem).
+			SetKeyRef(cert.KeyRef).
+			SetKeyExportable(cert.KeyExportable).
+			SetOffline(cert.Offline).
+			SetDesc(cert.Desc).
+			SetUsage(cert.Usage).
+			SetUpdatedAt(cert.UpdatedAt).
+			SetCreatedAt(cert.CreatedAt).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("create cert %d failed: %w", cert.ID, err)
+		}
+		if cert.KeyPem != "" {
+			sealedKeyPem, err := sctx.keyring.Seal(cert.KeyPem, certKeyAAD(created.ID))
+			if err != nil {
+				return nil, fmt.Errorf("seal key of cert %d failed: %w", cert.ID, err)
+			}
+			err = tx.Certificate.UpdateOneID(created.ID).
+				SetKeyPem(sealedKeyPem).
+				SetUpdatedAt(cert.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("store key of cert %d failed: %w", cert.ID, err)
+			}
+		}
+		certIDs[cert.ID] = created.ID
+		result.Certificates++
+	}
+	if err := sctx.loadCertificateState(ctx, tx, archive, keepIDs, nsIDs, certIDs, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// loadCertificateState inserts the signing requests, renewal policies and
+// notification records of archive, remapping their references with nsIDs and
+// certIDs. Rows that already exist are skipped.
+func (sctx *ServiceContext) loadCertificateState(ctx context.Context, tx *ent.Tx, archive *backupArchive, keepIDs bool, nsIDs, certIDs map[int]int, result *RestoreResult) error {
+	srIDs := make(map[int]int, len(archive.SigningRequests))
+	for _, sr := range archive.SigningRequests {
+		nsID := nsIDs[sr.NamespaceID]
+		existing, err := tx.SigningRequest.Query().
+			Where(signingrequest.NamespaceID(nsID), signingrequest.Template(sr.Template)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("query signing request %d failed: %w", sr.ID, err)
+		}
+		if existing != nil {
+			srIDs[sr.ID] = existing.ID
+			result.Skipped++
+			continue
+		}
+		create := tx.SigningRequest.Create()
+		if keepIDs {
+			create.SetID(sr.ID)
+		}
+		if sr.CertificateID != 0 {
+			create.SetCertificateID(certIDs[sr.CertificateID])
+		}
+		created, err := create.
+			SetNamespaceID(nsID).
+			SetIssuerID(certIDs[sr.IssuerID]).
+			SetKind(signingrequest.Kind(sr.Kind)).
+			SetTemplate(sr.Template).
+			SetKeyRef(sr.KeyRef).
+			SetDesc(sr.Desc).
+			SetUsage(sr.Usage).
+			SetStatus(signingrequest.Status(sr.Status)).
+			SetUpdatedAt(sr.UpdatedAt).
+			SetCreatedAt(sr.CreatedAt).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create signing request %d failed: %w", sr.ID, err)
+		}
+		if sr.KeyPem != "" {
+			sealedKeyPem, err := sctx.keyring.Seal(sr.KeyPem, signingRequestKeyAAD(created.ID))
+			if err != nil {
+				return fmt.Errorf("seal key of signing request %d failed: %w", sr.ID, err)
+			}
+			err = tx.SigningRequest.UpdateOneID(created.ID).
+				SetKeyPem(sealedKeyPem).
+				SetUpdatedAt(sr.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("store key of signing request %d failed: %w", sr.ID, err)
+			}
+		}
+		srIDs[sr.ID] = created.ID
+		result.SigningRequests++
+	}
+
+	policyIDs := make(map[int]int, len(archive.RenewalPolicies))
+	for _, policy := range archive.RenewalPolicies {
+		certID := certIDs[policy.CertificateID]
+		exist, err := tx.RenewalPolicy.Query().Where(renewalpolicy.CertificateID(certID)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("query renewal policy %d failed: %w", policy.ID, err)
+		}
+		if exist {
+			result.Skipped++
+			continue
+		}
+		create := tx.RenewalPolicy.Create()
+		if keepIDs {
+			create.SetID(policy.ID)
+		}
+		created, err := create.
+			SetCertificateID(certID).
+			SetTrigger(renewalpolicy.Trigger(policy.Trigger)).
+			SetRemainingFraction(policy.RemainingFraction).
+			SetIntervalDays(policy.IntervalDays).
+			SetRekey(policy.Rekey).
+			SetValidDays(policy.ValidDays).
+			SetEnabled(policy.Enabled).
+			SetFailures(policy.Failures).
+			SetLastError(policy.LastError).
+			SetNillableNextAttemptAt(policy.NextAttemptAt).
+			SetUpdatedAt(policy.UpdatedAt).
+			SetCreatedAt(policy.CreatedAt).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create renewal policy %d failed: %w", policy.ID, err)
+		}
+		policyIDs[policy.ID] = created.ID
+		result.RenewalPolicies++
+	}
+
+	for _, attempt := range archive.RenewalAttempts {
+		policyID, ok := policyIDs[attempt.PolicyID]
+		if !ok {
+			// The policy already existed and keeps its own history.
+			continue
+		}
+		create := tx.RenewalAttempt.Create()
+		if keepIDs {
+			create.SetID(attempt.ID)
+		}
+		if attempt.SigningRequestID != 0 {
+			create.SetSigningRequestID(srIDs[attempt.SigningRequestID])
+		}
+		err := create.
+			SetPolicyID(policyID).
+			SetCertificateID(certIDs[attempt.CertificateID]).
+			SetNamespaceID(nsIDs[attempt.NamespaceID]).
+			SetRekey(attempt.Rekey).
+			SetStatus(renewalattempt.Status(attempt.Status)).
+			SetError(attempt.Error).
+			SetCreatedAt(attempt.CreatedAt).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create renewal attempt %d failed: %w", attempt.ID, err)
+		}
+	}
+
+	for _, n := range archive.ExpiryNotifications {
+		certID := certIDs[n.CertificateID]
+		exist, err := tx.ExpiryNotification.Query().
+			Where(
+				expirynotification.CertificateID(certID),
+				expirynotification.Fingerprint(n.Fingerprint),
+				expirynotification.ThresholdDays(n.ThresholdDays),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("query expiry notification %d failed: %w", n.ID, err)
+		}
+		if exist {
+			continue
+		}
+		create := tx.ExpiryNotification.Create()
+		if keepIDs {
+			create.SetID(n.ID)
+		}
+		err = create.
+			SetCertificateID(certID).
+			SetFingerprint(n.Fingerprint).
+			SetThresholdDays(n.ThresholdDays).
+			SetNotAfter(n.NotAfter).
+			SetCreatedAt(n.CreatedAt).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create expiry notification %d failed: %w", n.ID, err)
+		}
+	}
+	return nil
+}
+
+// validate checks that every reference resolves inside the archive and that
+// every certificate and key parses.
+func (a *backupArchive) validate() error {
+	if a.Version != backupArchiveVersion {
+		return fmt.Errorf("unsupported archive version: %d", a.Version)
+	}
+	namespaces := make(map[int]bool, len(a.Namespaces))
+	names := make(map[string]bool, len(a.Namespaces))
+	for _, ns := range a.Namespaces {
+		if ns.Name == "" {
+			return fmt.Errorf("namespace %d has no name", ns.ID)
+		}
+		if namespaces[ns.ID] || names[ns.Name] {
+			return fmt.Errorf("duplicate namespace %d (%s)", ns.ID, ns.Name)
+		}
+		namespaces[ns.ID] = true
+		names[ns.Name] = true
+	}
+	certs := make(map[int]backupCertificate, len(a.Certificates))
+	roots := make(map[int]int)
+	for _, cert := range a.Certificates {
+		if _, ok := certs[cert.ID]; ok {
+			return fmt.Errorf("duplicate cert %d", cert.ID)
+		}
+		certs[cert.ID] = cert
+		if !namespaces[cert.NamespaceID] {
+			return fmt.Errorf("cert %d refers to unknown namespace %d", cert.ID, cert.NamespaceID)
+		}
+		if cert.IssuerID == 0 {
+			roots[cert.NamespaceID]++
+			if roots[cert.NamespaceID] > 1 {
+				return fmt.Errorf("namespace %d has more than one root certificate", cert.NamespaceID)
+			}
+		}
+		if _, err := getCertFromPem(cert.CertPem); err != nil {
+			return fmt.Errorf("get cert %d from pem failed: %w", cert.ID, err)
+		}
+		if cert.KeyPem != "" {
+			if _, err := getPrivateKeyFromPem(cert.KeyPem); err != nil {
+				return fmt.Errorf("get private key %d from pem failed: %w", cert.ID, err)
+			}
+		}
+	}
+	for _, cert := range a.Certificates {
+		if cert.IssuerID == 0 {
+			continue
+		}
+		issuer, ok := certs[cert.IssuerID]
+		if !ok {
+			return fmt.Errorf("cert %d refers to unknown issuer %d", cert.ID, cert.IssuerID)
+		}
+		if issuer.NamespaceID != cert.NamespaceID {
+			return fmt.Errorf("cert %d and its issuer %d are in different namespaces", cert.ID, cert.IssuerID)
+		}
+	}
+	if len(a.orderedCertificates()) != len(a.Certificates) {
+		return fmt.Errorf("issuer chain contains a cycle")
+	}
+
+	srs := make(map[int]bool, len(a.SigningRequests))
+	for _, sr := range a.SigningRequests {
+		if srs[sr.ID] {
+			return fmt.Errorf("duplicate signing request %d", sr.ID)
+		}
+		srs[sr.ID] = true
+		if !namespaces[sr.NamespaceID] {
+			return fmt.Errorf("signing request %d refers to unknown namespace %d", sr.ID, sr.NamespaceID)
+		}
+		if _, ok := certs[sr.IssuerID]; !ok {
+			return fmt.Errorf("signing request %d refers to unknown issuer %d", sr.ID, sr.IssuerID)
+		}
+		if _, ok := certs[sr.CertificateID]; sr.CertificateID != 0 && !ok {
+			return fmt.Errorf("signing request %d refers to unknown cert %d", sr.ID, sr.CertificateID)
+		}
+		if signingrequest.KindValidator(signingrequest.Kind(sr.Kind)) != nil || signingrequest.StatusValidator(signingrequest.Status(sr.Status)) != nil {
+			return fmt.Errorf("signing request %d has kind %q and status %q", sr.ID, sr.Kind, sr.Status)
+		}
+		if sr.KeyPem != "" {
+			if _, err := getPrivateKeyFromPem(sr.KeyPem); err != nil {
+				return fmt.Errorf("get private key of signing request %d from pem failed: %w", sr.ID, err)
+			}
+		}
+	}
+	policies := make(map[int]bool, len(a.RenewalPolicies))
+	for _, policy := range a.RenewalPolicies {
+		if policies[policy.ID] {
+			return fmt.Errorf("duplicate renewal policy %d", policy.ID)
+		}
+		policies[policy.ID] = true
+		if _, ok := certs[policy.CertificateID]; !ok {
+			return fmt.Errorf("renewal policy %d refers to unknown cert %d", policy.ID, policy.CertificateID)
+		}
+		if renewalpolicy.TriggerValidator(renewalpolicy.Trigger(policy.Trigger)) != nil {
+			return fmt.Errorf("renewal policy %d has trigger %q", policy.ID, policy.Trigger)
+		}
+	}
+	for _, attempt := range a.RenewalAttempts {
+		if !policies[attempt.PolicyID] {
+			return fmt.Errorf("renewal attempt %d refers to unknown policy %d", attempt.ID, attempt.PolicyID)
+		}
+		if _, ok := certs[attempt.CertificateID]; !ok || !namespaces[attempt.NamespaceID] {
+			return fmt.Errorf("renewal attempt %d refers to unknown cert %d", attempt.ID, attempt.CertificateID)
+		}
+		if attempt.SigningRequestID != 0 && !srs[attempt.SigningRequestID] {
+			return fmt.Errorf("renewal attempt %d refers to unknown signing request %d", attempt.ID, attempt.SigningRequestID)
+		}
+		if renewalattempt.StatusValidator(renewalattempt.Status(attempt.Status)) != nil {
+			return fmt.Errorf("renewal attempt %d has status %q", attempt.ID, attempt.Status)
+		}
+	}
+	for _, n := range a.ExpiryNotifications {
+		if _, ok := certs[n.CertificateID]; !ok {
+			return fmt.Errorf("expiry notification %d refers to unknown cert %d", n.ID, n.CertificateID)
+		}
+	}
+	return nil
+}
+
+func (a *backupArchive) hasKeys() bool {
+	for _, cert := range a.Certificates {
+		if cert.KeyPem != "" {
+			return true
+		}
+	}
+	for _, sr := range a.SigningRequests {
+		if sr.KeyPem != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// orderedCertificates returns the certificates with every issuer before the
+// certificates it signed. Certificates on a cycle are left out.
+func (a *backupArchive) orderedCertificates() []backupCertificate {
+	children := make(map[int][]backupCertificate)
+	for _, cert := range a.Certificates {
+		children[cert.IssuerID] = append(children[cert.IssuerID], cert)
+	}
+	var result []backupCertificate
+	queue := children[0]
+	for len(queue) > 0 {
+		cert := queue[0]
+		queue = queue[1:]
+		result = append(result, cert)
+		queue = append(queue, children[cert.ID]...)
+	}
+	return result
+}
+
+func encodeArchive(archive *backupArchive, passphrase string) ([]byte, error) {
+	if passphrase == "" && archive.hasKeys() {
+		return nil, ErrBackupPassphraseRequired
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(archive); err != nil {
+		return nil, fmt.Errorf("encode json failed: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("close gzip writer failed: %w", err)
+	}
+	if passphrase == "" {
+		return buf.Bytes(), nil
+	}
+	sealed, err := passphraseSeal(passphrase, buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("encrypt archive failed: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: encryptedBackupType, Bytes: sealed}), nil
+}
+
+func decodeArchive(data []byte, passphrase string) (*backupArchive, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		if block.Type != encryptedBackupType {
+			return nil, fmt.Errorf("unexpected pem type: %s", block.Type)
+		}
+		if passphrase == "" {
+			return nil, fmt.Errorf("archive is encrypted, a passphrase is required")
+		}
+		var err error
+		data, err = passphraseOpen(passphrase, block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt archive failed: %w", err)
+		}
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("open gzip reader failed: %w", err)
+	}
+	defer zr.Close()
+	jsonData, err := io.ReadAll(io.LimitReader(zr, maxArchiveJSONSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("decompress archive failed: %w", err)
+	}
+	if len(jsonData) > maxArchiveJSONSize {
+		return nil, fmt.Errorf("decompressed archive exceeds %d bytes", maxArchiveJSONSize)
+	}
+	var archive backupArchive
+	if err := json.Unmarshal(jsonData, &archive); err != nil {
+		return nil, fmt.Errorf("decode json failed: %w", err)
+	}
+	return &archive, nil
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+)
+
+func TestBackupRestoreRoundTrip(t *testing.T) {
+	src := newTestContext(t)
+	ctx := context.Background()
+	ns, _, inter, leaf := createTestChain(t, src)
+	leafKey, err := NewCertificateService(src).RevealPrivateKey(ctx, leaf.ID, RevealKeyReq{Reason: "test"})
+	if err != nil {
+		t.Fatalf("reveal: %v", err)
+	}
+
+	data, err := NewBackupService(src).Backup(ctx, "backup-passphrase")
+	if err != nil {
+		t.Fatalf("backup: %v", err)
+	}
+	if bytes.Contains(data, []byte("PRIVATE KEY")) {
+		t.Fatal("encrypted archive contains a plain key")
+	}
+
+	dst := newTestContext(t)
+	if _, err := NewBackupService(dst).Restore(ctx, data, RestoreModeReplace, "wrong-passphrase"); err == nil {
+		t.Fatal("restore with a wrong passphrase succeeded")
+	}
+	result, err := NewBackupService(dst).Restore(ctx, data, RestoreModeReplace, "backup-passphrase")
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if result.Namespaces != 1 || result.Certificates != 3 {
+		t.Fatalf("restored %+v, want 1 namespace and 3 certificates", result)
+	}
+	restored := dst.client.Certificate.GetX(ctx, leaf.ID)
+	if restored.NamespaceID != ns.ID || restored.IssuerID != inter.ID || restored.CertPem != leaf.CertPem {
+		t.Fatalf("restored leaf %+v does not match the original", restored)
+	}
+	restoredKey, err := NewCertificateService(dst).RevealPrivateKey(ctx, leaf.ID, RevealKeyReq{Reason: "test"})
+	if err != nil {
+		t.Fatalf("reveal restored key: %v", err)
+	}
+	if restoredKey != leafKey {
+		t.Fatal("restored key differs from the original")
+	}
+
+	result, err = NewBackupService(dst).Restore(ctx, data, RestoreModeMerge, "backup-passphrase")
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if result.Certificates != 0 || result.Skipped != 3 {
+		t.Fatalf("merge restored %+v, want every certificate skipped", result)
+	}
+}
+
+func TestBackupRequiresPassphraseForKeys(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns := createTestNamespace(t, sctx, "empty")
+
+	data, err := NewBackupService(sctx).Backup(ctx, "")
+	if err != nil {
+		t.Fatalf("backup without keys: %v", err)
+	}
+	archive, err := decodeArchive(data, "")
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(archive.Namespaces) != 1 || archive.Namespaces[0].ID != ns.ID {
+		t.Fatalf("archive namespaces %+v", archive.Namespaces)
+	}
+
+	createTestCert(t, sctx, testCertReq(ns.ID, 0, "Root CA", true))
+	if _, err := NewBackupService(sctx).Backup(ctx, ""); !errors.Is(err, ErrBackupPassphraseRequired) {
+		t.Fatalf("backup of keys without passphrase returned %v", err)
+	}
+}
+
+func TestDecodeArchiveRejectsOversize(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	chunk := make([]byte, 1<<20)
+	for written := 0; written <= maxArchiveJSONSize; written += len(chunk) {
+		if _, err := zw.Write(chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	_, err := decodeArchive(buf.Bytes(), "")
+	if err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Fatalf("decode of oversize archive returned %v", err)
+	}
+}
+
+func TestBackupRestoreKeepsCertificateState(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, _, inter, leaf := createTestChain(t, sctx)
+	takeTestOffline(t, sctx, inter.ID)
+	queued := createTestCert(t, sctx, testCertReq(ns.ID, inter.ID, "web.internal", false, "web.internal"))
+
+	policy, err := NewRenewalService(sctx).SetRenewalPolicy(ctx, leaf.ID, RenewalPolicyReq{
+		Trigger:           RenewalTriggerRemainingFraction,
+		RemainingFraction: 0.25,
+		Enabled:           true,
+	})
+	if err != nil {
+		t.Fatalf("set renewal policy: %v", err)
+	}
+	sctx.client.RenewalAttempt.Create().
+		SetPolicyID(policy.ID).
+		SetCertificateID(leaf.ID).
+		SetNamespaceID(ns.ID).
+		SetRekey(false).
+		SetStatus(renewalattempt.StatusPendingSignature).
+		SetSigningRequestID(queued.SigningRequestID).
+		ExecX(ctx)
+	leafRow := sctx.client.Certificate.GetX(ctx, leaf.ID)
+	sctx.client.ExpiryNotification.Create().
+		SetCertificateID(leaf.ID).
+		SetFingerprint(leafRow.Fingerprint).
+		SetThresholdDays(30).
+		SetNotAfter(leafRow.NotAfter).
+		ExecX(ctx)
+
+	svc := NewBackupService(sctx)
+	data, err := svc.Backup(ctx, "backup-passphrase")
+	if err != nil {
+		t.Fatalf("backup: %v", err)
+	}
+	for _, dst := range []*ServiceContext{sctx, newTestContext(t)} {
+		result, err := NewBackupService(dst).Restore(ctx, data, RestoreModeReplace, "backup-passphrase")
+		if err != nil {
+			t.Fatalf("restore: %v", err)
+		}
+		if result.SigningRequests != 1 || result.RenewalPolicies != 1 {
+			t.Fatalf("restored %+v, want a signing request and a renewal policy", result)
+		}
+
+		sr, err := dst.client.SigningRequest.Get(ctx, queued.SigningRequestID)
+		if err != nil {
+			t.Fatalf("signing request lost: %v", err)
+		}
+		if sr.IssuerID != inter.ID || sr.Status != signingrequest.StatusPending {
+			t.Fatalf("restored signing request %+v", sr)
+		}
+		if _, err := dst.keyring.Open(sr.KeyPem, signingRequestKeyAAD(sr.ID)); err != nil {
+			t.Fatalf("open restored signing request key: %v", err)
+		}
+		restoredPolicy, err := dst.client.RenewalPolicy.Query().Where(renewalpolicy.CertificateID(leaf.ID)).Only(ctx)
+		if err != nil {
+			t.Fatalf("renewal policy lost: %v", err)
+		}
+		if restoredPolicy.RemainingFraction != 0.25 || !restoredPolicy.Enabled {
+			t.Fatalf("restored policy %+v", restoredPolicy)
+		}
+		attempt, err := dst.client.RenewalAttempt.Query().Where(renewalattempt.PolicyID(restoredPolicy.ID)).Only(ctx)
+		if err != nil {
+			t.Fatalf("renewal attempt lost: %v", err)
+		}
+		if attempt.SigningRequestID != sr.ID {
+			t.Fatalf("restored attempt refers to signing request %d", attempt.SigningRequestID)
+		}
+		if n := dst.client.ExpiryNotification.Query().Where(expirynotification.CertificateID(leaf.ID)).CountX(ctx); n != 1 {
+			t.Fatalf("restored %d expiry notifications, want 1", n)
+		}
+	}
+
+	result, err := svc.Restore(ctx, data, RestoreModeMerge, "backup-passphrase")
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if result.SigningRequests != 0 || result.RenewalPolicies != 0 {
+		t.Fatalf("merge restored %+v, want existing rows skipped", result)
+	}
+	if n := sctx.client.ExpiryNotification.Query().CountX(ctx); n != 1 {
+		t.Fatalf("merge duplicated expiry notifications: %d", n)
+	}
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/hook"
+)
+
+// certificateSummaryFields are the columns needed to describe a certificate
+// without its PEM blobs.
+var certificateSummaryFields = []string{
+	certificate.FieldNamespaceID,
+	certificate.FieldIssuerID,
+	certificate.FieldDesc,
+	certificate.FieldUsage,
+	certificate.FieldSubject,
+	certificate.FieldCommonName,
+	certificate.FieldSerialNumber,
+	certificate.FieldFingerprint,
+	certificate.FieldKeyType,
+	certificate.FieldSignatureAlgorithm,
+	certificate.FieldIsCa,
+	certificate.FieldNotBefore,
+	certificate.FieldNotAfter,
+	certificate.FieldDNSNames,
+	certificate.FieldIPAddresses,
+	certificate.FieldUpdatedAt,
+	certificate.FieldCreatedAt,
+}
+
+// certAttributesHook keeps the parsed columns of a certificate in sync with
+// its cert_pem on every create and update.
+func certAttributesHook(next ent.Mutator) ent.Mutator {
+	return hook.CertificateFunc(func(ctx context.Context, m *ent.CertificateMutation) (ent.Value, error) {
+		certPem, ok := m.CertPem()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		if err := applyCertAttributes(m, certPem); err != nil {
+			return nil, err
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+func applyCertAttributes(m *ent.CertificateMutation, certPem string) error {
+	cert, err := getCertFromPem(certPem)
+	if err != nil {
+		return fmt.Errorf("get cert from pem failed: %w", err)
+	}
+	spec, err := keySpecOf(cert.PublicKey)
+	if err != nil {
+		return fmt.Errorf("get key spec failed: %w", err)
+	}
+	m.SetSubject(getSubject(cert))
+	m.SetCommonName(cert.Subject.CommonName)
+	m.SetSerialNumber(cert.SerialNumber.Text(16))
+	m.SetFingerprint(certFingerprint(cert))
+	m.SetSubjectKeyID(hex.EncodeToString(cert.SubjectKeyId))
+	m.SetAuthorityKeyID(hex.EncodeToString(cert.AuthorityKeyId))
+	m.SetKeyType(spec.KeyType)
+	m.SetSignatureAlgorithm(cert.SignatureAlgorithm.String())
+	m.SetIsCa(cert.IsCA)
+	m.SetNotBefore(cert.NotBefore)
+	m.SetNotAfter(cert.NotAfter)
+	m.SetDNSNames(cert.DNSNames)
+	m.SetIPAddresses(formatIPAddresses(cert.IPAddresses))
+	return nil
+}
+
+// BackfillCertificateAttributes fills the parsed columns of certificates
+// stored before they existed. Rewriting cert_pem lets the hook do the work;
+// updated_at is kept as is.
+func (sctx *ServiceContext) BackfillCertificateAttributes(ctx context.Context) (int, error) {
+	var count int
+	err := sctx.withTx(ctx, func(tx *ent.Tx) error {
+		certs, err := tx.Certificate.Query().
+			Where(certificate.Or(certificate.Fingerprint(""), certificate.SignatureAlgorithm(""))).
+			Select(certificate.FieldCertPem, certificate.FieldUpdatedAt).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query certificates failed: %w", err)
+		}
+		for _, cert := range certs {
+			err = tx.Certificate.UpdateOneID(cert.ID).
+				SetCertPem(cert.CertPem).
+				SetUpdatedAt(cert.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("backfill cert %d failed: %w", cert.ID, err)
+			}
+		}
+		count = len(certs)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("backfill certificate attributes with tx failed: %w", err)
+	}
+	return count, nil
+}
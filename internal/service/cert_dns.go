@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/logeable/certmgr/internal/ent/certificate"
+)
+
+// TLSA certificate usages, selectors and matching types from RFC 6698.
+const (
+	TLSAUsagePKIXTA = 0
+	TLSAUsagePKIXEE = 1
+	TLSAUsageDANETA = 2
+	TLSAUsageDANEEE = 3
+
+	TLSASelectorCert = 0
+	TLSASelectorSPKI = 1
+
+	TLSAMatchingFull   = 0
+	TLSAMatchingSHA256 = 1
+	TLSAMatchingSHA512 = 2
+)
+
+// colonHex formats b like openssl does, e.g. "AB:CD:EF".
+func colonHex(b []byte) string {
+	s := strings.ToUpper(hex.EncodeToString(b))
+	parts := make([]string, 0, len(b))
+	for i := 0; i < len(s); i += 2 {
+		parts = append(parts, s[i:i+2])
+	}
+	return strings.Join(parts, ":")
+}
+
+func certSHA1Fingerprint(cert *x509.Certificate) string {
+	sum := sha1.Sum(cert.Raw)
+	return colonHex(sum[:])
+}
+
+func certSHA256Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return colonHex(sum[:])
+}
+
+// spkiPin is the base64 SHA-256 of the SubjectPublicKeyInfo, as used by
+// pin-sha256 in HPKP and by curl --pinnedpubkey.
+func spkiPin(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// serialHex returns the serial number in upper case hex with an even number
+// of digits, like openssl x509 -serial.
+func serialHex(cert *x509.Certificate) string {
+	return strings.ToUpper(hex.EncodeToString(cert.SerialNumber.Bytes()))
+}
+
+type DNSRecordsReq struct {
+	// Domains to publish records for, defaults to the DNS names of the
+	// certificate. Wildcard domains only get CAA records.
+	Domains  []string
+	Port     int
+	Protocol string
+	// CADomain is the issuer domain used in CAA records. No CAA records are
+	// suggested without it.
+	CADomain string
+}
+
+type TLSARecord struct {
+	Name         string `json:"name"`
+	Usage        int    `json:"usage"`
+	Selector     int    `json:"selector"`
+	MatchingType int    `json:"matchingType"`
+	Data         string `json:"data"`
+	Record       string `json:"record"`
+}
+
+type CAARecord struct {
+	Name   string `json:"name"`
+	Flags  int    `json:"flags"`
+	Tag    string `json:"tag"`
+	Value  string `json:"value"`
+	Record string `json:"record"`
+}
+
+type DNSRecords struct {
+	TLSA []TLSARecord `json:"tlsa"`
+	CAA  []CAARecord  `json:"caa"`
+}
+
+// Zone renders the records as zone file lines.
+func (r *DNSRecords) Zone() string {
+	var b strings.Builder
+	for _, rec := range r.TLSA {
+		b.WriteString(rec.Record + "\n")
+	}
+	for _, rec := range r.CAA {
+		b.WriteString(rec.Record + "\n")
+	}
+	return b.String()
+}
+
+// DNSRecords generates TLSA records for every usage, selector and matching
+// type combination that applies to the certificate, and CAA suggestions for
+// its domains. A CA gets trust anchor usages, any other certificate end entity
+// usages.
+func (s *CertificateService) DNSRecords(ctx context.Context, id int, req DNSRecordsReq) (*DNSRecords, error) {
+	if req.Port == 0 {
+		req.Port = 443
+	}
+	if req.Port < 0 || req.Port > 65535 {
+		return nil, fmt.Errorf("invalid port: %d", req.Port)
+	}
+	if req.Protocol == "" {
+		req.Protocol = "tcp"
+	}
+	switch req.Protocol {
+	case "tcp", "udp", "sctp":
+	default:
+		return nil, fmt.Errorf("unsupported protocol: %s", req.Protocol)
+	}
+
+	cert, err := s.ctx.client.Certificate.Query().
+		Where(certificate.ID(id)).
+		Select(certificate.FieldCertPem).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get cert %d failed: %w", id, err)
+	}
+	x509Cert, err := getCertFromPem(cert.CertPem)
+	if err != nil {
+		return nil, fmt.Errorf("get cert %d from pem failed: %w", id, err)
+	}
+
+	domains := req.Domains
+	if len(domains) == 0 {
+		domains = x509Cert.DNSNames
+	}
+	if len(domains) == 0 {
+		return nil, fmt.Errorf("cert %d has no DNS names, domains are required", id)
+	}
+
+	usages := []int{TLSAUsagePKIXEE, TLSAUsageDANEEE}
+	if x509Cert.IsCA {
+		usages = []int{TLSAUsagePKIXTA, TLSAUsageDANETA}
+	}
+
+	result := &DNSRecords{TLSA: make([]TLSARecord, 0), CAA: make([]CAARecord, 0)}
+	seen := make(map[string]bool)
+	for _, domain := range domains {
+		domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+		if domain == "" {
+			continue
+		}
+		base, wildcard := strings.CutPrefix(domain, "*.")
+		if !wildcard && !seen["tlsa "+domain] {
+			seen["tlsa "+domain] = true
+			name := fmt.Sprintf("_%d._%s.%s.", req.Port, req.Protocol, domain)
+			for _, usage := range usages {
+				for _, selector := range []int{TLSASelectorCert, TLSASelectorSPKI} {
+					for _, matching := range []int{TLSAMatchingFull, TLSAMatchingSHA256, TLSAMatchingSHA512} {
+						data := tlsaData(x509Cert, selector, matching)
+						result.TLSA = append(result.TLSA, TLSARecord{
+							Name:         name,
+							Usage:        usage,
+							Selector:     selector,
+							MatchingType: matching,
+							Data:         data,
+							Record:       fmt.Sprintf("%s IN TLSA %d %d %d %s", name, usage, selector, matching, data),
+						})
+					}
+				}
+			}
+		}
+
+		if req.CADomain == "" {
+			continue
+		}
+		tag := "issue"
+		if wildcard {
+			tag = "issuewild"
+		}
+		key := tag + " " + base
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		name := base + "."
+		value := fmt.Sprintf("%q", req.CADomain)
+		result.CAA = append(result.CAA, CAARecord{
+			Name:   name,
+			Tag:    tag,
+			Value:  req.CADomain,
+			Record: fmt.Sprintf("%s IN CAA 0 %s %s", name, tag, value),
+		})
+	}
+	return result, nil
+}
+
+func tlsaData(cert *x509.Certificate, selector, matching int) string {
+	data := cert.Raw
+	if selector == TLSASelectorSPKI {
+		data = cert.RawSubjectPublicKeyInfo
+	}
+	switch matching {
+	case TLSAMatchingSHA256:
+		sum := sha256.Sum256(data)
+		return hex.EncodeToString(sum[:])
+	case TLSAMatchingSHA512:
+		sum := sha512.Sum512(data)
+		return hex.EncodeToString(sum[:])
+	default:
+		return hex.EncodeToString(data)
+	}
+}
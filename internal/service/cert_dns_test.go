@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCertificateFingerprints(t *testing.T) {
+	sctx := newTestContext(t)
+	_, _, _, leaf := createTestChain(t, sctx)
+	detail, err := NewCertificateService(sctx).GetCertificate(context.Background(), leaf.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	x509Cert, err := getCertFromPem(leaf.CertPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sha1Sum := sha1.Sum(x509Cert.Raw)
+	sha256Sum := sha256.Sum256(x509Cert.Raw)
+	pinSum := sha256.Sum256(x509Cert.RawSubjectPublicKeyInfo)
+	if got := strings.ReplaceAll(detail.FingerprintSHA1, ":", ""); got != strings.ToUpper(hex.EncodeToString(sha1Sum[:])) {
+		t.Fatalf("sha1 fingerprint %s", detail.FingerprintSHA1)
+	}
+	if got := strings.ReplaceAll(detail.FingerprintSHA256, ":", ""); got != strings.ToUpper(hex.EncodeToString(sha256Sum[:])) {
+		t.Fatalf("sha256 fingerprint %s", detail.FingerprintSHA256)
+	}
+	if len(detail.FingerprintSHA256) != 32*3-1 {
+		t.Fatalf("sha256 fingerprint %s is not colon separated", detail.FingerprintSHA256)
+	}
+	if detail.SPKIPinSHA256 != base64.StdEncoding.EncodeToString(pinSum[:]) {
+		t.Fatalf("spki pin %s", detail.SPKIPinSHA256)
+	}
+	if detail.SerialNumber != fmt.Sprintf("%X", x509Cert.SerialNumber) && detail.SerialNumber != fmt.Sprintf("0%X", x509Cert.SerialNumber) {
+		t.Fatalf("serial %s, want %X", detail.SerialNumber, x509Cert.SerialNumber)
+	}
+}
+
+func TestColonHex(t *testing.T) {
+	if got := colonHex([]byte{0xab, 0x01, 0xef}); got != "AB:01:EF" {
+		t.Fatalf("colonHex = %q", got)
+	}
+	if got := colonHex(nil); got != "" {
+		t.Fatalf("colonHex(nil) = %q", got)
+	}
+}
+
+func TestDNSRecords(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, root, inter, _ := createTestChain(t, sctx)
+	leaf := createTestCert(t, sctx, testCertReq(ns.ID, inter.ID, "web.internal", false, "web.internal", "*.web.internal", "Web.Internal."))
+	certs := NewCertificateService(sctx)
+
+	records, err := certs.DNSRecords(ctx, leaf.ID, DNSRecordsReq{CADomain: "ca.internal"})
+	if err != nil {
+		t.Fatalf("records: %v", err)
+	}
+	// Wildcards get no TLSA records and the name repeated in another case
+	// adds no records.
+	if len(records.TLSA) != 2*2*3 {
+		t.Fatalf("got %d TLSA records", len(records.TLSA))
+	}
+	x509Cert, err := getCertFromPem(leaf.CertPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spkiSum := sha512.Sum512(x509Cert.RawSubjectPublicKeyInfo)
+	var found bool
+	for _, rec := range records.TLSA {
+		if rec.Name != "_443._tcp.web.internal." {
+			t.Fatalf("TLSA name %s", rec.Name)
+		}
+		if rec.Usage != TLSAUsagePKIXEE && rec.Usage != TLSAUsageDANEEE {
+			t.Fatalf("leaf got usage %d", rec.Usage)
+		}
+		if rec.Usage == TLSAUsageDANEEE && rec.Selector == TLSASelectorSPKI && rec.MatchingType == TLSAMatchingSHA512 {
+			found = true
+			if rec.Data != hex.EncodeToString(spkiSum[:]) {
+				t.Fatalf("3 1 2 data %s", rec.Data)
+			}
+			if rec.Record != "_443._tcp.web.internal. IN TLSA 3 1 2 "+rec.Data {
+				t.Fatalf("record %q", rec.Record)
+			}
+		}
+		if rec.Selector == TLSASelectorCert && rec.MatchingType == TLSAMatchingFull && rec.Data != hex.EncodeToString(x509Cert.Raw) {
+			t.Fatal("full certificate data differs")
+		}
+	}
+	if !found {
+		t.Fatal("no 3 1 2 record")
+	}
+	if len(records.CAA) != 2 {
+		t.Fatalf("CAA records %+v", records.CAA)
+	}
+	if records.CAA[0].Record != `web.internal. IN CAA 0 issue "ca.internal"` || records.CAA[1].Record != `web.internal. IN CAA 0 issuewild "ca.internal"` {
+		t.Fatalf("CAA records %q and %q", records.CAA[0].Record, records.CAA[1].Record)
+	}
+	if zone := records.Zone(); strings.Count(zone, "\n") != len(records.TLSA)+len(records.CAA) {
+		t.Fatalf("zone has the wrong number of lines:\n%s", zone)
+	}
+
+	records, err = certs.DNSRecords(ctx, root.ID, DNSRecordsReq{Domains: []string{"root.internal"}, Port: 25, Protocol: "udp"})
+	if err != nil {
+		t.Fatalf("ca records: %v", err)
+	}
+	if len(records.TLSA) != 12 || len(records.CAA) != 0 {
+		t.Fatalf("ca got %d TLSA and %d CAA records", len(records.TLSA), len(records.CAA))
+	}
+	for _, rec := range records.TLSA {
+		if rec.Name != "_25._udp.root.internal." || (rec.Usage != TLSAUsagePKIXTA && rec.Usage != TLSAUsageDANETA) {
+			t.Fatalf("ca record %q", rec.Record)
+		}
+	}
+
+	bad := map[string]DNSRecordsReq{
+		"port":     {Port: 70000},
+		"protocol": {Protocol: "icmp"},
+	}
+	for name, req := range bad {
+		if _, err := certs.DNSRecords(ctx, leaf.ID, req); err == nil {
+			t.Errorf("invalid %s accepted", name)
+		}
+	}
+	if _, err := certs.DNSRecords(ctx, root.ID, DNSRecordsReq{}); err == nil {
+		t.Error("ca without DNS names and domains accepted")
+	}
+}
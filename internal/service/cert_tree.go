@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+// Tree traversals are single recursive CTE queries. They take the client to
+// run on, so callers inside withTx pass tx.Client() and see their own writes.
+// UNION instead of UNION ALL stops at cycles.
+
+// descendantsOf matches every certificate issued directly or indirectly by id.
+func descendantsOf(id int) predicate.Certificate {
+	return func(s *sql.Selector) {
+		s.Where(sql.ExprP(
+			s.C(certificate.FieldID)+" IN ("+
+				"WITH RECURSIVE sub(id) AS ("+
+				"SELECT id FROM "+certificate.Table+" WHERE issuer_id = ? "+
+				"UNION SELECT c.id FROM "+certificate.Table+" c JOIN sub ON c.issuer_id = sub.id"+
+				") SELECT id FROM sub)",
+			id,
+		))
+	}
+}
+
+// ancestorsOf matches id and every certificate above it up to the root.
+func ancestorsOf(id int) predicate.Certificate {
+	return func(s *sql.Selector) {
+		s.Where(sql.ExprP(
+			s.C(certificate.FieldID)+" IN ("+
+				"WITH RECURSIVE anc(id, issuer_id) AS ("+
+				"SELECT id, issuer_id FROM "+certificate.Table+" WHERE id = ? "+
+				"UNION SELECT c.id, c.issuer_id FROM "+certificate.Table+" c JOIN anc ON c.id = anc.issuer_id"+
+				") SELECT id FROM anc)",
+			id,
+		))
+	}
+}
+
+// certChain returns id followed by its issuers up to the root.
+func certChain(ctx context.Context, client *ent.Client, id int) ([]*ent.Certificate, error) {
+	certs, err := client.Certificate.Query().Where(ancestorsOf(id)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query chain of cert %d failed: %w", id, err)
+	}
+	byID := make(map[int]*ent.Certificate, len(certs))
+	for _, cert := range certs {
+		byID[cert.ID] = cert
+	}
+	cert, ok := byID[id]
+	if !ok {
+		return nil, fmt.Errorf("get cert %d failed: %w", id, &ent.NotFoundError{})
+	}
+	result := []*ent.Certificate{cert}
+	for cert.IssuerID != 0 {
+		issuer, ok := byID[cert.IssuerID]
+		if !ok {
+			return nil, fmt.Errorf("issuer %d of cert %d not found", cert.IssuerID, cert.ID)
+		}
+		if len(result) > len(certs) {
+			return nil, fmt.Errorf("issuer cycle at cert %d", cert.ID)
+		}
+		result = append(result, issuer)
+		cert = issuer
+	}
+	return result, nil
+}
+
+// CertificateNode is one certificate in a namespace tree.
+type CertificateNode struct {
+	ID       int               `json:"id"`
+	IssuerID int               `json:"issuerId"`
+	Desc     string            `json:"desc"`
+	Subject  string            `json:"subject"`
+	IsCA     bool              `json:"isCA"`
+	Usage    string            `json:"usage"`
+	KeyType  string            `json:"keyType"`
+	NotAfter time.Time         `json:"notAfter"`
+	Children []CertificateNode `json:"children"`
+}
+
+// CertificateTree returns the certificates of a namespace nested under their
+// issuers. Certificates whose issuer is missing are returned as extra roots.
+func (s *NamespaceService) CertificateTree(ctx context.Context, id int) ([]CertificateNode, error) {
+	if _, err := s.ctx.client.Namespace.Get(ctx, id); err != nil {
+		return nil, fmt.Errorf("get namespace %d failed: %w", id, err)
+	}
+	certs, err := s.ctx.client.Certificate.Query().
+		Where(certificate.NamespaceID(id)).
+		Order(ent.Asc(certificate.FieldID)).
+		Select(certificateSummaryFields...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query certificates of namespace %d failed: %w", id, err)
+	}
+	exists := make(map[int]bool, len(certs))
+	for _, cert := range certs {
+		exists[cert.ID] = true
+	}
+	children := make(map[int][]*ent.Certificate, len(certs))
+	for _, cert := range certs {
+		parent := cert.IssuerID
+		if !exists[parent] {
+			parent = 0
+		}
+		children[parent] = append(children[parent], cert)
+	}
+
+	visited := make(map[int]bool, len(certs))
+	var build func(parent int) []CertificateNode
+	build = func(parent int) []CertificateNode {
+		nodes := make([]CertificateNode, 0, len(children[parent]))
+		for _, cert := range children[parent] {
+			if visited[cert.ID] {
+				continue
+			}
+			visited[cert.ID] = true
+			nodes = append(nodes, CertificateNode{
+				ID:       cert.ID,
+				IssuerID: cert.IssuerID,
+				Desc:     cert.Desc,
+				Subject:  cert.Subject,
+				IsCA:     cert.IsCa,
+				Usage:    cert.Usage,
+				KeyType:  cert.KeyType,
+				NotAfter: cert.NotAfter,
+				Children: build(cert.ID),
+			})
+		}
+		return nodes
+	}
+	return build(0), nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/predicate"
+)
+
+func matchingIDs(t *testing.T, sctx *ServiceContext, p predicate.Certificate) []int {
+	t.Helper()
+	ids, err := sctx.client.Certificate.Query().Where(p).Order(ent.Asc(certificate.FieldID)).IDs(context.Background())
+	if err != nil {
+		t.Fatalf("query: %v", err)
+	}
+	return ids
+}
+
+func TestTreeTraversals(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, root, inter, leaf := createTestChain(t, sctx)
+	other := createTestCert(t, sctx, testCertReq(ns.ID, root.ID, "other.internal", false, "other.internal"))
+	createTestChain(t, sctx)
+
+	if got := matchingIDs(t, sctx, descendantsOf(root.ID)); !slices.Equal(got, []int{inter.ID, leaf.ID, other.ID}) {
+		t.Fatalf("descendants of the root %v", got)
+	}
+	if got := matchingIDs(t, sctx, descendantsOf(leaf.ID)); len(got) != 0 {
+		t.Fatalf("descendants of the leaf %v", got)
+	}
+	if got := matchingIDs(t, sctx, ancestorsOf(leaf.ID)); !slices.Equal(got, []int{root.ID, inter.ID, leaf.ID}) {
+		t.Fatalf("ancestors of the leaf %v", got)
+	}
+	chain, err := certChain(ctx, sctx.client, leaf.ID)
+	if err != nil {
+		t.Fatalf("chain: %v", err)
+	}
+	if len(chain) != 3 || chain[0].ID != leaf.ID || chain[1].ID != inter.ID || chain[2].ID != root.ID {
+		t.Fatal("chain is not leaf, intermediate, root")
+	}
+
+	// A cycle must end the recursion instead of looping.
+	sctx.client.Certificate.UpdateOneID(root.ID).SetIssuerID(leaf.ID).ExecX(ctx)
+	if got := matchingIDs(t, sctx, descendantsOf(root.ID)); !slices.Equal(got, []int{root.ID, inter.ID, leaf.ID, other.ID}) {
+		t.Fatalf("descendants in a cycle %v", got)
+	}
+	if _, err := certChain(ctx, sctx.client, leaf.ID); err == nil {
+		t.Fatal("chain of a cycle succeeded")
+	}
+}
+
+func TestCertificateTree(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, root, inter, leaf := createTestChain(t, sctx)
+	other := createTestCert(t, sctx, testCertReq(ns.ID, root.ID, "other.internal", false, "other.internal"))
+	namespaces := NewNamespaceService(sctx)
+
+	tree, err := namespaces.CertificateTree(ctx, ns.ID)
+	if err != nil {
+		t.Fatalf("tree: %v", err)
+	}
+	if len(tree) != 1 || tree[0].ID != root.ID || len(tree[0].Children) != 2 {
+		t.Fatalf("tree %+v, want the root with two children", tree)
+	}
+	if tree[0].Children[0].ID != inter.ID || tree[0].Children[1].ID != other.ID {
+		t.Fatal("children of the root are not the intermediate and the other leaf")
+	}
+	if grand := tree[0].Children[0].Children; len(grand) != 1 || grand[0].ID != leaf.ID || grand[0].Children == nil {
+		t.Fatalf("children of the intermediate %+v", grand)
+	}
+
+	// A certificate whose issuer is gone becomes an extra root.
+	sctx.client.Certificate.UpdateOneID(leaf.ID).SetIssuerID(9999).ExecX(ctx)
+	tree, err = namespaces.CertificateTree(ctx, ns.ID)
+	if err != nil {
+		t.Fatalf("tree: %v", err)
+	}
+	if len(tree) != 2 || tree[1].ID != leaf.ID || tree[1].IssuerID != 9999 {
+		t.Fatalf("tree roots %+v, want the root and the orphaned leaf", tree)
+	}
+
+	if _, err := namespaces.CertificateTree(ctx, 9999); !ent.IsNotFound(err) {
+		t.Fatalf("tree of a missing namespace: %v", err)
+	}
+}
@@ -1,8 +1,6 @@
 package service
 
 import (
-	"archive/tar"
-	"bytes"
 	"context"
 	"crypto"
 	"crypto/ecdsa"
@@ -17,10 +15,13 @@ import (
 	"math"
 	"math/big"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/logeable/certmgr/internal/ent"
 	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
 )
 
 type CertificateService struct {
@@ -33,7 +34,9 @@ func NewCertificateService(ctx *ServiceContext) *CertificateService {
 	}
 }
 
-func (s *CertificateService) CreateCertificate(ctx context.Context, req CreateCertReq) (*Certificate, error) {
+func (s *CertificateService) CreateCertificate(ctx context.Context, req CreateCertReq) (_ *Certificate, err error) {
+	ctx = withAuditOperation(ctx, "certificate.create", req)
+	defer s.ctx.auditFailure(ctx, "certificate", 0, &err)
 	count, err := s.ctx.client.Certificate.Query().
 		Where(certificate.NamespaceID(req.NamespaceId), certificate.IssuerID(0)).
 		Count(ctx)
@@ -43,10 +46,23 @@ func (s *CertificateService) CreateCertificate(ctx context.Context, req CreateCe
 	if count > 0 && req.IssuerId == 0 {
 		return nil, fmt.Errorf("root certificate already exists")
 	}
-	newKey, err := createPrivateKey(req.KeyType, req)
+	keyStore, err := s.ctx.keyStore(req.KeyBackend)
+	if err != nil {
+		return nil, fmt.Errorf("get key store failed: %w", err)
+	}
+	newKey, err := keyStore.GenerateKey(ctx, KeySpec{
+		KeyType:  req.KeyType,
+		KeyLen:   req.KeyLen,
+		ECCCurve: req.ECCCurve,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("create private key failed: %w", err)
 	}
+	defer func() {
+		if err != nil && newKey.KeyRef != "" {
+			s.ctx.deleteKeys(context.WithoutCancel(ctx), []string{newKey.KeyRef})
+		}
+	}()
 
 	serialNumber, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
 	if err != nil {
@@ -67,8 +83,12 @@ func (s *CertificateService) CreateCertificate(ctx context.Context, req CreateCe
 		IPAddresses:           buildIPAddresses(req.IPAddresses),
 	}
 
+	if err := s.ctx.lintBeforeIssue(certTemplate, newKey.Signer.Public()); err != nil {
+		return nil, err
+	}
+
 	parentCert := certTemplate
-	signKey := newKey
+	signer := newKey.Signer
 	if req.IssuerId != 0 {
 		issuer, err := s.ctx.client.Certificate.Get(ctx, req.IssuerId)
 		if err != nil {
@@ -81,14 +101,17 @@ func (s *CertificateService) CreateCertificate(ctx context.Context, req CreateCe
 		if !parentCert.IsCA {
 			return nil, fmt.Errorf("issuer (%d) is not a CA", req.IssuerId)
 		}
-		signKey, err = getPrivateKeyFromPem(issuer.KeyPem)
+		if issuer.Offline {
+			return s.queueCreateCertificate(ctx, req, issuer, certTemplate, newKey)
+		}
+		signer, err = s.ctx.signerFor(ctx, issuer)
 		if err != nil {
-			return nil, fmt.Errorf("get issuer (%d) key from pem failed: %w", req.IssuerId, err)
+			return nil, fmt.Errorf("get issuer (%d) signer failed: %w", req.IssuerId, err)
 		}
 	}
 
-	pubKey := newKey.(crypto.Signer).Public()
-	certDer, err := x509.CreateCertificate(rand.Reader, certTemplate, parentCert, pubKey, signKey)
+	pubKey := newKey.Signer.Public()
+	certDer, err := x509.CreateCertificate(rand.Reader, certTemplate, parentCert, pubKey, signer)
 	if err != nil {
 		return nil, fmt.Errorf("create x509certificate failed: %w", err)
 	}
@@ -99,16 +122,21 @@ func (s *CertificateService) CreateCertificate(ctx context.Context, req CreateCe
 	}
 
 	certPemBytes := x509CertToPem(x509Cert)
-	keyPemBytes := PrivateKeyToPem(newKey)
-
-	createdCert, err := s.ctx.client.Certificate.Create().
-		SetNamespaceID(req.NamespaceId).
-		SetIssuerID(req.IssuerId).
-		SetCertPem(string(certPemBytes)).
-		SetKeyPem(string(keyPemBytes)).
-		SetDesc(req.Desc).
-		SetUsage(req.Usage).
-		Save(ctx)
+	var createdCert *ent.Certificate
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		createdCert, err = tx.Certificate.Create().
+			SetNamespaceID(req.NamespaceId).
+			SetIssuerID(req.IssuerId).
+			SetCertPem(string(certPemBytes)).
+			SetKeyRef(newKey.KeyRef).
+			SetDesc(req.Desc).
+			SetUsage(req.Usage).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		return s.ctx.storeCertKey(ctx, tx.Client(), createdCert.ID, newKey.KeyPem)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("save to db failed: %w", err)
 	}
@@ -121,23 +149,79 @@ func (s *CertificateService) CreateCertificate(ctx context.Context, req CreateCe
 		UpdatedAt:   createdCert.UpdatedAt,
 		CreatedAt:   createdCert.CreatedAt,
 		CertPem:     string(certPemBytes),
-		KeyPem:      string(keyPemBytes),
 		Subject:     getSubject(x509Cert),
 		IsCA:        x509Cert.IsCA,
 	}, nil
 }
 
-func (s *CertificateService) ListCertificates(ctx context.Context, namespaceId int) ([]Certificate, error) {
-	var result []Certificate
-	certs, err := s.ctx.client.Certificate.Query().Where(certificate.NamespaceID(namespaceId)).All(ctx)
+// queueCreateCertificate parks a new certificate under an offline issuer. The
+// certificate is only stored once the signed bundle is uploaded.
+func (s *CertificateService) queueCreateCertificate(ctx context.Context, req CreateCertReq, issuer *ent.Certificate, certTemplate *x509.Certificate, newKey *GeneratedKey) (*Certificate, error) {
+	sr, err := s.ctx.queueSigningRequest(ctx, issuer, certTemplate, newKey.Signer.Public(), newKey.KeyPem, func(create *ent.SigningRequestCreate) *ent.SigningRequestCreate {
+		return create.
+			SetKind(signingrequest.KindCreate).
+			SetKeyRef(newKey.KeyRef).
+			SetDesc(req.Desc).
+			SetUsage(req.Usage)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("queue signing request failed: %w", err)
+	}
+	return &Certificate{
+		NamespaceID:      sr.NamespaceID,
+		Desc:             sr.Desc,
+		IssuerID:         sr.IssuerID,
+		Subject:          sr.Subject,
+		IsCA:             certTemplate.IsCA,
+		Usage:            sr.Usage,
+		SigningRequestID: sr.ID,
+	}, nil
+}
+
+// ListCertificates returns one page of the certificates in a namespace and
+// the total count. Private keys are never loaded.
+func (s *CertificateService) ListCertificates(ctx context.Context, namespaceId int, opts ListOptions) ([]Certificate, int, error) {
+	sortKey, desc, err := opts.sort(SortByCreated, SortByCreated, SortByUpdated, SortByExpiry, SortBySubject)
+	if err != nil {
+		return nil, 0, err
+	}
+	field := map[string]string{
+		SortByCreated: certificate.FieldCreatedAt,
+		SortByUpdated: certificate.FieldUpdatedAt,
+		SortByExpiry:  certificate.FieldNotAfter,
+		SortBySubject: certificate.FieldSubject,
+	}[sortKey]
+	order := ent.Asc(field, certificate.FieldID)
+	if desc {
+		order = ent.Desc(field, certificate.FieldID)
+	}
+
+	query := s.ctx.client.Certificate.Query().Where(certificate.NamespaceID(namespaceId))
+	total, err := query.Clone().Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("query certificates failed: %w", err)
+		return nil, 0, fmt.Errorf("count certificates failed: %w", err)
+	}
+	certs, err := query.
+		Order(order).
+		Offset(opts.Offset).
+		Limit(opts.limit()).
+		Select(
+			certificate.FieldNamespaceID,
+			certificate.FieldDesc,
+			certificate.FieldIssuerID,
+			certificate.FieldUsage,
+			certificate.FieldSubject,
+			certificate.FieldIsCa,
+			certificate.FieldUpdatedAt,
+			certificate.FieldCreatedAt,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query certificates failed: %w", err)
 	}
+
+	result := make([]Certificate, 0, len(certs))
 	for _, cert := range certs {
-		x509Cert, err := getCertFromPem(cert.CertPem)
-		if err != nil {
-			return nil, fmt.Errorf("get cert %d from pem failed: %w", cert.ID, err)
-		}
 		result = append(result, Certificate{
 			ID:          cert.ID,
 			NamespaceID: cert.NamespaceID,
@@ -145,14 +229,12 @@ func (s *CertificateService) ListCertificates(ctx context.Context, namespaceId i
 			IssuerID:    cert.IssuerID,
 			UpdatedAt:   cert.UpdatedAt,
 			CreatedAt:   cert.CreatedAt,
-			Subject:     getSubject(x509Cert),
-			IsCA:        x509Cert.IsCA,
-			CertPem:     cert.CertPem,
-			KeyPem:      cert.KeyPem,
+			Subject:     cert.Subject,
+			IsCA:        cert.IsCa,
 			Usage:       cert.Usage,
 		})
 	}
-	return result, nil
+	return result, total, nil
 }
 
 func (s *CertificateService) GetCertificate(ctx context.Context, id int) (*CertificateDetail, error) {
@@ -178,9 +260,9 @@ func (s *CertificateService) GetCertificate(ctx context.Context, id int) (*Certi
 		}
 	}
 
-	privateKey, err := getPrivateKeyFromPem(cert.KeyPem)
+	keyRevealable, err := s.keyRevealable(ctx, cert)
 	if err != nil {
-		return nil, fmt.Errorf("get private key %d from pem failed: %w", cert.ID, err)
+		return nil, err
 	}
 
 	x509Cert, err := getCertFromPem(cert.CertPem)
@@ -191,17 +273,37 @@ func (s *CertificateService) GetCertificate(ctx context.Context, id int) (*Certi
 	var keyType string
 	var keyLen int
 	var eccCurve string
-	switch privateKey.(type) {
-	case *rsa.PrivateKey:
+	switch pub := x509Cert.PublicKey.(type) {
+	case *rsa.PublicKey:
 		keyType = "RSA"
-		keyLen = x509Cert.PublicKey.(*rsa.PublicKey).N.BitLen()
-	case *ecdsa.PrivateKey:
+		keyLen = pub.N.BitLen()
+	case *ecdsa.PublicKey:
 		keyType = "ECDSA"
-		eccCurve = x509Cert.PublicKey.(*ecdsa.PublicKey).Curve.Params().Name
-	case ed25519.PrivateKey:
+		eccCurve = pub.Curve.Params().Name
+	case ed25519.PublicKey:
 		keyType = "ED25519"
 	default:
-		return nil, fmt.Errorf("unsupported key type: %T", privateKey)
+		return nil, fmt.Errorf("unsupported key type: %T", x509Cert.PublicKey)
+	}
+
+	var renewalPolicy *RenewalPolicy
+	policy, err := cert.QueryRenewalPolicy().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("get renewal policy of cert %d failed: %w", cert.ID, err)
+	}
+	if policy != nil {
+		renewalPolicy = toRenewalPolicy(policy)
+	}
+	var lastRenewalAttempt *RenewalAttempt
+	attempt, err := s.ctx.client.RenewalAttempt.Query().
+		Where(renewalattempt.CertificateID(cert.ID)).
+		Order(ent.Desc(renewalattempt.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("get last renewal attempt of cert %d failed: %w", cert.ID, err)
+	}
+	if attempt != nil {
+		lastRenewalAttempt = toRenewalAttempt(attempt)
 	}
 
 	return &CertificateDetail{
@@ -213,7 +315,10 @@ func (s *CertificateService) GetCertificate(ctx context.Context, id int) (*Certi
 		IssuerID:      cert.IssuerID,
 		IssuerSubject: issuerSubject,
 		CertPem:       cert.CertPem,
-		KeyPem:        cert.KeyPem,
+		KeyRef:        cert.KeyRef,
+		KeyExportable: cert.KeyExportable,
+		KeyRevealable: keyRevealable,
+		Offline:       cert.Offline,
 		KeyType:       keyType,
 		KeyLen:        keyLen,
 		ECCCurve:      eccCurve,
@@ -226,55 +331,76 @@ func (s *CertificateService) GetCertificate(ctx context.Context, id int) (*Certi
 		IPAddresses:   formatIPAddresses(x509Cert.IPAddresses),
 		IsCA:          x509Cert.IsCA,
 		Usage:         cert.Usage,
+
+		SerialNumber:      serialHex(x509Cert),
+		FingerprintSHA1:   certSHA1Fingerprint(x509Cert),
+		FingerprintSHA256: certSHA256Fingerprint(x509Cert),
+		SPKIPinSHA256:     spkiPin(x509Cert),
+
+		RenewalPolicy:      renewalPolicy,
+		LastRenewalAttempt: lastRenewalAttempt,
 	}, nil
 }
 
-func (s *CertificateService) DeleteCertificate(ctx context.Context, id int) error {
-	err := s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+// DeleteCertificate deletes cert id and every certificate below it. Keys kept
+// in an external key store are deleted from it after the commit.
+func (s *CertificateService) DeleteCertificate(ctx context.Context, id int) (err error) {
+	ctx = withAuditOperation(ctx, "certificate.delete", nil)
+	defer s.ctx.auditFailure(ctx, "certificate", id, &err)
+	var orphanedKeys []string
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
 		_, err := tx.Certificate.Get(ctx, id)
 		if err != nil {
 			return fmt.Errorf("get cert %d failed: %w", id, err)
 		}
-		subCerts, err := s.FindAllSubCertificates(ctx, id)
+		keyRefs, err := tx.Certificate.Query().
+			Where(certificate.Or(certificate.ID(id), descendantsOf(id))).
+			Select(certificate.FieldKeyRef).
+			Strings(ctx)
 		if err != nil {
-			return fmt.Errorf("find all sub certs of cert %d failed: %w", id, err)
+			return fmt.Errorf("query key refs of cert %d failed: %w", id, err)
 		}
-		for _, subCert := range subCerts {
-			err = tx.Certificate.DeleteOneID(subCert.ID).Exec(ctx)
-			if err != nil {
-				return fmt.Errorf("delete sub cert %d failed: %w", subCert.ID, err)
-			}
+		_, err = tx.Certificate.Delete().Where(descendantsOf(id)).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete sub certs of cert %d failed: %w", id, err)
 		}
 		err = tx.Certificate.DeleteOneID(id).Exec(ctx)
 		if err != nil {
 			return fmt.Errorf("delete cert %d failed: %w", id, err)
 		}
-		return nil
+		orphanedKeys, err = unreferencedKeys(ctx, tx.Client(), keyRefs)
+		return err
 	})
 	if err != nil {
 		return fmt.Errorf("delete cert with tx failed: %w", err)
 	}
+	s.ctx.deleteKeys(context.WithoutCancel(ctx), orphanedKeys)
 	return nil
 }
 
-func (s *CertificateService) RenewCertificate(ctx context.Context, id int, validDays int) error {
+// RenewCertificate re-issues cert with a new validity period and serial number.
+// When the issuer is offline a signing request is returned instead.
+func (s *CertificateService) RenewCertificate(ctx context.Context, id int, validDays int) (_ *SigningRequest, err error) {
+	ctx = withAuditOperation(ctx, "certificate.renew", map[string]any{"validDays": validDays})
+	defer s.ctx.auditFailure(ctx, "certificate", id, &err)
 	cert, err := s.ctx.client.Certificate.Get(ctx, id)
 	if err != nil {
-		return fmt.Errorf("get cert %d failed: %w", id, err)
+		return nil, fmt.Errorf("get cert %d failed: %w", id, err)
 	}
 
 	x509Cert, err := getCertFromPem(cert.CertPem)
 	if err != nil {
-		return fmt.Errorf("get cert %d from pem failed: %w", id, err)
+		return nil, fmt.Errorf("get cert %d from pem failed: %w", id, err)
 	}
-	key, err := getPrivateKeyFromPem(cert.KeyPem)
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
 	if err != nil {
-		return fmt.Errorf("get private key %d from pem failed: %w", id, err)
+		return nil, fmt.Errorf("generate serial number failed: %w", err)
 	}
-
 	now := time.Now()
 	certTemplate := &x509.Certificate{
-		SerialNumber:          x509Cert.SerialNumber,
+		SerialNumber:          serialNumber,
+		RawSubject:            x509Cert.RawSubject,
 		Subject:               x509Cert.Subject,
 		NotBefore:             now,
 		NotAfter:              now.AddDate(0, 0, validDays),
@@ -287,131 +413,185 @@ func (s *CertificateService) RenewCertificate(ctx context.Context, id int, valid
 		IPAddresses:           x509Cert.IPAddresses,
 	}
 
+	if err := s.ctx.lintBeforeIssue(certTemplate, x509Cert.PublicKey); err != nil {
+		return nil, err
+	}
+
 	issuerId := cert.IssuerID
 	if issuerId == 0 {
 		issuerId = cert.ID
 	}
 	issuerCert, err := s.ctx.client.Certificate.Get(ctx, issuerId)
 	if err != nil {
-		return fmt.Errorf("get issuer (%d) failed: %w", issuerId, err)
+		return nil, fmt.Errorf("get issuer (%d) failed: %w", issuerId, err)
 	}
 	issuerX509Cert, err := getCertFromPem(issuerCert.CertPem)
 	if err != nil {
-		return fmt.Errorf("get issuer (%d) cert from pem failed: %w", cert.IssuerID, err)
+		return nil, fmt.Errorf("get issuer (%d) cert from pem failed: %w", cert.IssuerID, err)
+	}
+	if issuerCert.Offline {
+		sr, err := s.ctx.queueSigningRequest(ctx, issuerCert, certTemplate, x509Cert.PublicKey, "", func(create *ent.SigningRequestCreate) *ent.SigningRequestCreate {
+			return create.
+				SetKind(signingrequest.KindRenew).
+				SetCertificateID(cert.ID).
+				SetDesc(cert.Desc).
+				SetUsage(cert.Usage)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("queue signing request failed: %w", err)
+		}
+		return sr, nil
 	}
-	issuerPrivateKey, err := getPrivateKeyFromPem(issuerCert.KeyPem)
+	issuerSigner, err := s.ctx.signerFor(ctx, issuerCert)
 	if err != nil {
-		return fmt.Errorf("get issuer (%d) private key from pem failed: %w", cert.IssuerID, err)
+		return nil, fmt.Errorf("get issuer (%d) signer failed: %w", issuerId, err)
 	}
-	pubKey := key.(crypto.Signer).Public()
-	certDer, err := x509.CreateCertificate(rand.Reader, certTemplate, issuerX509Cert, pubKey, issuerPrivateKey)
+	certDer, err := x509.CreateCertificate(rand.Reader, certTemplate, issuerX509Cert, x509Cert.PublicKey, issuerSigner)
 	if err != nil {
-		return fmt.Errorf("create x509 certificate failed: %w", err)
+		return nil, fmt.Errorf("create x509 certificate failed: %w", err)
 	}
 
 	newX509Cert, err := x509.ParseCertificate(certDer)
 	if err != nil {
-		return fmt.Errorf("parse x509 certificate failed: %w", err)
+		return nil, fmt.Errorf("parse x509 certificate failed: %w", err)
 	}
 
 	certPemBytes := x509CertToPem(newX509Cert)
-	err = s.ctx.client.Certificate.UpdateOne(cert).SetCertPem(string(certPemBytes)).Exec(ctx)
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		return tx.Certificate.UpdateOne(cert).SetCertPem(string(certPemBytes)).Exec(ctx)
+	})
 	if err != nil {
-		return fmt.Errorf("update cert %d failed: %w", id, err)
+		return nil, fmt.Errorf("update cert %d failed: %w", id, err)
 	}
-	return nil
+	return nil, nil
 }
 
-func (s *CertificateService) FindAllSubCertificates(ctx context.Context, id int) ([]*ent.Certificate, error) {
-	var result []*ent.Certificate
-
-	var dfs func(id int) error
-	dfs = func(id int) error {
-		certs, err := s.ctx.client.Certificate.Query().Where(certificate.IssuerIDEQ(id)).All(ctx)
+// RekeyCertificate re-issues cert with a new key and serial number. The key
+// is generated by the backend holding the current key, which deletes the old
+// key once it is replaced. CAs that already issued certificates cannot be
+// rekeyed, as that would break their chains.
+func (s *CertificateService) RekeyCertificate(ctx context.Context, id int, validDays int) (_ *SigningRequest, err error) {
+	ctx = withAuditOperation(ctx, "certificate.rekey", map[string]any{"validDays": validDays})
+	defer s.ctx.auditFailure(ctx, "certificate", id, &err)
+	cert, err := s.ctx.client.Certificate.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get cert %d failed: %w", id, err)
+	}
+	if cert.Offline {
+		return nil, fmt.Errorf("cert %d is offline, its key cannot be replaced", id)
+	}
+	x509Cert, err := getCertFromPem(cert.CertPem)
+	if err != nil {
+		return nil, fmt.Errorf("get cert %d from pem failed: %w", id, err)
+	}
+	if x509Cert.IsCA {
+		count, err := s.ctx.client.Certificate.Query().Where(certificate.IssuerID(id)).Count(ctx)
 		if err != nil {
-			return fmt.Errorf("query sub certs of cert %d failed: %w", id, err)
+			return nil, fmt.Errorf("count sub certs of cert %d failed: %w", id, err)
 		}
-		for _, subCert := range certs {
-			result = append(result, subCert)
-			err = dfs(subCert.ID)
-			if err != nil {
-				return fmt.Errorf("dfs of cert %d failed: %w", subCert.ID, err)
-			}
+		if count > 0 {
+			return nil, fmt.Errorf("cert %d has issued %d certificates, rekeying it would break them", id, count)
 		}
-		return nil
 	}
 
-	err := dfs(id)
+	spec, err := keySpecOf(x509Cert.PublicKey)
 	if err != nil {
-		return nil, fmt.Errorf("dfs failed: %w", err)
+		return nil, fmt.Errorf("get key spec of cert %d failed: %w", id, err)
 	}
-	return result, nil
-}
-
-func (s *CertificateService) ExportCertificate(ctx context.Context, id int) ([]byte, error) {
-	ancestors, err := s.findAllCertsAncestors(ctx, id)
+	keyStore, err := s.ctx.keyStore(keyRefBackend(cert.KeyRef))
 	if err != nil {
-		return nil, fmt.Errorf("find all certs ancestors of cert %d failed: %w", id, err)
-	}
-
-	var tarBuf bytes.Buffer
-	tw := tar.NewWriter(&tarBuf)
-
-	var certData bytes.Buffer
-	for _, cert := range ancestors {
-		certData.WriteString(cert.CertPem)
-		certData.WriteString("\n")
+		return nil, fmt.Errorf("get key store failed: %w", err)
 	}
-	var keyData bytes.Buffer
-	keyData.WriteString(ancestors[0].KeyPem)
-	keyData.WriteString("\n")
-
-	err = tw.WriteHeader(&tar.Header{
-		Name: "certificate.pem",
-		Size: int64(certData.Len()),
-		Mode: 0644,
-	})
+	newKey, err := keyStore.GenerateKey(ctx, spec)
 	if err != nil {
-		return nil, fmt.Errorf("write cert header to tar failed: %w", err)
+		return nil, fmt.Errorf("create private key failed: %w", err)
 	}
-	_, err = tw.Write(certData.Bytes())
+	defer func() {
+		if err != nil && newKey.KeyRef != "" {
+			s.ctx.deleteKeys(context.WithoutCancel(ctx), []string{newKey.KeyRef})
+		}
+	}()
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
 	if err != nil {
-		return nil, fmt.Errorf("write cert data to tar failed: %w", err)
+		return nil, fmt.Errorf("generate serial number failed: %w", err)
 	}
 
-	err = tw.WriteHeader(&tar.Header{
-		Name: "key.pem",
-		Size: int64(keyData.Len()),
-		Mode: 0600,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("write key header to tar failed: %w", err)
-	}
-	_, err = tw.Write(keyData.Bytes())
-	if err != nil {
-		return nil, fmt.Errorf("write key data to tar failed: %w", err)
+	now := time.Now()
+	certTemplate := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		RawSubject:            x509Cert.RawSubject,
+		Subject:               x509Cert.Subject,
+		NotBefore:             now,
+		NotAfter:              now.AddDate(0, 0, validDays),
+		KeyUsage:              x509Cert.KeyUsage,
+		ExtKeyUsage:           x509Cert.ExtKeyUsage,
+		BasicConstraintsValid: true,
+		IsCA:                  x509Cert.IsCA,
+		MaxPathLenZero:        false,
+		DNSNames:              x509Cert.DNSNames,
+		IPAddresses:           x509Cert.IPAddresses,
 	}
 
-	tw.Close()
-	return tarBuf.Bytes(), nil
-}
+	if err := s.ctx.lintBeforeIssue(certTemplate, newKey.Signer.Public()); err != nil {
+		return nil, err
+	}
 
-func (s *CertificateService) findAllCertsAncestors(ctx context.Context, id int) ([]*ent.Certificate, error) {
-	var result []*ent.Certificate
-	cert, err := s.ctx.client.Certificate.Get(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("get cert %d failed: %w", id, err)
+	parentCert := certTemplate
+	signer := newKey.Signer
+	if cert.IssuerID != 0 {
+		issuerCert, err := s.ctx.client.Certificate.Get(ctx, cert.IssuerID)
+		if err != nil {
+			return nil, fmt.Errorf("get issuer (%d) failed: %w", cert.IssuerID, err)
+		}
+		parentCert, err = getCertFromPem(issuerCert.CertPem)
+		if err != nil {
+			return nil, fmt.Errorf("get issuer (%d) cert from pem failed: %w", cert.IssuerID, err)
+		}
+		if issuerCert.Offline {
+			sr, err := s.ctx.queueSigningRequest(ctx, issuerCert, certTemplate, newKey.Signer.Public(), newKey.KeyPem, func(create *ent.SigningRequestCreate) *ent.SigningRequestCreate {
+				return create.
+					SetKind(signingrequest.KindRenew).
+					SetCertificateID(cert.ID).
+					SetKeyRef(newKey.KeyRef).
+					SetDesc(cert.Desc).
+					SetUsage(cert.Usage)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("queue signing request failed: %w", err)
+			}
+			return sr, nil
+		}
+		signer, err = s.ctx.signerFor(ctx, issuerCert)
+		if err != nil {
+			return nil, fmt.Errorf("get issuer (%d) signer failed: %w", cert.IssuerID, err)
+		}
 	}
-	result = append(result, cert)
-	if cert.IssuerID == 0 {
-		return result, nil
+	certDer, err := x509.CreateCertificate(rand.Reader, certTemplate, parentCert, newKey.Signer.Public(), signer)
+	if err != nil {
+		return nil, fmt.Errorf("create x509 certificate failed: %w", err)
 	}
-	ancestors, err := s.findAllCertsAncestors(ctx, cert.IssuerID)
+
+	var orphanedKeys []string
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		err := tx.Certificate.UpdateOne(cert).
+			SetCertPem(string(rawCertToPem(certDer))).
+			ClearKeyPem().
+			SetKeyRef(newKey.KeyRef).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("update cert %d failed: %w", id, err)
+		}
+		if err := s.ctx.storeCertKey(ctx, tx.Client(), cert.ID, newKey.KeyPem); err != nil {
+			return err
+		}
+		orphanedKeys, err = unreferencedKeys(ctx, tx.Client(), []string{cert.KeyRef})
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("find all certs ancestors of cert %d failed: %w", id, err)
+		return nil, fmt.Errorf("rekey cert with tx failed: %w", err)
 	}
-	result = append(result, ancestors...)
-	return result, nil
+	s.ctx.deleteKeys(context.WithoutCancel(ctx), orphanedKeys)
+	return nil, nil
 }
 
 type CertificateDetail struct {
@@ -423,7 +603,10 @@ type CertificateDetail struct {
 	IssuerID      int      `json:"issuerId"`
 	IssuerSubject string   `json:"issuerSubject"`
 	CertPem       string   `json:"certPem"`
-	KeyPem        string   `json:"keyPem"`
+	KeyRef        string   `json:"keyRef"`
+	KeyExportable bool     `json:"keyExportable"`
+	KeyRevealable bool     `json:"keyRevealable"`
+	Offline       bool     `json:"offline"`
 	KeyType       string   `json:"keyType"`
 	KeyLen        int      `json:"keyLen"`
 	ECCCurve      string   `json:"eccCurve"`
@@ -436,6 +619,14 @@ type CertificateDetail struct {
 	IPAddresses   []string `json:"ipAddresses"`
 	IsCA          bool     `json:"isCA"`
 	Usage         string   `json:"usage"`
+
+	SerialNumber      string `json:"serialNumber"`
+	FingerprintSHA1   string `json:"fingerprintSha1"`
+	FingerprintSHA256 string `json:"fingerprintSha256"`
+	SPKIPinSHA256     string `json:"spkiPinSha256"`
+
+	RenewalPolicy      *RenewalPolicy  `json:"renewalPolicy,omitempty"`
+	LastRenewalAttempt *RenewalAttempt `json:"lastRenewalAttempt,omitempty"`
 }
 
 type Subject struct {
@@ -447,17 +638,26 @@ type Subject struct {
 	CommonName string `json:"commonName"`
 }
 
+// ToPkixName leaves out empty attributes, which would otherwise be encoded as
+// empty RDNs such as "ST=".
 func (s *Subject) ToPkixName() pkix.Name {
 	return pkix.Name{
-		Country:            []string{s.Country},
-		Province:           []string{s.State},
-		Locality:           []string{s.City},
+		Country:            nonEmpty(s.Country),
+		Province:           nonEmpty(s.State),
+		Locality:           nonEmpty(s.City),
 		CommonName:         s.CommonName,
-		Organization:       []string{s.Org},
-		OrganizationalUnit: []string{s.Ou},
+		Organization:       nonEmpty(s.Org),
+		OrganizationalUnit: nonEmpty(s.Ou),
 	}
 }
 
+func nonEmpty(v string) []string {
+	if v == "" {
+		return nil
+	}
+	return []string{v}
+}
+
 type KeyUsage struct {
 	DigitalSignature bool `json:"digitalSignature"`
 	KeyEncipherment  bool `json:"keyEncipherment"`
@@ -511,13 +711,15 @@ type Certificate struct {
 	NamespaceID int
 	Desc        string
 	CertPem     string
-	KeyPem      string
 	IssuerID    int
 	UpdatedAt   time.Time
 	CreatedAt   time.Time
 	Subject     string
 	IsCA        bool
 	Usage       string
+	// SigningRequestID is set instead of ID when the issuer is offline and
+	// the certificate waits for its signature.
+	SigningRequestID int
 }
 
 type CreateCertReq struct {
@@ -526,6 +728,7 @@ type CreateCertReq struct {
 	KeyType          string           `json:"keyType"`
 	KeyLen           int              `json:"keyLen"`
 	ECCCurve         string           `json:"eccCurve"`
+	KeyBackend       string           `json:"keyBackend"`
 	ValidDays        int              `json:"validDays"`
 	Desc             string           `json:"desc"`
 	Subject          Subject          `json:"subject"`
@@ -563,24 +766,24 @@ func getSubjectFromPem(certPem string) (string, error) {
 
 func getSubject(cert *x509.Certificate) string {
 	subject := cert.Subject
-	var subjectStr string
+	var parts []string
 	for _, v := range subject.Country {
-		subjectStr += fmt.Sprintf("C=%s", v)
+		parts = append(parts, "C="+v)
 	}
 	for _, v := range subject.Province {
-		subjectStr += fmt.Sprintf(", ST=%s", v)
+		parts = append(parts, "ST="+v)
 	}
 	for _, v := range subject.Locality {
-		subjectStr += fmt.Sprintf(", L=%s", v)
+		parts = append(parts, "L="+v)
 	}
 	for _, v := range subject.Organization {
-		subjectStr += fmt.Sprintf(", O=%s", v)
+		parts = append(parts, "O="+v)
 	}
 	for _, v := range subject.OrganizationalUnit {
-		subjectStr += fmt.Sprintf(", OU=%s", v)
+		parts = append(parts, "OU="+v)
 	}
-	subjectStr += fmt.Sprintf(", CN=%s", subject.CommonName)
-	return subjectStr
+	parts = append(parts, "CN="+subject.CommonName)
+	return strings.Join(parts, ", ")
 }
 
 func rawCertToPem(cert []byte) []byte {
@@ -599,23 +802,14 @@ func PrivateKeyToPem(key crypto.PrivateKey) []byte {
 	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})
 }
 
-func createPrivateKey(keyType string, req CreateCertReq) (crypto.PrivateKey, error) {
-	switch keyType {
+func createPrivateKey(spec KeySpec) (crypto.PrivateKey, error) {
+	switch spec.KeyType {
 	case "RSA":
-		return rsa.GenerateKey(rand.Reader, req.KeyLen)
+		return rsa.GenerateKey(rand.Reader, spec.KeyLen)
 	case "ECDSA":
-		var curve elliptic.Curve
-		switch req.ECCCurve {
-		case "P224":
-			curve = elliptic.P224()
-		case "P256":
-			curve = elliptic.P256()
-		case "P384":
-			curve = elliptic.P384()
-		case "P521":
-			curve = elliptic.P521()
-		default:
-			return nil, fmt.Errorf("unsupported ecc curve: %s", req.ECCCurve)
+		curve, err := parseECCCurve(spec.ECCCurve)
+		if err != nil {
+			return nil, err
 		}
 		return ecdsa.GenerateKey(curve, rand.Reader)
 	case "ED25519":
@@ -625,7 +819,22 @@ func createPrivateKey(keyType string, req CreateCertReq) (crypto.PrivateKey, err
 		}
 		return privateKey, nil
 	default:
-		return nil, fmt.Errorf("unsupported key type: %s", keyType)
+		return nil, fmt.Errorf("unsupported key type: %s", spec.KeyType)
+	}
+}
+
+func parseECCCurve(name string) (elliptic.Curve, error) {
+	switch name {
+	case "P224":
+		return elliptic.P224(), nil
+	case "P256":
+		return elliptic.P256(), nil
+	case "P384":
+		return elliptic.P384(), nil
+	case "P521":
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("unsupported ecc curve: %s", name)
 	}
 }
 
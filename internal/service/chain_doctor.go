@@ -0,0 +1,359 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+)
+
+const (
+	ChainSourceBundle = "bundle"
+	ChainSourceStore  = "store"
+
+	maxChainLength = 10
+)
+
+// ChainDoctorReq carries an unordered PEM bundle and optionally the private
+// key of its leaf.
+type ChainDoctorReq struct {
+	Bundle string `json:"bundle"`
+	KeyPem string `json:"keyPem"`
+}
+
+type ChainEntry struct {
+	Fingerprint string    `json:"fingerprint"`
+	Subject     string    `json:"subject"`
+	Issuer      string    `json:"issuer"`
+	NotBefore   time.Time `json:"notBefore"`
+	NotAfter    time.Time `json:"notAfter"`
+	IsCA        bool      `json:"isCA"`
+	// Source tells whether the certificate came with the bundle or was
+	// filled in from a namespace, in which case the IDs are set.
+	Source        string `json:"source"`
+	CertificateID int    `json:"certificateId,omitempty"`
+	NamespaceID   int    `json:"namespaceId,omitempty"`
+}
+
+type ChainIssue struct {
+	Severity    string `json:"severity"`
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// ChainDoctorResult is the repaired chain, ordered leaf to root, with PEM
+// ready to be saved as fullchain.pem. Complete is set when the chain ends at
+// a self-signed root.
+type ChainDoctorResult struct {
+	Chain    []ChainEntry `json:"chain"`
+	Pem      string       `json:"pem"`
+	Complete bool         `json:"complete"`
+	Issues   []ChainIssue `json:"issues"`
+}
+
+type chainLink struct {
+	cert   *x509.Certificate
+	source string
+	stored *ent.Certificate
+}
+
+// DiagnoseChain orders a PEM bundle from leaf to root, fills in missing
+// issuers from all namespaces and reports what is wrong with it.
+func (s *CertificateService) DiagnoseChain(ctx context.Context, req ChainDoctorReq) (*ChainDoctorResult, error) {
+	parsed, err := parsePemCertificates(req.Bundle)
+	if err != nil {
+		return nil, fmt.Errorf("parse bundle failed: %w", err)
+	}
+	if len(parsed) == 0 {
+		return nil, fmt.Errorf("bundle contains no certificate")
+	}
+	var key crypto.Signer
+	if req.KeyPem != "" {
+		key, err = parseAnyPrivateKey(req.KeyPem)
+		if err != nil {
+			return nil, fmt.Errorf("parse keyPem failed: %w", err)
+		}
+	}
+
+	result := &ChainDoctorResult{Chain: make([]ChainEntry, 0), Issues: make([]ChainIssue, 0)}
+	addIssue := func(severity, code string, cert *x509.Certificate, format string, args ...any) {
+		issue := ChainIssue{Severity: severity, Code: code, Message: fmt.Sprintf(format, args...)}
+		if cert != nil {
+			issue.Fingerprint = certFingerprint(cert)
+		}
+		result.Issues = append(result.Issues, issue)
+	}
+
+	var certs []*x509.Certificate
+	seen := make(map[string]bool)
+	for _, cert := range parsed {
+		fp := certFingerprint(cert)
+		if seen[fp] {
+			addIssue(LintSeverityWarning, "duplicate", cert, "%s appears more than once", getSubject(cert))
+			continue
+		}
+		seen[fp] = true
+		certs = append(certs, cert)
+	}
+
+	leaf := findLeaf(certs, key)
+	chain := []chainLink{{cert: leaf, source: ChainSourceBundle}}
+	used := map[*x509.Certificate]bool{leaf: true}
+	for len(chain) < maxChainLength {
+		current := chain[len(chain)-1].cert
+		if isSelfSigned(current) {
+			result.Complete = true
+			break
+		}
+		if issuer := findIssuerIn(current, certs); issuer != nil && !used[issuer] {
+			chain = append(chain, chainLink{cert: issuer, source: ChainSourceBundle})
+			used[issuer] = true
+			continue
+		}
+		stored, issuer, err := s.findStoredIssuer(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		if issuer == nil {
+			addIssue(LintSeverityError, "missing_issuer", current, "issuer %s of %s is neither in the bundle nor in any namespace", current.Issuer.String(), getSubject(current))
+			break
+		}
+		chain = append(chain, chainLink{cert: issuer, source: ChainSourceStore, stored: stored})
+		addIssue(LintSeverityWarning, "filled_from_store", issuer, "%s was missing from the bundle and was taken from namespace %d", getSubject(issuer), stored.NamespaceID)
+	}
+
+	for _, cert := range certs {
+		if !used[cert] {
+			addIssue(LintSeverityWarning, "unrelated", cert, "%s is not part of the chain of %s", getSubject(cert), getSubject(leaf))
+		}
+	}
+	if !slices.Equal(bundleOrder(parsed, chain), chainOrder(chain)) {
+		addIssue(LintSeverityWarning, "misordered", nil, "the bundle is not ordered from leaf to root")
+	}
+
+	now := time.Now()
+	var pemBuf bytes.Buffer
+	for i, link := range chain {
+		cert := link.cert
+		entry := ChainEntry{
+			Fingerprint: certFingerprint(cert),
+			Subject:     getSubject(cert),
+			Issuer:      cert.Issuer.String(),
+			NotBefore:   cert.NotBefore,
+			NotAfter:    cert.NotAfter,
+			IsCA:        cert.IsCA,
+			Source:      link.source,
+		}
+		if link.stored != nil {
+			entry.CertificateID = link.stored.ID
+			entry.NamespaceID = link.stored.NamespaceID
+		}
+		result.Chain = append(result.Chain, entry)
+		pemBuf.Write(x509CertToPem(cert))
+
+		switch {
+		case now.After(cert.NotAfter):
+			addIssue(LintSeverityError, "expired", cert, "%s expired at %s", entry.Subject, cert.NotAfter.Format(time.RFC3339))
+		case now.Before(cert.NotBefore):
+			addIssue(LintSeverityError, "not_yet_valid", cert, "%s is not valid before %s", entry.Subject, cert.NotBefore.Format(time.RFC3339))
+		}
+		if i == 0 {
+			if len(cert.ExtKeyUsage) > 0 && !slices.ContainsFunc(cert.ExtKeyUsage, func(u x509.ExtKeyUsage) bool {
+				return u == x509.ExtKeyUsageServerAuth || u == x509.ExtKeyUsageAny
+			}) {
+				addIssue(LintSeverityWarning, "leaf_not_server_auth", cert, "%s is not valid for TLS server authentication", entry.Subject)
+			}
+			continue
+		}
+		if !cert.IsCA || !cert.BasicConstraintsValid {
+			addIssue(LintSeverityError, "issuer_not_ca", cert, "%s issues %s but is not a CA", entry.Subject, result.Chain[i-1].Subject)
+		}
+		if cert.KeyUsage != 0 && cert.KeyUsage&x509.KeyUsageCertSign == 0 {
+			addIssue(LintSeverityError, "issuer_key_usage", cert, "%s lacks the keyCertSign key usage", entry.Subject)
+		}
+		if cert.NotAfter.Before(chain[i-1].cert.NotAfter) {
+			addIssue(LintSeverityWarning, "issuer_expires_first", cert, "%s expires before %s", entry.Subject, result.Chain[i-1].Subject)
+		}
+	}
+	result.Pem = pemBuf.String()
+
+	if result.Complete {
+		if err := verifyChain(chain); err != nil {
+			addIssue(LintSeverityError, "verification_failed", leaf, "%v", err)
+		}
+	}
+	if key != nil {
+		if pub, ok := leaf.PublicKey.(interface{ Equal(crypto.PublicKey) bool }); !ok || !pub.Equal(key.Public()) {
+			addIssue(LintSeverityError, "key_mismatch", leaf, "the private key does not belong to %s", getSubject(leaf))
+		}
+	}
+	return result, nil
+}
+
+// findLeaf picks the certificate that issued none of the others, preferring
+// the one matching key and then non-CA certificates in bundle order.
+func findLeaf(certs []*x509.Certificate, key crypto.Signer) *x509.Certificate {
+	var candidates []*x509.Certificate
+	for _, cert := range certs {
+		issuesOther := slices.ContainsFunc(certs, func(other *x509.Certificate) bool {
+			return other != cert && issuedBy(other, cert)
+		})
+		if !issuesOther {
+			candidates = append(candidates, cert)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = certs
+	}
+	if key != nil {
+		for _, cert := range candidates {
+			if pub, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool }); ok && pub.Equal(key.Public()) {
+				return cert
+			}
+		}
+	}
+	for _, cert := range candidates {
+		if !cert.IsCA {
+			return cert
+		}
+	}
+	return candidates[0]
+}
+
+// issuedBy reports whether parent signed cert, matching the key identifiers
+// or names before checking the signature.
+func issuedBy(cert, parent *x509.Certificate) bool {
+	if len(cert.AuthorityKeyId) > 0 && len(parent.SubjectKeyId) > 0 {
+		if !bytes.Equal(cert.AuthorityKeyId, parent.SubjectKeyId) {
+			return false
+		}
+	} else if !bytes.Equal(cert.RawIssuer, parent.RawSubject) {
+		return false
+	}
+	return cert.CheckSignatureFrom(parent) == nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil
+}
+
+func findIssuerIn(cert *x509.Certificate, certs []*x509.Certificate) *x509.Certificate {
+	for _, parent := range certs {
+		if parent != cert && issuedBy(cert, parent) {
+			return parent
+		}
+	}
+	return nil
+}
+
+// findStoredIssuer looks for the issuer of cert in all namespaces, by subject
+// key identifier first and by subject otherwise.
+func (s *CertificateService) findStoredIssuer(ctx context.Context, cert *x509.Certificate) (*ent.Certificate, *x509.Certificate, error) {
+	query := s.ctx.client.Certificate.Query().Where(certificate.IsCa(true))
+	if len(cert.AuthorityKeyId) > 0 {
+		query = query.Where(certificate.SubjectKeyID(hex.EncodeToString(cert.AuthorityKeyId)))
+	} else {
+		query = query.Where(certificate.Subject(getSubject(&x509.Certificate{Subject: cert.Issuer})))
+	}
+	candidates, err := query.Select(certificate.FieldNamespaceID, certificate.FieldCertPem).All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query issuer candidates failed: %w", err)
+	}
+	for _, candidate := range candidates {
+		parent, err := getCertFromPem(candidate.CertPem)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get cert %d from pem failed: %w", candidate.ID, err)
+		}
+		if issuedBy(cert, parent) {
+			return candidate, parent, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// verifyChain checks chain against its last certificate as the only root. A
+// chain of a single self-signed certificate is its own root.
+func verifyChain(chain []chainLink) error {
+	roots := x509.NewCertPool()
+	roots.AddCert(chain[len(chain)-1].cert)
+	intermediates := x509.NewCertPool()
+	if len(chain) > 2 {
+		for _, link := range chain[1 : len(chain)-1] {
+			intermediates.AddCert(link.cert)
+		}
+	}
+	_, err := chain[0].cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err
+}
+
+// bundleOrder returns the fingerprints of the chain certificates in the order
+// they appeared in the bundle.
+func bundleOrder(parsed []*x509.Certificate, chain []chainLink) []string {
+	inChain := make(map[string]bool, len(chain))
+	for _, link := range chain {
+		if link.source == ChainSourceBundle {
+			inChain[certFingerprint(link.cert)] = true
+		}
+	}
+	var order []string
+	for _, cert := range parsed {
+		fp := certFingerprint(cert)
+		if inChain[fp] {
+			order = append(order, fp)
+			delete(inChain, fp)
+		}
+	}
+	return order
+}
+
+func chainOrder(chain []chainLink) []string {
+	var order []string
+	for _, link := range chain {
+		if link.source == ChainSourceBundle {
+			order = append(order, certFingerprint(link.cert))
+		}
+	}
+	return order
+}
+
+// parseAnyPrivateKey accepts PKCS#8, PKCS#1 and SEC 1 keys, as found in
+// files written by other tools.
+func parseAnyPrivateKey(keyPem string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(keyPem))
+	if block == nil {
+		return nil, fmt.Errorf("decode keyPem failed")
+	}
+	return parsePrivateKeyDer(block.Bytes)
+}
+
+func parsePrivateKeyDer(der []byte) (crypto.Signer, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		if rsaKey, rsaErr := x509.ParsePKCS1PrivateKey(der); rsaErr == nil {
+			key, err = rsaKey, nil
+		} else if ecKey, ecErr := x509.ParseECPrivateKey(der); ecErr == nil {
+			key, err = ecKey, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type: %T", key)
+	}
+	return signer, nil
+}
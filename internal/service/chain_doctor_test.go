@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDiagnoseChainSingleSelfSigned(t *testing.T) {
+	sctx := newTestContext(t)
+	ns := createTestNamespace(t, sctx, "ns")
+	root := createTestCert(t, sctx, testCertReq(ns.ID, 0, "Root CA", true))
+
+	result, err := NewCertificateService(sctx).DiagnoseChain(context.Background(), ChainDoctorReq{Bundle: root.CertPem})
+	if err != nil {
+		t.Fatalf("DiagnoseChain: %v", err)
+	}
+	if !result.Complete || len(result.Chain) != 1 {
+		t.Fatalf("got complete=%v with %d certificates, want a complete chain of 1", result.Complete, len(result.Chain))
+	}
+	for _, issue := range result.Issues {
+		if issue.Code == "verification_failed" {
+			t.Errorf("unexpected issue: %s", issue.Message)
+		}
+	}
+}
+
+func TestDiagnoseChainFillsFromStore(t *testing.T) {
+	sctx := newTestContext(t)
+	_, root, _, leaf := createTestChain(t, sctx)
+
+	result, err := NewCertificateService(sctx).DiagnoseChain(context.Background(), ChainDoctorReq{Bundle: root.CertPem + leaf.CertPem})
+	if err != nil {
+		t.Fatalf("DiagnoseChain: %v", err)
+	}
+	if !result.Complete || len(result.Chain) != 3 {
+		t.Fatalf("got complete=%v with %d certificates, want a complete chain of 3", result.Complete, len(result.Chain))
+	}
+	codes := make(map[string]bool)
+	for _, issue := range result.Issues {
+		codes[issue.Code] = true
+	}
+	for _, code := range []string{"filled_from_store", "misordered"} {
+		if !codes[code] {
+			t.Errorf("missing issue %s in %+v", code, result.Issues)
+		}
+	}
+	if codes["verification_failed"] {
+		t.Errorf("unexpected verification failure in %+v", result.Issues)
+	}
+}
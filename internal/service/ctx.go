@@ -3,18 +3,34 @@ package service
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/logeable/certmgr/internal/ent"
 )
 
 type ServiceContext struct {
-	client *ent.Client
+	client    *ent.Client
+	keyring   *Keyring
+	keyStores map[string]KeyStore
+
+	strictLint bool
+	// auditMu serializes appends to the audit chain within the process.
+	auditMu sync.Mutex
 }
 
 func NewServiceContext(client *ent.Client) *ServiceContext {
-	return &ServiceContext{
+	client.Certificate.Use(certAttributesHook)
+	sctx := &ServiceContext{
 		client: client,
 	}
+	client.Use(sctx.auditHook)
+	return sctx
+}
+
+// SetStrictLint makes lint errors abort certificate issuance instead of only
+// being logged.
+func (sctx *ServiceContext) SetStrictLint(strict bool) {
+	sctx.strictLint = strict
 }
 
 func (sctx *ServiceContext) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
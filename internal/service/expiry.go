@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/expirynotification"
+	"go.uber.org/zap"
+)
+
+var DefaultExpiryThresholds = []int{30, 7, 1}
+
+// ExpiryNotice reports a certificate whose chain expires within ThresholdDays.
+// NotAfter is the earliest expiry along the chain, which may belong to an
+// issuer (ExpiringCertificateID) rather than the certificate itself.
+type ExpiryNotice struct {
+	CertificateID         int       `json:"certificateId"`
+	NamespaceID           int       `json:"namespaceId"`
+	Subject               string    `json:"subject"`
+	NotAfter              time.Time `json:"notAfter"`
+	DaysLeft              int       `json:"daysLeft"`
+	ThresholdDays         int       `json:"thresholdDays"`
+	ExpiringCertificateID int       `json:"expiringCertificateId"`
+}
+
+type ExpiryService struct {
+	ctx        *ServiceContext
+	thresholds []int
+	notifiers  []Notifier
+}
+
+func NewExpiryService(ctx *ServiceContext, thresholds []int, notifiers []Notifier) *ExpiryService {
+	sorted := append([]int(nil), thresholds...)
+	sort.Ints(sorted)
+	return &ExpiryService{
+		ctx:        ctx,
+		thresholds: sorted,
+		notifiers:  notifiers,
+	}
+}
+
+// ScanExpiring notifies every certificate that crossed a threshold. Only the
+// most urgent threshold crossed is sent, and each fires once per version of
+// the certificate that expires first in the chain, so renewing it starts
+// over. It returns the number of notices sent.
+func (s *ExpiryService) ScanExpiring(ctx context.Context) (int, error) {
+	certs, err := s.ctx.client.Certificate.Query().Select(certificateSummaryFields...).All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("query certificates failed: %w", err)
+	}
+	byID := make(map[int]*ent.Certificate, len(certs))
+	for _, cert := range certs {
+		byID[cert.ID] = cert
+	}
+
+	now := time.Now()
+	var sent int
+	var errs []error
+	for _, cert := range certs {
+		notAfter, expiringID := chainNotAfter(cert.ID, byID)
+		daysLeft := int(math.Floor(notAfter.Sub(now).Hours() / 24))
+		threshold, ok := s.crossedThreshold(daysLeft)
+		if !ok {
+			continue
+		}
+		fingerprint := byID[expiringID].Fingerprint
+		exist, err := s.ctx.client.ExpiryNotification.Query().
+			Where(
+				expirynotification.CertificateID(cert.ID),
+				expirynotification.Fingerprint(fingerprint),
+				expirynotification.ThresholdDays(threshold),
+			).
+			Exist(ctx)
+		if err != nil {
+			return sent, fmt.Errorf("query notification of cert %d failed: %w", cert.ID, err)
+		}
+		if exist {
+			continue
+		}
+
+		notice := ExpiryNotice{
+			CertificateID:         cert.ID,
+			NamespaceID:           cert.NamespaceID,
+			Subject:               cert.Subject,
+			NotAfter:              notAfter,
+			DaysLeft:              daysLeft,
+			ThresholdDays:         threshold,
+			ExpiringCertificateID: expiringID,
+		}
+		if err := s.notify(ctx, notice); err != nil {
+			errs = append(errs, fmt.Errorf("notify cert %d failed: %w", cert.ID, err))
+			continue
+		}
+		err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+			return tx.ExpiryNotification.Create().
+				SetCertificateID(cert.ID).
+				SetFingerprint(fingerprint).
+				SetThresholdDays(threshold).
+				SetNotAfter(notAfter).
+				Exec(ctx)
+		})
+		if err != nil {
+			return sent, fmt.Errorf("save notification of cert %d failed: %w", cert.ID, err)
+		}
+		sent++
+	}
+	return sent, errors.Join(errs...)
+}
+
+// notify sends notice to every sink. A failed sink makes the whole notice
+// count as unsent so it is retried on the next scan.
+func (s *ExpiryService) notify(ctx context.Context, notice ExpiryNotice) error {
+	var errs []error
+	for _, n := range s.notifiers {
+		if err := n.Notify(ctx, notice); err != nil {
+			zap.L().Error("send expiry notice failed", zap.Int("certificateId", notice.CertificateID), zap.Error(err))
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// crossedThreshold returns the smallest threshold daysLeft is within.
+func (s *ExpiryService) crossedThreshold(daysLeft int) (int, bool) {
+	for _, t := range s.thresholds {
+		if daysLeft <= t {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// chainNotAfter returns the earliest NotAfter from id up to its root, and the
+// certificate it belongs to.
+func chainNotAfter(id int, byID map[int]*ent.Certificate) (time.Time, int) {
+	notAfter, expiringID := byID[id].NotAfter, id
+	visited := map[int]bool{id: true}
+	for cur := byID[id]; cur.IssuerID != 0 && !visited[cur.IssuerID]; {
+		issuer, ok := byID[cur.IssuerID]
+		if !ok {
+			break
+		}
+		visited[issuer.ID] = true
+		if issuer.NotAfter.Before(notAfter) {
+			notAfter, expiringID = issuer.NotAfter, issuer.ID
+		}
+		cur = issuer
+	}
+	return notAfter, expiringID
+}
+
+func certFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
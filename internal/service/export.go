@@ -0,0 +1,454 @@
+package service
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/pavlo-v-chernykh/keystore-go/v4"
+	"gopkg.in/yaml.v3"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const (
+	ExportFormatTar            = "tar"
+	ExportFormatK8sSecret      = "k8s-secret"
+	ExportFormatK8sCAConfigMap = "k8s-ca-configmap"
+	ExportFormatK8sCASecret    = "k8s-ca-secret"
+	ExportFormatJKSKeystore    = "jks-keystore"
+	ExportFormatJKSTruststore  = "jks-truststore"
+	ExportFormatP12Truststore  = "p12-truststore"
+)
+
+type ExportCertReq struct {
+	Format    string            `json:"format"`
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Labels    map[string]string `json:"labels"`
+	Password  string            `json:"password"`
+}
+
+type ExportedFile struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+func (s *CertificateService) ExportCertificate(ctx context.Context, id int, req ExportCertReq) (_ *ExportedFile, err error) {
+	ctx = withAuditOperation(ctx, "certificate.export", req)
+	defer s.ctx.auditFailure(ctx, "certificate", id, &err)
+	ancestors, err := certChain(ctx, s.ctx.client, id)
+	if err != nil {
+		return nil, fmt.Errorf("find all certs ancestors of cert %d failed: %w", id, err)
+	}
+	ns, err := s.ctx.client.Namespace.Get(ctx, ancestors[0].NamespaceID)
+	if err != nil {
+		return nil, fmt.Errorf("get namespace %d failed: %w", ancestors[0].NamespaceID, err)
+	}
+	if ns.KeyRevealDisabled {
+		if exportNeedsKey(req.Format) {
+			return nil, ErrKeyRevealDisabled
+		}
+		ancestors[0].KeyPem = ""
+	}
+	if !ancestors[0].KeyExportable {
+		if exportNeedsKey(req.Format) {
+			return nil, fmt.Errorf("private key of cert %d is not exportable", id)
+		}
+		ancestors[0].KeyPem = ""
+	}
+	ancestors[0].KeyPem, err = s.ctx.keyring.Open(ancestors[0].KeyPem, certKeyAAD(ancestors[0].ID))
+	if err != nil {
+		return nil, fmt.Errorf("open key of cert %d failed: %w", id, err)
+	}
+
+	var file *ExportedFile
+	switch req.Format {
+	case "", ExportFormatTar:
+		data, err := exportTar(ancestors)
+		if err != nil {
+			return nil, fmt.Errorf("export tar failed: %w", err)
+		}
+		file = &ExportedFile{
+			Filename:    fmt.Sprintf("certificate-%d.tar", id),
+			ContentType: "application/x-tar",
+			Data:        data,
+		}
+	case ExportFormatK8sSecret, ExportFormatK8sCAConfigMap, ExportFormatK8sCASecret:
+		data, err := exportK8sManifest(ancestors, req)
+		if err != nil {
+			return nil, fmt.Errorf("export kubernetes manifest failed: %w", err)
+		}
+		file = &ExportedFile{
+			Filename:    fmt.Sprintf("certificate-%d-%s.yaml", id, req.Format),
+			ContentType: "application/yaml",
+			Data:        data,
+		}
+	case ExportFormatJKSKeystore, ExportFormatJKSTruststore:
+		data, err := exportJKS(ancestors, req)
+		if err != nil {
+			return nil, fmt.Errorf("export jks failed: %w", err)
+		}
+		file = &ExportedFile{
+			Filename:    fmt.Sprintf("certificate-%d-%s.jks", id, strings.TrimPrefix(req.Format, "jks-")),
+			ContentType: "application/octet-stream",
+			Data:        data,
+		}
+	case ExportFormatP12Truststore:
+		data, err := exportP12Truststore(ancestors, req)
+		if err != nil {
+			return nil, fmt.Errorf("export pkcs12 truststore failed: %w", err)
+		}
+		file = &ExportedFile{
+			Filename:    fmt.Sprintf("certificate-%d-truststore.p12", id),
+			ContentType: "application/x-pkcs12",
+			Data:        data,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", req.Format)
+	}
+	if err := s.ctx.auditRead(ctx, "certificate", id, ancestors[0].NamespaceID); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func exportNeedsKey(format string) bool {
+	switch format {
+	case "", ExportFormatTar, ExportFormatK8sSecret, ExportFormatJKSKeystore:
+		return true
+	default:
+		return false
+	}
+}
+
+func exportTar(ancestors []*ent.Certificate) ([]byte, error) {
+	if ancestors[0].KeyPem == "" {
+		return nil, fmt.Errorf("cert %d has no private key", ancestors[0].ID)
+	}
+
+	var tarBuf bytes.Buffer
+	tw := tar.NewWriter(&tarBuf)
+
+	var certData bytes.Buffer
+	for _, cert := range ancestors {
+		certData.WriteString(cert.CertPem)
+		certData.WriteString("\n")
+	}
+	var keyData bytes.Buffer
+	keyData.WriteString(ancestors[0].KeyPem)
+	keyData.WriteString("\n")
+
+	err := tw.WriteHeader(&tar.Header{
+		Name: "certificate.pem",
+		Size: int64(certData.Len()),
+		Mode: 0644,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("write cert header to tar failed: %w", err)
+	}
+	_, err = tw.Write(certData.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("write cert data to tar failed: %w", err)
+	}
+
+	err = tw.WriteHeader(&tar.Header{
+		Name: "key.pem",
+		Size: int64(keyData.Len()),
+		Mode: 0600,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("write key header to tar failed: %w", err)
+	}
+	_, err = tw.Write(keyData.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("write key data to tar failed: %w", err)
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return nil, fmt.Errorf("close tar failed: %w", err)
+	}
+	return tarBuf.Bytes(), nil
+}
+
+type k8sObjectMeta struct {
+	Name      string            `yaml:"name"`
+	Namespace string            `yaml:"namespace,omitempty"`
+	Labels    map[string]string `yaml:"labels,omitempty"`
+}
+
+type k8sSecret struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   k8sObjectMeta     `yaml:"metadata"`
+	Type       string            `yaml:"type"`
+	Data       map[string]string `yaml:"data"`
+}
+
+type k8sConfigMap struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   k8sObjectMeta     `yaml:"metadata"`
+	Data       map[string]string `yaml:"data"`
+}
+
+// exportK8sManifest renders the chain as a kubernetes.io/tls Secret, or, for
+// the CA-only formats, as a trust bundle ConfigMap/Secret holding ca.crt.
+func exportK8sManifest(ancestors []*ent.Certificate, req ExportCertReq) ([]byte, error) {
+	leaf := ancestors[0]
+	name := req.Name
+	if name == "" {
+		x509Cert, err := getCertFromPem(leaf.CertPem)
+		if err != nil {
+			return nil, fmt.Errorf("get cert %d from pem failed: %w", leaf.ID, err)
+		}
+		name = k8sResourceName(x509Cert.Subject.CommonName, leaf.ID)
+	}
+	meta := k8sObjectMeta{
+		Name:      name,
+		Namespace: req.Namespace,
+		Labels:    req.Labels,
+	}
+
+	root := ancestors[len(ancestors)-1]
+	caBundle, err := buildCABundle(ancestors)
+	if err != nil {
+		return nil, fmt.Errorf("build ca bundle failed: %w", err)
+	}
+
+	var obj any
+	switch req.Format {
+	case ExportFormatK8sSecret:
+		if leaf.KeyPem == "" {
+			return nil, fmt.Errorf("cert %d has no private key", leaf.ID)
+		}
+		// tls.crt carries the leaf plus intermediates, the root goes to ca.crt.
+		chain := ancestors
+		if len(chain) > 1 {
+			chain = chain[:len(chain)-1]
+		}
+		var certData strings.Builder
+		for _, cert := range chain {
+			certData.WriteString(cert.CertPem)
+		}
+		obj = k8sSecret{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Metadata:   meta,
+			Type:       "kubernetes.io/tls",
+			Data: map[string]string{
+				"tls.crt": base64.StdEncoding.EncodeToString([]byte(certData.String())),
+				"tls.key": base64.StdEncoding.EncodeToString([]byte(leaf.KeyPem)),
+				"ca.crt":  base64.StdEncoding.EncodeToString([]byte(root.CertPem)),
+			},
+		}
+	case ExportFormatK8sCAConfigMap:
+		obj = k8sConfigMap{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Metadata:   meta,
+			Data: map[string]string{
+				"ca.crt": caBundle,
+			},
+		}
+	case ExportFormatK8sCASecret:
+		obj = k8sSecret{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Metadata:   meta,
+			Type:       "Opaque",
+			Data: map[string]string{
+				"ca.crt": base64.StdEncoding.EncodeToString([]byte(caBundle)),
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported kubernetes format: %s", req.Format)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(obj); err != nil {
+		return nil, fmt.Errorf("encode yaml failed: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("close yaml encoder failed: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// buildCABundle concatenates every CA certificate of the chain, root last.
+func buildCABundle(ancestors []*ent.Certificate) (string, error) {
+	caCerts, err := findChainCACerts(ancestors)
+	if err != nil {
+		return "", err
+	}
+	var bundle strings.Builder
+	for _, cert := range caCerts {
+		bundle.WriteString(cert.CertPem)
+	}
+	return bundle.String(), nil
+}
+
+func findChainCACerts(ancestors []*ent.Certificate) ([]*ent.Certificate, error) {
+	var result []*ent.Certificate
+	for _, cert := range ancestors {
+		x509Cert, err := getCertFromPem(cert.CertPem)
+		if err != nil {
+			return nil, fmt.Errorf("get cert %d from pem failed: %w", cert.ID, err)
+		}
+		if x509Cert.IsCA {
+			result = append(result, cert)
+		}
+	}
+	return result, nil
+}
+
+// exportJKS builds either a keystore holding the key and its chain, or a
+// truststore holding the CA certificates of the chain. Aliases are derived
+// from the subject common names.
+func exportJKS(ancestors []*ent.Certificate, req ExportCertReq) ([]byte, error) {
+	if req.Password == "" {
+		return nil, fmt.Errorf("store password is required")
+	}
+	password := []byte(req.Password)
+	now := time.Now()
+	ks := keystore.New()
+	aliases := make(map[string]bool)
+
+	switch req.Format {
+	case ExportFormatJKSKeystore:
+		leaf := ancestors[0]
+		if leaf.KeyPem == "" {
+			return nil, fmt.Errorf("cert %d has no private key", leaf.ID)
+		}
+		keyBlock, _ := pem.Decode([]byte(leaf.KeyPem))
+		if keyBlock == nil {
+			return nil, fmt.Errorf("decode keyPem of cert %d failed", leaf.ID)
+		}
+		var chain []keystore.Certificate
+		for _, cert := range ancestors {
+			block, _ := pem.Decode([]byte(cert.CertPem))
+			if block == nil {
+				return nil, fmt.Errorf("decode certPem of cert %d failed", cert.ID)
+			}
+			chain = append(chain, keystore.Certificate{Type: "X509", Content: block.Bytes})
+		}
+		alias, err := storeAlias(leaf, aliases)
+		if err != nil {
+			return nil, err
+		}
+		err = ks.SetPrivateKeyEntry(alias, keystore.PrivateKeyEntry{
+			CreationTime:     now,
+			PrivateKey:       keyBlock.Bytes,
+			CertificateChain: chain,
+		}, password)
+		if err != nil {
+			return nil, fmt.Errorf("set private key entry failed: %w", err)
+		}
+	case ExportFormatJKSTruststore:
+		caCerts, err := findChainCACerts(ancestors)
+		if err != nil {
+			return nil, fmt.Errorf("find ca certs failed: %w", err)
+		}
+		if len(caCerts) == 0 {
+			return nil, fmt.Errorf("no ca certificate in chain")
+		}
+		for _, cert := range caCerts {
+			block, _ := pem.Decode([]byte(cert.CertPem))
+			if block == nil {
+				return nil, fmt.Errorf("decode certPem of cert %d failed", cert.ID)
+			}
+			alias, err := storeAlias(cert, aliases)
+			if err != nil {
+				return nil, err
+			}
+			err = ks.SetTrustedCertificateEntry(alias, keystore.TrustedCertificateEntry{
+				CreationTime: now,
+				Certificate:  keystore.Certificate{Type: "X509", Content: block.Bytes},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("set trusted certificate entry failed: %w", err)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported jks format: %s", req.Format)
+	}
+
+	var buf bytes.Buffer
+	if err := ks.Store(&buf, password); err != nil {
+		return nil, fmt.Errorf("store keystore failed: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func exportP12Truststore(ancestors []*ent.Certificate, req ExportCertReq) ([]byte, error) {
+	if req.Password == "" {
+		return nil, fmt.Errorf("store password is required")
+	}
+	caCerts, err := findChainCACerts(ancestors)
+	if err != nil {
+		return nil, fmt.Errorf("find ca certs failed: %w", err)
+	}
+	if len(caCerts) == 0 {
+		return nil, fmt.Errorf("no ca certificate in chain")
+	}
+	aliases := make(map[string]bool)
+	var entries []pkcs12.TrustStoreEntry
+	for _, cert := range caCerts {
+		x509Cert, err := getCertFromPem(cert.CertPem)
+		if err != nil {
+			return nil, fmt.Errorf("get cert %d from pem failed: %w", cert.ID, err)
+		}
+		alias, err := storeAlias(cert, aliases)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, pkcs12.TrustStoreEntry{Cert: x509Cert, FriendlyName: alias})
+	}
+	return pkcs12.Modern.EncodeTrustStoreEntries(entries, req.Password)
+}
+
+// storeAlias returns the lower-cased common name of cert, made unique within
+// aliases by appending the certificate id.
+func storeAlias(cert *ent.Certificate, aliases map[string]bool) (string, error) {
+	x509Cert, err := getCertFromPem(cert.CertPem)
+	if err != nil {
+		return "", fmt.Errorf("get cert %d from pem failed: %w", cert.ID, err)
+	}
+	alias := strings.ToLower(strings.TrimSpace(x509Cert.Subject.CommonName))
+	if alias == "" {
+		alias = fmt.Sprintf("certificate-%d", cert.ID)
+	}
+	if aliases[alias] {
+		alias = fmt.Sprintf("%s-%d", alias, cert.ID)
+	}
+	aliases[alias] = true
+	return alias, nil
+}
+
+var k8sNameInvalidChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// k8sResourceName turns a common name into a DNS-1123 subdomain usable as
+// metadata.name, falling back to the certificate id.
+func k8sResourceName(commonName string, id int) string {
+	name := strings.ToLower(commonName)
+	name = strings.ReplaceAll(name, "*", "wildcard")
+	name = k8sNameInvalidChars.ReplaceAllString(name, "-")
+	name = strings.Trim(name, ".-")
+	if len(name) > 253 {
+		name = strings.Trim(name[:253], ".-")
+	}
+	if name == "" {
+		return fmt.Sprintf("certificate-%d", id)
+	}
+	return name
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/pavlo-v-chernykh/keystore-go/v4"
+	"gopkg.in/yaml.v3"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func TestExportK8sSecret(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	_, root, inter, leaf := createTestChain(t, sctx)
+	certs := NewCertificateService(sctx)
+
+	file, err := certs.ExportCertificate(ctx, leaf.ID, ExportCertReq{
+		Format:    ExportFormatK8sSecret,
+		Namespace: "prod",
+		Labels:    map[string]string{"app": "api"},
+	})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	var secret k8sSecret
+	if err := yaml.Unmarshal(file.Data, &secret); err != nil {
+		t.Fatalf("parse manifest: %v", err)
+	}
+	if secret.Kind != "Secret" || secret.Type != "kubernetes.io/tls" {
+		t.Fatalf("got %s of type %s", secret.Kind, secret.Type)
+	}
+	if secret.Metadata.Name != "api.internal" || secret.Metadata.Namespace != "prod" || secret.Metadata.Labels["app"] != "api" {
+		t.Fatalf("unexpected metadata %+v", secret.Metadata)
+	}
+	decode := func(key string) string {
+		t.Helper()
+		data, err := base64.StdEncoding.DecodeString(secret.Data[key])
+		if err != nil {
+			t.Fatalf("decode %s: %v", key, err)
+		}
+		return string(data)
+	}
+	if got, want := decode("tls.crt"), leaf.CertPem+inter.CertPem; got != want {
+		t.Fatalf("tls.crt holds %q, want the leaf and the intermediate", got)
+	}
+	if got := decode("ca.crt"); got != root.CertPem {
+		t.Fatalf("ca.crt holds %q, want the root", got)
+	}
+	keyPem, err := certs.RevealPrivateKey(ctx, leaf.ID, RevealKeyReq{Reason: "test"})
+	if err != nil {
+		t.Fatalf("reveal: %v", err)
+	}
+	if decode("tls.key") != keyPem {
+		t.Fatal("tls.key differs from the leaf key")
+	}
+}
+
+func TestExportK8sCABundle(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	_, root, inter, leaf := createTestChain(t, sctx)
+	certs := NewCertificateService(sctx)
+
+	file, err := certs.ExportCertificate(ctx, leaf.ID, ExportCertReq{Format: ExportFormatK8sCAConfigMap, Name: "trust"})
+	if err != nil {
+		t.Fatalf("export configmap: %v", err)
+	}
+	var cm k8sConfigMap
+	if err := yaml.Unmarshal(file.Data, &cm); err != nil {
+		t.Fatalf("parse configmap: %v", err)
+	}
+	if cm.Kind != "ConfigMap" || cm.Metadata.Name != "trust" {
+		t.Fatalf("got %s named %s", cm.Kind, cm.Metadata.Name)
+	}
+	if cm.Data["ca.crt"] != inter.CertPem+root.CertPem {
+		t.Fatalf("ca.crt holds %q, want the intermediate and the root", cm.Data["ca.crt"])
+	}
+
+	file, err = certs.ExportCertificate(ctx, leaf.ID, ExportCertReq{Format: ExportFormatK8sCASecret})
+	if err != nil {
+		t.Fatalf("export ca secret: %v", err)
+	}
+	var secret k8sSecret
+	if err := yaml.Unmarshal(file.Data, &secret); err != nil {
+		t.Fatalf("parse secret: %v", err)
+	}
+	if secret.Type != "Opaque" || len(secret.Data) != 1 {
+		t.Fatalf("ca secret of type %s holds %d entries", secret.Type, len(secret.Data))
+	}
+	if strings.Contains(string(file.Data), "tls.key") {
+		t.Fatal("ca secret contains a key")
+	}
+}
+
+func TestK8sResourceName(t *testing.T) {
+	tests := map[string]string{
+		"api.example.com":   "api.example.com",
+		"*.Example.COM":     "wildcard.example.com",
+		"My Service (prod)": "my-service-prod",
+		"--":                "certificate-7",
+		"":                  "certificate-7",
+	}
+	for commonName, want := range tests {
+		if got := k8sResourceName(commonName, 7); got != want {
+			t.Errorf("k8sResourceName(%q) = %q, want %q", commonName, got, want)
+		}
+	}
+	if got := k8sResourceName(strings.Repeat("a", 300), 7); len(got) != 253 {
+		t.Errorf("long name has length %d, want 253", len(got))
+	}
+}
+
+func TestExportJKSKeystore(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	_, root, inter, leaf := createTestChain(t, sctx)
+	certs := NewCertificateService(sctx)
+
+	if _, err := certs.ExportCertificate(ctx, leaf.ID, ExportCertReq{Format: ExportFormatJKSKeystore}); err == nil {
+		t.Fatal("export without a store password succeeded")
+	}
+	file, err := certs.ExportCertificate(ctx, leaf.ID, ExportCertReq{Format: ExportFormatJKSKeystore, Password: "changeit"})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	ks := keystore.New()
+	if err := ks.Load(bytes.NewReader(file.Data), []byte("changeit")); err != nil {
+		t.Fatalf("load keystore: %v", err)
+	}
+	entry, err := ks.GetPrivateKeyEntry("api.internal", []byte("changeit"))
+	if err != nil {
+		t.Fatalf("get key entry: %v", err)
+	}
+	keyPem, err := certs.RevealPrivateKey(ctx, leaf.ID, RevealKeyReq{Reason: "test"})
+	if err != nil {
+		t.Fatalf("reveal: %v", err)
+	}
+	keyBlock, _ := pem.Decode([]byte(keyPem))
+	if !bytes.Equal(entry.PrivateKey, keyBlock.Bytes) {
+		t.Fatal("keystore holds another key")
+	}
+	var chain []string
+	for _, cert := range entry.CertificateChain {
+		chain = append(chain, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Content})))
+	}
+	if !slices.Equal(chain, []string{leaf.CertPem, inter.CertPem, root.CertPem}) {
+		t.Fatal("keystore chain is not leaf, intermediate, root")
+	}
+}
+
+func TestExportTruststores(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	_, root, inter, leaf := createTestChain(t, sctx)
+	certs := NewCertificateService(sctx)
+
+	file, err := certs.ExportCertificate(ctx, leaf.ID, ExportCertReq{Format: ExportFormatJKSTruststore, Password: "changeit"})
+	if err != nil {
+		t.Fatalf("export jks truststore: %v", err)
+	}
+	ks := keystore.New()
+	if err := ks.Load(bytes.NewReader(file.Data), []byte("changeit")); err != nil {
+		t.Fatalf("load truststore: %v", err)
+	}
+	aliases := ks.Aliases()
+	slices.Sort(aliases)
+	if !slices.Equal(aliases, []string{"inter ca", "root ca"}) {
+		t.Fatalf("truststore aliases %v, want the two CAs", aliases)
+	}
+	for _, alias := range aliases {
+		if !ks.IsTrustedCertificateEntry(alias) {
+			t.Fatalf("%s is not a trusted certificate entry", alias)
+		}
+	}
+
+	file, err = certs.ExportCertificate(ctx, leaf.ID, ExportCertReq{Format: ExportFormatP12Truststore, Password: "changeit"})
+	if err != nil {
+		t.Fatalf("export pkcs12 truststore: %v", err)
+	}
+	trusted, err := pkcs12.DecodeTrustStore(file.Data, "changeit")
+	if err != nil {
+		t.Fatalf("decode pkcs12 truststore: %v", err)
+	}
+	var got []string
+	for _, cert := range trusted {
+		got = append(got, string(x509CertToPem(cert)))
+	}
+	if !slices.Equal(got, []string{inter.CertPem, root.CertPem}) {
+		t.Fatal("pkcs12 truststore does not hold exactly the intermediate and the root")
+	}
+	if _, err := pkcs12.DecodeTrustStore(file.Data, "wrong"); err == nil {
+		t.Fatal("pkcs12 truststore opened with a wrong password")
+	}
+}
+
+func TestStoreAliasIsUnique(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	_, root, _, _ := createTestChain(t, sctx)
+	cert := sctx.client.Certificate.GetX(ctx, root.ID)
+
+	aliases := make(map[string]bool)
+	first, err := storeAlias(cert, aliases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := storeAlias(cert, aliases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != "root ca" || second == first {
+		t.Fatalf("aliases %q and %q", first, second)
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/logeable/certmgr/internal/ent"
+)
+
+// ErrKeyRevealDisabled is returned for certificates whose namespace does not
+// allow revealing private keys.
+var ErrKeyRevealDisabled = errors.New("revealing private keys is disabled in this namespace")
+
+// RevealKeyReq carries why the key is needed, it is kept in the audit log.
+type RevealKeyReq struct {
+	Reason string `json:"reason"`
+}
+
+// RevealPrivateKey returns the plain PEM of a certificate's private key. Every
+// attempt is audited, refused ones included.
+func (s *CertificateService) RevealPrivateKey(ctx context.Context, id int, req RevealKeyReq) (_ string, err error) {
+	ctx = withAuditOperation(ctx, "certificate.reveal_key", req)
+	defer s.ctx.auditFailure(ctx, "certificate", id, &err)
+	cert, err := s.ctx.client.Certificate.Get(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("get cert failed: %w", err)
+	}
+	ns, err := s.ctx.client.Namespace.Get(ctx, cert.NamespaceID)
+	if err != nil {
+		return "", fmt.Errorf("get namespace %d failed: %w", cert.NamespaceID, err)
+	}
+	switch {
+	case ns.KeyRevealDisabled:
+		return "", ErrKeyRevealDisabled
+	case cert.Offline:
+		return "", fmt.Errorf("private key of cert %d is offline", id)
+	case cert.KeyRef != "":
+		return "", fmt.Errorf("private key of cert %d is held by a key store", id)
+	case cert.KeyPem == "":
+		return "", fmt.Errorf("cert %d has no private key", id)
+	case !cert.KeyExportable:
+		return "", fmt.Errorf("private key of cert %d is not exportable", id)
+	}
+
+	keyPem, err := s.ctx.keyring.Open(cert.KeyPem, certKeyAAD(cert.ID))
+	if err != nil {
+		return "", fmt.Errorf("open key of cert %d failed: %w", id, err)
+	}
+	if err := s.ctx.auditRead(ctx, "certificate", id, cert.NamespaceID); err != nil {
+		return "", err
+	}
+	return keyPem, nil
+}
+
+// keyRevealable tells whether RevealPrivateKey would hand out cert's key.
+func (s *CertificateService) keyRevealable(ctx context.Context, cert *ent.Certificate) (bool, error) {
+	if cert.Offline || cert.KeyRef != "" || cert.KeyPem == "" || !cert.KeyExportable {
+		return false, nil
+	}
+	ns, err := s.ctx.client.Namespace.Get(ctx, cert.NamespaceID)
+	if err != nil {
+		return false, fmt.Errorf("get namespace %d failed: %w", cert.NamespaceID, err)
+	}
+	return !ns.KeyRevealDisabled, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/logeable/certmgr/internal/ent"
+)
+
+func TestKeyRevealDisabledGovernsExports(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, _, _, leaf := createTestChain(t, sctx)
+	if _, err := NewNamespaceService(sctx).UpdateNamespace(ctx, ns.ID, ent.Namespace{Name: ns.Name, KeyRevealDisabled: true}); err != nil {
+		t.Fatalf("update namespace: %v", err)
+	}
+
+	certs := NewCertificateService(sctx)
+	if _, err := certs.RevealPrivateKey(ctx, leaf.ID, RevealKeyReq{Reason: "test"}); !errors.Is(err, ErrKeyRevealDisabled) {
+		t.Fatalf("reveal returned %v", err)
+	}
+	for _, format := range []string{ExportFormatTar, ExportFormatK8sSecret, ExportFormatJKSKeystore} {
+		if _, err := certs.ExportCertificate(ctx, leaf.ID, ExportCertReq{Format: format, Password: "changeit"}); !errors.Is(err, ErrKeyRevealDisabled) {
+			t.Fatalf("export %s returned %v", format, err)
+		}
+	}
+	if _, err := certs.ExportCertificate(ctx, leaf.ID, ExportCertReq{Format: ExportFormatK8sCAConfigMap}); err != nil {
+		t.Fatalf("export without key: %v", err)
+	}
+
+	// The full backup keeps the keys, so a replace restore does not lose them.
+	backups := NewBackupService(sctx)
+	if _, err := backups.Backup(ctx, ""); err == nil {
+		t.Fatal("backup holding keys succeeded without a passphrase")
+	}
+	data, err := backups.Backup(ctx, "backup-passphrase")
+	if err != nil {
+		t.Fatalf("backup: %v", err)
+	}
+	archive, err := decodeArchive(data, "backup-passphrase")
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	for _, cert := range archive.Certificates {
+		if cert.KeyPem == "" {
+			t.Fatalf("backup lacks the key of cert %d", cert.ID)
+		}
+	}
+	if _, err := backups.Restore(ctx, data, RestoreModeReplace, "backup-passphrase"); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	restored := sctx.client.Certificate.GetX(ctx, leaf.ID)
+	if restored.KeyPem == "" {
+		t.Fatal("restore lost the key")
+	}
+	if _, err := certs.RevealPrivateKey(ctx, leaf.ID, RevealKeyReq{Reason: "test"}); !errors.Is(err, ErrKeyRevealDisabled) {
+		t.Fatalf("reveal after restore returned %v", err)
+	}
+
+	_, data, err = NewNamespaceService(sctx).ExportNamespaceBundle(ctx, ns.ID, "")
+	if err != nil {
+		t.Fatalf("export bundle: %v", err)
+	}
+	if archive, err = decodeArchive(data, ""); err != nil {
+		t.Fatalf("decode bundle: %v", err)
+	}
+	for _, cert := range archive.Certificates {
+		if cert.KeyPem != "" {
+			t.Fatalf("bundle contains the key of cert %d", cert.ID)
+		}
+	}
+}
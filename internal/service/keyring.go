@@ -0,0 +1,534 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/keyring"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	encryptedKeyPemType = "CERTMGR ENCRYPTED PRIVATE KEY"
+	wrappedKeyHeader    = "Wrapped-Key"
+
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+	masterKeyLen  = 32
+)
+
+var keyringCheckPlaintext = []byte("certmgr keyring check")
+
+// MasterKeySource tells how to obtain the master key: either a passphrase
+// stretched with Argon2id, or a key file holding 32 raw, hex or base64 bytes.
+type MasterKeySource struct {
+	Passphrase string
+	KeyFile    string
+}
+
+func (src MasterKeySource) IsZero() bool {
+	return src.Passphrase == "" && src.KeyFile == ""
+}
+
+// Keyring seals private keys with envelope encryption: every key gets a random
+// data key, and the data key is wrapped by the master key. A nil master key
+// means encryption is disabled and keys are stored as plain PEM.
+type Keyring struct {
+	masterKey []byte
+}
+
+func (k *Keyring) Enabled() bool {
+	return k != nil && k.masterKey != nil
+}
+
+// Seal encrypts keyPem with aad as additional authenticated data. aad names
+// the row the key is stored in, see certKeyAAD, so that a key copied to
+// another row no longer opens.
+func (k *Keyring) Seal(keyPem string, aad string) (string, error) {
+	if !k.Enabled() || keyPem == "" {
+		return keyPem, nil
+	}
+	dataKey := make([]byte, masterKeyLen)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", fmt.Errorf("generate data key failed: %w", err)
+	}
+	ciphertext, err := aesGCMSeal(dataKey, []byte(keyPem), []byte(aad))
+	if err != nil {
+		return "", fmt.Errorf("encrypt key failed: %w", err)
+	}
+	wrapped, err := aesGCMSeal(k.masterKey, dataKey, nil)
+	if err != nil {
+		return "", fmt.Errorf("wrap data key failed: %w", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:    encryptedKeyPemType,
+		Headers: map[string]string{wrappedKeyHeader: base64.StdEncoding.EncodeToString(wrapped)},
+		Bytes:   ciphertext,
+	})), nil
+}
+
+// Open decrypts a key sealed for aad. It fails when the key was sealed for
+// another row.
+func (k *Keyring) Open(stored string, aad string) (string, error) {
+	block, dataKey, err := k.unwrap(stored)
+	if err != nil {
+		return "", err
+	}
+	if block == nil {
+		return stored, nil
+	}
+	plaintext, err := aesGCMOpen(dataKey, block.Bytes, []byte(aad))
+	if err != nil {
+		return "", fmt.Errorf("decrypt key failed: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// bind re-seals a key that was sealed without additional data for aad. Keys
+// already bound are returned unchanged.
+func (k *Keyring) bind(stored string, aad string) (string, error) {
+	if _, err := k.Open(stored, aad); err == nil {
+		return stored, nil
+	}
+	keyPem, err := k.Open(stored, "")
+	if err != nil {
+		return "", err
+	}
+	return k.Seal(keyPem, aad)
+}
+
+// certKeyAAD binds a sealed key to the certificate row holding it.
+func certKeyAAD(id int) string {
+	return "certificate:" + strconv.Itoa(id)
+}
+
+// signingRequestKeyAAD binds a sealed key to the signing request holding it
+// until the request is completed.
+func signingRequestKeyAAD(id int) string {
+	return "signing_request:" + strconv.Itoa(id)
+}
+
+// storeCertKey seals keyPem for certificate id and stores it. A row only has
+// an ID once inserted, so new certificates are saved first and get their key
+// afterwards in the same transaction.
+func (sctx *ServiceContext) storeCertKey(ctx context.Context, client *ent.Client, id int, keyPem string) error {
+	if keyPem == "" {
+		return nil
+	}
+	sealed, err := sctx.keyring.Seal(keyPem, certKeyAAD(id))
+	if err != nil {
+		return fmt.Errorf("seal key of cert %d failed: %w", id, err)
+	}
+	if err := client.Certificate.UpdateOneID(id).SetKeyPem(sealed).Exec(ctx); err != nil {
+		return fmt.Errorf("store key of cert %d failed: %w", id, err)
+	}
+	return nil
+}
+
+// Rewrap re-encrypts the data key of stored under next without touching the
+// key ciphertext itself, so it stays bound to its row. Plain PEM is sealed by
+// next for aad.
+func (k *Keyring) Rewrap(stored string, aad string, next *Keyring) (string, error) {
+	block, dataKey, err := k.unwrap(stored)
+	if err != nil {
+		return "", err
+	}
+	if block == nil {
+		return next.Seal(stored, aad)
+	}
+	wrapped, err := aesGCMSeal(next.masterKey, dataKey, nil)
+	if err != nil {
+		return "", fmt.Errorf("wrap data key failed: %w", err)
+	}
+	block.Headers[wrappedKeyHeader] = base64.StdEncoding.EncodeToString(wrapped)
+	return string(pem.EncodeToMemory(block)), nil
+}
+
+// unwrap returns a nil block when stored is not an encrypted key.
+func (k *Keyring) unwrap(stored string) (*pem.Block, []byte, error) {
+	if !isEncryptedKeyPem(stored) {
+		return nil, nil, nil
+	}
+	if !k.Enabled() {
+		return nil, nil, fmt.Errorf("keyring is locked")
+	}
+	block, _ := pem.Decode([]byte(stored))
+	if block == nil {
+		return nil, nil, fmt.Errorf("decode encrypted key failed")
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(block.Headers[wrappedKeyHeader])
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode wrapped key failed: %w", err)
+	}
+	dataKey, err := aesGCMOpen(k.masterKey, wrapped, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unwrap data key failed: %w", err)
+	}
+	return block, dataKey, nil
+}
+
+func isEncryptedKeyPem(keyPem string) bool {
+	return strings.HasPrefix(strings.TrimSpace(keyPem), "-----BEGIN "+encryptedKeyPemType+"-----")
+}
+
+// Unlock loads the keyring metadata and derives the master key from src. When
+// the database has no keyring yet and src is given, a new keyring is created;
+// without src the service runs with encryption disabled.
+func (sctx *ServiceContext) Unlock(ctx context.Context, src MasterKeySource) error {
+	kr, err := sctx.client.Keyring.Query().Order(ent.Desc(keyring.FieldID)).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("query keyring failed: %w", err)
+	}
+	if kr == nil {
+		if src.IsZero() {
+			return nil
+		}
+		created, masterKey, err := newKeyring(src)
+		if err != nil {
+			return fmt.Errorf("create keyring failed: %w", err)
+		}
+		err = sctx.withTx(ctx, func(tx *ent.Tx) error {
+			return tx.Keyring.Create().
+				SetSource(created.Source).
+				SetSalt(created.Salt).
+				SetKdfTime(created.KdfTime).
+				SetKdfMemory(created.KdfMemory).
+				SetKdfThreads(created.KdfThreads).
+				SetCheck(created.Check).
+				SetKeysBound(true).
+				Exec(ctx)
+		})
+		if err != nil {
+			return fmt.Errorf("save keyring failed: %w", err)
+		}
+		sctx.keyring = &Keyring{masterKey: masterKey}
+		return nil
+	}
+
+	if src.IsZero() {
+		return fmt.Errorf("private keys are encrypted, a passphrase or key file is required")
+	}
+	masterKey, err := deriveMasterKey(kr, src)
+	if err != nil {
+		return fmt.Errorf("derive master key failed: %w", err)
+	}
+	sctx.keyring = &Keyring{masterKey: masterKey}
+	if !kr.KeysBound {
+		if err := sctx.bindSealedKeys(ctx, kr.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindSealedKeys re-seals the keys encrypted before keys were bound to their
+// rows. It runs once per database, keys_bound records that it is done.
+func (sctx *ServiceContext) bindSealedKeys(ctx context.Context, keyringID int) error {
+	ctx = withAuditOperation(ctx, "keyring.bind_keys", nil)
+	encrypted := "-----BEGIN " + encryptedKeyPemType
+	err := sctx.withTx(ctx, func(tx *ent.Tx) error {
+		certs, err := tx.Certificate.Query().Where(certificate.KeyPemHasPrefix(encrypted)).All(ctx)
+		if err != nil {
+			return fmt.Errorf("query keys failed: %w", err)
+		}
+		for _, cert := range certs {
+			bound, err := sctx.keyring.bind(cert.KeyPem, certKeyAAD(cert.ID))
+			if err != nil {
+				return fmt.Errorf("bind key of cert %d failed: %w", cert.ID, err)
+			}
+			err = tx.Certificate.UpdateOneID(cert.ID).SetKeyPem(bound).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("update key of cert %d failed: %w", cert.ID, err)
+			}
+		}
+		srs, err := tx.SigningRequest.Query().Where(signingrequest.KeyPemHasPrefix(encrypted)).All(ctx)
+		if err != nil {
+			return fmt.Errorf("query keys of signing requests failed: %w", err)
+		}
+		for _, sr := range srs {
+			bound, err := sctx.keyring.bind(sr.KeyPem, signingRequestKeyAAD(sr.ID))
+			if err != nil {
+				return fmt.Errorf("bind key of signing request %d failed: %w", sr.ID, err)
+			}
+			err = tx.SigningRequest.UpdateOneID(sr.ID).SetKeyPem(bound).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("update key of signing request %d failed: %w", sr.ID, err)
+			}
+		}
+		return tx.Keyring.UpdateOneID(keyringID).SetKeysBound(true).Exec(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("bind sealed keys failed: %w", err)
+	}
+	return nil
+}
+
+// EncryptExistingKeys seals every private key still stored as plain PEM. It
+// is a no-op while encryption is disabled.
+func (sctx *ServiceContext) EncryptExistingKeys(ctx context.Context) (_ int, err error) {
+	ctx = withAuditOperation(ctx, "keyring.encrypt_existing_keys", nil)
+	defer sctx.auditFailure(ctx, "keyring", 0, &err)
+	if !sctx.keyring.Enabled() {
+		return 0, nil
+	}
+	var count int
+	err = sctx.withTx(ctx, func(tx *ent.Tx) error {
+		certs, err := tx.Certificate.Query().
+			Where(certificate.KeyPemNEQ(""), certificate.Not(certificate.KeyPemHasPrefix("-----BEGIN "+encryptedKeyPemType))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query plaintext keys failed: %w", err)
+		}
+		for _, cert := range certs {
+			sealed, err := sctx.keyring.Seal(cert.KeyPem, certKeyAAD(cert.ID))
+			if err != nil {
+				return fmt.Errorf("seal key of cert %d failed: %w", cert.ID, err)
+			}
+			err = tx.Certificate.UpdateOneID(cert.ID).SetKeyPem(sealed).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("update key of cert %d failed: %w", cert.ID, err)
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("encrypt existing keys failed: %w", err)
+	}
+	return count, nil
+}
+
+// RotateMasterKey re-wraps every data key under a master key obtained from
+// next and replaces the keyring metadata in the same transaction.
+func (sctx *ServiceContext) RotateMasterKey(ctx context.Context, next MasterKeySource) (_ int, err error) {
+	ctx = withAuditOperation(ctx, "keyring.rotate", map[string]any{"keyFile": next.KeyFile != "", "passphrase": next.Passphrase != ""})
+	defer sctx.auditFailure(ctx, "keyring", 0, &err)
+	if !sctx.keyring.Enabled() {
+		return 0, fmt.Errorf("keyring is not unlocked")
+	}
+	created, masterKey, err := newKeyring(next)
+	if err != nil {
+		return 0, fmt.Errorf("create keyring failed: %w", err)
+	}
+	nextKeyring := &Keyring{masterKey: masterKey}
+
+	var count int
+	err = sctx.withTx(ctx, func(tx *ent.Tx) error {
+		certs, err := tx.Certificate.Query().Where(certificate.KeyPemNEQ("")).All(ctx)
+		if err != nil {
+			return fmt.Errorf("query keys failed: %w", err)
+		}
+		for _, cert := range certs {
+			rewrapped, err := sctx.keyring.Rewrap(cert.KeyPem, certKeyAAD(cert.ID), nextKeyring)
+			if err != nil {
+				return fmt.Errorf("rewrap key of cert %d failed: %w", cert.ID, err)
+			}
+			err = tx.Certificate.UpdateOneID(cert.ID).SetKeyPem(rewrapped).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("update key of cert %d failed: %w", cert.ID, err)
+			}
+			count++
+		}
+		srs, err := tx.SigningRequest.Query().Where(signingrequest.KeyPemNEQ("")).All(ctx)
+		if err != nil {
+			return fmt.Errorf("query keys of signing requests failed: %w", err)
+		}
+		for _, sr := range srs {
+			rewrapped, err := sctx.keyring.Rewrap(sr.KeyPem, signingRequestKeyAAD(sr.ID), nextKeyring)
+			if err != nil {
+				return fmt.Errorf("rewrap key of signing request %d failed: %w", sr.ID, err)
+			}
+			err = tx.SigningRequest.UpdateOneID(sr.ID).SetKeyPem(rewrapped).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("update key of signing request %d failed: %w", sr.ID, err)
+			}
+			count++
+		}
+		_, err = tx.Keyring.Delete().Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete old keyring failed: %w", err)
+		}
+		err = tx.Keyring.Create().
+			SetSource(created.Source).
+			SetSalt(created.Salt).
+			SetKdfTime(created.KdfTime).
+			SetKdfMemory(created.KdfMemory).
+			SetKdfThreads(created.KdfThreads).
+			SetCheck(created.Check).
+			SetKeysBound(true).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("save keyring failed: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("rotate master key failed: %w", err)
+	}
+	sctx.keyring = nextKeyring
+	return count, nil
+}
+
+func newKeyring(src MasterKeySource) (*ent.Keyring, []byte, error) {
+	kr := &ent.Keyring{}
+	var masterKey []byte
+	switch {
+	case src.KeyFile != "":
+		key, err := readKeyFile(src.KeyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		kr.Source = keyring.SourceKeyfile
+		masterKey = key
+	case src.Passphrase != "":
+		salt := make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, nil, fmt.Errorf("generate salt failed: %w", err)
+		}
+		kr.Source = keyring.SourcePassphrase
+		kr.Salt = salt
+		kr.KdfTime = argon2Time
+		kr.KdfMemory = argon2Memory
+		kr.KdfThreads = argon2Threads
+		masterKey = argon2.IDKey([]byte(src.Passphrase), salt, kr.KdfTime, kr.KdfMemory, kr.KdfThreads, masterKeyLen)
+	default:
+		return nil, nil, fmt.Errorf("no master key source given")
+	}
+	check, err := aesGCMSeal(masterKey, keyringCheckPlaintext, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("seal check value failed: %w", err)
+	}
+	kr.Check = check
+	return kr, masterKey, nil
+}
+
+func deriveMasterKey(kr *ent.Keyring, src MasterKeySource) ([]byte, error) {
+	var masterKey []byte
+	switch kr.Source {
+	case keyring.SourceKeyfile:
+		if src.KeyFile == "" {
+			return nil, fmt.Errorf("keyring was created from a key file")
+		}
+		key, err := readKeyFile(src.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		masterKey = key
+	case keyring.SourcePassphrase:
+		if src.Passphrase == "" {
+			return nil, fmt.Errorf("keyring was created from a passphrase")
+		}
+		masterKey = argon2.IDKey([]byte(src.Passphrase), kr.Salt, kr.KdfTime, kr.KdfMemory, kr.KdfThreads, masterKeyLen)
+	default:
+		return nil, fmt.Errorf("unsupported keyring source: %s", kr.Source)
+	}
+	check, err := aesGCMOpen(masterKey, kr.Check, nil)
+	if err != nil || !bytes.Equal(check, keyringCheckPlaintext) {
+		return nil, fmt.Errorf("wrong master key")
+	}
+	return masterKey, nil
+}
+
+func readKeyFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read key file failed: %w", err)
+	}
+	if len(data) == masterKeyLen {
+		return data, nil
+	}
+	text := strings.TrimSpace(string(data))
+	if key, err := hex.DecodeString(text); err == nil && len(key) == masterKeyLen {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(text); err == nil && len(key) == masterKeyLen {
+		return key, nil
+	}
+	return nil, fmt.Errorf("key file must contain %d raw, hex or base64 encoded bytes", masterKeyLen)
+}
+
+// GenerateKeyFile writes a new random master key, hex encoded, to path.
+func GenerateKeyFile(path string) error {
+	key := make([]byte, masterKeyLen)
+	if _, err := rand.Read(key); err != nil {
+		return fmt.Errorf("generate key failed: %w", err)
+	}
+	err := os.WriteFile(path, []byte(hex.EncodeToString(key)+"\n"), 0600)
+	if err != nil {
+		return fmt.Errorf("write key file failed: %w", err)
+	}
+	return nil
+}
+
+// passphraseSeal encrypts data under a key stretched from passphrase with a
+// fresh salt. The result is salt || nonce || ciphertext.
+func passphraseSeal(passphrase string, data []byte) ([]byte, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("generate salt failed: %w", err)
+	}
+	key := argon2.IDKey([]byte(passphrase), salt, argon2Time, argon2Memory, argon2Threads, masterKeyLen)
+	sealed, err := aesGCMSeal(key, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	return append(salt, sealed...), nil
+}
+
+func passphraseOpen(passphrase string, sealed []byte) ([]byte, error) {
+	if len(sealed) < 16 {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	key := argon2.IDKey([]byte(passphrase), sealed[:16], argon2Time, argon2Memory, argon2Threads, masterKeyLen)
+	data, err := aesGCMOpen(key, sealed[16:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("wrong passphrase or corrupted data")
+	}
+	return data, nil
+}
+
+func aesGCMSeal(key, plaintext, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func aesGCMOpen(key, ciphertext, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, aad)
+}
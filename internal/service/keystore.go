@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+	"go.uber.org/zap"
+)
+
+const DatabaseKeyBackend = "database"
+
+// KeySpec describes the key to generate.
+type KeySpec struct {
+	KeyType  string
+	KeyLen   int
+	ECCCurve string
+}
+
+// GeneratedKey is a freshly created key. KeyPem is the plain PKCS#8 PEM for
+// keys kept in the database, and empty for keys that never leave their backend,
+// which are addressed by KeyRef instead.
+type GeneratedKey struct {
+	Signer crypto.Signer
+	KeyRef string
+	KeyPem string
+}
+
+// KeyStore creates private keys and hands out signers for them, so callers
+// never need the raw bytes of a CA key. DeleteKey removes a generated key that
+// no certificate ended up using.
+type KeyStore interface {
+	GenerateKey(ctx context.Context, spec KeySpec) (*GeneratedKey, error)
+	Signer(ctx context.Context, cert *ent.Certificate) (crypto.Signer, error)
+	DeleteKey(ctx context.Context, keyRef string) error
+}
+
+// RegisterKeyStore makes ks available under name. Certificates whose key_ref
+// starts with "name:" are signed through it.
+func (sctx *ServiceContext) RegisterKeyStore(name string, ks KeyStore) {
+	if sctx.keyStores == nil {
+		sctx.keyStores = make(map[string]KeyStore)
+	}
+	sctx.keyStores[name] = ks
+}
+
+func (sctx *ServiceContext) keyStore(name string) (KeyStore, error) {
+	if name == "" || name == DatabaseKeyBackend {
+		return &databaseKeyStore{keyring: sctx.keyring}, nil
+	}
+	ks, ok := sctx.keyStores[name]
+	if !ok {
+		return nil, fmt.Errorf("key backend %s is not configured", name)
+	}
+	return ks, nil
+}
+
+func (sctx *ServiceContext) signerFor(ctx context.Context, cert *ent.Certificate) (crypto.Signer, error) {
+	ks, err := sctx.keyStore(keyRefBackend(cert.KeyRef))
+	if err != nil {
+		return nil, err
+	}
+	return ks.Signer(ctx, cert)
+}
+
+// keyRefBackend returns the backend part of a "backend:id" key reference.
+func keyRefBackend(keyRef string) string {
+	backend, _, _ := strings.Cut(keyRef, ":")
+	return backend
+}
+
+func keyRefID(keyRef string) string {
+	_, id, _ := strings.Cut(keyRef, ":")
+	return id
+}
+
+// databaseKeyStore keeps keys as (optionally encrypted) PEM in key_pem.
+type databaseKeyStore struct {
+	keyring *Keyring
+}
+
+func (ks *databaseKeyStore) GenerateKey(ctx context.Context, spec KeySpec) (*GeneratedKey, error) {
+	key, err := createPrivateKey(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &GeneratedKey{
+		Signer: key.(crypto.Signer),
+		KeyPem: string(PrivateKeyToPem(key)),
+	}, nil
+}
+
+// DeleteKey is a no-op: database keys only exist in the row that holds them.
+func (ks *databaseKeyStore) DeleteKey(ctx context.Context, keyRef string) error {
+	return nil
+}
+
+func (ks *databaseKeyStore) Signer(ctx context.Context, cert *ent.Certificate) (crypto.Signer, error) {
+	if cert.KeyPem == "" {
+		return nil, fmt.Errorf("cert %d has no private key", cert.ID)
+	}
+	keyPem, err := ks.keyring.Open(cert.KeyPem, certKeyAAD(cert.ID))
+	if err != nil {
+		return nil, fmt.Errorf("open key of cert %d failed: %w", cert.ID, err)
+	}
+	key, err := getPrivateKeyFromPem(keyPem)
+	if err != nil {
+		return nil, fmt.Errorf("get private key %d from pem failed: %w", cert.ID, err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("key of cert %d is not a signer", cert.ID)
+	}
+	return signer, nil
+}
+
+// deleteKeys removes keys from their key stores once no row references them,
+// either because the rows were never committed or because they were deleted.
+// Failures are only logged, the keys are unreferenced either way.
+func (sctx *ServiceContext) deleteKeys(ctx context.Context, keyRefs []string) {
+	for _, keyRef := range keyRefs {
+		ks, err := sctx.keyStore(keyRefBackend(keyRef))
+		if err == nil {
+			err = ks.DeleteKey(ctx, keyRef)
+		}
+		if err != nil {
+			zap.L().Error("delete orphaned key failed", zap.String("keyRef", keyRef), zap.Error(err))
+		}
+	}
+}
+
+// unreferencedKeys returns the external keys among keyRefs that no certificate
+// or pending signing request uses. Imported bundles can share a key between
+// rows.
+func unreferencedKeys(ctx context.Context, client *ent.Client, keyRefs []string) ([]string, error) {
+	var external []string
+	for _, keyRef := range keyRefs {
+		if backend := keyRefBackend(keyRef); backend != "" && backend != DatabaseKeyBackend {
+			external = append(external, keyRef)
+		}
+	}
+	if len(external) == 0 {
+		return nil, nil
+	}
+	certRefs, err := client.Certificate.Query().
+		Where(certificate.KeyRefIn(external...)).
+		Select(certificate.FieldKeyRef).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query certificate key refs failed: %w", err)
+	}
+	srRefs, err := client.SigningRequest.Query().
+		Where(signingrequest.KeyRefIn(external...), signingrequest.StatusEQ(signingrequest.StatusPending)).
+		Select(signingrequest.FieldKeyRef).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query signing request key refs failed: %w", err)
+	}
+	var result []string
+	for _, keyRef := range external {
+		if !slices.Contains(certRefs, keyRef) && !slices.Contains(srRefs, keyRef) {
+			result = append(result, keyRef)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ThalesGroup/crypto11"
+	"github.com/logeable/certmgr/internal/ent"
+)
+
+const PKCS11KeyBackend = "pkcs11"
+
+type PKCS11Config struct {
+	ModulePath string
+	SlotNumber int
+	Pin        string
+}
+
+// PKCS11KeyStore generates keys on a PKCS#11 token and signs through it. The
+// certificate row only keeps the CKA_ID of the key pair as "pkcs11:<hex id>".
+type PKCS11KeyStore struct {
+	ctx *crypto11.Context
+}
+
+func NewPKCS11KeyStore(cfg PKCS11Config) (*PKCS11KeyStore, error) {
+	slot := cfg.SlotNumber
+	ctx, err := crypto11.Configure(&crypto11.Config{
+		Path:       cfg.ModulePath,
+		SlotNumber: &slot,
+		Pin:        cfg.Pin,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("configure pkcs11 failed: %w", err)
+	}
+	return &PKCS11KeyStore{ctx: ctx}, nil
+}
+
+func (ks *PKCS11KeyStore) Close() error {
+	return ks.ctx.Close()
+}
+
+func (ks *PKCS11KeyStore) GenerateKey(ctx context.Context, spec KeySpec) (*GeneratedKey, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("generate key id failed: %w", err)
+	}
+	label := []byte("certmgr-" + hex.EncodeToString(id))
+
+	signer, err := ks.generateKeyPair(id, label, spec)
+	if err != nil {
+		return nil, fmt.Errorf("generate key on token failed: %w", err)
+	}
+	return &GeneratedKey{
+		Signer: signer,
+		KeyRef: PKCS11KeyBackend + ":" + hex.EncodeToString(id),
+	}, nil
+}
+
+func (ks *PKCS11KeyStore) generateKeyPair(id, label []byte, spec KeySpec) (crypto.Signer, error) {
+	switch spec.KeyType {
+	case "RSA":
+		return ks.ctx.GenerateRSAKeyPairWithLabel(id, label, spec.KeyLen)
+	case "ECDSA":
+		curve, err := parseECCCurve(spec.ECCCurve)
+		if err != nil {
+			return nil, err
+		}
+		return ks.ctx.GenerateECDSAKeyPairWithLabel(id, label, curve)
+	default:
+		return nil, fmt.Errorf("unsupported key type for pkcs11: %s", spec.KeyType)
+	}
+}
+
+func (ks *PKCS11KeyStore) DeleteKey(ctx context.Context, keyRef string) error {
+	id, err := hex.DecodeString(keyRefID(keyRef))
+	if err != nil {
+		return fmt.Errorf("decode key id %s failed: %w", keyRef, err)
+	}
+	signer, err := ks.ctx.FindKeyPair(id, nil)
+	if err != nil {
+		return fmt.Errorf("find key pair %s failed: %w", keyRef, err)
+	}
+	if signer == nil {
+		return nil
+	}
+	if err := signer.Delete(); err != nil {
+		return fmt.Errorf("delete key pair %s failed: %w", keyRef, err)
+	}
+	return nil
+}
+
+func (ks *PKCS11KeyStore) Signer(ctx context.Context, cert *ent.Certificate) (crypto.Signer, error) {
+	id, err := hex.DecodeString(keyRefID(cert.KeyRef))
+	if err != nil {
+		return nil, fmt.Errorf("decode key id of cert %d failed: %w", cert.ID, err)
+	}
+	signer, err := ks.ctx.FindKeyPair(id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("find key pair of cert %d failed: %w", cert.ID, err)
+	}
+	if signer == nil {
+		return nil, fmt.Errorf("key %s of cert %d not found in token", cert.KeyRef, cert.ID)
+	}
+	return signer, nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newTestPKCS11KeyStore opens the token named by CERTMGR_TEST_PKCS11_MODULE,
+// CERTMGR_TEST_PKCS11_SLOT and CERTMGR_TEST_PKCS11_PIN, skipping the test
+// when no module is given. With SoftHSM:
+//
+//	softhsm2-util --init-token --free --label certmgr-test --pin 1234 --so-pin 5678
+//	CERTMGR_TEST_PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so \
+//	CERTMGR_TEST_PKCS11_SLOT=<slot> CERTMGR_TEST_PKCS11_PIN=1234 go test ./internal/service -run PKCS11
+func newTestPKCS11KeyStore(t *testing.T) *PKCS11KeyStore {
+	t.Helper()
+	module := os.Getenv("CERTMGR_TEST_PKCS11_MODULE")
+	if module == "" {
+		t.Skip("CERTMGR_TEST_PKCS11_MODULE is not set")
+	}
+	slot, err := strconv.Atoi(os.Getenv("CERTMGR_TEST_PKCS11_SLOT"))
+	if err != nil {
+		t.Fatalf("parse CERTMGR_TEST_PKCS11_SLOT: %v", err)
+	}
+	ks, err := NewPKCS11KeyStore(PKCS11Config{
+		ModulePath: module,
+		SlotNumber: slot,
+		Pin:        os.Getenv("CERTMGR_TEST_PKCS11_PIN"),
+	})
+	if err != nil {
+		t.Fatalf("open pkcs11 key store: %v", err)
+	}
+	t.Cleanup(func() { _ = ks.Close() })
+	return ks
+}
+
+func TestPKCS11KeyStoreIssuesCertificates(t *testing.T) {
+	ks := newTestPKCS11KeyStore(t)
+	sctx := newTestContext(t)
+	sctx.RegisterKeyStore(PKCS11KeyBackend, ks)
+	ctx := context.Background()
+
+	for _, keyType := range []string{"ECDSA", "RSA"} {
+		ns := createTestNamespace(t, sctx, "hsm-"+keyType)
+		rootReq := testCertReq(ns.ID, 0, keyType+" Root CA", true)
+		rootReq.KeyBackend = PKCS11KeyBackend
+		rootReq.KeyType = keyType
+		rootReq.KeyLen = 2048
+		root := createTestCert(t, sctx, rootReq)
+		rootRow := sctx.client.Certificate.GetX(ctx, root.ID)
+		if rootRow.KeyPem != "" || !strings.HasPrefix(rootRow.KeyRef, PKCS11KeyBackend+":") {
+			t.Fatalf("%s root stored key_pem=%q key_ref=%q", keyType, rootRow.KeyPem, rootRow.KeyRef)
+		}
+
+		leaf := createTestCert(t, sctx, testCertReq(ns.ID, root.ID, "hsm.internal", false, "hsm.internal"))
+		leafX509, err := getCertFromPem(leaf.CertPem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rootX509, err := getCertFromPem(root.CertPem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := leafX509.CheckSignatureFrom(rootX509); err != nil {
+			t.Fatalf("leaf is not signed by the %s token key: %v", keyType, err)
+		}
+		if _, err := NewCertificateService(sctx).RevealPrivateKey(ctx, root.ID, RevealKeyReq{Reason: "test"}); err == nil {
+			t.Fatalf("revealed the %s token key", keyType)
+		}
+	}
+}
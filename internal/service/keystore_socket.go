@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"go.uber.org/zap"
+)
+
+const SocketKeyBackend = "socket"
+
+// SignerRequest is one call to an external signing process. The protocol is a
+// single JSON request and a single JSON response per connection.
+type SignerRequest struct {
+	Op         string `json:"op"`
+	KeyID      string `json:"keyId,omitempty"`
+	KeyType    string `json:"keyType,omitempty"`
+	KeyLen     int    `json:"keyLen,omitempty"`
+	ECCCurve   string `json:"eccCurve,omitempty"`
+	Digest     []byte `json:"digest,omitempty"`
+	Hash       string `json:"hash,omitempty"`
+	PSSSaltLen int    `json:"pssSaltLen,omitempty"`
+	PSS        bool   `json:"pss,omitempty"`
+}
+
+type SignerResponse struct {
+	KeyID     string `json:"keyId,omitempty"`
+	PublicKey []byte `json:"publicKey,omitempty"`
+	Signature []byte `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// socketKeyStore delegates key generation and signing to a process listening
+// on a unix socket. Only key ids and public keys cross the socket.
+type socketKeyStore struct {
+	path string
+}
+
+func NewSocketKeyStore(path string) KeyStore {
+	return &socketKeyStore{path: path}
+}
+
+func (ks *socketKeyStore) GenerateKey(ctx context.Context, spec KeySpec) (*GeneratedKey, error) {
+	resp, err := ks.call(ctx, SignerRequest{
+		Op:       "generate",
+		KeyType:  spec.KeyType,
+		KeyLen:   spec.KeyLen,
+		ECCCurve: spec.ECCCurve,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate key failed: %w", err)
+	}
+	pub, err := x509.ParsePKIXPublicKey(resp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key failed: %w", err)
+	}
+	return &GeneratedKey{
+		Signer: &socketSigner{ctx: ctx, ks: ks, keyID: resp.KeyID, pub: pub},
+		KeyRef: SocketKeyBackend + ":" + resp.KeyID,
+	}, nil
+}
+
+func (ks *socketKeyStore) Signer(ctx context.Context, cert *ent.Certificate) (crypto.Signer, error) {
+	keyID := keyRefID(cert.KeyRef)
+	resp, err := ks.call(ctx, SignerRequest{Op: "public", KeyID: keyID})
+	if err != nil {
+		return nil, fmt.Errorf("get public key of %s failed: %w", keyID, err)
+	}
+	pub, err := x509.ParsePKIXPublicKey(resp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key failed: %w", err)
+	}
+	return &socketSigner{ctx: ctx, ks: ks, keyID: keyID, pub: pub}, nil
+}
+
+func (ks *socketKeyStore) DeleteKey(ctx context.Context, keyRef string) error {
+	keyID := keyRefID(keyRef)
+	if _, err := ks.call(ctx, SignerRequest{Op: "delete", KeyID: keyID}); err != nil {
+		return fmt.Errorf("delete key %s failed: %w", keyID, err)
+	}
+	return nil
+}
+
+func (ks *socketKeyStore) call(ctx context.Context, req SignerRequest) (*SignerResponse, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", ks.path)
+	if err != nil {
+		return nil, fmt.Errorf("dial signer failed: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(30 * time.Second))
+	}
+
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return nil, fmt.Errorf("send request failed: %w", err)
+	}
+	var resp SignerResponse
+	if err := json.NewDecoder(conn).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("read response failed: %w", err)
+	}
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+	return &resp, nil
+}
+
+// socketSigner signs through the signing process. crypto.Signer has no context
+// parameter, so it keeps the context of the request that created it.
+type socketSigner struct {
+	ctx   context.Context
+	ks    *socketKeyStore
+	keyID string
+	pub   crypto.PublicKey
+}
+
+func (s *socketSigner) Public() crypto.PublicKey {
+	return s.pub
+}
+
+func (s *socketSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	req := SignerRequest{
+		Op:     "sign",
+		KeyID:  s.keyID,
+		Digest: digest,
+	}
+	if opts.HashFunc() != 0 {
+		req.Hash = opts.HashFunc().String()
+	}
+	if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+		req.PSS = true
+		req.PSSSaltLen = pssOpts.SaltLength
+	}
+	resp, err := s.ks.call(s.ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("remote sign failed: %w", err)
+	}
+	return resp.Signature, nil
+}
+
+var signerKeyIDPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// ServeSocketSigner runs the reference signing process: keys are PKCS#8 PEM
+// files in dir, named after their key id, and never leave this process.
+func ServeSocketSigner(ctx context.Context, ln net.Listener, dir string) error {
+	go func() {
+		<-ctx.Done()
+		_ = ln.Close()
+	}()
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("accept failed: %w", err)
+		}
+		go func() {
+			defer conn.Close()
+			var req SignerRequest
+			if err := json.NewDecoder(conn).Decode(&req); err != nil {
+				zap.L().Error("decode signer request failed", zap.Error(err))
+				return
+			}
+			resp, err := handleSignerRequest(dir, req)
+			if err != nil {
+				zap.L().Error("signer request failed", zap.String("op", req.Op), zap.String("keyId", req.KeyID), zap.Error(err))
+				resp = &SignerResponse{Error: err.Error()}
+			}
+			if err := json.NewEncoder(conn).Encode(resp); err != nil {
+				zap.L().Error("encode signer response failed", zap.Error(err))
+			}
+		}()
+	}
+}
+
+func handleSignerRequest(dir string, req SignerRequest) (*SignerResponse, error) {
+	switch req.Op {
+	case "generate":
+		key, err := createPrivateKey(KeySpec{KeyType: req.KeyType, KeyLen: req.KeyLen, ECCCurve: req.ECCCurve})
+		if err != nil {
+			return nil, fmt.Errorf("create private key failed: %w", err)
+		}
+		idBytes := make([]byte, 16)
+		if _, err := rand.Read(idBytes); err != nil {
+			return nil, fmt.Errorf("generate key id failed: %w", err)
+		}
+		keyID := hex.EncodeToString(idBytes)
+		err = os.WriteFile(filepath.Join(dir, keyID+".pem"), PrivateKeyToPem(key), 0600)
+		if err != nil {
+			return nil, fmt.Errorf("write key failed: %w", err)
+		}
+		pub, err := x509.MarshalPKIXPublicKey(key.(crypto.Signer).Public())
+		if err != nil {
+			return nil, fmt.Errorf("marshal public key failed: %w", err)
+		}
+		return &SignerResponse{KeyID: keyID, PublicKey: pub}, nil
+	case "public", "sign":
+		signer, err := loadSignerKey(dir, req.KeyID)
+		if err != nil {
+			return nil, err
+		}
+		if req.Op == "public" {
+			pub, err := x509.MarshalPKIXPublicKey(signer.Public())
+			if err != nil {
+				return nil, fmt.Errorf("marshal public key failed: %w", err)
+			}
+			return &SignerResponse{KeyID: req.KeyID, PublicKey: pub}, nil
+		}
+		opts, err := signerOptsFromRequest(signer, req)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := signer.Sign(rand.Reader, req.Digest, opts)
+		if err != nil {
+			return nil, fmt.Errorf("sign failed: %w", err)
+		}
+		return &SignerResponse{KeyID: req.KeyID, Signature: signature}, nil
+	case "delete":
+		if !signerKeyIDPattern.MatchString(req.KeyID) {
+			return nil, fmt.Errorf("invalid key id: %s", req.KeyID)
+		}
+		if err := os.Remove(filepath.Join(dir, req.KeyID+".pem")); err != nil {
+			return nil, fmt.Errorf("remove key %s failed: %w", req.KeyID, err)
+		}
+		return &SignerResponse{KeyID: req.KeyID}, nil
+	default:
+		return nil, fmt.Errorf("unsupported op: %s", req.Op)
+	}
+}
+
+func loadSignerKey(dir, keyID string) (crypto.Signer, error) {
+	if !signerKeyIDPattern.MatchString(keyID) {
+		return nil, fmt.Errorf("invalid key id: %s", keyID)
+	}
+	keyPem, err := os.ReadFile(filepath.Join(dir, keyID+".pem"))
+	if err != nil {
+		return nil, fmt.Errorf("read key %s failed: %w", keyID, err)
+	}
+	key, err := getPrivateKeyFromPem(string(keyPem))
+	if err != nil {
+		return nil, fmt.Errorf("parse key %s failed: %w", keyID, err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("key %s is not a signer", keyID)
+	}
+	return signer, nil
+}
+
+func signerOptsFromRequest(signer crypto.Signer, req SignerRequest) (crypto.SignerOpts, error) {
+	var hash crypto.Hash
+	if req.Hash != "" {
+		for _, h := range []crypto.Hash{crypto.SHA1, crypto.SHA256, crypto.SHA384, crypto.SHA512} {
+			if h.String() == req.Hash {
+				hash = h
+			}
+		}
+		if hash == 0 {
+			return nil, fmt.Errorf("unsupported hash: %s", req.Hash)
+		}
+	}
+	if _, ok := signer.(*rsa.PrivateKey); ok && req.PSS {
+		return &rsa.PSSOptions{SaltLength: req.PSSSaltLen, Hash: hash}, nil
+	}
+	if _, ok := signer.(ed25519.PrivateKey); ok && hash != 0 {
+		return nil, fmt.Errorf("ed25519 signs the message without prehash")
+	}
+	return hash, nil
+}
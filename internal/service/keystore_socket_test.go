@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent/certificate"
+)
+
+// serveTestSigner runs the reference signing process on a temporary unix
+// socket, registers it as the socket backend and returns its key directory.
+func serveTestSigner(t *testing.T, sctx *ServiceContext) string {
+	t.Helper()
+	// unix socket paths are limited to about 100 bytes, t.TempDir is too long.
+	sockDir, err := os.MkdirTemp("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(sockDir) })
+	sockPath := filepath.Join(sockDir, "s.sock")
+	ln, err := net.Listen("unix", sockPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = ServeSocketSigner(ctx, ln, keyDir)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	sctx.RegisterKeyStore(SocketKeyBackend, NewSocketKeyStore(sockPath))
+	return keyDir
+}
+
+func signerKeyFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestSocketKeyStoreSigns(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	keyDir := serveTestSigner(t, sctx)
+	ns := createTestNamespace(t, sctx, "socket")
+
+	rootReq := testCertReq(ns.ID, 0, "Root CA", true)
+	rootReq.KeyBackend = SocketKeyBackend
+	root := createTestCert(t, sctx, rootReq)
+	leaf := createTestCert(t, sctx, testCertReq(ns.ID, root.ID, "api.internal", false, "api.internal"))
+
+	rootRow := sctx.client.Certificate.GetX(ctx, root.ID)
+	if rootRow.KeyPem != "" || !strings.HasPrefix(rootRow.KeyRef, SocketKeyBackend+":") {
+		t.Fatalf("root key stored as key_pem=%q key_ref=%q", rootRow.KeyPem, rootRow.KeyRef)
+	}
+	if _, err := os.Stat(filepath.Join(keyDir, keyRefID(rootRow.KeyRef)+".pem")); err != nil {
+		t.Fatalf("root key not in the signer directory: %v", err)
+	}
+	rootCert, err := getCertFromPem(root.CertPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafCert, err := getCertFromPem(leaf.CertPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := leafCert.CheckSignatureFrom(rootCert); err != nil {
+		t.Fatalf("leaf is not signed by the socket root: %v", err)
+	}
+}
+
+func TestSocketSignerOptions(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	serveTestSigner(t, sctx)
+	ks, err := sctx.keyStore(SocketKeyBackend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256([]byte("message"))
+
+	rsaKey, err := ks.GenerateKey(ctx, KeySpec{KeyType: "RSA", KeyLen: 2048})
+	if err != nil {
+		t.Fatalf("generate rsa: %v", err)
+	}
+	pssOpts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+	sig, err := rsaKey.Signer.Sign(rand.Reader, digest[:], pssOpts)
+	if err != nil {
+		t.Fatalf("sign pss: %v", err)
+	}
+	if err := rsa.VerifyPSS(rsaKey.Signer.Public().(*rsa.PublicKey), crypto.SHA256, digest[:], sig, pssOpts); err != nil {
+		t.Fatalf("verify pss: %v", err)
+	}
+	sig, err = rsaKey.Signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		t.Fatalf("sign pkcs1: %v", err)
+	}
+	if err := rsa.VerifyPKCS1v15(rsaKey.Signer.Public().(*rsa.PublicKey), crypto.SHA256, digest[:], sig); err != nil {
+		t.Fatalf("verify pkcs1: %v", err)
+	}
+
+	ecKey, err := ks.GenerateKey(ctx, KeySpec{KeyType: "ECDSA", ECCCurve: "P384"})
+	if err != nil {
+		t.Fatalf("generate ecdsa: %v", err)
+	}
+	sig, err = ecKey.Signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		t.Fatalf("sign ecdsa: %v", err)
+	}
+	if !ecdsa.VerifyASN1(ecKey.Signer.Public().(*ecdsa.PublicKey), digest[:], sig) {
+		t.Fatal("ecdsa signature does not verify")
+	}
+
+	edKey, err := ks.GenerateKey(ctx, KeySpec{KeyType: "ED25519"})
+	if err != nil {
+		t.Fatalf("generate ed25519: %v", err)
+	}
+	message := []byte("message")
+	sig, err = edKey.Signer.Sign(rand.Reader, message, crypto.Hash(0))
+	if err != nil {
+		t.Fatalf("sign ed25519: %v", err)
+	}
+	if !ed25519.Verify(edKey.Signer.Public().(ed25519.PublicKey), message, sig) {
+		t.Fatal("ed25519 signature does not verify")
+	}
+	if _, err := edKey.Signer.Sign(rand.Reader, digest[:], crypto.SHA256); err == nil {
+		t.Fatal("ed25519 signed a prehashed digest")
+	}
+
+	if err := ks.DeleteKey(ctx, ecKey.KeyRef); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := ecKey.Signer.Sign(rand.Reader, digest[:], crypto.SHA256); err == nil {
+		t.Fatal("deleted key still signs")
+	}
+}
+
+func TestHandleSignerRequestRejectsBadInput(t *testing.T) {
+	dir := t.TempDir()
+	for _, req := range []SignerRequest{
+		{Op: "public", KeyID: "../../etc/passwd"},
+		{Op: "sign", KeyID: "ABCDEF"},
+		{Op: "delete", KeyID: "../master"},
+		{Op: "export"},
+		{Op: "generate", KeyType: "DSA"},
+	} {
+		if _, err := handleSignerRequest(dir, req); err == nil {
+			t.Errorf("request %+v succeeded", req)
+		}
+	}
+
+	resp, err := handleSignerRequest(dir, SignerRequest{Op: "generate", KeyType: "ECDSA", ECCCurve: "P256"})
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if !signerKeyIDPattern.MatchString(resp.KeyID) {
+		t.Fatalf("generated key id %q", resp.KeyID)
+	}
+	if _, err := x509.ParsePKIXPublicKey(resp.PublicKey); err != nil {
+		t.Fatalf("parse public key: %v", err)
+	}
+	if _, err := handleSignerRequest(dir, SignerRequest{Op: "sign", KeyID: resp.KeyID, Digest: []byte("x"), Hash: "MD5"}); err == nil {
+		t.Fatal("signed with an unsupported hash")
+	}
+}
+
+func TestSocketSignerHonoursContext(t *testing.T) {
+	sockDir, err := os.MkdirTemp("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(sockDir) })
+	sockPath := filepath.Join(sockDir, "s.sock")
+	ln, err := net.Listen("unix", sockPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	// A signer that accepts requests but never answers.
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { _ = conn.Close() })
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	signer := &socketSigner{ctx: ctx, ks: &socketKeyStore{path: sockPath}, keyID: strings.Repeat("0", 32)}
+	start := time.Now()
+	if _, err := signer.Sign(rand.Reader, make([]byte, 32), crypto.SHA256); err == nil {
+		t.Fatal("sign against a hung signer succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("sign returned after %s", elapsed)
+	}
+}
+
+func TestCreateCertificateDeletesKeyOnFailure(t *testing.T) {
+	sctx := newTestContext(t)
+	keyDir := serveTestSigner(t, sctx)
+	ns, _, _, leaf := createTestChain(t, sctx)
+
+	req := testCertReq(ns.ID, leaf.ID, "web.internal", false, "web.internal")
+	req.KeyBackend = SocketKeyBackend
+	if _, err := NewCertificateService(sctx).CreateCertificate(context.Background(), req); err == nil {
+		t.Fatal("issued a certificate under a leaf")
+	}
+	if files := signerKeyFiles(t, keyDir); len(files) != 0 {
+		t.Fatalf("failed create left %d keys on the signer", len(files))
+	}
+}
+
+func TestDeleteCertificateDeletesExternalKeys(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	keyDir := serveTestSigner(t, sctx)
+	ns := createTestNamespace(t, sctx, "socket")
+	rootReq := testCertReq(ns.ID, 0, "Root CA", true)
+	rootReq.KeyBackend = SocketKeyBackend
+	root := createTestCert(t, sctx, rootReq)
+	interReq := testCertReq(ns.ID, root.ID, "Inter CA", true)
+	interReq.KeyBackend = SocketKeyBackend
+	inter := createTestCert(t, sctx, interReq)
+	createTestCert(t, sctx, testCertReq(ns.ID, inter.ID, "api.internal", false, "api.internal"))
+	interKey := filepath.Join(keyDir, keyRefID(sctx.client.Certificate.GetX(ctx, inter.ID).KeyRef)+".pem")
+
+	// The imported copy refers to the same signer keys.
+	nss := NewNamespaceService(sctx)
+	_, bundle, err := nss.ExportNamespaceBundle(ctx, ns.ID, "bundle-passphrase")
+	if err != nil {
+		t.Fatalf("export bundle: %v", err)
+	}
+	imported, err := nss.ImportNamespaceBundle(ctx, bundle, "bundle-passphrase", "copy")
+	if err != nil {
+		t.Fatalf("import bundle: %v", err)
+	}
+
+	certs := NewCertificateService(sctx)
+	if err := certs.DeleteCertificate(ctx, inter.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := os.Stat(interKey); err != nil {
+		t.Fatalf("deleted a key the imported copy still uses: %v", err)
+	}
+	importedRoot := sctx.client.Certificate.Query().
+		Where(certificate.NamespaceID(imported.ID), certificate.IssuerID(0)).
+		OnlyX(ctx)
+	if err := certs.DeleteCertificate(ctx, importedRoot.ID); err != nil {
+		t.Fatalf("delete imported tree: %v", err)
+	}
+	if _, err := os.Stat(interKey); !os.IsNotExist(err) {
+		t.Fatalf("intermediate key left on the signer: %v", err)
+	}
+	if files := signerKeyFiles(t, keyDir); len(files) != 1 {
+		t.Fatalf("signer holds %d keys, want only the original root key", len(files))
+	}
+}
@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const (
+	LintSeverityError   = "error"
+	LintSeverityWarning = "warning"
+
+	// maxLeafValidityDays is the CA/B Forum limit for TLS server certificates.
+	maxLeafValidityDays = 398
+)
+
+type LintFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Source   string `json:"source"`
+	Message  string `json:"message"`
+}
+
+type LintResult struct {
+	CertificateID int           `json:"certificateId"`
+	Subject       string        `json:"subject"`
+	Errors        int           `json:"errors"`
+	Warnings      int           `json:"warnings"`
+	Findings      []LintFinding `json:"findings"`
+}
+
+// lintRule checks one property of a certificate and returns a message for
+// every violation.
+type lintRule struct {
+	name     string
+	severity string
+	source   string
+	check    func(cert *x509.Certificate) []string
+}
+
+var lintRules = []lintRule{
+	{
+		name:     "subject_empty_rdn",
+		severity: LintSeverityError,
+		source:   "RFC 5280 4.1.2.4",
+		check: func(cert *x509.Certificate) []string {
+			var msgs []string
+			for _, attr := range subjectAttributes(cert.Subject) {
+				if slices.Contains(attr.values, "") {
+					msgs = append(msgs, fmt.Sprintf("subject attribute %s is empty", attr.name))
+				}
+			}
+			return msgs
+		},
+	},
+	{
+		name:     "subject_country_code",
+		severity: LintSeverityError,
+		source:   "X.520, CA/B BR 7.1.4.2.2",
+		check: func(cert *x509.Certificate) []string {
+			var msgs []string
+			for _, c := range cert.Subject.Country {
+				if c != "" && !isCountryCode(c) {
+					msgs = append(msgs, fmt.Sprintf("country %q is not a two letter ISO 3166 code", c))
+				}
+			}
+			return msgs
+		},
+	},
+	{
+		name:     "subject_empty_without_san",
+		severity: LintSeverityError,
+		source:   "RFC 5280 4.1.2.6",
+		check: func(cert *x509.Certificate) []string {
+			if subjectEmpty(cert.Subject) && !hasSAN(cert) {
+				return []string{"subject is empty and there is no subject alternative name"}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "validity_order",
+		severity: LintSeverityError,
+		source:   "RFC 5280 4.1.2.5",
+		check: func(cert *x509.Certificate) []string {
+			if !cert.NotAfter.After(cert.NotBefore) {
+				return []string{"notAfter is not after notBefore"}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "serial_number",
+		severity: LintSeverityError,
+		source:   "RFC 5280 4.1.2.2",
+		check: func(cert *x509.Certificate) []string {
+			switch {
+			case cert.SerialNumber == nil || cert.SerialNumber.Sign() <= 0:
+				return []string{"serial number must be positive"}
+			case len(cert.SerialNumber.Bytes()) > 20:
+				return []string{"serial number is longer than 20 octets"}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "ca_basic_constraints",
+		severity: LintSeverityError,
+		source:   "RFC 5280 4.2.1.9",
+		check: func(cert *x509.Certificate) []string {
+			if cert.IsCA && !cert.BasicConstraintsValid {
+				return []string{"CA certificate has no basicConstraints extension"}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "ca_key_cert_sign",
+		severity: LintSeverityError,
+		source:   "RFC 5280 4.2.1.3",
+		check: func(cert *x509.Certificate) []string {
+			if cert.IsCA && cert.KeyUsage&x509.KeyUsageCertSign == 0 {
+				return []string{"CA certificate lacks the keyCertSign key usage"}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "ca_crl_sign",
+		severity: LintSeverityWarning,
+		source:   "CA/B BR 7.1.2.1",
+		check: func(cert *x509.Certificate) []string {
+			if cert.IsCA && cert.KeyUsage&x509.KeyUsageCRLSign == 0 {
+				return []string{"CA certificate lacks the cRLSign key usage"}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "leaf_key_cert_sign",
+		severity: LintSeverityError,
+		source:   "RFC 5280 4.2.1.3",
+		check: func(cert *x509.Certificate) []string {
+			if !cert.IsCA && cert.KeyUsage&x509.KeyUsageCertSign != 0 {
+				return []string{"keyCertSign is set on a certificate that is not a CA"}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "leaf_digital_signature",
+		severity: LintSeverityWarning,
+		source:   "RFC 5280 4.2.1.3",
+		check: func(cert *x509.Certificate) []string {
+			if !cert.IsCA && cert.KeyUsage&x509.KeyUsageDigitalSignature == 0 {
+				return []string{"end entity certificate lacks the digitalSignature key usage"}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "server_san_missing",
+		severity: LintSeverityError,
+		source:   "CA/B BR 7.1.2.3, RFC 6125 6.4.4",
+		check: func(cert *x509.Certificate) []string {
+			if isServerCert(cert) && !hasSAN(cert) {
+				return []string{"server certificate has no DNS name or IP address, clients ignore the common name"}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "server_cn_not_in_san",
+		severity: LintSeverityWarning,
+		source:   "CA/B BR 7.1.4.2.2",
+		check: func(cert *x509.Certificate) []string {
+			cn := cert.Subject.CommonName
+			if !isServerCert(cert) || cn == "" || !hasSAN(cert) {
+				return nil
+			}
+			if slices.ContainsFunc(cert.DNSNames, func(n string) bool { return strings.EqualFold(n, cn) }) {
+				return nil
+			}
+			if ip := net.ParseIP(cn); ip != nil && slices.ContainsFunc(cert.IPAddresses, ip.Equal) {
+				return nil
+			}
+			return []string{fmt.Sprintf("common name %q is not one of the subject alternative names", cn)}
+		},
+	},
+	{
+		name:     "server_validity",
+		severity: LintSeverityWarning,
+		source:   "CA/B BR 6.3.2",
+		check: func(cert *x509.Certificate) []string {
+			days := int(cert.NotAfter.Sub(cert.NotBefore).Hours() / 24)
+			if isServerCert(cert) && days > maxLeafValidityDays {
+				return []string{fmt.Sprintf("validity of %d days exceeds %d days", days, maxLeafValidityDays)}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "dns_name_syntax",
+		severity: LintSeverityError,
+		source:   "RFC 5280 4.2.1.6",
+		check: func(cert *x509.Certificate) []string {
+			var msgs []string
+			for _, name := range cert.DNSNames {
+				if err := checkDNSName(name); err != nil {
+					msgs = append(msgs, fmt.Sprintf("DNS name %q: %v", name, err))
+				}
+			}
+			return msgs
+		},
+	},
+	{
+		name:     "key_strength",
+		severity: LintSeverityError,
+		source:   "CA/B BR 6.1.5",
+		check: func(cert *x509.Certificate) []string {
+			switch pub := cert.PublicKey.(type) {
+			case *rsa.PublicKey:
+				if pub.N.BitLen() < 2048 {
+					return []string{fmt.Sprintf("RSA key of %d bits is shorter than 2048 bits", pub.N.BitLen())}
+				}
+			case *ecdsa.PublicKey:
+				switch pub.Curve {
+				case elliptic.P256(), elliptic.P384(), elliptic.P521():
+				default:
+					return []string{fmt.Sprintf("curve %s is not P-256, P-384 or P-521", pub.Curve.Params().Name)}
+				}
+			}
+			return nil
+		},
+	},
+	{
+		name:     "key_encipherment_non_rsa",
+		severity: LintSeverityWarning,
+		source:   "RFC 8813 3",
+		check: func(cert *x509.Certificate) []string {
+			switch cert.PublicKey.(type) {
+			case *ecdsa.PublicKey, ed25519.PublicKey:
+				if cert.KeyUsage&x509.KeyUsageKeyEncipherment != 0 {
+					return []string{"keyEncipherment is meaningless for a non-RSA key"}
+				}
+			}
+			return nil
+		},
+	},
+}
+
+// LintCertificate runs every rule on cert. Templates can be linted before
+// signing as long as PublicKey is set.
+func LintCertificate(cert *x509.Certificate) []LintFinding {
+	findings := make([]LintFinding, 0)
+	for _, rule := range lintRules {
+		for _, msg := range rule.check(cert) {
+			findings = append(findings, LintFinding{
+				Rule:     rule.name,
+				Severity: rule.severity,
+				Source:   rule.source,
+				Message:  msg,
+			})
+		}
+	}
+	return findings
+}
+
+func (s *CertificateService) LintCertificate(ctx context.Context, id int) (*LintResult, error) {
+	cert, err := s.ctx.client.Certificate.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get cert %d failed: %w", id, err)
+	}
+	x509Cert, err := getCertFromPem(cert.CertPem)
+	if err != nil {
+		return nil, fmt.Errorf("get cert %d from pem failed: %w", id, err)
+	}
+	result := &LintResult{
+		CertificateID: cert.ID,
+		Subject:       cert.Subject,
+		Findings:      LintCertificate(x509Cert),
+	}
+	for _, f := range result.Findings {
+		switch f.Severity {
+		case LintSeverityError:
+			result.Errors++
+		case LintSeverityWarning:
+			result.Warnings++
+		}
+	}
+	return result, nil
+}
+
+// lintBeforeIssue lints tmpl, which is about to be signed for pub. Findings
+// are logged; with strict lint errors abort the issuance.
+func (sctx *ServiceContext) lintBeforeIssue(tmpl *x509.Certificate, pub crypto.PublicKey) error {
+	t := *tmpl
+	t.PublicKey = pub
+	var errs []string
+	for _, f := range LintCertificate(&t) {
+		zap.L().Warn("certificate lint finding",
+			zap.String("subject", getSubject(&t)),
+			zap.String("rule", f.Rule),
+			zap.String("severity", f.Severity),
+			zap.String("message", f.Message))
+		if f.Severity == LintSeverityError {
+			errs = append(errs, f.Rule+": "+f.Message)
+		}
+	}
+	if sctx.strictLint && len(errs) > 0 {
+		return fmt.Errorf("certificate lint failed: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type subjectAttribute struct {
+	name   string
+	values []string
+}
+
+func subjectAttributes(name pkix.Name) []subjectAttribute {
+	return []subjectAttribute{
+		{"C", name.Country},
+		{"ST", name.Province},
+		{"L", name.Locality},
+		{"STREET", name.StreetAddress},
+		{"POSTALCODE", name.PostalCode},
+		{"O", name.Organization},
+		{"OU", name.OrganizationalUnit},
+	}
+}
+
+func subjectEmpty(name pkix.Name) bool {
+	if name.CommonName != "" || name.SerialNumber != "" {
+		return false
+	}
+	for _, attr := range subjectAttributes(name) {
+		if len(attr.values) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func hasSAN(cert *x509.Certificate) bool {
+	return len(cert.DNSNames) > 0 || len(cert.IPAddresses) > 0 ||
+		len(cert.EmailAddresses) > 0 || len(cert.URIs) > 0
+}
+
+func isServerCert(cert *x509.Certificate) bool {
+	return !cert.IsCA && slices.ContainsFunc(cert.ExtKeyUsage, func(u x509.ExtKeyUsage) bool {
+		return u == x509.ExtKeyUsageServerAuth || u == x509.ExtKeyUsageAny
+	})
+}
+
+func isCountryCode(c string) bool {
+	return len(c) == 2 && c[0] >= 'A' && c[0] <= 'Z' && c[1] >= 'A' && c[1] <= 'Z'
+}
+
+// checkDNSName checks the preferred name syntax, allowing a wildcard as the
+// whole leftmost label.
+func checkDNSName(name string) error {
+	if name == "" {
+		return fmt.Errorf("empty name")
+	}
+	if len(name) > 253 {
+		return fmt.Errorf("longer than 253 characters")
+	}
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		if label == "*" && i == 0 && len(labels) > 2 {
+			continue
+		}
+		if label == "" || len(label) > 63 {
+			return fmt.Errorf("label %q must have 1 to 63 characters", label)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("label %q starts or ends with a hyphen", label)
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return fmt.Errorf("label %q contains %q", label, r)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// lintTemplate returns a server certificate that passes every rule.
+func lintTemplate(t *testing.T) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{Country: []string{"CN"}, CommonName: "api.internal"},
+		NotBefore:    now,
+		NotAfter:     now.AddDate(0, 0, 90),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{"api.internal", "*.api.internal"},
+		PublicKey:    key.Public(),
+	}
+}
+
+func lintRuleNames(cert *x509.Certificate) []string {
+	var names []string
+	for _, f := range LintCertificate(cert) {
+		names = append(names, f.Rule)
+	}
+	return names
+}
+
+func TestLintRules(t *testing.T) {
+	if got := lintRuleNames(lintTemplate(t)); len(got) != 0 {
+		t.Fatalf("clean template has findings %v", got)
+	}
+	weakKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p224Key, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]func(c *x509.Certificate){
+		"subject_empty_rdn":    func(c *x509.Certificate) { c.Subject.Organization = []string{""} },
+		"subject_country_code": func(c *x509.Certificate) { c.Subject.Country = []string{"China"} },
+		"subject_empty_without_san": func(c *x509.Certificate) {
+			c.Subject = pkix.Name{}
+			c.DNSNames = nil
+			c.ExtKeyUsage = nil
+		},
+		"validity_order":           func(c *x509.Certificate) { c.NotAfter = c.NotBefore },
+		"serial_number":            func(c *x509.Certificate) { c.SerialNumber = new(big.Int).Lsh(big.NewInt(1), 170) },
+		"leaf_key_cert_sign":       func(c *x509.Certificate) { c.KeyUsage |= x509.KeyUsageCertSign },
+		"leaf_digital_signature":   func(c *x509.Certificate) { c.KeyUsage = x509.KeyUsageKeyAgreement },
+		"server_san_missing":       func(c *x509.Certificate) { c.DNSNames = nil },
+		"server_cn_not_in_san":     func(c *x509.Certificate) { c.Subject.CommonName = "other.internal" },
+		"server_validity":          func(c *x509.Certificate) { c.NotAfter = c.NotBefore.AddDate(0, 0, 400) },
+		"dns_name_syntax":          func(c *x509.Certificate) { c.DNSNames = append(c.DNSNames, "bad_name.internal") },
+		"key_strength":             func(c *x509.Certificate) { c.PublicKey = p224Key.Public() },
+		"key_encipherment_non_rsa": func(c *x509.Certificate) { c.KeyUsage |= x509.KeyUsageKeyEncipherment },
+		"ca_basic_constraints": func(c *x509.Certificate) {
+			c.IsCA = true
+			c.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+		},
+		"ca_key_cert_sign": func(c *x509.Certificate) {
+			c.IsCA, c.BasicConstraintsValid = true, true
+			c.KeyUsage = x509.KeyUsageCRLSign
+		},
+		"ca_crl_sign": func(c *x509.Certificate) {
+			c.IsCA, c.BasicConstraintsValid = true, true
+			c.KeyUsage = x509.KeyUsageCertSign
+		},
+	}
+	for rule, mutate := range tests {
+		cert := lintTemplate(t)
+		mutate(cert)
+		if got := lintRuleNames(cert); !slices.Equal(got, []string{rule}) {
+			t.Errorf("%s: got findings %v", rule, got)
+		}
+	}
+
+	cert := lintTemplate(t)
+	cert.PublicKey = weakKey.Public()
+	cert.KeyUsage |= x509.KeyUsageKeyEncipherment
+	if got := lintRuleNames(cert); !slices.Equal(got, []string{"key_strength"}) {
+		t.Errorf("weak RSA key: got findings %v", got)
+	}
+	cert = lintTemplate(t)
+	cert.Subject.CommonName = "10.0.0.1"
+	cert.IPAddresses = []net.IP{net.ParseIP("10.0.0.1")}
+	if got := lintRuleNames(cert); len(got) != 0 {
+		t.Errorf("common name matching an IP address: got findings %v", got)
+	}
+}
+
+func TestCheckDNSName(t *testing.T) {
+	for _, name := range []string{"example.com", "*.example.com", "a-b.example", "xn--bcher-kva.example"} {
+		if err := checkDNSName(name); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+	bad := []string{"", "*.com", "a.*.example", "-a.example", "a-.example", "a..example", "a b.example", strings.Repeat("a", 64) + ".example"}
+	for _, name := range bad {
+		if err := checkDNSName(name); err == nil {
+			t.Errorf("%q accepted", name)
+		}
+	}
+}
+
+func TestStrictLint(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, _, inter, leaf := createTestChain(t, sctx)
+	certs := NewCertificateService(sctx)
+
+	result, err := certs.LintCertificate(ctx, leaf.ID)
+	if err != nil {
+		t.Fatalf("lint: %v", err)
+	}
+	if result.CertificateID != leaf.ID || result.Errors != 0 || result.Warnings != 0 {
+		t.Fatalf("lint result %+v", result)
+	}
+
+	req := testCertReq(ns.ID, inter.ID, "bad.internal", false, "bad.internal")
+	req.Subject.Country = "China"
+	bad := createTestCert(t, sctx, req)
+	result, err = certs.LintCertificate(ctx, bad.ID)
+	if err != nil {
+		t.Fatalf("lint: %v", err)
+	}
+	if result.Errors != 1 || result.Findings[0].Rule != "subject_country_code" {
+		t.Fatalf("lint result %+v", result)
+	}
+
+	sctx.SetStrictLint(true)
+	req.Subject.CommonName = "strict.internal"
+	if _, err := certs.CreateCertificate(ctx, req); err == nil || !strings.Contains(err.Error(), "subject_country_code") {
+		t.Fatalf("strict lint let a bad certificate through: %v", err)
+	}
+}
@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/logeable/certmgr/internal/ent"
 	"github.com/logeable/certmgr/internal/ent/certificate"
+	"github.com/logeable/certmgr/internal/ent/namespace"
 )
 
 type NamespaceService struct {
@@ -19,20 +21,42 @@ func NewNamespaceService(ctx *ServiceContext) *NamespaceService {
 	}
 }
 
-func (s *NamespaceService) CreateNamespace(ctx context.Context, req ent.Namespace) (*ent.Namespace, error) {
-	ns, err := s.ctx.client.Namespace.Create().SetName(req.Name).SetDesc(req.Desc).Save(ctx)
+func (s *NamespaceService) CreateNamespace(ctx context.Context, req ent.Namespace) (_ *ent.Namespace, err error) {
+	ctx = withAuditOperation(ctx, "namespace.create", map[string]any{
+		"name": req.Name, "desc": req.Desc, "keyRevealDisabled": req.KeyRevealDisabled,
+	})
+	defer s.ctx.auditFailure(ctx, "namespace", 0, &err)
+	var ns *ent.Namespace
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		ns, err = tx.Namespace.Create().
+			SetName(req.Name).
+			SetDesc(req.Desc).
+			SetKeyRevealDisabled(req.KeyRevealDisabled).
+			Save(ctx)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("db save failed: %w", err)
 	}
 	return ns, nil
 }
 
-func (s *NamespaceService) UpdateNamespace(ctx context.Context, id int, req ent.Namespace) (*ent.Namespace, error) {
-	_, err := s.ctx.client.Namespace.Get(ctx, id)
+func (s *NamespaceService) UpdateNamespace(ctx context.Context, id int, req ent.Namespace) (_ *ent.Namespace, err error) {
+	ctx = withAuditOperation(ctx, "namespace.update", map[string]any{
+		"name": req.Name, "desc": req.Desc, "keyRevealDisabled": req.KeyRevealDisabled,
+	})
+	defer s.ctx.auditFailure(ctx, "namespace", id, &err)
+	_, err = s.ctx.client.Namespace.Get(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("db check namespace exist failed: %w", err)
 	}
-	err = s.ctx.client.Namespace.UpdateOneID(id).SetName(req.Name).SetDesc(req.Desc).Exec(ctx)
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		return tx.Namespace.UpdateOneID(id).
+			SetName(req.Name).
+			SetDesc(req.Desc).
+			SetKeyRevealDisabled(req.KeyRevealDisabled).
+			Exec(ctx)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("db update failed: %w", err)
 	}
@@ -43,8 +67,12 @@ func (s *NamespaceService) UpdateNamespace(ctx context.Context, id int, req ent.
 	return ns, nil
 }
 
-func (s *NamespaceService) DeleteNamespace(ctx context.Context, id int) error {
-	err := s.ctx.client.Namespace.DeleteOneID(id).Exec(ctx)
+func (s *NamespaceService) DeleteNamespace(ctx context.Context, id int) (err error) {
+	ctx = withAuditOperation(ctx, "namespace.delete", nil)
+	defer s.ctx.auditFailure(ctx, "namespace", id, &err)
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		return tx.Namespace.DeleteOneID(id).Exec(ctx)
+	})
 	if err != nil {
 		return fmt.Errorf("db delete failed: %w", err)
 	}
@@ -60,36 +88,60 @@ func (s *NamespaceService) GetNamespace(ctx context.Context, id int) (*ent.Names
 }
 
 type Namespace struct {
-	ID        int
-	Name      string
-	Desc      string
-	UpdatedAt time.Time
-	CreatedAt time.Time
-	CertCount int
+	ID           int
+	Name         string
+	Desc         string
+	UpdatedAt    time.Time
+	CreatedAt    time.Time
+	Certificates CertificateStats
+
+	// KeyRevealDisabled is set when the private keys of the namespace
+	// cannot be revealed.
+	KeyRevealDisabled bool
 }
 
-func (s *NamespaceService) ListNamespaces(ctx context.Context) ([]Namespace, error) {
+// ListNamespaces returns one page of namespaces and the total count.
+func (s *NamespaceService) ListNamespaces(ctx context.Context, opts ListOptions) ([]Namespace, int, error) {
+	sortKey, desc, err := opts.sort(SortByCreated, SortByCreated, SortByUpdated, SortByName)
+	if err != nil {
+		return nil, 0, err
+	}
+	field := map[string]string{
+		SortByCreated: namespace.FieldCreatedAt,
+		SortByUpdated: namespace.FieldUpdatedAt,
+		SortByName:    namespace.FieldName,
+	}[sortKey]
+	order := ent.Asc(field, namespace.FieldID)
+	if desc {
+		order = ent.Desc(field, namespace.FieldID)
+	}
+
 	var result []Namespace
-	err := s.ctx.withTx(ctx, func(tx *ent.Tx) error {
-		ns, err := tx.Namespace.Query().All(ctx)
+	var total int
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		total, err = tx.Namespace.Query().Count(ctx)
+		if err != nil {
+			return fmt.Errorf("count namespaces failed: %w", err)
+		}
+		ns, err := tx.Namespace.Query().Order(order).Offset(opts.Offset).Limit(opts.limit()).All(ctx)
 		if err != nil {
 			return fmt.Errorf("query namespaces failed: %w", err)
 		}
-		for _, namespace := range ns {
-			certCount, err := tx.Certificate.Query().Where(certificate.NamespaceID(namespace.ID)).Count(ctx)
-			if err != nil {
-				return fmt.Errorf("query cert count of namespace %d failed: %w", namespace.ID, err)
-			}
-			ns := entToNamespace(namespace)
-			ns.CertCount = certCount
+		stats, err := certificateStatsBy(ctx, tx.Client(), certificate.FieldNamespaceID)
+		if err != nil {
+			return err
+		}
+		for _, n := range ns {
+			ns := entToNamespace(n)
+			ns.Certificates = stats[strconv.Itoa(n.ID)]
 			result = append(result, ns)
 		}
 		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("list namespaces failed: %w", err)
+		return nil, 0, fmt.Errorf("list namespaces failed: %w", err)
 	}
-	return result, nil
+	return result, total, nil
 }
 
 func entToNamespace(ns *ent.Namespace) Namespace {
@@ -99,5 +151,7 @@ func entToNamespace(ns *ent.Namespace) Namespace {
 		Desc:      ns.Desc,
 		UpdatedAt: ns.UpdatedAt,
 		CreatedAt: ns.CreatedAt,
+
+		KeyRevealDisabled: ns.KeyRevealDisabled,
 	}
 }
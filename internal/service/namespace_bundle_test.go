@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestExportNamespaceBundleWithholdsUnexportableKeys(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, root, inter, leaf := createTestChain(t, sctx)
+	sctx.client.Certificate.UpdateOneID(inter.ID).SetKeyExportable(false).ExecX(ctx)
+
+	svc := NewNamespaceService(sctx)
+	if _, _, err := svc.ExportNamespaceBundle(ctx, ns.ID, ""); !errors.Is(err, ErrBackupPassphraseRequired) {
+		t.Fatalf("export with keys and no passphrase returned %v", err)
+	}
+	_, data, err := svc.ExportNamespaceBundle(ctx, ns.ID, "bundle-passphrase")
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	archive, err := decodeArchive(data, "bundle-passphrase")
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	keys := map[int]bool{}
+	for _, cert := range archive.Certificates {
+		keys[cert.ID] = cert.KeyPem != ""
+	}
+	if !keys[root.ID] || keys[inter.ID] || !keys[leaf.ID] {
+		t.Fatalf("bundled keys %v, want all but the intermediate", keys)
+	}
+
+	imported, err := svc.ImportNamespaceBundle(ctx, data, "bundle-passphrase", "")
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if imported.Name != ns.Name+"-2" {
+		t.Fatalf("imported as %s", imported.Name)
+	}
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+)
+
+// DomainRewrite replaces the domain suffix From with To in common names and
+// DNS SANs, e.g. "dev.example" -> "qa.example" turns "*.dev.example" into
+// "*.qa.example".
+type DomainRewrite struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type CloneNamespaceReq struct {
+	Name           string          `json:"name"`
+	Desc           string          `json:"desc"`
+	DomainRewrites []DomainRewrite `json:"domainRewrites"`
+}
+
+// CloneNamespace re-creates the certificate tree of namespace id in a new
+// namespace. Every certificate keeps its subject, SANs, usages and validity
+// period, but gets a new key and serial number and is valid from now on.
+func (s *NamespaceService) CloneNamespace(ctx context.Context, id int, req CloneNamespaceReq) (_ *ent.Namespace, err error) {
+	ctx = withAuditOperation(ctx, "namespace.clone", req)
+	defer s.ctx.auditFailure(ctx, "namespace", id, &err)
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	rewrites := make([]DomainRewrite, 0, len(req.DomainRewrites))
+	for _, rw := range req.DomainRewrites {
+		from := strings.TrimPrefix(rw.From, "*.")
+		to := strings.TrimPrefix(rw.To, "*.")
+		if from == "" || to == "" {
+			return nil, fmt.Errorf("invalid domain rewrite %q -> %q", rw.From, rw.To)
+		}
+		rewrites = append(rewrites, DomainRewrite{From: from, To: to})
+	}
+
+	var ns *ent.Namespace
+	// Keys outside the database survive a rollback, so remember them and
+	// delete them again if the clone fails.
+	var generated []string
+	defer func() {
+		if err != nil {
+			s.ctx.deleteKeys(context.WithoutCancel(ctx), generated)
+		}
+	}()
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		certs, err := tx.Certificate.Query().
+			Where(certificate.NamespaceID(id)).
+			Order(ent.Asc(certificate.FieldID)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query certificates of namespace %d failed: %w", id, err)
+		}
+		source, err := tx.Namespace.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get namespace %d failed: %w", id, err)
+		}
+		ns, err = tx.Namespace.Create().
+			SetName(req.Name).
+			SetDesc(req.Desc).
+			SetKeyRevealDisabled(source.KeyRevealDisabled).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create namespace failed: %w", err)
+		}
+
+		children := make(map[int][]*ent.Certificate)
+		for _, cert := range certs {
+			children[cert.IssuerID] = append(children[cert.IssuerID], cert)
+		}
+		type clonedCert struct {
+			id     int
+			cert   *x509.Certificate
+			signer crypto.Signer
+		}
+		cloned := make(map[int]clonedCert, len(certs))
+		queue := children[0]
+		now := time.Now()
+		for len(queue) > 0 {
+			src := queue[0]
+			queue = queue[1:]
+
+			srcX509Cert, err := getCertFromPem(src.CertPem)
+			if err != nil {
+				return fmt.Errorf("get cert %d from pem failed: %w", src.ID, err)
+			}
+			spec, err := keySpecOf(srcX509Cert.PublicKey)
+			if err != nil {
+				return fmt.Errorf("get key spec of cert %d failed: %w", src.ID, err)
+			}
+			keyStore, err := s.ctx.keyStore(keyRefBackend(src.KeyRef))
+			if err != nil {
+				return fmt.Errorf("get key store of cert %d failed: %w", src.ID, err)
+			}
+			newKey, err := keyStore.GenerateKey(ctx, spec)
+			if err != nil {
+				return fmt.Errorf("create private key for cert %d failed: %w", src.ID, err)
+			}
+			if newKey.KeyRef != "" {
+				generated = append(generated, newKey.KeyRef)
+			}
+			serialNumber, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+			if err != nil {
+				return fmt.Errorf("generate serial number failed: %w", err)
+			}
+
+			subject := srcX509Cert.Subject
+			subject.CommonName = rewriteDomain(subject.CommonName, rewrites)
+			dnsNames := make([]string, 0, len(srcX509Cert.DNSNames))
+			for _, name := range srcX509Cert.DNSNames {
+				dnsNames = append(dnsNames, rewriteDomain(name, rewrites))
+			}
+			certTemplate := &x509.Certificate{
+				SerialNumber:          serialNumber,
+				Subject:               subject,
+				NotBefore:             now,
+				NotAfter:              now.Add(srcX509Cert.NotAfter.Sub(srcX509Cert.NotBefore)),
+				KeyUsage:              srcX509Cert.KeyUsage,
+				ExtKeyUsage:           srcX509Cert.ExtKeyUsage,
+				BasicConstraintsValid: srcX509Cert.BasicConstraintsValid,
+				IsCA:                  srcX509Cert.IsCA,
+				MaxPathLen:            srcX509Cert.MaxPathLen,
+				MaxPathLenZero:        srcX509Cert.MaxPathLenZero,
+				DNSNames:              dnsNames,
+				IPAddresses:           srcX509Cert.IPAddresses,
+			}
+
+			parentCert, signer, issuerID := certTemplate, newKey.Signer, 0
+			if src.IssuerID != 0 {
+				parent := cloned[src.IssuerID]
+				parentCert, signer, issuerID = parent.cert, parent.signer, parent.id
+			}
+			certDer, err := x509.CreateCertificate(rand.Reader, certTemplate, parentCert, newKey.Signer.Public(), signer)
+			if err != nil {
+				return fmt.Errorf("create x509 certificate for cert %d failed: %w", src.ID, err)
+			}
+			x509Cert, err := x509.ParseCertificate(certDer)
+			if err != nil {
+				return fmt.Errorf("parse x509 certificate failed: %w", err)
+			}
+			created, err := tx.Certificate.Create().
+				SetNamespaceID(ns.ID).
+				SetIssuerID(issuerID).
+				SetCertPem(string(x509CertToPem(x509Cert))).
+				SetKeyRef(newKey.KeyRef).
+				SetDesc(src.Desc).
+				SetUsage(src.Usage).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("save clone of cert %d failed: %w", src.ID, err)
+			}
+			if err := s.ctx.storeCertKey(ctx, tx.Client(), created.ID, newKey.KeyPem); err != nil {
+				return err
+			}
+			cloned[src.ID] = clonedCert{id: created.ID, cert: x509Cert, signer: newKey.Signer}
+			queue = append(queue, children[src.ID]...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("clone namespace %d with tx failed: %w", id, err)
+	}
+	return ns, nil
+}
+
+func rewriteDomain(name string, rewrites []DomainRewrite) string {
+	for _, rw := range rewrites {
+		if name == rw.From {
+			return rw.To
+		}
+		if strings.HasSuffix(name, "."+rw.From) {
+			return strings.TrimSuffix(name, rw.From) + rw.To
+		}
+	}
+	return name
+}
+
+func keySpecOf(pub crypto.PublicKey) (KeySpec, error) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return KeySpec{KeyType: "RSA", KeyLen: pub.N.BitLen()}, nil
+	case *ecdsa.PublicKey:
+		return KeySpec{KeyType: "ECDSA", ECCCurve: strings.ReplaceAll(pub.Curve.Params().Name, "-", "")}, nil
+	case ed25519.PublicKey:
+		return KeySpec{KeyType: "ED25519"}, nil
+	default:
+		return KeySpec{}, fmt.Errorf("unsupported key type: %T", pub)
+	}
+}
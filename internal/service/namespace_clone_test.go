@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCloneNamespaceDeletesKeysOnRollback(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	keyDir := serveTestSigner(t, sctx)
+
+	ns := createTestNamespace(t, sctx, "source")
+	rootReq := testCertReq(ns.ID, 0, "Root CA", true)
+	rootReq.KeyBackend = SocketKeyBackend
+	root := createTestCert(t, sctx, rootReq)
+	interReq := testCertReq(ns.ID, root.ID, "Intermediate CA", true)
+	interReq.KeyBackend = SocketKeyBackend
+	inter := createTestCert(t, sctx, interReq)
+	leaf := createTestCert(t, sctx, testCertReq(ns.ID, inter.ID, "api.internal", false, "api.internal"))
+	if n := len(signerKeyFiles(t, keyDir)); n != 2 {
+		t.Fatalf("signer holds %d keys, want 2", n)
+	}
+
+	// The leaf is cloned last, after both CA keys were generated on the signer.
+	sctx.client.Certificate.UpdateOneID(leaf.ID).SetKeyRef("pkcs11:00").ExecX(ctx)
+	svc := NewNamespaceService(sctx)
+	if _, err := svc.CloneNamespace(ctx, ns.ID, CloneNamespaceReq{Name: "clone"}); err == nil {
+		t.Fatal("clone with an unconfigured key backend succeeded")
+	}
+	if n := len(signerKeyFiles(t, keyDir)); n != 2 {
+		t.Fatalf("signer holds %d keys after a failed clone, want 2", n)
+	}
+
+	sctx.client.Certificate.UpdateOneID(leaf.ID).SetKeyRef("").ExecX(ctx)
+	if _, err := svc.CloneNamespace(ctx, ns.ID, CloneNamespaceReq{Name: "clone"}); err != nil {
+		t.Fatalf("clone: %v", err)
+	}
+	if n := len(signerKeyFiles(t, keyDir)); n != 4 {
+		t.Fatalf("signer holds %d keys after a clone, want 4", n)
+	}
+}
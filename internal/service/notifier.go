@@ -0,0 +1,168 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Notifier delivers expiry notices to one sink.
+type Notifier interface {
+	Notify(ctx context.Context, notice ExpiryNotice) error
+}
+
+// LogNotifier writes notices to the global zap logger.
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(ctx context.Context, notice ExpiryNotice) error {
+	zap.L().Warn("certificate expiring",
+		zap.Int("certificateId", notice.CertificateID),
+		zap.Int("namespaceId", notice.NamespaceID),
+		zap.String("subject", notice.Subject),
+		zap.Int("daysLeft", notice.DaysLeft),
+		zap.Int("thresholdDays", notice.ThresholdDays),
+		zap.Int("expiringCertificateId", notice.ExpiringCertificateID),
+		zap.Time("notAfter", notice.NotAfter),
+	)
+	return nil
+}
+
+// WebhookNotifier posts each notice as JSON to URL.
+type WebhookNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, notice ExpiryNotice) error {
+	body, err := json.Marshal(notice)
+	if err != nil {
+		return fmt.Errorf("marshal notice failed: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build webhook request failed: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := n.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// smtpTimeout bounds a whole SMTP exchange when ctx has no deadline.
+const smtpTimeout = 30 * time.Second
+
+// SMTPNotifier mails each notice. Authentication is only attempted when
+// Username is set, so a local SMTP stub works without credentials.
+type SMTPNotifier struct {
+	Addr     string
+	From     string
+	To       []string
+	Username string
+	Password string
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, notice ExpiryNotice) error {
+	subject := fmt.Sprintf("[certmgr] %s expires in %d days", notice.Subject, notice.DaysLeft)
+	if notice.DaysLeft < 0 {
+		subject = fmt.Sprintf("[certmgr] %s has expired", notice.Subject)
+	}
+	// The certificate subject is user input, a line break in it would start
+	// new headers.
+	subject = strings.NewReplacer("\r", "", "\n", "").Replace(subject)
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(n.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "Certificate: %d (%s)\r\n", notice.CertificateID, notice.Subject)
+	fmt.Fprintf(&msg, "Namespace: %d\r\n", notice.NamespaceID)
+	fmt.Fprintf(&msg, "Not after: %s\r\n", notice.NotAfter.Format(time.RFC3339))
+	if notice.ExpiringCertificateID != notice.CertificateID {
+		fmt.Fprintf(&msg, "The chain expires first at certificate %d.\r\n", notice.ExpiringCertificateID)
+	}
+	err := n.send(ctx, msg.Bytes())
+	if err != nil {
+		return fmt.Errorf("send mail failed: %w", err)
+	}
+	return nil
+}
+
+// send does what smtp.SendMail does, but gives up at the deadline of ctx or
+// after smtpTimeout, so an unresponsive server cannot stall the scheduler.
+func (n *SMTPNotifier) send(ctx context.Context, msg []byte) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(smtpTimeout)
+	}
+	dialer := net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", n.Addr)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	host, _, _ := strings.Cut(n.Addr, ":")
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if n.Username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return fmt.Errorf("server does not support AUTH")
+		}
+		if err := c.Auth(smtp.PlainAuth("", n.Username, n.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(n.From); err != nil {
+		return err
+	}
+	for _, to := range n.To {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
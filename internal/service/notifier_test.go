@@ -0,0 +1,129 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"mime"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stubSMTPServer accepts one session per connection and sends every received
+// message to the returned channel. With silent set it accepts connections but
+// never greets.
+func stubSMTPServer(t *testing.T, silent bool) (string, <-chan string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	messages := make(chan string, 1)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			if silent {
+				t.Cleanup(func() { _ = conn.Close() })
+				continue
+			}
+			go serveSMTP(conn, messages)
+		}
+	}()
+	return ln.Addr().String(), messages
+}
+
+func serveSMTP(conn net.Conn, messages chan<- string) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+	reply("220 stub ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 stub")
+		case strings.HasPrefix(cmd, "DATA"):
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			messages <- data.String()
+			reply("250 queued")
+		case strings.HasPrefix(cmd, "QUIT"):
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func TestSMTPNotifierEncodesSubject(t *testing.T) {
+	addr, messages := stubSMTPServer(t, false)
+	n := &SMTPNotifier{Addr: addr, From: "certmgr@example.com", To: []string{"ops@example.com"}}
+	notice := ExpiryNotice{
+		CertificateID:         3,
+		NamespaceID:           1,
+		Subject:               "CN=证书.example\r\nBcc: victim@example.com",
+		NotAfter:              time.Now().Add(72 * time.Hour),
+		DaysLeft:              3,
+		ExpiringCertificateID: 3,
+	}
+	if err := n.Notify(context.Background(), notice); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(<-messages))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	if bcc := msg.Header.Get("Bcc"); bcc != "" {
+		t.Fatalf("subject injected a Bcc header: %q", bcc)
+	}
+	rawSubject := msg.Header.Get("Subject")
+	if !strings.HasPrefix(rawSubject, "=?utf-8?q?") {
+		t.Fatalf("subject is not Q-encoded: %q", rawSubject)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(rawSubject)
+	if err != nil {
+		t.Fatalf("decode subject: %v", err)
+	}
+	want := "[certmgr] CN=证书.exampleBcc: victim@example.com expires in 3 days"
+	if subject != want {
+		t.Fatalf("subject %q, want %q", subject, want)
+	}
+}
+
+func TestSMTPNotifierHonoursDeadline(t *testing.T) {
+	addr, _ := stubSMTPServer(t, true)
+	n := &SMTPNotifier{Addr: addr, From: "certmgr@example.com", To: []string{"ops@example.com"}}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := n.Notify(ctx, ExpiryNotice{Subject: "CN=slow", DaysLeft: 1})
+	if err == nil {
+		t.Fatal("notify against a silent server succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("notify returned after %s", elapsed)
+	}
+}
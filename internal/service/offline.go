@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+)
+
+const (
+	offlineKeyPemType    = "CERTMGR OFFLINE PRIVATE KEY"
+	signingBundleVersion = 1
+	minOfflinePassphrase = 8
+)
+
+// SigningTemplate is everything an offline CA needs to sign a certificate,
+// without any access to the certmgr database.
+type SigningTemplate struct {
+	SerialNumber string    `json:"serialNumber"`
+	Subject      []byte    `json:"subject"`
+	NotBefore    time.Time `json:"notBefore"`
+	NotAfter     time.Time `json:"notAfter"`
+	KeyUsage     int       `json:"keyUsage"`
+	ExtKeyUsage  []int     `json:"extKeyUsage"`
+	IsCA         bool      `json:"isCA"`
+	DNSNames     []string  `json:"dnsNames"`
+	IPAddresses  []string  `json:"ipAddresses"`
+	PublicKey    []byte    `json:"publicKey"`
+}
+
+// SigningBundle is handed to the operator of an offline CA. It is signed with
+// `certmgr sign-bundle` and the resulting certificate is uploaded back.
+type SigningBundle struct {
+	Version       int             `json:"version"`
+	RequestID     int             `json:"requestId"`
+	IssuerCertPem string          `json:"issuerCertPem"`
+	Template      SigningTemplate `json:"template"`
+}
+
+func newSigningTemplate(tmpl *x509.Certificate, pub crypto.PublicKey) (*SigningTemplate, error) {
+	rawSubject := tmpl.RawSubject
+	if rawSubject == nil {
+		var err error
+		rawSubject, err = asn1.Marshal(tmpl.Subject.ToRDNSequence())
+		if err != nil {
+			return nil, fmt.Errorf("marshal subject failed: %w", err)
+		}
+	}
+	pubDer, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("marshal public key failed: %w", err)
+	}
+	extKeyUsage := make([]int, len(tmpl.ExtKeyUsage))
+	for i, eku := range tmpl.ExtKeyUsage {
+		extKeyUsage[i] = int(eku)
+	}
+	return &SigningTemplate{
+		SerialNumber: tmpl.SerialNumber.Text(16),
+		Subject:      rawSubject,
+		NotBefore:    tmpl.NotBefore,
+		NotAfter:     tmpl.NotAfter,
+		KeyUsage:     int(tmpl.KeyUsage),
+		ExtKeyUsage:  extKeyUsage,
+		IsCA:         tmpl.IsCA,
+		DNSNames:     tmpl.DNSNames,
+		IPAddresses:  formatIPAddresses(tmpl.IPAddresses),
+		PublicKey:    pubDer,
+	}, nil
+}
+
+func (t *SigningTemplate) toX509() (*x509.Certificate, crypto.PublicKey, error) {
+	serialNumber, ok := new(big.Int).SetString(t.SerialNumber, 16)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid serial number: %s", t.SerialNumber)
+	}
+	pub, err := x509.ParsePKIXPublicKey(t.PublicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse public key failed: %w", err)
+	}
+	extKeyUsage := make([]x509.ExtKeyUsage, len(t.ExtKeyUsage))
+	for i, eku := range t.ExtKeyUsage {
+		extKeyUsage[i] = x509.ExtKeyUsage(eku)
+	}
+	var ipAddresses []net.IP
+	for _, ip := range t.IPAddresses {
+		parsed := net.ParseIP(ip)
+		if parsed == nil {
+			return nil, nil, fmt.Errorf("invalid ip address: %s", ip)
+		}
+		ipAddresses = append(ipAddresses, parsed)
+	}
+	return &x509.Certificate{
+		SerialNumber:          serialNumber,
+		RawSubject:            t.Subject,
+		NotBefore:             t.NotBefore,
+		NotAfter:              t.NotAfter,
+		KeyUsage:              x509.KeyUsage(t.KeyUsage),
+		ExtKeyUsage:           extKeyUsage,
+		BasicConstraintsValid: true,
+		IsCA:                  t.IsCA,
+		DNSNames:              t.DNSNames,
+		IPAddresses:           ipAddresses,
+	}, pub, nil
+}
+
+// SignBundle signs bundle with the offline key of its issuer and returns the
+// certificate PEM to upload.
+func SignBundle(bundle *SigningBundle, signer crypto.Signer) (string, error) {
+	if bundle.Version != signingBundleVersion {
+		return "", fmt.Errorf("unsupported bundle version: %d", bundle.Version)
+	}
+	issuer, err := getCertFromPem(bundle.IssuerCertPem)
+	if err != nil {
+		return "", fmt.Errorf("get issuer cert from pem failed: %w", err)
+	}
+	issuerPub, ok := issuer.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !issuerPub.Equal(signer.Public()) {
+		return "", fmt.Errorf("key does not belong to the issuer of this bundle")
+	}
+	tmpl, pub, err := bundle.Template.toX509()
+	if err != nil {
+		return "", fmt.Errorf("build template failed: %w", err)
+	}
+	certDer, err := x509.CreateCertificate(rand.Reader, tmpl, issuer, pub, signer)
+	if err != nil {
+		return "", fmt.Errorf("create x509 certificate failed: %w", err)
+	}
+	return string(rawCertToPem(certDer)), nil
+}
+
+// OpenOfflineKey decrypts a key exported by ExportOfflineKey.
+func OpenOfflineKey(data []byte, passphrase string) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != offlineKeyPemType {
+		return nil, fmt.Errorf("not an offline key")
+	}
+	keyPem, err := passphraseOpen(passphrase, block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt offline key failed: %w", err)
+	}
+	key, err := getPrivateKeyFromPem(string(keyPem))
+	if err != nil {
+		return nil, fmt.Errorf("get private key from pem failed: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("offline key is not a signer")
+	}
+	return signer, nil
+}
+
+// ExportOfflineKey returns the key of a CA encrypted under passphrase. The
+// database keeps its copy until TakeCertificateOffline is called with the
+// returned PEM, so a lost download does not lose the key.
+func (s *CertificateService) ExportOfflineKey(ctx context.Context, id int, passphrase string) (_ string, err error) {
+	ctx = withAuditOperation(ctx, "certificate.export_offline_key", nil)
+	defer s.ctx.auditFailure(ctx, "certificate", id, &err)
+	if len(passphrase) < minOfflinePassphrase {
+		return "", fmt.Errorf("passphrase must be at least %d characters", minOfflinePassphrase)
+	}
+	cert, _, err := s.offlineCandidate(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	keyPem, err := s.ctx.keyring.Open(cert.KeyPem, certKeyAAD(cert.ID))
+	if err != nil {
+		return "", fmt.Errorf("open key of cert %d failed: %w", id, err)
+	}
+	sealed, err := passphraseSeal(passphrase, []byte(keyPem))
+	if err != nil {
+		return "", fmt.Errorf("encrypt key of cert %d failed: %w", id, err)
+	}
+	if err := s.ctx.auditRead(ctx, "certificate", id, cert.NamespaceID); err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: offlineKeyPemType, Bytes: sealed})), nil
+}
+
+// TakeCertificateOffline wipes the key of a CA from the database once
+// offlineKey, as returned by ExportOfflineKey, opens with passphrase and holds
+// the key of the certificate. From then on every signature by this CA goes
+// through a signing request.
+func (s *CertificateService) TakeCertificateOffline(ctx context.Context, id int, offlineKey []byte, passphrase string) (err error) {
+	ctx = withAuditOperation(ctx, "certificate.take_offline", nil)
+	defer s.ctx.auditFailure(ctx, "certificate", id, &err)
+	cert, x509Cert, err := s.offlineCandidate(ctx, id)
+	if err != nil {
+		return err
+	}
+	signer, err := OpenOfflineKey(offlineKey, passphrase)
+	if err != nil {
+		return err
+	}
+	certPub, ok := x509Cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !certPub.Equal(signer.Public()) {
+		return fmt.Errorf("offline key does not belong to cert %d", id)
+	}
+
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		return tx.Certificate.UpdateOne(cert).
+			SetOffline(true).
+			SetKeyExportable(false).
+			SetKeyPem("").
+			Exec(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("update cert %d failed: %w", id, err)
+	}
+	return nil
+}
+
+// offlineCandidate returns cert id when it is a CA whose key is held in the
+// database and can be moved offline.
+func (s *CertificateService) offlineCandidate(ctx context.Context, id int) (*ent.Certificate, *x509.Certificate, error) {
+	cert, err := s.ctx.client.Certificate.Get(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get cert %d failed: %w", id, err)
+	}
+	if cert.Offline {
+		return nil, nil, fmt.Errorf("cert %d is already offline", id)
+	}
+	x509Cert, err := getCertFromPem(cert.CertPem)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get cert %d from pem failed: %w", id, err)
+	}
+	if !x509Cert.IsCA {
+		return nil, nil, fmt.Errorf("cert %d is not a CA", id)
+	}
+	if backend := keyRefBackend(cert.KeyRef); backend != "" && backend != DatabaseKeyBackend {
+		return nil, nil, fmt.Errorf("key of cert %d is held by the %s backend", id, backend)
+	}
+	if cert.KeyPem == "" {
+		return nil, nil, fmt.Errorf("cert %d has no private key", id)
+	}
+	return cert, x509Cert, nil
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 1000
+
+	SortByCreated = "created"
+	SortByUpdated = "updated"
+	SortByExpiry  = "expiry"
+	SortBySubject = "subject"
+	SortByName    = "name"
+)
+
+// ListOptions selects one page of a list. Limit 0 means DefaultListLimit.
+// SortBy is one of the SortBy constants, prefixed with "-" for descending
+// order. Fields is a mask of JSON keys to keep in every item, empty keeps all.
+type ListOptions struct {
+	Offset int
+	Limit  int
+	SortBy string
+	Fields []string
+}
+
+type ListMeta struct {
+	Total  int `json:"total"`
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+}
+
+// ListPage is the envelope returned by list endpoints and list tools.
+type ListPage struct {
+	Items any      `json:"items"`
+	Meta  ListMeta `json:"meta"`
+}
+
+// ParseListOptions builds ListOptions from the raw query values shared by the
+// HTTP API and the MCP tools.
+func ParseListOptions(offset, limit int, sort, fields string) (ListOptions, error) {
+	if offset < 0 {
+		return ListOptions{}, fmt.Errorf("offset must not be negative")
+	}
+	if limit < 0 || limit > MaxListLimit {
+		return ListOptions{}, fmt.Errorf("limit must be between 0 and %d", MaxListLimit)
+	}
+	opts := ListOptions{Offset: offset, Limit: limit, SortBy: sort}
+	if fields != "" {
+		for _, f := range strings.Split(fields, ",") {
+			if f = strings.TrimSpace(f); f != "" {
+				opts.Fields = append(opts.Fields, f)
+			}
+		}
+	}
+	return opts, nil
+}
+
+func (o ListOptions) limit() int {
+	if o.Limit == 0 {
+		return DefaultListLimit
+	}
+	return o.Limit
+}
+
+// sort splits SortBy into the key and the direction, checking the key against
+// allowed. An empty SortBy falls back to def ascending.
+func (o ListOptions) sort(def string, allowed ...string) (string, bool, error) {
+	key, desc := strings.CutPrefix(o.SortBy, "-")
+	if key == "" {
+		return def, false, nil
+	}
+	for _, a := range allowed {
+		if key == a {
+			return key, desc, nil
+		}
+	}
+	return "", false, fmt.Errorf("unsupported sort key %q, supported: %s", key, strings.Join(allowed, ", "))
+}
+
+// CheckFields checks the field mask against the JSON keys of item, so callers
+// can reject an unknown field before running the query.
+func (o ListOptions) CheckFields(item any) error {
+	return checkFieldMask(reflect.TypeOf(item), o.Fields)
+}
+
+// NewListPage wraps items in the list envelope, keeping only the masked
+// fields of every item.
+func NewListPage(items any, total int, opts ListOptions) (*ListPage, error) {
+	if t := reflect.TypeOf(items); t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		if err := checkFieldMask(t.Elem(), opts.Fields); err != nil {
+			return nil, err
+		}
+	}
+	masked, err := applyFieldMask(items, opts.Fields)
+	if err != nil {
+		return nil, err
+	}
+	return &ListPage{
+		Items: masked,
+		Meta:  ListMeta{Total: total, Offset: opts.Offset, Limit: opts.limit()},
+	}, nil
+}
+
+func applyFieldMask(items any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return items, nil
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("marshal items failed: %w", err)
+	}
+	var rows []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("field mask only applies to lists of objects: %w", err)
+	}
+	masked := make([]map[string]json.RawMessage, 0, len(rows))
+	for _, row := range rows {
+		m := make(map[string]json.RawMessage, len(fields))
+		for _, f := range fields {
+			// Fields were checked against the item type, a missing key is
+			// an omitted empty value.
+			if v, ok := row[f]; ok {
+				m[f] = v
+			}
+		}
+		masked = append(masked, m)
+	}
+	return masked, nil
+}
+
+func checkFieldMask(t reflect.Type, fields []string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	known := make(map[string]bool)
+	collectJSONFields(t, known)
+	for _, f := range fields {
+		if !known[f] {
+			return fmt.Errorf("unknown field %q", f)
+		}
+	}
+	return nil
+}
+
+// collectJSONFields adds the JSON keys encoding/json produces for t to known,
+// following embedded structs.
+func collectJSONFields(t reflect.Type, known map[string]bool) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			collectJSONFields(f.Type, known)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		known[name] = true
+	}
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestListCertificatesPages(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, root, inter, leaf := createTestChain(t, sctx)
+	certs := NewCertificateService(sctx)
+
+	list := func(opts ListOptions) ([]string, int) {
+		t.Helper()
+		page, total, err := certs.ListCertificates(ctx, ns.ID, opts)
+		if err != nil {
+			t.Fatalf("list %+v: %v", opts, err)
+		}
+		var subjects []string
+		for _, cert := range page {
+			subjects = append(subjects, cert.Subject)
+		}
+		return subjects, total
+	}
+
+	all, total := list(ListOptions{})
+	if total != 3 || !slices.Equal(all, []string{root.Subject, inter.Subject, leaf.Subject}) {
+		t.Fatalf("default page %v of %d, want the chain in creation order", all, total)
+	}
+	page, total := list(ListOptions{Offset: 1, Limit: 1})
+	if total != 3 || !slices.Equal(page, []string{inter.Subject}) {
+		t.Fatalf("second page %v of %d, want the intermediate", page, total)
+	}
+	page, total = list(ListOptions{Offset: 5})
+	if total != 3 || len(page) != 0 {
+		t.Fatalf("page past the end %v of %d", page, total)
+	}
+	page, _ = list(ListOptions{SortBy: "-" + SortByCreated})
+	if !slices.Equal(page, []string{leaf.Subject, inter.Subject, root.Subject}) {
+		t.Fatalf("descending page %v", page)
+	}
+	if _, _, err := certs.ListCertificates(ctx, ns.ID, ListOptions{SortBy: SortByName}); err == nil {
+		t.Fatal("certificates sorted by namespace name")
+	}
+}
+
+func TestParseListOptions(t *testing.T) {
+	opts, err := ParseListOptions(10, 20, "-expiry", " id, subject ,,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.Offset != 10 || opts.limit() != 20 || opts.SortBy != "-expiry" || !slices.Equal(opts.Fields, []string{"id", "subject"}) {
+		t.Fatalf("parsed %+v", opts)
+	}
+	if opts, _ := ParseListOptions(0, 0, "", ""); opts.limit() != DefaultListLimit {
+		t.Fatalf("default limit %d", opts.limit())
+	}
+	for _, in := range [][2]int{{-1, 0}, {0, -1}, {0, MaxListLimit + 1}} {
+		if _, err := ParseListOptions(in[0], in[1], "", ""); err == nil {
+			t.Errorf("offset %d and limit %d accepted", in[0], in[1])
+		}
+	}
+}
+
+type maskedItem struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name,omitempty"`
+	Secret string `json:"-"`
+	maskedEmbedded
+}
+
+type maskedEmbedded struct {
+	Extra string `json:"extra"`
+}
+
+func TestListFieldMask(t *testing.T) {
+	opts := ListOptions{Fields: []string{"id", "name", "extra"}}
+	if err := opts.CheckFields(maskedItem{}); err != nil {
+		t.Fatalf("check known fields: %v", err)
+	}
+	for _, field := range []string{"Secret", "secret", "ID", "missing"} {
+		bad := ListOptions{Fields: []string{"id", field}}
+		if err := bad.CheckFields(maskedItem{}); err == nil {
+			t.Errorf("field %q accepted", field)
+		}
+		if _, err := NewListPage([]maskedItem{}, 0, bad); err == nil {
+			t.Errorf("field %q accepted on an empty page", field)
+		}
+	}
+
+	page, err := NewListPage([]maskedItem{{ID: 1, Name: "a", Secret: "s"}, {ID: 2}}, 7, ListOptions{Fields: []string{"name"}})
+	if err != nil {
+		t.Fatalf("mask: %v", err)
+	}
+	data, err := json.Marshal(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"items":[{"name":"a"},{}],"meta":{"total":7,"offset":0,"limit":100}}`
+	if string(data) != want {
+		t.Fatalf("page %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent/certificate"
+)
+
+const DefaultProbeTimeout = 10 * time.Second
+
+// ProbeReq describes a TLS endpoint to connect to. ServerName is sent as SNI
+// and defaults to the host of Address. CertificateID is the managed
+// certificate the endpoint is expected to serve, ClientCertificateID the one
+// to present when the server asks for a client certificate.
+type ProbeReq struct {
+	Address             string `json:"address"`
+	ServerName          string `json:"serverName"`
+	CertificateID       int    `json:"certificateId"`
+	ClientCertificateID int    `json:"clientCertificateId"`
+	TimeoutSeconds      int    `json:"timeoutSeconds"`
+}
+
+type ProbedCert struct {
+	Fingerprint string    `json:"fingerprint"`
+	Subject     string    `json:"subject"`
+	Issuer      string    `json:"issuer"`
+	DNSNames    []string  `json:"dnsNames"`
+	IPAddresses []string  `json:"ipAddresses"`
+	NotBefore   time.Time `json:"notBefore"`
+	NotAfter    time.Time `json:"notAfter"`
+	IsCA        bool      `json:"isCA"`
+	// CertificateID is set when the certificate is managed by certmgr.
+	CertificateID int `json:"certificateId,omitempty"`
+}
+
+// ProbeComparison compares the served chain with a managed certificate.
+// MissingIntermediates lists the issuers of the managed certificate, roots
+// excluded, that the endpoint did not send.
+type ProbeComparison struct {
+	CertificateID        int       `json:"certificateId"`
+	Match                bool      `json:"match"`
+	FingerprintMatch     bool      `json:"fingerprintMatch"`
+	ExpectedFingerprint  string    `json:"expectedFingerprint"`
+	SANsMatch            bool      `json:"sansMatch"`
+	MissingSANs          []string  `json:"missingSans"`
+	UnexpectedSANs       []string  `json:"unexpectedSans"`
+	NotAfterMatch        bool      `json:"notAfterMatch"`
+	ExpectedNotAfter     time.Time `json:"expectedNotAfter"`
+	ChainComplete        bool      `json:"chainComplete"`
+	MissingIntermediates []string  `json:"missingIntermediates"`
+}
+
+// ProbeResult is what the endpoint presented. ChainOrdered is set when every
+// certificate is signed by the next one, HostnameMatch when the served leaf
+// is valid for ServerName.
+type ProbeResult struct {
+	Address                    string           `json:"address"`
+	ServerName                 string           `json:"serverName"`
+	TLSVersion                 string           `json:"tlsVersion"`
+	CipherSuite                string           `json:"cipherSuite"`
+	NegotiatedProtocol         string           `json:"negotiatedProtocol,omitempty"`
+	ClientCertificateRequested bool             `json:"clientCertificateRequested"`
+	Presented                  []ProbedCert     `json:"presented"`
+	ChainOrdered               bool             `json:"chainOrdered"`
+	HostnameMatch              bool             `json:"hostnameMatch"`
+	Comparison                 *ProbeComparison `json:"comparison,omitempty"`
+}
+
+// ProbeEndpoint connects to a TLS endpoint and reports the chain it presents.
+// The server certificate is not verified during the handshake, so broken
+// endpoints can be inspected as well.
+func (s *CertificateService) ProbeEndpoint(ctx context.Context, req ProbeReq) (*ProbeResult, error) {
+	host, _, err := net.SplitHostPort(req.Address)
+	if err != nil {
+		return nil, fmt.Errorf("parse address failed: %w", err)
+	}
+	serverName := req.ServerName
+	if serverName == "" {
+		serverName = host
+	}
+	timeout := DefaultProbeTimeout
+	if req.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+
+	result := &ProbeResult{
+		Address:    req.Address,
+		ServerName: serverName,
+		Presented:  make([]ProbedCert, 0),
+	}
+	config := &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true,
+	}
+	clientCert := &tls.Certificate{}
+	if req.ClientCertificateID != 0 {
+		clientCert, err = s.tlsCertificate(ctx, req.ClientCertificateID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		result.ClientCertificateRequested = true
+		return clientCert, nil
+	}
+
+	dialer := &tls.Dialer{NetDialer: &net.Dialer{Timeout: timeout}, Config: config}
+	dialCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	conn, err := dialer.DialContext(dialCtx, "tcp", req.Address)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s failed: %w", req.Address, err)
+	}
+	defer conn.Close()
+	state := conn.(*tls.Conn).ConnectionState()
+	result.TLSVersion = tls.VersionName(state.Version)
+	result.CipherSuite = tls.CipherSuiteName(state.CipherSuite)
+	result.NegotiatedProtocol = state.NegotiatedProtocol
+
+	presented := state.PeerCertificates
+	if len(presented) == 0 {
+		return nil, fmt.Errorf("%s presented no certificate", req.Address)
+	}
+	fingerprints := make([]string, 0, len(presented))
+	for _, cert := range presented {
+		fingerprints = append(fingerprints, certFingerprint(cert))
+	}
+	managed, err := s.ctx.client.Certificate.Query().
+		Where(certificate.FingerprintIn(fingerprints...)).
+		Select(certificate.FieldFingerprint).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query certificates by fingerprint failed: %w", err)
+	}
+	known := make(map[string]int, len(managed))
+	for _, cert := range managed {
+		known[cert.Fingerprint] = cert.ID
+	}
+	for i, cert := range presented {
+		result.Presented = append(result.Presented, ProbedCert{
+			Fingerprint:   fingerprints[i],
+			Subject:       getSubject(cert),
+			Issuer:        cert.Issuer.String(),
+			DNSNames:      nonNilStrings(cert.DNSNames),
+			IPAddresses:   ipStrings(cert),
+			NotBefore:     cert.NotBefore,
+			NotAfter:      cert.NotAfter,
+			IsCA:          cert.IsCA,
+			CertificateID: known[fingerprints[i]],
+		})
+	}
+	result.ChainOrdered = true
+	for i := 0; i+1 < len(presented); i++ {
+		if !issuedBy(presented[i], presented[i+1]) {
+			result.ChainOrdered = false
+			break
+		}
+	}
+	result.HostnameMatch = presented[0].VerifyHostname(serverName) == nil
+
+	if req.CertificateID != 0 {
+		result.Comparison, err = s.compareProbed(ctx, req.CertificateID, presented)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *CertificateService) compareProbed(ctx context.Context, id int, presented []*x509.Certificate) (*ProbeComparison, error) {
+	chain, err := certChain(ctx, s.ctx.client, id)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := getCertFromPem(chain[0].CertPem)
+	if err != nil {
+		return nil, fmt.Errorf("get cert %d from pem failed: %w", id, err)
+	}
+	served := presented[0]
+	cmp := &ProbeComparison{
+		CertificateID:        id,
+		ExpectedFingerprint:  certFingerprint(expected),
+		ExpectedNotAfter:     expected.NotAfter,
+		MissingSANs:          make([]string, 0),
+		UnexpectedSANs:       make([]string, 0),
+		MissingIntermediates: make([]string, 0),
+	}
+	cmp.FingerprintMatch = certFingerprint(served) == cmp.ExpectedFingerprint
+	cmp.NotAfterMatch = served.NotAfter.Equal(expected.NotAfter)
+
+	expectedSANs := append(nonNilStrings(expected.DNSNames), ipStrings(expected)...)
+	servedSANs := append(nonNilStrings(served.DNSNames), ipStrings(served)...)
+	for _, san := range expectedSANs {
+		if !slices.Contains(servedSANs, san) {
+			cmp.MissingSANs = append(cmp.MissingSANs, san)
+		}
+	}
+	for _, san := range servedSANs {
+		if !slices.Contains(expectedSANs, san) {
+			cmp.UnexpectedSANs = append(cmp.UnexpectedSANs, san)
+		}
+	}
+	cmp.SANsMatch = len(cmp.MissingSANs) == 0 && len(cmp.UnexpectedSANs) == 0
+
+	servedFingerprints := make([]string, 0, len(presented))
+	for _, cert := range presented {
+		servedFingerprints = append(servedFingerprints, certFingerprint(cert))
+	}
+	for _, issuer := range chain[1:] {
+		if issuer.IssuerID == 0 {
+			continue
+		}
+		if !slices.Contains(servedFingerprints, issuer.Fingerprint) {
+			subject, err := getSubjectFromPem(issuer.CertPem)
+			if err != nil {
+				return nil, fmt.Errorf("get subject of cert %d failed: %w", issuer.ID, err)
+			}
+			cmp.MissingIntermediates = append(cmp.MissingIntermediates, subject)
+		}
+	}
+	cmp.ChainComplete = len(cmp.MissingIntermediates) == 0
+	cmp.Match = cmp.FingerprintMatch && cmp.SANsMatch && cmp.NotAfterMatch && cmp.ChainComplete
+	return cmp, nil
+}
+
+// tlsCertificate loads a managed certificate with its chain, roots excluded,
+// and a signer from its key store.
+func (s *CertificateService) tlsCertificate(ctx context.Context, id int) (*tls.Certificate, error) {
+	chain, err := certChain(ctx, s.ctx.client, id)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := s.ctx.signerFor(ctx, chain[0])
+	if err != nil {
+		return nil, fmt.Errorf("get signer of cert %d failed: %w", id, err)
+	}
+	tlsCert := &tls.Certificate{PrivateKey: signer}
+	for _, cert := range chain {
+		if cert.IssuerID == 0 && cert.ID != id {
+			continue
+		}
+		x509Cert, err := getCertFromPem(cert.CertPem)
+		if err != nil {
+			return nil, fmt.Errorf("get cert %d from pem failed: %w", cert.ID, err)
+		}
+		tlsCert.Certificate = append(tlsCert.Certificate, x509Cert.Raw)
+	}
+	return tlsCert, nil
+}
+
+func nonNilStrings(values []string) []string {
+	return append(make([]string, 0, len(values)), values...)
+}
+
+func ipStrings(cert *x509.Certificate) []string {
+	ips := make([]string, 0, len(cert.IPAddresses))
+	for _, ip := range cert.IPAddresses {
+		ips = append(ips, ip.String())
+	}
+	return ips
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+// serveTestTLS starts a TLS server presenting cert and returns its address.
+func serveTestTLS(t *testing.T, cert *tls.Certificate) string {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	srv.TLS = &tls.Config{Certificates: []tls.Certificate{*cert}}
+	// The probe hangs up right after the handshake.
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv.Listener.Addr().String()
+}
+
+func TestProbeEndpoint(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, _, inter, leaf := createTestChain(t, sctx)
+	other := createTestCert(t, sctx, testCertReq(ns.ID, inter.ID, "other.internal", false, "other.internal"))
+	svc := NewCertificateService(sctx)
+
+	leafTLS, err := svc.tlsCertificate(ctx, leaf.ID)
+	if err != nil {
+		t.Fatalf("load leaf: %v", err)
+	}
+	otherTLS, err := svc.tlsCertificate(ctx, other.ID)
+	if err != nil {
+		t.Fatalf("load other: %v", err)
+	}
+	leafOnly := *leafTLS
+	leafOnly.Certificate = leafTLS.Certificate[:1]
+
+	probe := func(cert *tls.Certificate) *ProbeResult {
+		t.Helper()
+		result, err := svc.ProbeEndpoint(ctx, ProbeReq{
+			Address:       serveTestTLS(t, cert),
+			ServerName:    "api.internal",
+			CertificateID: leaf.ID,
+		})
+		if err != nil {
+			t.Fatalf("probe: %v", err)
+		}
+		return result
+	}
+
+	t.Run("matching", func(t *testing.T) {
+		result := probe(leafTLS)
+		cmp := result.Comparison
+		if !cmp.Match || !cmp.FingerprintMatch || !cmp.SANsMatch || !cmp.ChainComplete {
+			t.Fatalf("comparison %+v, want a full match", cmp)
+		}
+		if !result.HostnameMatch || !result.ChainOrdered || len(result.Presented) != 2 {
+			t.Fatalf("result %+v, want an ordered two certificate chain for api.internal", result)
+		}
+		if result.Presented[0].CertificateID != leaf.ID || result.Presented[1].CertificateID != inter.ID {
+			t.Fatalf("presented %+v not mapped to managed certificates", result.Presented)
+		}
+	})
+
+	t.Run("fingerprint and SAN mismatch", func(t *testing.T) {
+		result := probe(otherTLS)
+		cmp := result.Comparison
+		if cmp.Match || cmp.FingerprintMatch {
+			t.Fatalf("comparison %+v matched another certificate", cmp)
+		}
+		if cmp.SANsMatch || !slices.Equal(cmp.MissingSANs, []string{"api.internal"}) || !slices.Equal(cmp.UnexpectedSANs, []string{"other.internal"}) {
+			t.Fatalf("SAN comparison missing=%v unexpected=%v", cmp.MissingSANs, cmp.UnexpectedSANs)
+		}
+		if result.HostnameMatch {
+			t.Fatal("other.internal reported as valid for api.internal")
+		}
+		if !cmp.ChainComplete {
+			t.Fatal("shared intermediate reported missing")
+		}
+	})
+
+	t.Run("missing intermediate", func(t *testing.T) {
+		result := probe(&leafOnly)
+		cmp := result.Comparison
+		if cmp.Match || cmp.ChainComplete || !cmp.FingerprintMatch {
+			t.Fatalf("comparison %+v, want only the chain to differ", cmp)
+		}
+		if len(cmp.MissingIntermediates) != 1 || cmp.MissingIntermediates[0] != inter.Subject {
+			t.Fatalf("missing intermediates %v, want %q", cmp.MissingIntermediates, inter.Subject)
+		}
+	})
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+)
+
+const (
+	RenewalTriggerRemainingFraction = "remaining_fraction"
+	RenewalTriggerInterval          = "interval"
+
+	renewalBaseBackoff = 5 * time.Minute
+	renewalMaxBackoff  = 24 * time.Hour
+)
+
+// RenewalPolicyReq configures automatic renewal. With the remaining_fraction
+// trigger a certificate is renewed once RemainingFraction of its lifetime is
+// left; with the interval trigger every IntervalDays after NotBefore.
+// ValidDays defaults to the current lifetime.
+type RenewalPolicyReq struct {
+	Trigger           string  `json:"trigger"`
+	RemainingFraction float64 `json:"remainingFraction"`
+	IntervalDays      int     `json:"intervalDays"`
+	Rekey             bool    `json:"rekey"`
+	ValidDays         int     `json:"validDays"`
+	Enabled           bool    `json:"enabled"`
+}
+
+type RenewalPolicy struct {
+	ID                int        `json:"id"`
+	CertificateID     int        `json:"certificateId"`
+	Trigger           string     `json:"trigger"`
+	RemainingFraction float64    `json:"remainingFraction"`
+	IntervalDays      int        `json:"intervalDays"`
+	Rekey             bool       `json:"rekey"`
+	ValidDays         int        `json:"validDays"`
+	Enabled           bool       `json:"enabled"`
+	Failures          int        `json:"failures"`
+	LastError         string     `json:"lastError"`
+	NextAttemptAt     *time.Time `json:"nextAttemptAt"`
+}
+
+type RenewalAttempt struct {
+	ID               int       `json:"id"`
+	CertificateID    int       `json:"certificateId"`
+	NamespaceID      int       `json:"namespaceId"`
+	Rekey            bool      `json:"rekey"`
+	Status           string    `json:"status"`
+	Error            string    `json:"error"`
+	SigningRequestID int       `json:"signingRequestId"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+type RenewalService struct {
+	ctx *ServiceContext
+}
+
+func NewRenewalService(ctx *ServiceContext) *RenewalService {
+	return &RenewalService{
+		ctx: ctx,
+	}
+}
+
+func (s *RenewalService) SetRenewalPolicy(ctx context.Context, certID int, req RenewalPolicyReq) (_ *RenewalPolicy, err error) {
+	ctx = withAuditOperation(ctx, "renewal_policy.set", req)
+	defer s.ctx.auditFailure(ctx, "certificate", certID, &err)
+	switch req.Trigger {
+	case RenewalTriggerRemainingFraction:
+		if req.RemainingFraction <= 0 || req.RemainingFraction >= 1 {
+			return nil, fmt.Errorf("remainingFraction must be between 0 and 1")
+		}
+	case RenewalTriggerInterval:
+		if req.IntervalDays <= 0 {
+			return nil, fmt.Errorf("intervalDays must be positive")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported renewal trigger: %s", req.Trigger)
+	}
+	if req.ValidDays < 0 {
+		return nil, fmt.Errorf("validDays must not be negative")
+	}
+	if _, err := s.ctx.client.Certificate.Get(ctx, certID); err != nil {
+		return nil, fmt.Errorf("get cert %d failed: %w", certID, err)
+	}
+
+	existing, err := s.ctx.client.RenewalPolicy.Query().Where(renewalpolicy.CertificateID(certID)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("query renewal policy of cert %d failed: %w", certID, err)
+	}
+	var policy *ent.RenewalPolicy
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		if existing == nil {
+			policy, err = tx.RenewalPolicy.Create().
+				SetCertificateID(certID).
+				SetTrigger(renewalpolicy.Trigger(req.Trigger)).
+				SetRemainingFraction(req.RemainingFraction).
+				SetIntervalDays(req.IntervalDays).
+				SetRekey(req.Rekey).
+				SetValidDays(req.ValidDays).
+				SetEnabled(req.Enabled).
+				Save(ctx)
+			return err
+		}
+		// A changed policy starts with a clean slate.
+		policy, err = tx.RenewalPolicy.UpdateOne(existing).
+			SetTrigger(renewalpolicy.Trigger(req.Trigger)).
+			SetRemainingFraction(req.RemainingFraction).
+			SetIntervalDays(req.IntervalDays).
+			SetRekey(req.Rekey).
+			SetValidDays(req.ValidDays).
+			SetEnabled(req.Enabled).
+			SetFailures(0).
+			SetLastError("").
+			ClearNextAttemptAt().
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save renewal policy failed: %w", err)
+	}
+	return toRenewalPolicy(policy), nil
+}
+
+func (s *RenewalService) DeleteRenewalPolicy(ctx context.Context, certID int) (err error) {
+	ctx = withAuditOperation(ctx, "renewal_policy.delete", nil)
+	defer s.ctx.auditFailure(ctx, "certificate", certID, &err)
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		_, err := tx.RenewalPolicy.Delete().Where(renewalpolicy.CertificateID(certID)).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("delete renewal policy of cert %d failed: %w", certID, err)
+	}
+	return nil
+}
+
+// ListRenewalAttempts returns the newest attempts in a namespace, optionally
+// filtered by status and certificate.
+func (s *RenewalService) ListRenewalAttempts(ctx context.Context, namespaceID int, certID int, status string, limit int) ([]RenewalAttempt, error) {
+	query := s.ctx.client.RenewalAttempt.Query().Where(renewalattempt.NamespaceID(namespaceID))
+	if certID != 0 {
+		query = query.Where(renewalattempt.CertificateID(certID))
+	}
+	if status != "" {
+		if err := renewalattempt.StatusValidator(renewalattempt.Status(status)); err != nil {
+			return nil, fmt.Errorf("invalid status: %w", err)
+		}
+		query = query.Where(renewalattempt.StatusEQ(renewalattempt.Status(status)))
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+	attempts, err := query.Order(ent.Desc(renewalattempt.FieldID)).Limit(limit).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query renewal attempts failed: %w", err)
+	}
+	result := make([]RenewalAttempt, 0, len(attempts))
+	for _, a := range attempts {
+		result = append(result, *toRenewalAttempt(a))
+	}
+	return result, nil
+}
+
+// RunDueRenewals renews every certificate whose enabled policy is due. Failed
+// renewals are retried with exponential backoff. Renewals waiting for an
+// offline signature are not queued again.
+func (s *RenewalService) RunDueRenewals(ctx context.Context) (int, error) {
+	policies, err := s.ctx.client.RenewalPolicy.Query().
+		Where(renewalpolicy.Enabled(true)).
+		WithCertificate(func(q *ent.CertificateQuery) {
+			q.Select(certificateSummaryFields...)
+		}).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("query renewal policies failed: %w", err)
+	}
+	now := time.Now()
+	var renewed int
+	var errs []error
+	for _, policy := range policies {
+		if policy.NextAttemptAt != nil && now.Before(*policy.NextAttemptAt) {
+			continue
+		}
+		cert := policy.Edges.Certificate
+		if !renewalDue(policy, cert.NotBefore, cert.NotAfter, now) {
+			continue
+		}
+		waiting, err := s.ctx.client.SigningRequest.Query().
+			Where(
+				signingrequest.CertificateID(cert.ID),
+				signingrequest.KindEQ(signingrequest.KindRenew),
+				signingrequest.StatusEQ(signingrequest.StatusPending),
+			).
+			Exist(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("query signing requests of cert %d failed: %w", cert.ID, err))
+			continue
+		}
+		if waiting {
+			continue
+		}
+
+		validDays := policy.ValidDays
+		if validDays == 0 {
+			validDays = int(math.Round(cert.NotAfter.Sub(cert.NotBefore).Hours() / 24))
+		}
+		certSvc := NewCertificateService(s.ctx)
+		var sr *SigningRequest
+		if policy.Rekey {
+			sr, err = certSvc.RekeyCertificate(ctx, cert.ID, validDays)
+		} else {
+			sr, err = certSvc.RenewCertificate(ctx, cert.ID, validDays)
+		}
+		if recordErr := s.recordAttempt(ctx, policy, cert, sr, err); recordErr != nil {
+			errs = append(errs, recordErr)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("renew cert %d failed: %w", cert.ID, err))
+			continue
+		}
+		renewed++
+	}
+	return renewed, errors.Join(errs...)
+}
+
+func (s *RenewalService) recordAttempt(ctx context.Context, policy *ent.RenewalPolicy, cert *ent.Certificate, sr *SigningRequest, renewErr error) error {
+	return s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		attempt := tx.RenewalAttempt.Create().
+			SetPolicyID(policy.ID).
+			SetCertificateID(cert.ID).
+			SetNamespaceID(cert.NamespaceID).
+			SetRekey(policy.Rekey)
+		update := tx.RenewalPolicy.UpdateOne(policy)
+		switch {
+		case renewErr != nil:
+			failures := policy.Failures + 1
+			attempt.SetStatus(renewalattempt.StatusFailed).SetError(renewErr.Error())
+			update.SetFailures(failures).
+				SetLastError(renewErr.Error()).
+				SetNextAttemptAt(time.Now().Add(renewalBackoff(failures)))
+		case sr != nil:
+			attempt.SetStatus(renewalattempt.StatusPendingSignature).SetSigningRequestID(sr.ID)
+			update.SetFailures(0).SetLastError("").ClearNextAttemptAt()
+		default:
+			attempt.SetStatus(renewalattempt.StatusSucceeded)
+			update.SetFailures(0).SetLastError("").ClearNextAttemptAt()
+		}
+		if err := attempt.Exec(ctx); err != nil {
+			return fmt.Errorf("save renewal attempt of cert %d failed: %w", cert.ID, err)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("update renewal policy of cert %d failed: %w", cert.ID, err)
+		}
+		return nil
+	})
+}
+
+func renewalDue(policy *ent.RenewalPolicy, notBefore, notAfter, now time.Time) bool {
+	switch policy.Trigger {
+	case renewalpolicy.TriggerRemainingFraction:
+		lifetime := notAfter.Sub(notBefore)
+		return notAfter.Sub(now) <= time.Duration(float64(lifetime)*policy.RemainingFraction)
+	case renewalpolicy.TriggerInterval:
+		return !now.Before(notBefore.AddDate(0, 0, policy.IntervalDays))
+	default:
+		return false
+	}
+}
+
+func renewalBackoff(failures int) time.Duration {
+	backoff := renewalBaseBackoff
+	for i := 1; i < failures && backoff < renewalMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, renewalMaxBackoff)
+}
+
+func toRenewalPolicy(p *ent.RenewalPolicy) *RenewalPolicy {
+	return &RenewalPolicy{
+		ID:                p.ID,
+		CertificateID:     p.CertificateID,
+		Trigger:           p.Trigger.String(),
+		RemainingFraction: p.RemainingFraction,
+		IntervalDays:      p.IntervalDays,
+		Rekey:             p.Rekey,
+		ValidDays:         p.ValidDays,
+		Enabled:           p.Enabled,
+		Failures:          p.Failures,
+		LastError:         p.LastError,
+		NextAttemptAt:     p.NextAttemptAt,
+	}
+}
+
+func toRenewalAttempt(a *ent.RenewalAttempt) *RenewalAttempt {
+	return &RenewalAttempt{
+		ID:               a.ID,
+		CertificateID:    a.CertificateID,
+		NamespaceID:      a.NamespaceID,
+		Rekey:            a.Rekey,
+		Status:           a.Status.String(),
+		Error:            a.Error,
+		SigningRequestID: a.SigningRequestID,
+		CreatedAt:        a.CreatedAt,
+	}
+}
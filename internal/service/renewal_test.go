@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/renewalattempt"
+	"github.com/logeable/certmgr/internal/ent/renewalpolicy"
+)
+
+func TestRenewalDue(t *testing.T) {
+	notBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := notBefore.AddDate(0, 0, 100)
+	tests := []struct {
+		name   string
+		policy ent.RenewalPolicy
+		now    time.Time
+		want   bool
+	}{
+		{"fraction not reached", ent.RenewalPolicy{Trigger: renewalpolicy.TriggerRemainingFraction, RemainingFraction: 0.25}, notBefore.AddDate(0, 0, 74), false},
+		{"fraction reached", ent.RenewalPolicy{Trigger: renewalpolicy.TriggerRemainingFraction, RemainingFraction: 0.25}, notBefore.AddDate(0, 0, 75), true},
+		{"expired", ent.RenewalPolicy{Trigger: renewalpolicy.TriggerRemainingFraction, RemainingFraction: 0.25}, notAfter.AddDate(0, 0, 1), true},
+		{"interval not reached", ent.RenewalPolicy{Trigger: renewalpolicy.TriggerInterval, IntervalDays: 30}, notBefore.AddDate(0, 0, 29), false},
+		{"interval reached", ent.RenewalPolicy{Trigger: renewalpolicy.TriggerInterval, IntervalDays: 30}, notBefore.AddDate(0, 0, 30), true},
+	}
+	for _, tt := range tests {
+		if got := renewalDue(&tt.policy, notBefore, notAfter, tt.now); got != tt.want {
+			t.Errorf("%s: renewalDue = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRenewalBackoff(t *testing.T) {
+	tests := map[int]time.Duration{
+		1:  5 * time.Minute,
+		2:  10 * time.Minute,
+		3:  20 * time.Minute,
+		9:  1280 * time.Minute,
+		10: 24 * time.Hour,
+		50: 24 * time.Hour,
+	}
+	for failures, want := range tests {
+		if got := renewalBackoff(failures); got != want {
+			t.Errorf("renewalBackoff(%d) = %s, want %s", failures, got, want)
+		}
+	}
+}
+
+func TestRunDueRenewals(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	_, _, inter, leaf := createTestChain(t, sctx)
+	renewals := NewRenewalService(sctx)
+
+	_, err := renewals.SetRenewalPolicy(ctx, leaf.ID, RenewalPolicyReq{
+		Trigger:           RenewalTriggerRemainingFraction,
+		RemainingFraction: 0.5,
+		ValidDays:         30,
+		Enabled:           true,
+	})
+	if err != nil {
+		t.Fatalf("set leaf policy: %v", err)
+	}
+	// Rekeying a CA that issued certificates fails.
+	_, err = renewals.SetRenewalPolicy(ctx, inter.ID, RenewalPolicyReq{
+		Trigger:           RenewalTriggerRemainingFraction,
+		RemainingFraction: 0.5,
+		Rekey:             true,
+		Enabled:           true,
+	})
+	if err != nil {
+		t.Fatalf("set intermediate policy: %v", err)
+	}
+	// Every certificate has less than all of its lifetime left, so both
+	// policies are always due.
+	sctx.client.RenewalPolicy.Update().SetRemainingFraction(1).ExecX(ctx)
+
+	original, err := getCertFromPem(leaf.CertPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serials := map[string]bool{original.SerialNumber.String(): true}
+	for run := 1; run <= 2; run++ {
+		renewed, err := renewals.RunDueRenewals(ctx)
+		if err == nil && run == 1 {
+			t.Fatal("failed renewal of the intermediate was not reported")
+		}
+		if renewed != 1 {
+			t.Fatalf("run %d renewed %d certificates, want 1", run, renewed)
+		}
+		renewedCert, err := getCertFromPem(sctx.client.Certificate.GetX(ctx, leaf.ID).CertPem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if serials[renewedCert.SerialNumber.String()] {
+			t.Fatalf("run %d reused serial %s", run, renewedCert.SerialNumber)
+		}
+		serials[renewedCert.SerialNumber.String()] = true
+		if !renewedCert.PublicKey.(interface{ Equal(crypto.PublicKey) bool }).Equal(original.PublicKey) {
+			t.Fatal("renewal without rekey changed the key")
+		}
+		if days := renewedCert.NotAfter.Sub(renewedCert.NotBefore).Hours() / 24; days != 30 {
+			t.Fatalf("renewed cert is valid for %v days, want 30", days)
+		}
+	}
+
+	interPolicy := sctx.client.RenewalPolicy.Query().Where(renewalpolicy.CertificateID(inter.ID)).OnlyX(ctx)
+	if interPolicy.Failures != 1 || interPolicy.LastError == "" || interPolicy.NextAttemptAt == nil {
+		t.Fatalf("failed policy %+v was not backed off", interPolicy)
+	}
+	if wait := time.Until(*interPolicy.NextAttemptAt); wait < 4*time.Minute || wait > renewalBaseBackoff {
+		t.Fatalf("next attempt in %s, want about %s", wait, renewalBaseBackoff)
+	}
+	failed := sctx.client.RenewalAttempt.Query().
+		Where(renewalattempt.CertificateID(inter.ID), renewalattempt.StatusEQ(renewalattempt.StatusFailed)).
+		CountX(ctx)
+	if failed != 1 {
+		t.Fatalf("recorded %d failed attempts, want 1 as the second run waits for the backoff", failed)
+	}
+	succeeded := sctx.client.RenewalAttempt.Query().
+		Where(renewalattempt.CertificateID(leaf.ID), renewalattempt.StatusEQ(renewalattempt.StatusSucceeded)).
+		CountX(ctx)
+	if succeeded != 2 {
+		t.Fatalf("recorded %d successful attempts, want 2", succeeded)
+	}
+}
+
+func TestRunDueRenewalsWaitsForOfflineSignature(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	_, _, inter, leaf := createTestChain(t, sctx)
+	takeTestOffline(t, sctx, inter.ID)
+	renewals := NewRenewalService(sctx)
+	_, err := renewals.SetRenewalPolicy(ctx, leaf.ID, RenewalPolicyReq{
+		Trigger:           RenewalTriggerRemainingFraction,
+		RemainingFraction: 0.5,
+		Enabled:           true,
+	})
+	if err != nil {
+		t.Fatalf("set policy: %v", err)
+	}
+	sctx.client.RenewalPolicy.Update().SetRemainingFraction(1).ExecX(ctx)
+
+	for run := 0; run < 2; run++ {
+		if _, err := renewals.RunDueRenewals(ctx); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+	}
+	attempts := sctx.client.RenewalAttempt.Query().Where(renewalattempt.CertificateID(leaf.ID)).AllX(ctx)
+	if len(attempts) != 1 || attempts[0].Status != renewalattempt.StatusPendingSignature || attempts[0].SigningRequestID == 0 {
+		t.Fatalf("attempts %+v, want one waiting for its signature", attempts)
+	}
+}
+
+func TestRekeyCertificate(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	_, _, inter, leaf := createTestChain(t, sctx)
+	certs := NewCertificateService(sctx)
+
+	if _, err := certs.RekeyCertificate(ctx, inter.ID, 365); err == nil {
+		t.Fatal("rekeyed a CA that issued certificates")
+	}
+	before, err := getCertFromPem(leaf.CertPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := certs.RekeyCertificate(ctx, leaf.ID, 90); err != nil {
+		t.Fatalf("rekey: %v", err)
+	}
+	row := sctx.client.Certificate.GetX(ctx, leaf.ID)
+	after, err := getCertFromPem(row.CertPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.SerialNumber.Cmp(before.SerialNumber) == 0 {
+		t.Fatal("rekey kept the serial number")
+	}
+	if after.PublicKey.(interface{ Equal(crypto.PublicKey) bool }).Equal(before.PublicKey) {
+		t.Fatal("rekey kept the key")
+	}
+	keyPem, err := certs.RevealPrivateKey(ctx, leaf.ID, RevealKeyReq{Reason: "test"})
+	if err != nil {
+		t.Fatalf("reveal rekeyed key: %v", err)
+	}
+	key, err := getPrivateKeyFromPem(keyPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !after.PublicKey.(interface{ Equal(crypto.PublicKey) bool }).Equal(key.(crypto.Signer).Public()) {
+		t.Fatal("stored key does not match the rekeyed certificate")
+	}
+	issuer, err := getCertFromPem(inter.CertPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := after.CheckSignatureFrom(issuer); err != nil {
+		t.Fatalf("rekeyed cert is not signed by its issuer: %v", err)
+	}
+}
+
+func TestRekeyCertificateDeletesReplacedKeys(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	keyDir := serveTestSigner(t, sctx)
+	ns, _, inter, _ := createTestChain(t, sctx)
+	req := testCertReq(ns.ID, inter.ID, "web.internal", false, "web.internal")
+	req.KeyBackend = SocketKeyBackend
+	leaf := createTestCert(t, sctx, req)
+	certs := NewCertificateService(sctx)
+	keyFile := func() string {
+		return filepath.Join(keyDir, keyRefID(sctx.client.Certificate.GetX(ctx, leaf.ID).KeyRef)+".pem")
+	}
+
+	oldKey := keyFile()
+	if _, err := certs.RekeyCertificate(ctx, leaf.ID, 90); err != nil {
+		t.Fatalf("rekey: %v", err)
+	}
+	if _, err := os.Stat(oldKey); !os.IsNotExist(err) {
+		t.Fatalf("replaced key left on the signer: %v", err)
+	}
+	if files := signerKeyFiles(t, keyDir); len(files) != 1 || files[0] != keyFile() {
+		t.Fatalf("signer holds %v, want only the new key", files)
+	}
+
+	// Signing fails after the new key was generated.
+	interKeyRef := sctx.client.Certificate.GetX(ctx, inter.ID).KeyRef
+	sctx.client.Certificate.UpdateOneID(inter.ID).SetKeyRef("pkcs11:00").ExecX(ctx)
+	if _, err := certs.RekeyCertificate(ctx, leaf.ID, 90); err == nil {
+		t.Fatal("rekey with an unavailable issuer key succeeded")
+	}
+	if files := signerKeyFiles(t, keyDir); len(files) != 1 {
+		t.Fatalf("failed rekey left %d keys on the signer, want 1", len(files))
+	}
+	sctx.client.Certificate.UpdateOneID(inter.ID).SetKeyRef(interKeyRef).ExecX(ctx)
+
+	// Under an offline issuer the old key goes once the signed cert is uploaded.
+	offlineSigner := takeTestOffline(t, sctx, inter.ID)
+	oldKey = keyFile()
+	sr, err := certs.RekeyCertificate(ctx, leaf.ID, 90)
+	if err != nil || sr == nil {
+		t.Fatalf("queue rekey: %v %v", sr, err)
+	}
+	if len(signerKeyFiles(t, keyDir)) != 2 {
+		t.Fatal("queued rekey did not keep both keys")
+	}
+	srs := NewSigningRequestService(sctx)
+	bundle, err := srs.GetSigningBundle(ctx, sr.ID)
+	if err != nil {
+		t.Fatalf("get bundle: %v", err)
+	}
+	certPem, err := SignBundle(bundle, offlineSigner)
+	if err != nil {
+		t.Fatalf("sign bundle: %v", err)
+	}
+	if _, err := srs.CompleteSigningRequest(ctx, sr.ID, certPem); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	if _, err := os.Stat(oldKey); !os.IsNotExist(err) {
+		t.Fatalf("key replaced through the signing request left on the signer: %v", err)
+	}
+	if files := signerKeyFiles(t, keyDir); len(files) != 1 || files[0] != keyFile() {
+		t.Fatalf("signer holds %v, want only the new key", files)
+	}
+}
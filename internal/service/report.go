@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+)
+
+// ExpiringCertificate is one row of the expiring report. ChainNotAfter is the
+// earliest expiry along the chain and may be before NotAfter.
+type ExpiringCertificate struct {
+	CertificateID         int       `json:"certificateId"`
+	NamespaceID           int       `json:"namespaceId"`
+	NamespaceName         string    `json:"namespaceName"`
+	Subject               string    `json:"subject"`
+	DNSNames              []string  `json:"dnsNames"`
+	IPAddresses           []string  `json:"ipAddresses"`
+	IssuerID              int       `json:"issuerId"`
+	IssuerSubject         string    `json:"issuerSubject"`
+	NotAfter              time.Time `json:"notAfter"`
+	ChainNotAfter         time.Time `json:"chainNotAfter"`
+	ExpiringCertificateID int       `json:"expiringCertificateId"`
+	DaysLeft              int       `json:"daysLeft"`
+}
+
+type ReportService struct {
+	ctx *ServiceContext
+}
+
+func NewReportService(ctx *ServiceContext) *ReportService {
+	return &ReportService{
+		ctx: ctx,
+	}
+}
+
+// ExpiringReport lists certificates of all namespaces whose chain expires
+// within the given duration, already expired ones included, soonest first.
+func (s *ReportService) ExpiringReport(ctx context.Context, within time.Duration) ([]ExpiringCertificate, error) {
+	certs, err := s.ctx.client.Certificate.Query().
+		WithNamespace().
+		Select(certificateSummaryFields...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query certificates failed: %w", err)
+	}
+	byID := make(map[int]*ent.Certificate, len(certs))
+	for _, cert := range certs {
+		byID[cert.ID] = cert
+	}
+
+	now := time.Now()
+	deadline := now.Add(within)
+	result := make([]ExpiringCertificate, 0)
+	for _, cert := range certs {
+		chainExpiry, expiringID := chainNotAfter(cert.ID, byID)
+		if chainExpiry.After(deadline) {
+			continue
+		}
+		issuerSubject := cert.Subject
+		if issuer, ok := byID[cert.IssuerID]; ok {
+			issuerSubject = issuer.Subject
+		}
+		var nsName string
+		if cert.Edges.Namespace != nil {
+			nsName = cert.Edges.Namespace.Name
+		}
+		result = append(result, ExpiringCertificate{
+			CertificateID:         cert.ID,
+			NamespaceID:           cert.NamespaceID,
+			NamespaceName:         nsName,
+			Subject:               cert.Subject,
+			DNSNames:              cert.DNSNames,
+			IPAddresses:           cert.IPAddresses,
+			IssuerID:              cert.IssuerID,
+			IssuerSubject:         issuerSubject,
+			NotAfter:              cert.NotAfter,
+			ChainNotAfter:         chainExpiry,
+			ExpiringCertificateID: expiringID,
+			DaysLeft:              int(math.Floor(chainExpiry.Sub(now).Hours() / 24)),
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].ChainNotAfter.Before(result[j].ChainNotAfter)
+	})
+	return result, nil
+}
+
+// WriteExpiringCSV writes rows as CSV with a header line. Multiple SANs are
+// joined with ";".
+func WriteExpiringCSV(w io.Writer, rows []ExpiringCertificate) error {
+	cw := csv.NewWriter(w)
+	header := []string{
+		"certificate_id", "namespace_id", "namespace_name", "subject", "dns_names", "ip_addresses",
+		"issuer_id", "issuer_subject", "not_after", "chain_not_after", "expiring_certificate_id", "days_left",
+	}
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("write csv header failed: %w", err)
+	}
+	for _, row := range rows {
+		record := []string{
+			strconv.Itoa(row.CertificateID),
+			strconv.Itoa(row.NamespaceID),
+			row.NamespaceName,
+			row.Subject,
+			strings.Join(row.DNSNames, ";"),
+			strings.Join(row.IPAddresses, ";"),
+			strconv.Itoa(row.IssuerID),
+			row.IssuerSubject,
+			row.NotAfter.UTC().Format(time.RFC3339),
+			row.ChainNotAfter.UTC().Format(time.RFC3339),
+			strconv.Itoa(row.ExpiringCertificateID),
+			strconv.Itoa(row.DaysLeft),
+		}
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("write csv record failed: %w", err)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ParseWithin parses a report window such as "30d", "12h" or "90m". A bare
+// number is taken as days.
+func ParseWithin(s string) (time.Duration, error) {
+	if s == "" {
+		return 30 * 24 * time.Hour, nil
+	}
+	days, ok := strings.CutSuffix(s, "d")
+	if n, err := strconv.Atoi(days); err == nil {
+		if n < 0 {
+			return 0, fmt.Errorf("invalid window %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	} else if ok {
+		return 0, fmt.Errorf("invalid window %q", s)
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid window %q", s)
+	}
+	return d, nil
+}
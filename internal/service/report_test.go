@@ -0,0 +1,101 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestExpiringReport(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns := createTestNamespace(t, sctx, "short")
+	root := createTestCert(t, sctx, testCertReq(ns.ID, 0, "Root CA", true))
+	interReq := testCertReq(ns.ID, root.ID, "Inter CA", true)
+	interReq.ValidDays = 20
+	inter := createTestCert(t, sctx, interReq)
+	leaf := createTestCert(t, sctx, testCertReq(ns.ID, inter.ID, "api.internal", false, "api.internal", "www.internal"))
+
+	other := createTestNamespace(t, sctx, "soon")
+	otherReq := testCertReq(other.ID, 0, "Soon CA", true)
+	otherReq.ValidDays = 5
+	soon := createTestCert(t, sctx, otherReq)
+	createTestChain(t, sctx)
+
+	rows, err := NewReportService(sctx).ExpiringReport(ctx, 30*24*time.Hour)
+	if err != nil {
+		t.Fatalf("report: %v", err)
+	}
+	var ids []int
+	for _, row := range rows {
+		ids = append(ids, row.CertificateID)
+	}
+	if len(rows) != 3 || rows[0].CertificateID != soon.ID {
+		t.Fatalf("report lists %v, want %d first and then the intermediate and its leaf", ids, soon.ID)
+	}
+	if rows[0].NamespaceName != "soon" || rows[0].DaysLeft != 4 {
+		t.Fatalf("first row %+v", rows[0])
+	}
+	var leafRow *ExpiringCertificate
+	for i := range rows {
+		if rows[i].CertificateID == leaf.ID {
+			leafRow = &rows[i]
+		}
+	}
+	if leafRow == nil {
+		t.Fatalf("leaf expiring with its issuer is not reported: %v", ids)
+	}
+	if leafRow.ExpiringCertificateID != inter.ID || !leafRow.ChainNotAfter.Before(leafRow.NotAfter) {
+		t.Fatalf("leaf row %+v does not point at the intermediate", leafRow)
+	}
+	if leafRow.IssuerSubject != inter.Subject {
+		t.Fatalf("leaf issuer subject %q, want %q", leafRow.IssuerSubject, inter.Subject)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteExpiringCSV(&buf, rows); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	if len(records) != 4 || records[0][0] != "certificate_id" || records[0][11] != "days_left" {
+		t.Fatalf("csv has %d records, header %v", len(records), records[0])
+	}
+	for _, record := range records[1:] {
+		if record[0] != strconv.Itoa(leaf.ID) {
+			continue
+		}
+		if record[4] != "api.internal;www.internal" {
+			t.Fatalf("dns names column %q", record[4])
+		}
+		if record[9] != leafRow.ChainNotAfter.UTC().Format(time.RFC3339) {
+			t.Fatalf("chain_not_after column %q", record[9])
+		}
+	}
+}
+
+func TestParseWithin(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":    30 * 24 * time.Hour,
+		"7":   7 * 24 * time.Hour,
+		"90d": 90 * 24 * time.Hour,
+		"12h": 12 * time.Hour,
+		"45m": 45 * time.Minute,
+	}
+	for in, want := range tests {
+		got, err := ParseWithin(in)
+		if err != nil || got != want {
+			t.Errorf("ParseWithin(%q) = %s, %v, want %s", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"-1d", "-5", "xd", "soon", "-1h"} {
+		if _, err := ParseWithin(in); err == nil {
+			t.Errorf("ParseWithin(%q) succeeded", in)
+		}
+	}
+}
@@ -0,0 +1,409 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const (
+	ScanFileMatched    = "matched"
+	ScanFileSkipped    = "skipped"
+	ScanFileUnreadable = "unreadable"
+
+	ScanCertImported  = "imported"
+	ScanCertDuplicate = "duplicate"
+	ScanCertKeyAdded  = "key_added"
+	ScanCertSkipped   = "skipped"
+
+	maxScanFileSize = 1 << 20
+)
+
+// ScanImportReq names the directory to scan. PKCS#12 files are opened with an
+// empty password first and then with each of P12Passwords.
+type ScanImportReq struct {
+	Dir          string   `json:"dir"`
+	P12Passwords []string `json:"p12Passwords"`
+}
+
+type ScannedFile struct {
+	Path         string `json:"path"`
+	Status       string `json:"status"`
+	Reason       string `json:"reason,omitempty"`
+	Certificates int    `json:"certificates"`
+	Keys         int    `json:"keys"`
+}
+
+type ScannedCert struct {
+	Path          string `json:"path"`
+	KeyPath       string `json:"keyPath,omitempty"`
+	Subject       string `json:"subject"`
+	Fingerprint   string `json:"fingerprint"`
+	Status        string `json:"status"`
+	Reason        string `json:"reason,omitempty"`
+	CertificateID int    `json:"certificateId,omitempty"`
+	IssuerID      int    `json:"issuerId,omitempty"`
+}
+
+// ScanImportResult reports every file that was looked at and every distinct
+// certificate found. UnmatchedKeys lists files with keys that belong to none
+// of the certificates.
+type ScanImportResult struct {
+	Files         []ScannedFile `json:"files"`
+	Certificates  []ScannedCert `json:"certificates"`
+	UnmatchedKeys []string      `json:"unmatchedKeys"`
+}
+
+type scannedKey struct {
+	signer  crypto.Signer
+	path    string
+	matched bool
+}
+
+type scannedCert struct {
+	cert   *x509.Certificate
+	path   string
+	key    *scannedKey
+	result *ScannedCert
+}
+
+// ImportDirectory walks a directory for PEM, DER and PKCS#12 files, pairs the
+// keys with their certificates and imports them into the namespace, issuers
+// first. Certificates already in the namespace are skipped by fingerprint,
+// but get their key when they had none. Like restore, a second self-signed
+// root is not imported into a namespace that already has one.
+func (s *NamespaceService) ImportDirectory(ctx context.Context, id int, req ScanImportReq) (_ *ScanImportResult, err error) {
+	ctx = withAuditOperation(ctx, "namespace.import_directory", map[string]any{"dir": req.Dir})
+	defer s.ctx.auditFailure(ctx, "namespace", id, &err)
+	if _, err := s.ctx.client.Namespace.Get(ctx, id); err != nil {
+		return nil, fmt.Errorf("get namespace %d failed: %w", id, err)
+	}
+
+	result := &ScanImportResult{
+		Files:         make([]ScannedFile, 0),
+		Certificates:  make([]ScannedCert, 0),
+		UnmatchedKeys: make([]string, 0),
+	}
+	var certs []*scannedCert
+	var keys []*scannedKey
+	seen := make(map[string]bool)
+	err = filepath.WalkDir(req.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == req.Dir {
+				return err
+			}
+			result.Files = append(result.Files, ScannedFile{Path: path, Status: ScanFileUnreadable, Reason: err.Error()})
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		file := ScannedFile{Path: path, Status: ScanFileMatched}
+		fileCerts, fileKeys, err := scanFile(path, req.P12Passwords)
+		switch {
+		case errors.Is(err, errNotCertOrKey):
+			file.Status, file.Reason = ScanFileSkipped, err.Error()
+		case err != nil:
+			file.Status, file.Reason = ScanFileUnreadable, err.Error()
+		}
+		file.Certificates, file.Keys = len(fileCerts), len(fileKeys)
+		result.Files = append(result.Files, file)
+		for _, cert := range fileCerts {
+			fp := certFingerprint(cert)
+			if seen[fp] {
+				continue
+			}
+			seen[fp] = true
+			certs = append(certs, &scannedCert{cert: cert, path: path})
+		}
+		for _, key := range fileKeys {
+			keys = append(keys, &scannedKey{signer: key, path: path})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan %s failed: %w", req.Dir, err)
+	}
+
+	for _, sc := range certs {
+		pub, ok := sc.cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+		if !ok {
+			continue
+		}
+		for _, key := range keys {
+			if pub.Equal(key.signer.Public()) {
+				sc.key, key.matched = key, true
+				break
+			}
+		}
+	}
+	for _, key := range keys {
+		if !key.matched && !slices.Contains(result.UnmatchedKeys, key.path) {
+			result.UnmatchedKeys = append(result.UnmatchedKeys, key.path)
+		}
+	}
+	for i, file := range result.Files {
+		if file.Status == ScanFileMatched && file.Certificates == 0 && slices.Contains(result.UnmatchedKeys, file.Path) {
+			result.Files[i].Status, result.Files[i].Reason = ScanFileSkipped, "private key matches no certificate"
+		}
+	}
+
+	// Self-signed CAs come first so that they take the root slot.
+	slices.SortStableFunc(certs, func(a, b *scannedCert) int {
+		return scanRank(a.cert) - scanRank(b.cert)
+	})
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		return s.ctx.importScanned(ctx, tx, id, certs)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("import certificates with tx failed: %w", err)
+	}
+	for _, sc := range certs {
+		result.Certificates = append(result.Certificates, *sc.result)
+	}
+	return result, nil
+}
+
+func (sctx *ServiceContext) importScanned(ctx context.Context, tx *ent.Tx, nsID int, certs []*scannedCert) error {
+	existing, err := tx.Certificate.Query().
+		Where(certificate.NamespaceID(nsID)).
+		Select(certificate.FieldCertPem, certificate.FieldIssuerID, certificate.FieldFingerprint, certificate.FieldKeyPem, certificate.FieldKeyRef).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query certificates of namespace %d failed: %w", nsID, err)
+	}
+	type issuer struct {
+		id   int
+		cert *x509.Certificate
+	}
+	var issuers []issuer
+	byFingerprint := make(map[string]*ent.Certificate, len(existing))
+	hasRoot := false
+	for _, cert := range existing {
+		x509Cert, err := getCertFromPem(cert.CertPem)
+		if err != nil {
+			return fmt.Errorf("get cert %d from pem failed: %w", cert.ID, err)
+		}
+		issuers = append(issuers, issuer{id: cert.ID, cert: x509Cert})
+		byFingerprint[cert.Fingerprint] = cert
+		hasRoot = hasRoot || cert.IssuerID == 0
+	}
+
+	for _, sc := range certs {
+		sc.result = &ScannedCert{Path: sc.path, Subject: getSubject(sc.cert), Fingerprint: certFingerprint(sc.cert)}
+		if sc.key != nil {
+			sc.result.KeyPath = sc.key.path
+		}
+	}
+	// Each pass imports the certificates whose issuer is known by now.
+	for progress := true; progress; {
+		progress = false
+		for _, sc := range certs {
+			if sc.result.Status != "" {
+				continue
+			}
+			if stored, ok := byFingerprint[sc.result.Fingerprint]; ok {
+				sc.result.Status, sc.result.CertificateID, sc.result.IssuerID = ScanCertDuplicate, stored.ID, stored.IssuerID
+				if sc.key != nil && stored.KeyPem == "" && stored.KeyRef == "" {
+					if err := sctx.attachScannedKey(ctx, tx, stored.ID, sc.key); err != nil {
+						return err
+					}
+					sc.result.Status = ScanCertKeyAdded
+				}
+				continue
+			}
+			issuerID := 0
+			if isSelfSigned(sc.cert) {
+				if hasRoot {
+					sc.result.Status, sc.result.Reason = ScanCertSkipped, "namespace already has a root certificate"
+					continue
+				}
+			} else {
+				idx := slices.IndexFunc(issuers, func(i issuer) bool { return issuedBy(sc.cert, i.cert) })
+				if idx < 0 {
+					continue
+				}
+				issuerID = issuers[idx].id
+			}
+			created, err := sctx.createScanned(ctx, tx, nsID, issuerID, sc)
+			if err != nil {
+				return err
+			}
+			sc.result.Status, sc.result.CertificateID, sc.result.IssuerID = ScanCertImported, created.ID, issuerID
+			issuers = append(issuers, issuer{id: created.ID, cert: sc.cert})
+			byFingerprint[sc.result.Fingerprint] = created
+			hasRoot = hasRoot || issuerID == 0
+			progress = true
+		}
+	}
+	for _, sc := range certs {
+		if sc.result.Status != "" {
+			continue
+		}
+		sc.result.Status = ScanCertSkipped
+		idx := slices.IndexFunc(certs, func(other *scannedCert) bool { return other != sc && issuedBy(sc.cert, other.cert) })
+		if idx < 0 {
+			sc.result.Reason = "issuer found neither in the directory nor in the namespace"
+		} else {
+			sc.result.Reason = fmt.Sprintf("issuer %s from %s was not imported", certs[idx].result.Subject, certs[idx].path)
+		}
+	}
+	return nil
+}
+
+func (sctx *ServiceContext) createScanned(ctx context.Context, tx *ent.Tx, nsID, issuerID int, sc *scannedCert) (*ent.Certificate, error) {
+	created, err := tx.Certificate.Create().
+		SetNamespaceID(nsID).
+		SetIssuerID(issuerID).
+		SetCertPem(string(x509CertToPem(sc.cert))).
+		SetUsage(scanUsage(sc.cert)).
+		SetDesc(fmt.Sprintf("imported from %s", sc.path)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create cert from %s failed: %w", sc.path, err)
+	}
+	if sc.key != nil {
+		if err := sctx.attachScannedKey(ctx, tx, created.ID, sc.key); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+func (sctx *ServiceContext) attachScannedKey(ctx context.Context, tx *ent.Tx, id int, key *scannedKey) error {
+	keyPem, err := sctx.keyring.Seal(string(PrivateKeyToPem(key.signer)), certKeyAAD(id))
+	if err != nil {
+		return fmt.Errorf("seal key from %s failed: %w", key.path, err)
+	}
+	if err := tx.Certificate.UpdateOneID(id).SetKeyPem(keyPem).Exec(ctx); err != nil {
+		return fmt.Errorf("update key of cert %d failed: %w", id, err)
+	}
+	return nil
+}
+
+var errNotCertOrKey = errors.New("no certificate or private key found")
+
+// scanFile reads the certificates and keys of one file. PEM is recognised by
+// its content, PKCS#12 by the .p12 and .pfx extensions, anything else is
+// tried as DER.
+func scanFile(path string, p12Passwords []string) ([]*x509.Certificate, []crypto.Signer, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.Size() > maxScanFileSize {
+		return nil, nil, fmt.Errorf("%w: file larger than %d bytes", errNotCertOrKey, maxScanFileSize)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); {
+	case bytes.Contains(data, []byte("-----BEGIN ")):
+		return scanPem(data)
+	case ext == ".p12" || ext == ".pfx":
+		return scanPKCS12(data, p12Passwords)
+	}
+	if certs, err := x509.ParseCertificates(data); err == nil && len(certs) > 0 {
+		return certs, nil, nil
+	}
+	if key, err := parsePrivateKeyDer(data); err == nil {
+		return nil, []crypto.Signer{key}, nil
+	}
+	return nil, nil, errNotCertOrKey
+}
+
+func scanPem(data []byte) ([]*x509.Certificate, []crypto.Signer, error) {
+	var certs []*x509.Certificate
+	var keys []crypto.Signer
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("parse certificate %d failed: %w", len(certs)+1, err)
+			}
+			certs = append(certs, cert)
+		case "PRIVATE KEY", "RSA PRIVATE KEY", "EC PRIVATE KEY":
+			if _, ok := block.Headers["DEK-Info"]; ok {
+				return nil, nil, fmt.Errorf("encrypted private keys are not supported")
+			}
+			key, err := parsePrivateKeyDer(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("parse private key %d failed: %w", len(keys)+1, err)
+			}
+			keys = append(keys, key)
+		case "ENCRYPTED PRIVATE KEY":
+			return nil, nil, fmt.Errorf("encrypted private keys are not supported")
+		}
+	}
+	if len(certs) == 0 && len(keys) == 0 {
+		return nil, nil, errNotCertOrKey
+	}
+	return certs, keys, nil
+}
+
+func scanPKCS12(data []byte, passwords []string) ([]*x509.Certificate, []crypto.Signer, error) {
+	var lastErr error
+	for _, password := range append([]string{""}, passwords...) {
+		key, cert, caCerts, err := pkcs12.DecodeChain(data, password)
+		if err == nil {
+			signer, ok := key.(crypto.Signer)
+			if !ok {
+				return nil, nil, fmt.Errorf("unsupported private key type: %T", key)
+			}
+			return append([]*x509.Certificate{cert}, caCerts...), []crypto.Signer{signer}, nil
+		}
+		if certs, trustErr := pkcs12.DecodeTrustStore(data, password); trustErr == nil {
+			return certs, nil, nil
+		}
+		lastErr = err
+	}
+	return nil, nil, fmt.Errorf("decode pkcs12 failed: %w", lastErr)
+}
+
+func scanRank(cert *x509.Certificate) int {
+	switch {
+	case isSelfSigned(cert) && cert.IsCA:
+		return 0
+	case cert.IsCA:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// scanUsage maps a certificate to the usages offered when creating one.
+func scanUsage(cert *x509.Certificate) string {
+	switch {
+	case cert.IsCA:
+		return "CA"
+	case slices.Contains(cert.ExtKeyUsage, x509.ExtKeyUsageServerAuth):
+		return "server"
+	case slices.Contains(cert.ExtKeyUsage, x509.ExtKeyUsageClientAuth):
+		return "client"
+	case slices.Contains(cert.ExtKeyUsage, x509.ExtKeyUsageCodeSigning):
+		return "code"
+	default:
+		return "other"
+	}
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func TestImportDirectory(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	_, root, inter, leaf := createTestChain(t, sctx)
+	certs := NewCertificateService(sctx)
+	namespaces := NewNamespaceService(sctx)
+
+	keyPem := func(id int) string {
+		t.Helper()
+		keyPem, err := certs.RevealPrivateKey(ctx, id, RevealKeyReq{Reason: "test"})
+		if err != nil {
+			t.Fatalf("reveal: %v", err)
+		}
+		return keyPem
+	}
+	x509Cert := func(certPem string) []byte {
+		t.Helper()
+		cert, err := getCertFromPem(certPem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert.Raw
+	}
+	leafKeyBlock, _ := pem.Decode([]byte(keyPem(leaf.ID)))
+	leafKey, err := parsePrivateKeyDer(leafKeyBlock.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafCert, _ := getCertFromPem(leaf.CertPem)
+	interCert, _ := getCertFromPem(inter.CertPem)
+	p12, err := pkcs12.Modern.Encode(leafKey, leafCert, []*x509.Certificate{interCert}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreignNs, _, foreignInter, _ := createTestChain(t, sctx)
+	foreignLeaf := createTestCert(t, sctx, testCertReq(foreignNs.ID, foreignInter.ID, "foreign.internal", false, "foreign.internal"))
+	stray := createTestCert(t, sctx, testCertReq(createTestNamespace(t, sctx, "stray").ID, 0, "Stray CA", true))
+
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"ca/root.pem":          []byte(root.CertPem),
+		"ca/inter.der":         x509Cert(inter.CertPem),
+		"ca/private/inter.key": []byte(keyPem(inter.ID)),
+		"leaf.p12":             p12,
+		"notes.txt":            []byte("nothing to see"),
+		"broken.pfx":           []byte("not pkcs12"),
+		"foreign.crt":          []byte(foreignLeaf.CertPem),
+		"stray.key":            []byte(keyPem(stray.ID)),
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	target := createTestNamespace(t, sctx, "scanned")
+	result, err := namespaces.ImportDirectory(ctx, target.ID, ScanImportReq{Dir: dir, P12Passwords: []string{"secret"}})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+
+	fileStatus := make(map[string]string)
+	for _, f := range result.Files {
+		rel, _ := filepath.Rel(dir, f.Path)
+		fileStatus[rel] = f.Status
+	}
+	wantFiles := map[string]string{
+		"ca/root.pem":          ScanFileMatched,
+		"ca/inter.der":         ScanFileMatched,
+		"ca/private/inter.key": ScanFileMatched,
+		"leaf.p12":             ScanFileMatched,
+		"notes.txt":            ScanFileSkipped,
+		"broken.pfx":           ScanFileUnreadable,
+		"foreign.crt":          ScanFileMatched,
+		"stray.key":            ScanFileSkipped,
+	}
+	for name, want := range wantFiles {
+		if fileStatus[name] != want {
+			t.Errorf("%s: status %q, want %q", name, fileStatus[name], want)
+		}
+	}
+	if !slices.Equal(result.UnmatchedKeys, []string{filepath.Join(dir, "stray.key")}) {
+		t.Errorf("unmatched keys %v", result.UnmatchedKeys)
+	}
+
+	bySubject := make(map[string]ScannedCert)
+	for _, c := range result.Certificates {
+		bySubject[c.Subject] = c
+	}
+	// The intermediate comes from both the DER file and the PKCS#12 chain.
+	if len(result.Certificates) != 4 {
+		t.Fatalf("got %d certificates: %+v", len(result.Certificates), result.Certificates)
+	}
+	gotRoot, gotInter, gotLeaf := bySubject[root.Subject], bySubject[inter.Subject], bySubject[leaf.Subject]
+	if gotRoot.Status != ScanCertImported || gotRoot.IssuerID != 0 {
+		t.Fatalf("root %+v", gotRoot)
+	}
+	if gotInter.Status != ScanCertImported || gotInter.IssuerID != gotRoot.CertificateID || gotInter.KeyPath == "" {
+		t.Fatalf("intermediate %+v", gotInter)
+	}
+	if gotLeaf.Status != ScanCertImported || gotLeaf.IssuerID != gotInter.CertificateID || gotLeaf.KeyPath != filepath.Join(dir, "leaf.p12") {
+		t.Fatalf("leaf %+v", gotLeaf)
+	}
+	foreign := bySubject[foreignLeaf.Subject]
+	if foreign.Path != filepath.Join(dir, "foreign.crt") || foreign.Status != ScanCertSkipped || foreign.CertificateID != 0 {
+		t.Fatalf("leaf without its issuer %+v", foreign)
+	}
+	if got := keyPem(gotLeaf.CertificateID); got != keyPem(leaf.ID) {
+		t.Fatal("imported leaf has another key")
+	}
+	if _, err := certs.RevealPrivateKey(ctx, gotRoot.CertificateID, RevealKeyReq{Reason: "test"}); err == nil {
+		t.Fatal("root was imported with a key")
+	}
+
+	// A second scan finds the same certificates and adds the root key.
+	if err := os.WriteFile(filepath.Join(dir, "ca/private/root.key"), []byte(keyPem(root.ID)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	result, err = namespaces.ImportDirectory(ctx, target.ID, ScanImportReq{Dir: dir, P12Passwords: []string{"secret"}})
+	if err != nil {
+		t.Fatalf("import again: %v", err)
+	}
+	for _, c := range result.Certificates {
+		want := ScanCertDuplicate
+		switch c.Subject {
+		case root.Subject:
+			want = ScanCertKeyAdded
+		case foreignLeaf.Subject:
+			want = ScanCertSkipped
+		}
+		if c.Status != want {
+			t.Errorf("second scan of %s: status %s, want %s", c.Subject, c.Status, want)
+		}
+	}
+	if got := keyPem(gotRoot.CertificateID); got != keyPem(root.ID) {
+		t.Fatal("root key was not added")
+	}
+	total, err := sctx.client.Certificate.Query().Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 3+4+1+3 {
+		t.Fatalf("%d certificates after two scans", total)
+	}
+
+	if _, err := namespaces.ImportDirectory(ctx, target.ID, ScanImportReq{Dir: filepath.Join(dir, "missing")}); err == nil {
+		t.Fatal("scan of a missing directory succeeded")
+	}
+}
+
+func TestImportDirectorySkipsSecondRoot(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, _, _, _ := createTestChain(t, sctx)
+	other := createTestCert(t, sctx, testCertReq(createTestNamespace(t, sctx, "other").ID, 0, "Other CA", true))
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "other.pem"), []byte(other.CertPem), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	result, err := NewNamespaceService(sctx).ImportDirectory(ctx, ns.ID, ScanImportReq{Dir: dir})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if len(result.Certificates) != 1 || result.Certificates[0].Status != ScanCertSkipped {
+		t.Fatalf("second root %+v", result.Certificates)
+	}
+}
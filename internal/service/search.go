@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+)
+
+const (
+	CertStatusValid       = "valid"
+	CertStatusExpired     = "expired"
+	CertStatusNotYetValid = "not_yet_valid"
+)
+
+// SearchCertificatesReq filters certificates. Zero values are ignored, so an
+// empty request returns every certificate. NamespaceID 0 searches all
+// namespaces.
+type SearchCertificatesReq struct {
+	NamespaceID   int
+	CommonName    string
+	DNSName       string
+	IPAddress     string
+	SerialNumber  string
+	Fingerprint   string
+	KeyType       string
+	IsCA          *bool
+	Usage         string
+	ExpiresWithin time.Duration
+	Status        string
+}
+
+type CertificateSearchResult struct {
+	ID            int       `json:"id"`
+	NamespaceID   int       `json:"namespaceId"`
+	NamespaceName string    `json:"namespaceName"`
+	IssuerID      int       `json:"issuerId"`
+	Subject       string    `json:"subject"`
+	DNSNames      []string  `json:"dnsNames"`
+	IPAddresses   []string  `json:"ipAddresses"`
+	SerialNumber  string    `json:"serialNumber"`
+	Fingerprint   string    `json:"fingerprint"`
+	KeyType       string    `json:"keyType"`
+	IsCA          bool      `json:"isCA"`
+	Usage         string    `json:"usage"`
+	NotBefore     time.Time `json:"notBefore"`
+	NotAfter      time.Time `json:"notAfter"`
+	Status        string    `json:"status"`
+}
+
+func (s *CertificateService) SearchCertificates(ctx context.Context, req SearchCertificatesReq) ([]CertificateSearchResult, error) {
+	switch req.Status {
+	case "", CertStatusValid, CertStatusExpired, CertStatusNotYetValid:
+	case "revoked":
+		return nil, fmt.Errorf("revocation is not tracked, status revoked is not supported")
+	default:
+		return nil, fmt.Errorf("unsupported status: %s", req.Status)
+	}
+	var ip net.IP
+	if req.IPAddress != "" {
+		if ip = net.ParseIP(req.IPAddress); ip == nil {
+			return nil, fmt.Errorf("invalid ip address: %s", req.IPAddress)
+		}
+	}
+	serial := normalizeHex(req.SerialNumber)
+	serial = strings.TrimLeft(serial, "0")
+	fingerprint := normalizeHex(req.Fingerprint)
+
+	now := time.Now().UTC()
+	query := s.ctx.client.Certificate.Query().WithNamespace().Order(ent.Asc(certificate.FieldID))
+	if req.NamespaceID != 0 {
+		query = query.Where(certificate.NamespaceID(req.NamespaceID))
+	}
+	if req.CommonName != "" {
+		query = query.Where(certificate.CommonNameContainsFold(req.CommonName))
+	}
+	if serial != "" {
+		query = query.Where(certificate.SerialNumber(serial))
+	}
+	if fingerprint != "" {
+		query = query.Where(certificate.Fingerprint(fingerprint))
+	}
+	if req.KeyType != "" {
+		query = query.Where(certificate.KeyTypeEqualFold(req.KeyType))
+	}
+	if req.IsCA != nil {
+		query = query.Where(certificate.IsCa(*req.IsCA))
+	}
+	if req.Usage != "" {
+		query = query.Where(certificate.Usage(req.Usage))
+	}
+	if req.ExpiresWithin > 0 {
+		query = query.Where(certificate.NotAfterLTE(now.Add(req.ExpiresWithin)))
+	}
+	switch req.Status {
+	case CertStatusValid:
+		query = query.Where(certificate.NotBeforeLTE(now), certificate.NotAfterGTE(now))
+	case CertStatusExpired:
+		query = query.Where(certificate.NotAfterLT(now))
+	case CertStatusNotYetValid:
+		query = query.Where(certificate.NotBeforeGT(now))
+	}
+	certs, err := query.Select(certificateSummaryFields...).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query certificates failed: %w", err)
+	}
+
+	result := make([]CertificateSearchResult, 0)
+	for _, cert := range certs {
+		// SANs are stored as JSON, so wildcard and IP matching stay in Go.
+		if req.DNSName != "" && !matchDNSNames(cert.DNSNames, req.DNSName) {
+			continue
+		}
+		if ip != nil && !containsIP(cert.IPAddresses, ip) {
+			continue
+		}
+
+		var nsName string
+		if cert.Edges.Namespace != nil {
+			nsName = cert.Edges.Namespace.Name
+		}
+		result = append(result, CertificateSearchResult{
+			ID:            cert.ID,
+			NamespaceID:   cert.NamespaceID,
+			NamespaceName: nsName,
+			IssuerID:      cert.IssuerID,
+			Subject:       cert.Subject,
+			DNSNames:      cert.DNSNames,
+			IPAddresses:   cert.IPAddresses,
+			SerialNumber:  cert.SerialNumber,
+			Fingerprint:   cert.Fingerprint,
+			KeyType:       cert.KeyType,
+			IsCA:          cert.IsCa,
+			Usage:         cert.Usage,
+			NotBefore:     cert.NotBefore,
+			NotAfter:      cert.NotAfter,
+			Status:        certStatus(cert.NotBefore, cert.NotAfter, now),
+		})
+	}
+	return result, nil
+}
+
+func certStatus(notBefore, notAfter, now time.Time) string {
+	switch {
+	case now.Before(notBefore):
+		return CertStatusNotYetValid
+	case now.After(notAfter):
+		return CertStatusExpired
+	default:
+		return CertStatusValid
+	}
+}
+
+// matchDNSNames reports whether name is one of sans or covered by a wildcard
+// SAN. A wildcard query such as "*.example.com" also matches the names it
+// covers.
+func matchDNSNames(sans []string, name string) bool {
+	name = strings.ToLower(name)
+	for _, san := range sans {
+		san = strings.ToLower(san)
+		if san == name || wildcardCovers(san, name) || wildcardCovers(name, san) {
+			return true
+		}
+	}
+	return false
+}
+
+// wildcardCovers reports whether pattern "*.suffix" covers name, which must
+// have exactly one more label than suffix.
+func wildcardCovers(pattern, name string) bool {
+	suffix, ok := strings.CutPrefix(pattern, "*.")
+	if !ok {
+		return false
+	}
+	label, ok := strings.CutSuffix(name, "."+suffix)
+	return ok && label != "" && !strings.Contains(label, ".") && label != "*"
+}
+
+func containsIP(ips []string, ip net.IP) bool {
+	for _, v := range ips {
+		if net.ParseIP(v).Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeHex lowercases s and drops ":" and spaces, so that serials and
+// fingerprints can be pasted from openssl output.
+func normalizeHex(s string) string {
+	s = strings.ToLower(s)
+	s = strings.ReplaceAll(s, ":", "")
+	return strings.ReplaceAll(s, " ", "")
+}
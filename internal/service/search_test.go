@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSearchCertificatesStatusInLocalTimezone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("load timezone: %v", err)
+	}
+	local := time.Local
+	time.Local = loc
+	t.Cleanup(func() { time.Local = local })
+
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, _, _, leaf := createTestChain(t, sctx)
+	svc := NewCertificateService(sctx)
+
+	valid, err := svc.SearchCertificates(ctx, SearchCertificatesReq{NamespaceID: ns.ID, CommonName: "api.internal", Status: CertStatusValid})
+	if err != nil {
+		t.Fatalf("search valid: %v", err)
+	}
+	if len(valid) != 1 || valid[0].ID != leaf.ID {
+		t.Fatalf("valid certificates %+v, want the leaf", valid)
+	}
+	notYetValid, err := svc.SearchCertificates(ctx, SearchCertificatesReq{NamespaceID: ns.ID, Status: CertStatusNotYetValid})
+	if err != nil {
+		t.Fatalf("search not yet valid: %v", err)
+	}
+	if len(notYetValid) != 0 {
+		t.Fatalf("fresh certificates reported as not yet valid: %+v", notYetValid)
+	}
+}
+
+func TestSearchCertificatesFilters(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, root, inter, leaf := createTestChain(t, sctx)
+	wildReq := testCertReq(ns.ID, inter.ID, "*.apps.internal", false, "*.apps.internal")
+	wildReq.IPAddresses = []string{"10.0.0.7"}
+	wildReq.Usage = "ingress"
+	wildReq.ValidDays = 10
+	wild := createTestCert(t, sctx, wildReq)
+	_, otherRoot, _, _ := createTestChain(t, sctx)
+	svc := NewCertificateService(sctx)
+
+	leafRow := sctx.client.Certificate.GetX(ctx, leaf.ID)
+	isCA, notCA := true, false
+	// Serials and fingerprints are pasted from openssl output.
+	serial := strings.ToUpper("00:" + leafRow.SerialNumber)
+	var fingerprint strings.Builder
+	for i := 0; i < len(leafRow.Fingerprint); i += 2 {
+		if i > 0 {
+			fingerprint.WriteString(":")
+		}
+		fingerprint.WriteString(strings.ToUpper(leafRow.Fingerprint[i : i+2]))
+	}
+
+	tests := []struct {
+		name string
+		req  SearchCertificatesReq
+		want []int
+	}{
+		{"namespace", SearchCertificatesReq{NamespaceID: ns.ID}, []int{root.ID, inter.ID, leaf.ID, wild.ID}},
+		{"common name across namespaces", SearchCertificatesReq{CommonName: "root"}, []int{root.ID, otherRoot.ID}},
+		{"exact dns name", SearchCertificatesReq{NamespaceID: ns.ID, DNSName: "API.internal"}, []int{leaf.ID}},
+		{"name under wildcard san", SearchCertificatesReq{DNSName: "web.apps.internal"}, []int{wild.ID}},
+		{"wildcard query", SearchCertificatesReq{NamespaceID: ns.ID, DNSName: "*.internal"}, []int{leaf.ID}},
+		{"wildcard covers one label only", SearchCertificatesReq{DNSName: "a.b.apps.internal"}, nil},
+		{"ip address", SearchCertificatesReq{IPAddress: "10.0.0.7"}, []int{wild.ID}},
+		{"serial number", SearchCertificatesReq{SerialNumber: serial}, []int{leaf.ID}},
+		{"fingerprint", SearchCertificatesReq{Fingerprint: fingerprint.String()}, []int{leaf.ID}},
+		{"key type", SearchCertificatesReq{NamespaceID: ns.ID, KeyType: "rsa"}, nil},
+		{"is ca", SearchCertificatesReq{NamespaceID: ns.ID, IsCA: &isCA}, []int{root.ID, inter.ID}},
+		{"not ca", SearchCertificatesReq{NamespaceID: ns.ID, IsCA: &notCA}, []int{leaf.ID, wild.ID}},
+		{"usage", SearchCertificatesReq{Usage: "ingress"}, []int{wild.ID}},
+		{"expires within", SearchCertificatesReq{ExpiresWithin: 30 * 24 * time.Hour}, []int{wild.ID}},
+		{"expired", SearchCertificatesReq{Status: CertStatusExpired}, nil},
+	}
+	for _, tt := range tests {
+		results, err := svc.SearchCertificates(ctx, tt.req)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		var got []int
+		for _, r := range results {
+			got = append(got, r.ID)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	for _, req := range []SearchCertificatesReq{
+		{Status: "revoked"},
+		{Status: "unknown"},
+		{IPAddress: "not-an-ip"},
+	} {
+		if _, err := svc.SearchCertificates(ctx, req); err == nil {
+			t.Errorf("search %+v succeeded", req)
+		}
+	}
+}
+
+func TestWildcardCovers(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"*.example.com", "www.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "a.b.example.com", false},
+		{"*.example.com", "*.example.com", false},
+		{"*.example.com", "wwwexample.com", false},
+		{"www.example.com", "www.example.com", false},
+	}
+	for _, tt := range tests {
+		if got := wildcardCovers(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("wildcardCovers(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
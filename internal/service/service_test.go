@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var testDBSeq atomic.Int64
+
+// newTestContext returns a service context on a fresh in-memory database
+// whose keyring is unlocked with a random key file.
+func newTestContext(t *testing.T) *ServiceContext {
+	t.Helper()
+	sctx, _ := newTestContextWithKeyFile(t)
+	return sctx
+}
+
+// newTestContextWithKeyFile is newTestContext that also returns the key file,
+// for tests that unlock the database again.
+func newTestContextWithKeyFile(t *testing.T) (*ServiceContext, string) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", newTestDSN())
+	t.Cleanup(func() { _ = client.Close() })
+
+	sctx := NewServiceContext(client)
+	keyFile := writeTestKeyFile(t)
+	if err := sctx.Unlock(context.Background(), MasterKeySource{KeyFile: keyFile}); err != nil {
+		t.Fatalf("unlock keyring: %v", err)
+	}
+	return sctx, keyFile
+}
+
+// newTestDSN names a fresh in-memory database. It is shared by every client
+// opened on it while the first one is open.
+func newTestDSN() string {
+	return fmt.Sprintf("file:certmgr-test-%d?mode=memory&cache=shared&_fk=1", testDBSeq.Add(1))
+}
+
+func writeTestKeyFile(t *testing.T) string {
+	t.Helper()
+	keyFile := filepath.Join(t.TempDir(), "master.key")
+	if err := os.WriteFile(keyFile, []byte(hex.EncodeToString(randomBytes(t, masterKeyLen))), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return keyFile
+}
+
+func randomBytes(t *testing.T, n int) []byte {
+	t.Helper()
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func createTestNamespace(t *testing.T, sctx *ServiceContext, name string) *ent.Namespace {
+	t.Helper()
+	ns, err := NewNamespaceService(sctx).CreateNamespace(context.Background(), ent.Namespace{Name: name})
+	if err != nil {
+		t.Fatalf("create namespace: %v", err)
+	}
+	return ns
+}
+
+func testCertReq(nsID, issuerID int, cn string, ca bool, dnsNames ...string) CreateCertReq {
+	req := CreateCertReq{
+		NamespaceId: nsID,
+		IssuerId:    issuerID,
+		KeyType:     "ECDSA",
+		ECCCurve:    "P256",
+		ValidDays:   365,
+		Subject:     Subject{Country: "CN", CommonName: cn},
+		KeyUsage: KeyUsage{
+			DigitalSignature: !ca,
+			KeyCertSign:      ca,
+			CRLSign:          ca,
+		},
+		ExtendedKeyUsage: ExtendedKeyUsage{ServerAuth: !ca},
+		BasicConstraints: BasicConstraints{CA: ca},
+		DNSNames:         dnsNames,
+	}
+	if ca {
+		req.Usage = "CA"
+	} else {
+		req.Usage = "server"
+	}
+	return req
+}
+
+func createTestCert(t *testing.T, sctx *ServiceContext, req CreateCertReq) *Certificate {
+	t.Helper()
+	cert, err := NewCertificateService(sctx).CreateCertificate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("create certificate %s: %v", req.Subject.CommonName, err)
+	}
+	return cert
+}
+
+// createTestChain creates a root, an intermediate and a server leaf for
+// api.internal in a new namespace.
+func createTestChain(t *testing.T, sctx *ServiceContext) (ns *ent.Namespace, root, inter, leaf *Certificate) {
+	t.Helper()
+	ns = createTestNamespace(t, sctx, fmt.Sprintf("ns-%d", testDBSeq.Add(1)))
+	root = createTestCert(t, sctx, testCertReq(ns.ID, 0, "Root CA", true))
+	inter = createTestCert(t, sctx, testCertReq(ns.ID, root.ID, "Inter CA", true))
+	leaf = createTestCert(t, sctx, testCertReq(ns.ID, inter.ID, "api.internal", false, "api.internal"))
+	return ns, root, inter, leaf
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/signingrequest"
+)
+
+type SigningRequestService struct {
+	ctx *ServiceContext
+}
+
+func NewSigningRequestService(ctx *ServiceContext) *SigningRequestService {
+	return &SigningRequestService{
+		ctx: ctx,
+	}
+}
+
+type SigningRequest struct {
+	ID            int    `json:"id"`
+	NamespaceID   int    `json:"namespaceId"`
+	IssuerID      int    `json:"issuerId"`
+	Kind          string `json:"kind"`
+	CertificateID int    `json:"certificateId"`
+	Subject       string `json:"subject"`
+	Desc          string `json:"desc"`
+	Usage         string `json:"usage"`
+	Status        string `json:"status"`
+	UpdatedAt     int64  `json:"updatedAt"`
+	CreatedAt     int64  `json:"createdAt"`
+}
+
+// queueSigningRequest stores tmpl for the offline issuer to sign later. The
+// caller fills the create with the kind and key ref; keyPem, if any, is sealed
+// to the stored request.
+func (sctx *ServiceContext) queueSigningRequest(ctx context.Context, issuer *ent.Certificate, tmpl *x509.Certificate, pub crypto.PublicKey, keyPem string, fill func(*ent.SigningRequestCreate) *ent.SigningRequestCreate) (*SigningRequest, error) {
+	signingTemplate, err := newSigningTemplate(tmpl, pub)
+	if err != nil {
+		return nil, fmt.Errorf("build signing template failed: %w", err)
+	}
+	templateJSON, err := json.Marshal(signingTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("marshal signing template failed: %w", err)
+	}
+	var sr *ent.SigningRequest
+	err = sctx.withTx(ctx, func(tx *ent.Tx) error {
+		sr, err = fill(tx.SigningRequest.Create()).
+			SetNamespaceID(issuer.NamespaceID).
+			SetIssuerID(issuer.ID).
+			SetTemplate(string(templateJSON)).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if keyPem == "" {
+			return nil
+		}
+		sealed, err := sctx.keyring.Seal(keyPem, signingRequestKeyAAD(sr.ID))
+		if err != nil {
+			return fmt.Errorf("seal private key failed: %w", err)
+		}
+		sr, err = tx.SigningRequest.UpdateOne(sr).SetKeyPem(sealed).Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save signing request failed: %w", err)
+	}
+	return toSigningRequest(sr)
+}
+
+func (s *SigningRequestService) ListSigningRequests(ctx context.Context, namespaceId int) ([]SigningRequest, error) {
+	srs, err := s.ctx.client.SigningRequest.Query().
+		Where(signingrequest.NamespaceID(namespaceId)).
+		Order(ent.Desc(signingrequest.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query signing requests failed: %w", err)
+	}
+	result := make([]SigningRequest, 0, len(srs))
+	for _, sr := range srs {
+		item, err := toSigningRequest(sr)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *item)
+	}
+	return result, nil
+}
+
+func (s *SigningRequestService) GetSigningBundle(ctx context.Context, id int) (*SigningBundle, error) {
+	sr, err := s.getPending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	issuer, err := s.ctx.client.Certificate.Get(ctx, sr.IssuerID)
+	if err != nil {
+		return nil, fmt.Errorf("get issuer (%d) failed: %w", sr.IssuerID, err)
+	}
+	var tmpl SigningTemplate
+	if err := json.Unmarshal([]byte(sr.Template), &tmpl); err != nil {
+		return nil, fmt.Errorf("unmarshal template of signing request %d failed: %w", id, err)
+	}
+	return &SigningBundle{
+		Version:       signingBundleVersion,
+		RequestID:     sr.ID,
+		IssuerCertPem: issuer.CertPem,
+		Template:      tmpl,
+	}, nil
+}
+
+// CompleteSigningRequest checks that certPem was signed by the issuer of the
+// request over the queued template, then stores it. It returns the id of the
+// created or renewed certificate.
+func (s *SigningRequestService) CompleteSigningRequest(ctx context.Context, id int, certPem string) (_ int, err error) {
+	ctx = withAuditOperation(ctx, "signing_request.complete", nil)
+	defer s.ctx.auditFailure(ctx, "signing_request", id, &err)
+	sr, err := s.getPending(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	issuer, err := s.ctx.client.Certificate.Get(ctx, sr.IssuerID)
+	if err != nil {
+		return 0, fmt.Errorf("get issuer (%d) failed: %w", sr.IssuerID, err)
+	}
+	issuerX509Cert, err := getCertFromPem(issuer.CertPem)
+	if err != nil {
+		return 0, fmt.Errorf("get issuer (%d) cert from pem failed: %w", sr.IssuerID, err)
+	}
+	x509Cert, err := getCertFromPem(certPem)
+	if err != nil {
+		return 0, fmt.Errorf("get uploaded cert from pem failed: %w", err)
+	}
+	if err := x509Cert.CheckSignatureFrom(issuerX509Cert); err != nil {
+		return 0, fmt.Errorf("uploaded cert is not signed by issuer (%d): %w", sr.IssuerID, err)
+	}
+	var signingTemplate SigningTemplate
+	if err := json.Unmarshal([]byte(sr.Template), &signingTemplate); err != nil {
+		return 0, fmt.Errorf("unmarshal template of signing request %d failed: %w", id, err)
+	}
+	tmpl, pub, err := signingTemplate.toX509()
+	if err != nil {
+		return 0, fmt.Errorf("build template of signing request %d failed: %w", id, err)
+	}
+	if field := templateMismatch(x509Cert, tmpl, pub); field != "" {
+		return 0, fmt.Errorf("uploaded cert does not match signing request %d: %s differs", id, field)
+	}
+
+	keyPem := ""
+	if sr.KeyPem != "" {
+		keyPem, err = s.ctx.keyring.Open(sr.KeyPem, signingRequestKeyAAD(sr.ID))
+		if err != nil {
+			return 0, fmt.Errorf("open key of signing request %d failed: %w", id, err)
+		}
+	}
+
+	normalizedPem := string(x509CertToPem(x509Cert))
+	certID := sr.CertificateID
+	var orphanedKeys []string
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		switch sr.Kind {
+		case signingrequest.KindCreate:
+			created, err := tx.Certificate.Create().
+				SetNamespaceID(sr.NamespaceID).
+				SetIssuerID(sr.IssuerID).
+				SetCertPem(normalizedPem).
+				SetKeyRef(sr.KeyRef).
+				SetDesc(sr.Desc).
+				SetUsage(sr.Usage).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("save cert failed: %w", err)
+			}
+			certID = created.ID
+			if err := s.ctx.storeCertKey(ctx, tx.Client(), certID, keyPem); err != nil {
+				return err
+			}
+		case signingrequest.KindRenew:
+			old, err := tx.Certificate.Get(ctx, sr.CertificateID)
+			if err != nil {
+				return fmt.Errorf("get cert %d failed: %w", sr.CertificateID, err)
+			}
+			update := tx.Certificate.UpdateOne(old).SetCertPem(normalizedPem)
+			rekeyed := sr.KeyPem != "" || sr.KeyRef != ""
+			if rekeyed {
+				update.ClearKeyPem().SetKeyRef(sr.KeyRef)
+			}
+			if err := update.Exec(ctx); err != nil {
+				return fmt.Errorf("update cert %d failed: %w", sr.CertificateID, err)
+			}
+			if err := s.ctx.storeCertKey(ctx, tx.Client(), certID, keyPem); err != nil {
+				return err
+			}
+			if rekeyed {
+				orphanedKeys, err = unreferencedKeys(ctx, tx.Client(), []string{old.KeyRef})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		err := tx.SigningRequest.UpdateOneID(sr.ID).
+			SetStatus(signingrequest.StatusCompleted).
+			SetCertificateID(certID).
+			ClearKeyPem().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("update signing request %d failed: %w", sr.ID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("complete signing request with tx failed: %w", err)
+	}
+	s.ctx.deleteKeys(context.WithoutCancel(ctx), orphanedKeys)
+	return certID, nil
+}
+
+// templateMismatch names the first field in which cert differs from the
+// queued template, or returns "" when the offline CA signed it unchanged.
+func templateMismatch(cert *x509.Certificate, tmpl *x509.Certificate, pub crypto.PublicKey) string {
+	certPub, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	switch {
+	case cert.SerialNumber.Cmp(tmpl.SerialNumber) != 0:
+		return "serial number"
+	case !ok || !certPub.Equal(pub):
+		return "public key"
+	case !bytes.Equal(cert.RawSubject, tmpl.RawSubject):
+		return "subject"
+	case !slices.Equal(cert.DNSNames, tmpl.DNSNames):
+		return "dns names"
+	case !slices.EqualFunc(cert.IPAddresses, tmpl.IPAddresses, net.IP.Equal):
+		return "ip addresses"
+	case !cert.NotBefore.Equal(tmpl.NotBefore.Truncate(time.Second)),
+		!cert.NotAfter.Equal(tmpl.NotAfter.Truncate(time.Second)):
+		return "validity"
+	case cert.IsCA != tmpl.IsCA:
+		return "basic constraints"
+	case cert.KeyUsage != tmpl.KeyUsage:
+		return "key usage"
+	case !slices.Equal(cert.ExtKeyUsage, tmpl.ExtKeyUsage):
+		return "extended key usage"
+	}
+	return ""
+}
+
+func (s *SigningRequestService) DeleteSigningRequest(ctx context.Context, id int) (err error) {
+	ctx = withAuditOperation(ctx, "signing_request.delete", nil)
+	defer s.ctx.auditFailure(ctx, "signing_request", id, &err)
+	err = s.ctx.withTx(ctx, func(tx *ent.Tx) error {
+		return tx.SigningRequest.DeleteOneID(id).Exec(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("delete signing request %d failed: %w", id, err)
+	}
+	return nil
+}
+
+func (s *SigningRequestService) getPending(ctx context.Context, id int) (*ent.SigningRequest, error) {
+	sr, err := s.ctx.client.SigningRequest.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get signing request %d failed: %w", id, err)
+	}
+	if sr.Status != signingrequest.StatusPending {
+		return nil, fmt.Errorf("signing request %d is %s", id, sr.Status)
+	}
+	return sr, nil
+}
+
+func toSigningRequest(sr *ent.SigningRequest) (*SigningRequest, error) {
+	var tmpl SigningTemplate
+	if err := json.Unmarshal([]byte(sr.Template), &tmpl); err != nil {
+		return nil, fmt.Errorf("unmarshal template of signing request %d failed: %w", sr.ID, err)
+	}
+	var rdns pkix.RDNSequence
+	if _, err := asn1.Unmarshal(tmpl.Subject, &rdns); err != nil {
+		return nil, fmt.Errorf("parse subject of signing request %d failed: %w", sr.ID, err)
+	}
+	var subject x509.Certificate
+	subject.Subject.FillFromRDNSequence(&rdns)
+	return &SigningRequest{
+		ID:            sr.ID,
+		NamespaceID:   sr.NamespaceID,
+		IssuerID:      sr.IssuerID,
+		Kind:          sr.Kind.String(),
+		CertificateID: sr.CertificateID,
+		Subject:       getSubject(&subject),
+		Desc:          sr.Desc,
+		Usage:         sr.Usage,
+		Status:        sr.Status.String(),
+		UpdatedAt:     sr.UpdatedAt.Unix(),
+		CreatedAt:     sr.CreatedAt.Unix(),
+	}, nil
+}
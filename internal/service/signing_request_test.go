@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"net"
+	"strings"
+	"testing"
+)
+
+// takeTestOffline takes cert id offline and returns the signer of its key.
+func takeTestOffline(t *testing.T, sctx *ServiceContext, id int) crypto.Signer {
+	t.Helper()
+	const passphrase = "offline-passphrase"
+	ctx := context.Background()
+	svc := NewCertificateService(sctx)
+	offlineKey, err := svc.ExportOfflineKey(ctx, id, passphrase)
+	if err != nil {
+		t.Fatalf("export offline key of cert %d: %v", id, err)
+	}
+	signer, err := OpenOfflineKey([]byte(offlineKey), passphrase)
+	if err != nil {
+		t.Fatalf("open offline key: %v", err)
+	}
+	if err := svc.TakeCertificateOffline(ctx, id, []byte(offlineKey), passphrase); err != nil {
+		t.Fatalf("take cert %d offline: %v", id, err)
+	}
+	return signer
+}
+
+func TestCompleteSigningRequestStoresBoundKey(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, _, inter, _ := createTestChain(t, sctx)
+	offlineSigner := takeTestOffline(t, sctx, inter.ID)
+
+	queued := createTestCert(t, sctx, testCertReq(ns.ID, inter.ID, "web.internal", false, "web.internal"))
+	if queued.SigningRequestID == 0 {
+		t.Fatal("certificate under an offline issuer was not queued")
+	}
+	sr := sctx.client.SigningRequest.GetX(ctx, queued.SigningRequestID)
+	if _, err := sctx.keyring.Open(sr.KeyPem, signingRequestKeyAAD(sr.ID)); err != nil {
+		t.Fatalf("open key of signing request: %v", err)
+	}
+
+	srs := NewSigningRequestService(sctx)
+	bundle, err := srs.GetSigningBundle(ctx, sr.ID)
+	if err != nil {
+		t.Fatalf("get bundle: %v", err)
+	}
+	certPem, err := SignBundle(bundle, offlineSigner)
+	if err != nil {
+		t.Fatalf("sign bundle: %v", err)
+	}
+	certID, err := srs.CompleteSigningRequest(ctx, sr.ID, certPem)
+	if err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+
+	keyPem, err := NewCertificateService(sctx).RevealPrivateKey(ctx, certID, RevealKeyReq{Reason: "test"})
+	if err != nil {
+		t.Fatalf("reveal key of completed cert: %v", err)
+	}
+	key, err := getPrivateKeyFromPem(keyPem)
+	if err != nil {
+		t.Fatalf("parse key: %v", err)
+	}
+	x509Cert, err := getCertFromPem(certPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, _ := x509Cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if pub == nil || !pub.Equal(key.(crypto.Signer).Public()) {
+		t.Fatal("stored key does not match the signed certificate")
+	}
+	if sctx.client.SigningRequest.GetX(ctx, sr.ID).KeyPem != "" {
+		t.Fatal("key left on the completed signing request")
+	}
+}
+
+func TestCompleteSigningRequestRejectsAlteredTemplate(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, _, inter, _ := createTestChain(t, sctx)
+	offlineSigner := takeTestOffline(t, sctx, inter.ID)
+	queued := createTestCert(t, sctx, testCertReq(ns.ID, inter.ID, "web.internal", false, "web.internal"))
+
+	srs := NewSigningRequestService(sctx)
+	bundle, err := srs.GetSigningBundle(ctx, queued.SigningRequestID)
+	if err != nil {
+		t.Fatalf("get bundle: %v", err)
+	}
+	issuer, err := getCertFromPem(bundle.IssuerCertPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSubject, err := asn1.Marshal(pkix.Name{CommonName: "evil.internal"}.ToRDNSequence())
+	if err != nil {
+		t.Fatal(err)
+	}
+	alterations := map[string]func(*x509.Certificate){
+		"subject":            func(c *x509.Certificate) { c.RawSubject = otherSubject },
+		"dns names":          func(c *x509.Certificate) { c.DNSNames = append(c.DNSNames, "evil.internal") },
+		"ip addresses":       func(c *x509.Certificate) { c.IPAddresses = []net.IP{net.ParseIP("10.0.0.1")} },
+		"validity":           func(c *x509.Certificate) { c.NotAfter = c.NotAfter.AddDate(10, 0, 0) },
+		"basic constraints":  func(c *x509.Certificate) { c.IsCA = true },
+		"key usage":          func(c *x509.Certificate) { c.KeyUsage |= x509.KeyUsageCertSign },
+		"extended key usage": func(c *x509.Certificate) { c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageAny} },
+	}
+	for field, alter := range alterations {
+		tmpl, pub, err := bundle.Template.toX509()
+		if err != nil {
+			t.Fatal(err)
+		}
+		alter(tmpl)
+		certDer, err := x509.CreateCertificate(rand.Reader, tmpl, issuer, pub, offlineSigner)
+		if err != nil {
+			t.Fatalf("sign altered %s: %v", field, err)
+		}
+		_, err = srs.CompleteSigningRequest(ctx, queued.SigningRequestID, string(rawCertToPem(certDer)))
+		if err == nil || !strings.Contains(err.Error(), field+" differs") {
+			t.Fatalf("altered %s completed with %v", field, err)
+		}
+	}
+
+	certPem, err := SignBundle(bundle, offlineSigner)
+	if err != nil {
+		t.Fatalf("sign bundle: %v", err)
+	}
+	if _, err := srs.CompleteSigningRequest(ctx, queued.SigningRequestID, certPem); err != nil {
+		t.Fatalf("complete unaltered: %v", err)
+	}
+}
+
+func TestTakeCertificateOfflineNeedsConfirmation(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	_, root, inter, _ := createTestChain(t, sctx)
+	svc := NewCertificateService(sctx)
+
+	offlineKey, err := svc.ExportOfflineKey(ctx, inter.ID, "offline-passphrase")
+	if err != nil {
+		t.Fatalf("export offline key: %v", err)
+	}
+	if row := sctx.client.Certificate.GetX(ctx, inter.ID); row.Offline || row.KeyPem == "" {
+		t.Fatal("exporting the offline key already wiped the database copy")
+	}
+	if err := svc.TakeCertificateOffline(ctx, inter.ID, []byte(offlineKey), "wrong-passphrase"); err == nil {
+		t.Fatal("confirmed with a wrong passphrase")
+	}
+	rootKey, err := svc.ExportOfflineKey(ctx, root.ID, "offline-passphrase")
+	if err != nil {
+		t.Fatalf("export root offline key: %v", err)
+	}
+	if err := svc.TakeCertificateOffline(ctx, inter.ID, []byte(rootKey), "offline-passphrase"); err == nil {
+		t.Fatal("confirmed with the key of another certificate")
+	}
+	if sctx.client.Certificate.GetX(ctx, inter.ID).KeyPem == "" {
+		t.Fatal("failed confirmation wiped the key")
+	}
+
+	if err := svc.TakeCertificateOffline(ctx, inter.ID, []byte(offlineKey), "offline-passphrase"); err != nil {
+		t.Fatalf("take offline: %v", err)
+	}
+	row := sctx.client.Certificate.GetX(ctx, inter.ID)
+	if !row.Offline || row.KeyPem != "" || row.KeyExportable {
+		t.Fatalf("cert after taking offline: offline=%v key=%q exportable=%v", row.Offline, row.KeyPem, row.KeyExportable)
+	}
+}
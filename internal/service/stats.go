@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/logeable/certmgr/internal/ent"
+	"github.com/logeable/certmgr/internal/ent/certificate"
+)
+
+// StatsExpiringWindow is the window of CertificateStats.Expiring.
+const StatsExpiringWindow = 30 * 24 * time.Hour
+
+// CertificateStats aggregates a set of certificates. Expiring counts the
+// certificates that are still valid but expire within StatsExpiringWindow,
+// NextExpiry is the earliest expiry that has not passed yet. Revocation is not
+// tracked, so Revoked is always nil.
+type CertificateStats struct {
+	Total      int        `json:"total"`
+	CA         int        `json:"ca"`
+	Leaf       int        `json:"leaf"`
+	Expired    int        `json:"expired"`
+	Expiring   int        `json:"expiring"`
+	Revoked    *int       `json:"revoked"`
+	NextExpiry *time.Time `json:"nextExpiry"`
+}
+
+func (st *CertificateStats) add(o CertificateStats) {
+	st.Total += o.Total
+	st.CA += o.CA
+	st.Leaf += o.Leaf
+	st.Expired += o.Expired
+	st.Expiring += o.Expiring
+	if o.NextExpiry != nil && (st.NextExpiry == nil || o.NextExpiry.Before(*st.NextExpiry)) {
+		st.NextExpiry = o.NextExpiry
+	}
+}
+
+type GroupStats struct {
+	Key string `json:"key"`
+	CertificateStats
+}
+
+// Stats is the dashboard summary over all namespaces.
+type Stats struct {
+	Namespaces           int              `json:"namespaces"`
+	Certificates         CertificateStats `json:"certificates"`
+	ByKeyType            []GroupStats     `json:"byKeyType"`
+	BySignatureAlgorithm []GroupStats     `json:"bySignatureAlgorithm"`
+}
+
+type certificateStatsRow struct {
+	Key        string        `json:"key"`
+	Total      int           `json:"total"`
+	CA         int           `json:"ca"`
+	Expired    int           `json:"expired"`
+	Expiring   int           `json:"expiring"`
+	NextExpiry sql.NullInt64 `json:"next_expiry"`
+}
+
+// certificateStatsBy aggregates all certificates grouped by field in a single
+// query. Keys are the column values as strings.
+func certificateStatsBy(ctx context.Context, client *ent.Client, field string) (map[string]CertificateStats, error) {
+	now := time.Now().UTC()
+	soon := now.Add(StatsExpiringWindow)
+	var rows []certificateStatsRow
+	err := client.Certificate.Query().
+		GroupBy(field).
+		Aggregate(func(s *entsql.Selector) string {
+			// Selecting the columns here replaces the default selection,
+			// which cannot carry query arguments.
+			notAfter := s.C(certificate.FieldNotAfter)
+			s.Select().AppendSelectAs(s.C(field), "key")
+			s.AppendSelectExprAs(entsql.Expr("COUNT(*)"), "total")
+			s.AppendSelectExprAs(entsql.Expr("SUM(CASE WHEN "+s.C(certificate.FieldIsCa)+" THEN 1 ELSE 0 END)"), "ca")
+			s.AppendSelectExprAs(entsql.Expr("SUM(CASE WHEN "+notAfter+" < ? THEN 1 ELSE 0 END)", now), "expired")
+			s.AppendSelectExprAs(entsql.Expr("SUM(CASE WHEN "+notAfter+" >= ? AND "+notAfter+" <= ? THEN 1 ELSE 0 END)", now, soon), "expiring")
+			s.AppendSelectExprAs(entsql.Expr("MIN(CASE WHEN "+notAfter+" >= ? THEN CAST(strftime('%s', "+notAfter+") AS INTEGER) END)", now), "next_expiry")
+			return ""
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate certificates by %s failed: %w", field, err)
+	}
+	result := make(map[string]CertificateStats, len(rows))
+	for _, row := range rows {
+		st := CertificateStats{
+			Total:    row.Total,
+			CA:       row.CA,
+			Leaf:     row.Total - row.CA,
+			Expired:  row.Expired,
+			Expiring: row.Expiring,
+		}
+		if row.NextExpiry.Valid {
+			t := time.Unix(row.NextExpiry.Int64, 0).UTC()
+			st.NextExpiry = &t
+		}
+		result[row.Key] = st
+	}
+	return result, nil
+}
+
+func (s *ReportService) Stats(ctx context.Context) (*Stats, error) {
+	namespaces, err := s.ctx.client.Namespace.Query().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count namespaces failed: %w", err)
+	}
+	byKeyType, err := certificateStatsBy(ctx, s.ctx.client, certificate.FieldKeyType)
+	if err != nil {
+		return nil, err
+	}
+	bySigAlg, err := certificateStatsBy(ctx, s.ctx.client, certificate.FieldSignatureAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	stats := &Stats{
+		Namespaces:           namespaces,
+		ByKeyType:            sortedGroupStats(byKeyType),
+		BySignatureAlgorithm: sortedGroupStats(bySigAlg),
+	}
+	for _, st := range byKeyType {
+		stats.Certificates.add(st)
+	}
+	return stats, nil
+}
+
+func sortedGroupStats(m map[string]CertificateStats) []GroupStats {
+	result := make([]GroupStats, 0, len(m))
+	for key, st := range m {
+		result = append(result, GroupStats{Key: key, CertificateStats: st})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
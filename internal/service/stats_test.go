@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, _, inter, _ := createTestChain(t, sctx)
+	rsaReq := testCertReq(ns.ID, inter.ID, "rsa.internal", false, "rsa.internal")
+	rsaReq.KeyType = "RSA"
+	rsaReq.KeyLen = 2048
+	rsaReq.ValidDays = 10
+	rsa := createTestCert(t, sctx, rsaReq)
+	expired := createTestCert(t, sctx, testCertReq(ns.ID, inter.ID, "old.internal", false, "old.internal"))
+	past := time.Now().Add(-time.Hour)
+	sctx.client.Certificate.UpdateOneID(expired.ID).SetNotAfter(past).ExecX(ctx)
+	empty := createTestNamespace(t, sctx, "empty")
+
+	stats, err := NewReportService(sctx).Stats(ctx)
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	total := stats.Certificates
+	if stats.Namespaces != 2 || total.Total != 5 || total.CA != 2 || total.Leaf != 3 || total.Expired != 1 || total.Expiring != 1 {
+		t.Fatalf("totals %+v over %d namespaces", total, stats.Namespaces)
+	}
+	rsaCert := sctx.client.Certificate.GetX(ctx, rsa.ID)
+	if total.NextExpiry == nil || !total.NextExpiry.Equal(rsaCert.NotAfter.Truncate(time.Second)) {
+		t.Fatalf("next expiry %v, want %v", total.NextExpiry, rsaCert.NotAfter)
+	}
+	if len(stats.ByKeyType) != 2 {
+		t.Fatalf("key type groups %+v", stats.ByKeyType)
+	}
+	for _, group := range stats.ByKeyType {
+		if group.Key == rsaCert.KeyType && (group.Total != 1 || group.Expiring != 1) {
+			t.Fatalf("rsa group %+v", group)
+		}
+		if group.Key != rsaCert.KeyType && (group.Total != 4 || group.CA != 2 || group.Expired != 1) {
+			t.Fatalf("ecdsa group %+v", group)
+		}
+	}
+	if len(stats.BySignatureAlgorithm) == 0 || stats.BySignatureAlgorithm[0].Key > stats.BySignatureAlgorithm[len(stats.BySignatureAlgorithm)-1].Key {
+		t.Fatalf("signature algorithm groups %+v", stats.BySignatureAlgorithm)
+	}
+
+	data, err := json.Marshal(total)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"revoked":null`) {
+		t.Fatalf("stats %s do not report revocation as untracked", data)
+	}
+
+	namespaces, _, err := NewNamespaceService(sctx).ListNamespaces(ctx, ListOptions{})
+	if err != nil {
+		t.Fatalf("list namespaces: %v", err)
+	}
+	for _, n := range namespaces {
+		switch n.ID {
+		case ns.ID:
+			if n.Certificates.Total != 5 || n.Certificates.Expired != 1 || n.Certificates.NextExpiry == nil {
+				t.Fatalf("namespace stats %+v", n.Certificates)
+			}
+		case empty.ID:
+			if n.Certificates.Total != 0 || n.Certificates.NextExpiry != nil {
+				t.Fatalf("empty namespace stats %+v", n.Certificates)
+			}
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/logeable/certmgr/internal/ent/certificate"
+)
+
+const (
+	VerifyKeyUsageAny         = "any"
+	VerifyKeyUsageServer      = "server"
+	VerifyKeyUsageClient      = "client"
+	VerifyKeyUsageCodeSigning = "code_signing"
+
+	RevocationUnknown = "unknown"
+)
+
+// VerifyReq describes a certificate to verify. CertPem may carry the
+// intermediates after the leaf. KeyUsage defaults to any and Time to now.
+type VerifyReq struct {
+	CertPem       string     `json:"certPem"`
+	Intermediates string     `json:"intermediates"`
+	Hostname      string     `json:"hostname"`
+	KeyUsage      string     `json:"keyUsage"`
+	Time          *time.Time `json:"time"`
+}
+
+type VerifiedCert struct {
+	// ID is the certificate in the namespace, 0 for certificates that only
+	// came with the request.
+	ID          int       `json:"id"`
+	Subject     string    `json:"subject"`
+	Issuer      string    `json:"issuer"`
+	Fingerprint string    `json:"fingerprint"`
+	NotBefore   time.Time `json:"notBefore"`
+	NotAfter    time.Time `json:"notAfter"`
+	IsCA        bool      `json:"isCA"`
+}
+
+// VerifyResult reports the chains to the namespace roots, or why none could be
+// built. Reason is a stable code, Error the message from crypto/x509.
+type VerifyResult struct {
+	Valid      bool             `json:"valid"`
+	Reason     string           `json:"reason,omitempty"`
+	Error      string           `json:"error,omitempty"`
+	Chains     [][]VerifiedCert `json:"chains"`
+	Revocation string           `json:"revocation"`
+	// RevocationDetail explains Revocation.
+	RevocationDetail string `json:"revocationDetail"`
+}
+
+// VerifyCertificate verifies a certificate against the CAs of a namespace.
+// Self-signed CAs are the roots, the other CAs are offered as intermediates.
+// A failed verification is reported in the result, errors are only returned
+// for bad input.
+func (s *NamespaceService) VerifyCertificate(ctx context.Context, id int, req VerifyReq) (*VerifyResult, error) {
+	certs, err := parsePemCertificates(req.CertPem)
+	if err != nil {
+		return nil, fmt.Errorf("parse certPem failed: %w", err)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("certPem contains no certificate")
+	}
+	extra, err := parsePemCertificates(req.Intermediates)
+	if err != nil {
+		return nil, fmt.Errorf("parse intermediates failed: %w", err)
+	}
+	keyUsages, err := verifyKeyUsages(req.KeyUsage)
+	if err != nil {
+		return nil, err
+	}
+	at := time.Now()
+	if req.Time != nil {
+		at = *req.Time
+	}
+
+	if _, err := s.ctx.client.Namespace.Get(ctx, id); err != nil {
+		return nil, fmt.Errorf("get namespace %d failed: %w", id, err)
+	}
+	cas, err := s.ctx.client.Certificate.Query().
+		Where(certificate.NamespaceID(id), certificate.IsCa(true)).
+		Select(certificate.FieldCertPem, certificate.FieldIssuerID, certificate.FieldFingerprint).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query CAs of namespace %d failed: %w", id, err)
+	}
+	roots := x509.NewCertPool()
+	intermediates := x509.NewCertPool()
+	known := make(map[string]int, len(cas))
+	for _, ca := range cas {
+		x509Cert, err := getCertFromPem(ca.CertPem)
+		if err != nil {
+			return nil, fmt.Errorf("get cert %d from pem failed: %w", ca.ID, err)
+		}
+		known[ca.Fingerprint] = ca.ID
+		if ca.IssuerID == 0 {
+			roots.AddCert(x509Cert)
+		} else {
+			intermediates.AddCert(x509Cert)
+		}
+	}
+	for _, c := range append(certs[1:], extra...) {
+		intermediates.AddCert(c)
+	}
+	leaf := certs[0]
+	stored, err := s.ctx.client.Certificate.Query().
+		Where(certificate.NamespaceID(id), certificate.Fingerprint(certFingerprint(leaf))).
+		Select(certificate.FieldFingerprint).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query certificate by fingerprint failed: %w", err)
+	}
+	for _, cert := range stored {
+		known[cert.Fingerprint] = cert.ID
+	}
+
+	result := &VerifyResult{
+		Chains:           make([][]VerifiedCert, 0),
+		Revocation:       RevocationUnknown,
+		RevocationDetail: "revocation is not tracked, no CRL is available",
+	}
+	chains, err := leaf.Verify(x509.VerifyOptions{
+		DNSName:       req.Hostname,
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+		KeyUsages:     keyUsages,
+	})
+	if err != nil {
+		result.Reason = verifyFailureReason(err, at)
+		result.Error = err.Error()
+		return result, nil
+	}
+	result.Valid = true
+	for _, chain := range chains {
+		verified := make([]VerifiedCert, 0, len(chain))
+		for _, c := range chain {
+			fp := certFingerprint(c)
+			verified = append(verified, VerifiedCert{
+				ID:          known[fp],
+				Subject:     getSubject(c),
+				Issuer:      c.Issuer.String(),
+				Fingerprint: fp,
+				NotBefore:   c.NotBefore,
+				NotAfter:    c.NotAfter,
+				IsCA:        c.IsCA,
+			})
+		}
+		result.Chains = append(result.Chains, verified)
+	}
+	return result, nil
+}
+
+func parsePemCertificates(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return certs, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse certificate %d failed: %w", len(certs)+1, err)
+		}
+		certs = append(certs, cert)
+	}
+}
+
+func verifyKeyUsages(usage string) ([]x509.ExtKeyUsage, error) {
+	switch usage {
+	case "", VerifyKeyUsageAny:
+		return []x509.ExtKeyUsage{x509.ExtKeyUsageAny}, nil
+	case VerifyKeyUsageServer:
+		return []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, nil
+	case VerifyKeyUsageClient:
+		return []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, nil
+	case VerifyKeyUsageCodeSigning:
+		return []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key usage: %s", usage)
+	}
+}
+
+// verifyFailureReason maps a verification error to a stable code.
+func verifyFailureReason(err error, at time.Time) string {
+	var invalid x509.CertificateInvalidError
+	var hostname x509.HostnameError
+	var unknown x509.UnknownAuthorityError
+	switch {
+	case errors.As(err, &hostname):
+		return "hostname_mismatch"
+	case errors.As(err, &unknown):
+		return "unknown_authority"
+	case errors.As(err, &invalid):
+		switch invalid.Reason {
+		case x509.Expired:
+			if invalid.Cert != nil && at.Before(invalid.Cert.NotBefore) {
+				return "not_yet_valid"
+			}
+			return "expired"
+		case x509.NotAuthorizedToSign:
+			return "issuer_not_authorized_to_sign"
+		case x509.CANotAuthorizedForThisName, x509.CANotAuthorizedForExtKeyUsage:
+			return "issuer_constraints_violated"
+		case x509.TooManyIntermediates:
+			return "path_too_long"
+		case x509.IncompatibleUsage:
+			return "incompatible_key_usage"
+		case x509.NameMismatch:
+			return "issuer_name_mismatch"
+		case x509.UnconstrainedName, x509.NameConstraintsWithoutSANs:
+			return "name_constraints"
+		default:
+			return "invalid_certificate"
+		}
+	default:
+		return "verification_failed"
+	}
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestVerifyCertificate(t *testing.T) {
+	sctx := newTestContext(t)
+	ctx := context.Background()
+	ns, root, inter, leaf := createTestChain(t, sctx)
+	otherNs, _, _, otherLeaf := createTestChain(t, sctx)
+	namespaces := NewNamespaceService(sctx)
+
+	result, err := namespaces.VerifyCertificate(ctx, ns.ID, VerifyReq{CertPem: leaf.CertPem, Hostname: "api.internal", KeyUsage: VerifyKeyUsageServer})
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if !result.Valid || len(result.Chains) != 1 || len(result.Chains[0]) != 3 {
+		t.Fatalf("result %+v, want one chain of three", result)
+	}
+	chain := result.Chains[0]
+	if chain[0].ID != leaf.ID || chain[1].ID != inter.ID || chain[2].ID != root.ID {
+		t.Fatalf("chain ids %d, %d, %d", chain[0].ID, chain[1].ID, chain[2].ID)
+	}
+	if result.Revocation != RevocationUnknown {
+		t.Fatalf("revocation %s", result.Revocation)
+	}
+
+	result, err = namespaces.VerifyCertificate(ctx, otherNs.ID, VerifyReq{CertPem: leaf.CertPem})
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if result.Valid || result.Reason != "unknown_authority" {
+		t.Fatalf("leaf of another namespace: %+v", result)
+	}
+
+	// A certificate that only came with the request has id 0.
+	if err := NewCertificateService(sctx).DeleteCertificate(ctx, otherLeaf.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	result, err = namespaces.VerifyCertificate(ctx, otherNs.ID, VerifyReq{CertPem: otherLeaf.CertPem})
+	if err != nil || !result.Valid {
+		t.Fatalf("deleted leaf: %+v, %v", result, err)
+	}
+	if result.Chains[0][0].ID != 0 || result.Chains[0][1].ID == 0 {
+		t.Fatalf("deleted leaf chain %+v", result.Chains[0])
+	}
+
+	later := time.Now().AddDate(5, 0, 0)
+	earlier := time.Now().AddDate(-1, 0, 0)
+	tests := map[string]VerifyReq{
+		"hostname_mismatch":      {CertPem: leaf.CertPem, Hostname: "other.internal"},
+		"incompatible_key_usage": {CertPem: leaf.CertPem, KeyUsage: VerifyKeyUsageCodeSigning},
+		"expired":                {CertPem: leaf.CertPem, Time: &later},
+		"not_yet_valid":          {CertPem: leaf.CertPem, Time: &earlier},
+	}
+	for reason, req := range tests {
+		result, err := namespaces.VerifyCertificate(ctx, ns.ID, req)
+		if err != nil {
+			t.Fatalf("%s: %v", reason, err)
+		}
+		if result.Valid || result.Reason != reason || result.Error == "" {
+			t.Errorf("%s: got %+v", reason, result)
+		}
+	}
+
+	bad := map[string]VerifyReq{
+		"no certificate": {CertPem: "nothing"},
+		"key usage":      {CertPem: leaf.CertPem, KeyUsage: "email"},
+		"intermediates":  {CertPem: leaf.CertPem, Intermediates: "-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n"},
+	}
+	for name, req := range bad {
+		if _, err := namespaces.VerifyCertificate(ctx, ns.ID, req); err == nil {
+			t.Errorf("bad %s accepted", name)
+		}
+	}
+}
+
+func TestVerifyFailureReason(t *testing.T) {
+	now := time.Now()
+	future := &x509.Certificate{NotBefore: now.Add(time.Hour)}
+	past := &x509.Certificate{NotBefore: now.Add(-time.Hour)}
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{x509.HostnameError{Certificate: past, Host: "x"}, "hostname_mismatch"},
+		{x509.UnknownAuthorityError{}, "unknown_authority"},
+		{x509.CertificateInvalidError{Cert: past, Reason: x509.Expired}, "expired"},
+		{x509.CertificateInvalidError{Cert: future, Reason: x509.Expired}, "not_yet_valid"},
+		{x509.CertificateInvalidError{Reason: x509.NotAuthorizedToSign}, "issuer_not_authorized_to_sign"},
+		{x509.CertificateInvalidError{Reason: x509.CANotAuthorizedForThisName}, "issuer_constraints_violated"},
+		{x509.CertificateInvalidError{Reason: x509.CANotAuthorizedForExtKeyUsage}, "issuer_constraints_violated"},
+		{x509.CertificateInvalidError{Reason: x509.TooManyIntermediates}, "path_too_long"},
+		{x509.CertificateInvalidError{Reason: x509.IncompatibleUsage}, "incompatible_key_usage"},
+		{x509.CertificateInvalidError{Reason: x509.NameMismatch}, "issuer_name_mismatch"},
+		{x509.CertificateInvalidError{Reason: x509.UnconstrainedName}, "name_constraints"},
+		{x509.CertificateInvalidError{Reason: x509.NameConstraintsWithoutSANs}, "name_constraints"},
+		{x509.CertificateInvalidError{Reason: x509.TooManyConstraints}, "invalid_certificate"},
+		{errors.New("boom"), "verification_failed"},
+	}
+	for _, tt := range tests {
+		if got := verifyFailureReason(tt.err, now); got != tt.want {
+			t.Errorf("verifyFailureReason(%v) = %s, want %s", tt.err, got, tt.want)
+		}
+	}
+}
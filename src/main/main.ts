@@ -141,6 +141,24 @@ function handleIPC(serverBaseURL: string) {
     return await handleResponse(fetch(`${serverBaseURL}/${path}`));
   }
 
+  // 列表接口分页返回 { items, meta }，这里逐页读取直到取完全部数据
+  async function doGetAll(path: string, params: Record<string, string> = {}) {
+    const pageSize = 1000;
+    const items: unknown[] = [];
+    for (;;) {
+      const query = new URLSearchParams({
+        ...params,
+        offset: String(items.length),
+        limit: String(pageSize),
+      });
+      const page = await doGet(`${path}?${query}`);
+      items.push(...page.items);
+      if (page.items.length === 0 || items.length >= page.meta.total) {
+        return items;
+      }
+    }
+  }
+
   async function doPost(path: string, body: unknown) {
     return await handleResponse(
       fetch(`${serverBaseURL}/${path}`, {
@@ -170,7 +188,7 @@ function handleIPC(serverBaseURL: string) {
   }
 
   handleWrapper('namespaces:list', async () => {
-    return await doGet('namespaces/');
+    return await doGetAll('namespaces/');
   });
 
   handleWrapper('namespaces:get', async (...args: unknown[]) => {
@@ -193,11 +211,14 @@ function handleIPC(serverBaseURL: string) {
     return await doDelete(`namespaces/${id}`);
   });
 
+  handleWrapper('namespaces:tree', async (...args: unknown[]) => {
+    const [id] = args;
+    return await doGet(`namespaces/${id}/tree`);
+  });
+
   handleWrapper('certificates:list', async (...args: unknown[]) => {
     const [namespaceId] = args;
-    return await doGet(
-      `certificates/?${new URLSearchParams({ namespaceId: String(namespaceId) })}`,
-    );
+    return await doGetAll('certificates/', { namespaceId: String(namespaceId) });
   });
 
   handleWrapper('certificates:create', async (...args: unknown[]) => {
@@ -220,6 +241,11 @@ function handleIPC(serverBaseURL: string) {
     return await doGet(`certificates/${certId}`);
   });
 
+  handleWrapper('certificates:revealKey', async (...args: unknown[]) => {
+    const [certId, body] = args;
+    return await doPost(`certificates/${certId}/private-key`, body);
+  });
+
   handleWrapper('certificates:export', async (...args: unknown[]) => {
     const [certId] = args;
     try {
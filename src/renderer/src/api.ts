@@ -3,14 +3,20 @@ export interface Namespace {
   name: string;
   desc: string;
   certCount: number;
+  caCount: number;
+  leafCount: number;
+  expiredCount: number;
+  expiringCount: number;
+  nextExpiry: number | null;
+  keyRevealDisabled: boolean;
   createdAt: number;
+  updatedAt: number;
 }
 
 export interface Certificate {
   id: number;
   desc: string;
   certPem: string;
-  keyPem: string;
   updatedAt: number;
   createdAt: number;
   subject: string;
@@ -19,8 +25,21 @@ export interface Certificate {
   usage: string;
 }
 
+export interface CertificateNode {
+  id: number;
+  issuerId: number;
+  desc: string;
+  subject: string;
+  isCA: boolean;
+  usage: string;
+  keyType: string;
+  notAfter: string;
+  children: CertificateNode[];
+}
+
 export interface CertificateDetail extends Certificate {
   issuerSubject: string;
+  keyRevealable: boolean;
   keyType: string;
   keyLen: number;
   eccCurve: string;
@@ -32,6 +51,10 @@ export interface CertificateDetail extends Certificate {
   dnsNames: string[];
   ipAddresses: string[];
   usage: string;
+  serialNumber: string;
+  fingerprintSha1: string;
+  fingerprintSha256: string;
+  spkiPinSha256: string;
 }
 
 const api = {
@@ -50,15 +73,23 @@ const api = {
       }
       throw new Error(res.error);
     },
-    create: async (name: string, desc: string) => {
-      const res = await window.request_server<{ id: number }>('namespaces:create', { name, desc });
+    create: async (name: string, desc: string, keyRevealDisabled: boolean) => {
+      const res = await window.request_server<{ id: number }>('namespaces:create', {
+        name,
+        desc,
+        keyRevealDisabled,
+      });
       if (res.success) {
         return res.data;
       }
       throw new Error(res.error);
     },
-    update: async (id: string, name: string, desc: string) => {
-      const res = await window.request_server<Namespace>('namespaces:update', id, { name, desc });
+    update: async (id: string, name: string, desc: string, keyRevealDisabled: boolean) => {
+      const res = await window.request_server<Namespace>('namespaces:update', id, {
+        name,
+        desc,
+        keyRevealDisabled,
+      });
       if (res.success) {
         return res.data;
       }
@@ -71,6 +102,13 @@ const api = {
       }
       throw new Error(res.error);
     },
+    tree: async (id: string) => {
+      const res = await window.request_server<CertificateNode[]>('namespaces:tree', id);
+      if (res.success) {
+        return res.data;
+      }
+      throw new Error(res.error);
+    },
   },
   certificates: {
     list: async (namespaceId: string) => {
@@ -157,6 +195,17 @@ const api = {
         return res.data;
       }
     },
+    revealKey: async (certId: number, reason: string) => {
+      const res = await window.request_server<{ keyPem: string }>(
+        'certificates:revealKey',
+        certId,
+        { reason },
+      );
+      if (res.success) {
+        return res.data.keyPem;
+      }
+      throw new Error(res.error);
+    },
     export: async (certId: number) => {
       const res = await window.request_server<void>('certificates:export', certId);
       if (res.success) {
@@ -1,19 +1,30 @@
 import { useEffect, useState } from 'react';
-import { Modal, Descriptions, Tag, Space, Typography, Collapse, Button, Spin, App } from 'antd';
+import {
+  Modal,
+  Descriptions,
+  Tag,
+  Space,
+  Typography,
+  Collapse,
+  Button,
+  Spin,
+  App,
+  Input,
+} from 'antd';
 import {
   SafetyCertificateOutlined,
   InfoCircleOutlined,
   EyeOutlined,
   CopyOutlined,
 } from '@ant-design/icons';
-import api, { Certificate, CertificateDetail } from '../../api';
+import api, { CertificateDetail, CertificateNode } from '../../api';
 
 const { Title, Paragraph } = Typography;
 const { Panel } = Collapse;
 
 interface Props {
   open: boolean;
-  cert: Certificate | null;
+  cert: CertificateNode | null;
   onClose: () => void;
 }
 
@@ -33,9 +44,14 @@ function parseSubject(subject: string) {
 export default function CertificateDetailModal({ open, cert, onClose }: Props) {
   const [detail, setDetail] = useState<CertificateDetail | null>(null);
   const [loading, setLoading] = useState(false);
+  const [keyPem, setKeyPem] = useState<string | null>(null);
+  const [revealReason, setRevealReason] = useState('');
+  const [revealing, setRevealing] = useState(false);
   const { message } = App.useApp();
 
   useEffect(() => {
+    setKeyPem(null);
+    setRevealReason('');
     if (open && cert) {
       setLoading(true);
       api.certificates
@@ -53,6 +69,22 @@ export default function CertificateDetailModal({ open, cert, onClose }: Props) {
 
   const subject = parseSubject(detail?.subject || '');
 
+  // 私钥不随详情返回，需要单独请求，每次查看都会记入审计日志
+  const handleRevealKey = async () => {
+    if (!detail) {
+      return;
+    }
+    setRevealing(true);
+    try {
+      setKeyPem(await api.certificates.revealKey(detail.id, revealReason));
+    } catch (error) {
+      message.error('查看私钥失败');
+      console.error('Failed to reveal private key:', error);
+    } finally {
+      setRevealing(false);
+    }
+  };
+
   return (
     <Modal
       title={
@@ -172,6 +204,34 @@ export default function CertificateDetailModal({ open, cert, onClose }: Props) {
               </Descriptions.Item>
             </Descriptions>
 
+            {/* 指纹 */}
+            <Title level={5} style={{ marginBottom: 16 }}>
+              <InfoCircleOutlined style={{ marginRight: 8, color: '#1890ff' }} />
+              指纹
+            </Title>
+            <Descriptions bordered column={1} size="small" style={{ marginBottom: 24 }}>
+              <Descriptions.Item label="序列号">
+                <Paragraph copyable style={{ marginBottom: 0 }}>
+                  {detail.serialNumber}
+                </Paragraph>
+              </Descriptions.Item>
+              <Descriptions.Item label="SHA-1">
+                <Paragraph copyable style={{ marginBottom: 0 }}>
+                  {detail.fingerprintSha1}
+                </Paragraph>
+              </Descriptions.Item>
+              <Descriptions.Item label="SHA-256">
+                <Paragraph copyable style={{ marginBottom: 0 }}>
+                  {detail.fingerprintSha256}
+                </Paragraph>
+              </Descriptions.Item>
+              <Descriptions.Item label="SPKI Pin (SHA-256)">
+                <Paragraph copyable style={{ marginBottom: 0 }}>
+                  {detail.spkiPinSha256}
+                </Paragraph>
+              </Descriptions.Item>
+            </Descriptions>
+
             {/* 证书原文 */}
             <Collapse style={{ marginBottom: 16 }}>
               <Panel
@@ -202,7 +262,7 @@ export default function CertificateDetailModal({ open, cert, onClose }: Props) {
               </Panel>
             </Collapse>
             {/* 密钥原文 */}
-            {detail.keyPem && (
+            {detail.keyRevealable && (
               <Collapse style={{ marginBottom: 16 }}>
                 <Panel
                   header={
@@ -213,30 +273,44 @@ export default function CertificateDetailModal({ open, cert, onClose }: Props) {
                   }
                   key="keyPem"
                 >
-                  <Paragraph
-                    style={{
-                      whiteSpace: 'pre',
-                      fontFamily: 'monospace',
-                      fontSize: 13,
-                      color: '#ff4d4f',
-                    }}
-                  >
-                    {detail.keyPem}
-                  </Paragraph>
-                  <Button
-                    icon={<CopyOutlined />}
-                    size="small"
-                    danger
-                    onClick={async () => {
-                      if (detail.keyPem) {
-                        await navigator.clipboard.writeText(detail.keyPem);
-                        message.success('密钥内容已复制');
-                      }
-                    }}
-                    style={{ float: 'right' }}
-                  >
-                    复制密钥内容
-                  </Button>
+                  {keyPem ? (
+                    <>
+                      <Paragraph
+                        style={{
+                          whiteSpace: 'pre',
+                          fontFamily: 'monospace',
+                          fontSize: 13,
+                          color: '#ff4d4f',
+                        }}
+                      >
+                        {keyPem}
+                      </Paragraph>
+                      <Button
+                        icon={<CopyOutlined />}
+                        size="small"
+                        danger
+                        onClick={async () => {
+                          await navigator.clipboard.writeText(keyPem);
+                          message.success('密钥内容已复制');
+                        }}
+                        style={{ float: 'right' }}
+                      >
+                        复制密钥内容
+                      </Button>
+                    </>
+                  ) : (
+                    <Space.Compact style={{ width: '100%' }}>
+                      <Input
+                        placeholder="查看原因（可选，记入审计日志）"
+                        value={revealReason}
+                        onChange={e => setRevealReason(e.target.value)}
+                        onPressEnter={handleRevealKey}
+                      />
+                      <Button danger loading={revealing} onClick={handleRevealKey}>
+                        查看私钥
+                      </Button>
+                    </Space.Compact>
+                  )}
                 </Panel>
               </Collapse>
             )}
@@ -30,7 +30,7 @@ import {
 import CreateCertModal from './CreateCertModal';
 import CertificateDetailModal from './CertificateDetailModal';
 import RenewCertModal from './RenewCertModal';
-import api, { Certificate, Namespace } from '../../api';
+import api, { CertificateNode, Namespace } from '../../api';
 
 const { Title } = Typography;
 const { Option } = Select;
@@ -38,13 +38,13 @@ const { Option } = Select;
 export default function CertificateManager() {
   const [namespaces, setNamespaces] = useState<Namespace[]>([]);
   const [selectedNs, setSelectedNs] = useState('');
-  const [certs, setCerts] = useState<Certificate[]>([]);
+  const [tree, setTree] = useState<CertificateNode[]>([]);
   const [showCreate, setShowCreate] = useState(false);
   const [issuerId, setIssuerId] = useState(0);
   const [showDetail, setShowDetail] = useState(false);
-  const [detailCert, setDetailCert] = useState<Certificate | null>(null);
+  const [detailCert, setDetailCert] = useState<CertificateNode | null>(null);
   const [showRenew, setShowRenew] = useState(false);
-  const [renewCert, setRenewCert] = useState<Certificate | null>(null);
+  const [renewCert, setRenewCert] = useState<CertificateNode | null>(null);
   const [namespacesLoading, setNamespacesLoading] = useState(false);
   const [certsLoading, setCertsLoading] = useState(false);
   const { message, modal } = App.useApp();
@@ -52,6 +52,7 @@ export default function CertificateManager() {
   const [showTreeHelp, setShowTreeHelp] = useState(false);
   const [showDeleteConfirm, setShowDeleteConfirm] = useState(false);
   const [showInstallRootConfirm, setShowInstallRootConfirm] = useState(false);
+  const certs = flattenTree(tree);
 
   useEffect(() => {
     const fetchNamespaces = async () => {
@@ -76,8 +77,7 @@ export default function CertificateManager() {
       if (selectedNs) {
         setCertsLoading(true);
         try {
-          const list = await api.certificates.list(selectedNs);
-          setCerts(list);
+          setTree(await api.namespaces.tree(selectedNs));
         } catch (error) {
           message.error('获取证书列表失败');
           console.error('Failed to fetch certificates:', error);
@@ -127,7 +127,7 @@ export default function CertificateManager() {
     showCreate,
     showRenew,
     showTreeHelp,
-    certs,
+    tree,
     showDeleteConfirm,
     showInstallRootConfirm,
   ]);
@@ -244,11 +244,11 @@ export default function CertificateManager() {
     if (selectedNs) {
       try {
         // 同时刷新证书列表和空间列表
-        const [certList, namespaceList] = await Promise.all([
-          api.certificates.list(selectedNs),
+        const [certTree, namespaceList] = await Promise.all([
+          api.namespaces.tree(selectedNs),
           api.namespaces.list(),
         ]);
-        setCerts(certList);
+        setTree(certTree);
         setNamespaces(namespaceList);
       } catch (error) {
         message.error('刷新数据失败');
@@ -339,7 +339,7 @@ export default function CertificateManager() {
                     />
                   </div>
                   <TreeWithContextMenu
-                    certs={certs}
+                    tree={tree}
                     onIssue={onIssue}
                     onDelete={onDelete}
                     onViewDetails={onViewDetails}
@@ -412,7 +412,7 @@ export default function CertificateManager() {
 }
 
 const TreeWithContextMenu = ({
-  certs,
+  tree,
   onIssue,
   onViewDetails,
   onRenew,
@@ -421,7 +421,7 @@ const TreeWithContextMenu = ({
   onInstallRootCert,
   setSelectedCertId,
 }: {
-  certs: Certificate[];
+  tree: CertificateNode[];
   onIssue: (id: number) => void;
   onViewDetails: (id: number) => void;
   onRenew: (id: number) => void;
@@ -459,7 +459,7 @@ const TreeWithContextMenu = ({
     },
     // 只有CA证书才显示签发菜单
     ...(contextMenuInfo.node &&
-    certs.find(c => c.id === (contextMenuInfo.node?.key as number) && c.isCA)
+    flattenTree(tree).find(c => c.id === (contextMenuInfo.node?.key as number) && c.isCA)
       ? [
           {
             key: 'issue',
@@ -540,11 +540,11 @@ const TreeWithContextMenu = ({
     }
   };
 
-  const treeData = convertCert(certs);
+  const treeData = convertCert(tree);
 
   useEffect(() => {
-    setExpandedKeys(certs.map(item => item.id));
-  }, [certs]);
+    setExpandedKeys(flattenTree(tree).map(item => item.id));
+  }, [tree]);
 
   return (
     <div>
@@ -591,12 +591,10 @@ const TreeWithContextMenu = ({
   );
 };
 
-function convertCert(certs: Certificate[]): TreeDataNode[] {
-  const certMap = new Map<number, TreeDataNode>();
-
-  // 将所有证书转换为 Cert 并存入 Map
-  certs.forEach(cert => {
-    const title = (
+function convertCert(nodes: CertificateNode[]): TreeDataNode[] {
+  return nodes.map(cert => ({
+    key: cert.id,
+    title: (
       <Tooltip title={cert.desc || '无描述'}>
         <span style={{ userSelect: 'none' }}>
           {cert.subject}
@@ -607,23 +605,12 @@ function convertCert(certs: Certificate[]): TreeDataNode[] {
           )}
         </span>
       </Tooltip>
-    );
-    certMap.set(cert.id, { key: cert.id, title, children: [] });
-  });
-
-  const root: TreeDataNode[] = [];
-
-  // 组织树形结构
-  certs.forEach(cert => {
-    const currentCert = certMap.get(cert.id)!;
-    if (cert.issuerId === 0 || !certMap.has(cert.issuerId)) {
-      // 如果 issuerId 为 0 或找不到父级，认为是根节点
-      root.push(currentCert);
-    } else {
-      const parent = certMap.get(cert.issuerId)!;
-      parent.children!.push(currentCert);
-    }
-  });
+    ),
+    children: convertCert(cert.children),
+  }));
+}
 
-  return root;
+// 后端返回的是嵌套结构，按 id 查找证书时展开成列表
+function flattenTree(nodes: CertificateNode[]): CertificateNode[] {
+  return nodes.flatMap(node => [node, ...flattenTree(node.children)]);
 }
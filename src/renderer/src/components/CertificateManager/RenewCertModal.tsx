@@ -1,11 +1,11 @@
 import { useState } from 'react';
 import { Modal, Form, InputNumber, Button, Space, Alert, App } from 'antd';
 import { ReloadOutlined, ClockCircleOutlined } from '@ant-design/icons';
-import api, { Certificate } from '../../api';
+import api, { CertificateNode } from '../../api';
 
 interface Props {
   open: boolean;
-  cert: Certificate | null;
+  cert: CertificateNode | null;
   onClose: () => void;
   onSuccess: () => void;
 }
@@ -1,5 +1,17 @@
 import { useState, useEffect } from 'react';
-import { Table, Button, Modal, Form, Input, Space, Typography, Popconfirm, Tag, App } from 'antd';
+import {
+  Table,
+  Button,
+  Modal,
+  Form,
+  Input,
+  Space,
+  Typography,
+  Popconfirm,
+  Tag,
+  App,
+  Switch,
+} from 'antd';
 import { PlusOutlined, EditOutlined, DeleteOutlined, SearchOutlined } from '@ant-design/icons';
 import type { ColumnsType } from 'antd/es/table';
 import api, { Namespace } from '../../api';
@@ -11,6 +23,7 @@ const { TextArea } = Input;
 interface NamespaceFormData {
   name: string;
   desc: string;
+  keyRevealDisabled: boolean;
 }
 
 export default function NamespaceManager() {
@@ -48,6 +61,7 @@ export default function NamespaceManager() {
       form.setFieldsValue({
         name: namespace.name,
         desc: namespace.desc,
+        keyRevealDisabled: namespace.keyRevealDisabled,
       });
     } else {
       form.resetFields();
@@ -71,7 +85,12 @@ export default function NamespaceManager() {
       if (editingNamespace) {
         // 编辑
         try {
-          await api.namespaces.update(editingNamespace.id, values.name, values.desc);
+          await api.namespaces.update(
+            editingNamespace.id,
+            values.name,
+            values.desc,
+            values.keyRevealDisabled ?? false,
+          );
         } catch (error) {
           message.error('编辑空间失败');
           console.error('Failed to update namespace:', error);
@@ -81,7 +100,7 @@ export default function NamespaceManager() {
       } else {
         // 新建
         try {
-          await api.namespaces.create(values.name, values.desc);
+          await api.namespaces.create(values.name, values.desc, values.keyRevealDisabled ?? false);
         } catch (error) {
           message.error('创建空间失败');
           console.error('Failed to create namespace:', error);
@@ -149,6 +168,27 @@ export default function NamespaceManager() {
       render: (count: number) => <Tag color={count > 0 ? 'blue' : 'default'}>{count} 个证书</Tag>,
       sorter: (a, b) => a.certCount - b.certCount,
     },
+    {
+      title: '过期情况',
+      key: 'expiry',
+      render: (_, record) => (
+        <Space size={4}>
+          {record.expiredCount > 0 && <Tag color="red">{record.expiredCount} 个已过期</Tag>}
+          {record.expiringCount > 0 && (
+            <Tag color="orange">{record.expiringCount} 个 30 天内过期</Tag>
+          )}
+          {record.expiredCount === 0 && record.expiringCount === 0 && (
+            <span style={{ color: '#999' }}>
+              {record.nextExpiry
+                ? `最近过期 ${new Date(record.nextExpiry * 1000).toLocaleDateString()}`
+                : '-'}
+            </span>
+          )}
+        </Space>
+      ),
+      sorter: (a, b) =>
+        (a.nextExpiry ?? Number.MAX_SAFE_INTEGER) - (b.nextExpiry ?? Number.MAX_SAFE_INTEGER),
+    },
     {
       title: '描述',
       dataIndex: 'desc',
@@ -272,6 +312,15 @@ export default function NamespaceManager() {
               style={{ resize: 'none' }}
             />
           </Form.Item>
+
+          <Form.Item
+            name="keyRevealDisabled"
+            label="禁止查看私钥"
+            valuePropName="checked"
+            tooltip="开启后无法查看或导出该空间证书的私钥，备份和空间归档中也不包含这些私钥"
+          >
+            <Switch />
+          </Form.Item>
         </Form>
       </Modal>
     </div>